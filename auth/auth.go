@@ -0,0 +1,71 @@
+// Package auth holds the caller identity established by the authentication middlewares
+package auth
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Method is the authentication scheme a principal was established with
+type Method string
+
+// Authentication methods set by the middleware package
+const (
+	MethodBasic  Method = "basic"
+	MethodBearer Method = "bearer"
+	MethodAPIKey Method = "api_key"
+	MethodJWT    Method = "jwt"
+)
+
+// PrincipalKey is the gin context key holding the *Principal, prefer PrincipalFromContext
+const PrincipalKey = "ginpb.auth.principal"
+
+// principalKey is the standard context key holding the *Principal
+type principalKey struct{}
+
+// Principal is the authenticated caller
+type Principal struct {
+	// Subject identifies the caller, e.g. the user id or basic auth user name
+	Subject string
+	// Method is the scheme the caller authenticated with
+	Method Method
+	// Credential is the raw token, API key or JWT the caller presented, empty for basic auth
+	Credential string
+	// Scopes are the scopes granted to the caller, checked by middleware.Authorize
+	Scopes []string
+	// Claims carries additional attributes such as JWT claims
+	Claims map[string]interface{}
+}
+
+// SetPrincipal stores p in the gin context and bridges it into the request context,
+// so it is visible to middlewares as well as to service methods receiving a context.Context
+func SetPrincipal(c *gin.Context, p *Principal) {
+	c.Set(PrincipalKey, p)
+	c.Request = c.Request.WithContext(NewContext(c.Request.Context(), p))
+}
+
+// NewContext returns a copy of ctx carrying p
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal of the caller, ctx may be a *gin.Context,
+// the context passed to service methods or the request context
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	if p, ok := ctx.Value(principalKey{}).(*Principal); ok && p != nil {
+		return p, true
+	}
+	// gin.Context resolves string keys from its own keys, also through derived contexts
+	p, ok := ctx.Value(PrincipalKey).(*Principal)
+	return p, ok && p != nil
+}
+
+// TokenFromContext returns the credential the caller presented
+func TokenFromContext(ctx context.Context) (string, bool) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok || p.Credential == "" {
+		return "", false
+	}
+	return p.Credential, true
+}
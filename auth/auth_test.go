@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrincipalFromContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	_, ok := PrincipalFromContext(c)
+	assert.False(t, ok)
+
+	SetPrincipal(c, &Principal{Subject: "u1", Method: MethodBearer, Credential: "t0k"})
+
+	// The gin context, the context handed to service methods and the request context all see it
+	for name, ctx := range map[string]func() (*Principal, bool){
+		"gin":      func() (*Principal, bool) { return PrincipalFromContext(c) },
+		"metadata": func() (*Principal, bool) { return PrincipalFromContext(metadata.NewContext(c)) },
+		"request":  func() (*Principal, bool) { return PrincipalFromContext(c.Request.Context()) },
+	} {
+		p, ok := ctx()
+		require.True(t, ok, name)
+		assert.Equal(t, "u1", p.Subject, name)
+	}
+
+	token, ok := TokenFromContext(c)
+	assert.True(t, ok)
+	assert.Equal(t, "t0k", token)
+}
@@ -1,10 +1,16 @@
 package binding
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	ginbinding "github.com/gin-gonic/gin/binding"
+	"google.golang.org/protobuf/proto"
 )
 
 // BindByContentType automatically selects the appropriate binding method based on Content-Type header.
@@ -18,24 +24,188 @@ import (
 //   - Multipart Form
 //   - URL-encoded Form
 func BindByContentType(ctx *gin.Context, obj any) error {
+	err := bindByContentType(ctx, obj)
+	if err != nil {
+		_ = ctx.AbortWithError(err.(*Error).Status, err).SetType(gin.ErrorTypeBind)
+	}
+	return err
+}
+
+// Error is a request that could not be bound, e.g. a malformed body, a header or query parameter of the wrong
+// type or a field violating its binding rules. The functions taking a Config and the other helpers used by
+// generated handlers return it without writing the response, the handlers pass it to their ErrorEncoder.
+type Error struct {
+	// Status is 413 for bodies over Config.MaxBodyBytes, 400 otherwise
+	Status int
+	Err    error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// bindError wraps err in an Error with 413 when it comes from reading past MaxBodyBytes, with 400 otherwise
+func bindError(err error) error {
+	if err == nil {
+		return nil
+	}
+	status := http.StatusBadRequest
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	return &Error{Status: status, Err: err}
+}
+
+// bindByContentType binds obj with the binding of the Content-Type header without writing the response
+func bindByContentType(ctx *gin.Context, obj any) error {
 	contentType := ctx.GetHeader("Content-Type")
 	switch {
 	case strings.Contains(contentType, "application/xml") || strings.Contains(contentType, "text/xml"):
-		return ctx.BindXML(obj)
+		return bindError(ctx.ShouldBindXML(obj))
 	case strings.Contains(contentType, "application/x-yaml") || strings.Contains(contentType, "text/yaml"):
-		return ctx.BindYAML(obj)
+		return bindError(ctx.ShouldBindYAML(obj))
 	case strings.Contains(contentType, "application/toml"):
-		return ctx.BindTOML(obj)
+		return bindError(ctx.ShouldBindTOML(obj))
 	case strings.Contains(contentType, "application/x-protobuf"):
-		return ctx.ShouldBindWith(obj, ginbinding.ProtoBuf)
+		return bindError(ctx.ShouldBindWith(obj, ginbinding.ProtoBuf))
 	case strings.Contains(contentType, "application/x-msgpack"):
-		return ctx.ShouldBindWith(obj, ginbinding.MsgPack)
+		return bindError(ctx.ShouldBindWith(obj, ginbinding.MsgPack))
 	case strings.Contains(contentType, "multipart/form-data"):
-		return ctx.ShouldBindWith(obj, ginbinding.FormMultipart)
+		return bindError(ctx.ShouldBindWith(obj, ginbinding.FormMultipart))
 	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
-		return ctx.Bind(obj)
+		return bindError(ctx.ShouldBind(obj))
 	default:
 		// Default to JSON binding for application/json and other content types
-		return ctx.BindJSON(obj)
+		return bindError(ctx.ShouldBindJSON(obj))
+	}
+}
+
+// Config defines limits applied when binding request bodies
+type Config struct {
+	// MaxBodyBytes rejects bodies larger than the limit, zero means unlimited
+	MaxBodyBytes int64
+
+	// MaxMultipartMemory is the memory used to parse multipart forms before spilling files to disk,
+	// zero keeps gin's 32MB default
+	MaxMultipartMemory int64
+}
+
+// DefaultConfig returns a default binding configuration
+func DefaultConfig() Config {
+	return Config{
+		MaxBodyBytes:       0,
+		MaxMultipartMemory: 0,
+	}
+}
+
+// BindByContentTypeWithConfig binds like BindByContentType while honoring the size limits in config,
+// failures are returned as Error without writing the response
+func BindByContentTypeWithConfig(ctx *gin.Context, obj any, config Config) error {
+	if err := limitBody(ctx, config); err != nil {
+		return err
+	}
+
+	if strings.Contains(ctx.GetHeader("Content-Type"), "multipart/form-data") && config.MaxMultipartMemory > 0 {
+		// Parse with the configured limit first; gin's binding then reuses the parsed form
+		if err := ctx.Request.ParseMultipartForm(config.MaxMultipartMemory); err != nil {
+			return bindError(err)
+		}
+	}
+	return bindByContentType(ctx, obj)
+}
+
+// limitBody rejects bodies larger than config.MaxBodyBytes
+func limitBody(ctx *gin.Context, config Config) error {
+	if config.MaxBodyBytes > 0 && ctx.Request.ContentLength > config.MaxBodyBytes {
+		// Reject before reading so Expect: 100-continue clients never upload the body
+		err := fmt.Errorf("request body of %d bytes exceeds the limit of %d bytes", ctx.Request.ContentLength, config.MaxBodyBytes)
+		return &Error{Status: http.StatusRequestEntityTooLarge, Err: err}
+	}
+	if config.MaxBodyBytes > 0 && ctx.Request.Body != nil {
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, config.MaxBodyBytes)
+	}
+	return nil
+}
+
+// IsProtobuf reports whether the request body is application/x-protobuf
+func IsProtobuf(ctx *gin.Context) bool {
+	return strings.Contains(ctx.GetHeader("Content-Type"), ginbinding.MIMEPROTOBUF)
+}
+
+// BindProtobufWithConfig decodes the protobuf body into msg honoring the size limit in config, failures are
+// returned as Error. Generated handlers bind protobuf bodies into the request message and copy it into their gin struct,
+// which is checked with Validate afterwards.
+func BindProtobufWithConfig(ctx *gin.Context, msg proto.Message, config Config) error {
+	if err := limitBody(ctx, config); err != nil {
+		return err
+	}
+	var b []byte
+	var err error
+	if ctx.Request.Body != nil {
+		b, err = io.ReadAll(ctx.Request.Body)
+	}
+	if err == nil {
+		err = proto.Unmarshal(b, msg)
+	}
+	if err != nil {
+		return bindError(fmt.Errorf("decode protobuf body as %s: %w", proto.MessageName(msg), err))
+	}
+	return nil
+}
+
+// Validate checks obj against its binding tags like gin's binding does, failures are returned as Error
+func Validate(ctx *gin.Context, obj any) error {
+	return bindError(ginbinding.Validator.ValidateStruct(obj))
+}
+
+// BindQuery binds the query parameters like gin's ShouldBindQuery, failures are returned as Error
+func BindQuery(ctx *gin.Context, obj any) error {
+	return bindError(ctx.ShouldBindQuery(obj))
+}
+
+// BindUri binds the path parameters like gin's ShouldBindUri, failures are returned as Error
+func BindUri(ctx *gin.Context, obj any) error {
+	return bindError(ctx.ShouldBindUri(obj))
+}
+
+// BindHeader maps the request headers named by the header tags of obj without validating it. Generated handlers
+// bind headers before the body, query and path, whose binding validates the whole struct, so required headers
+// are present by then. Header names match case-insensitively. Failures are returned as Error.
+func BindHeader(ctx *gin.Context, obj any) error {
+	form := make(map[string][]string)
+	for _, name := range headerTags(reflect.TypeOf(obj), nil) {
+		if values := ctx.Request.Header.Values(name); len(values) > 0 {
+			form[name] = values
+		}
+	}
+	return bindError(ginbinding.MapFormWithTag(obj, form, "header"))
+}
+
+// headerTags returns the header tag names of t and the structs it embeds or holds, seen guards recursive types
+func headerTags(t reflect.Type, seen map[reflect.Type]bool) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return nil
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[t] = true
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if name, _, _ := strings.Cut(f.Tag.Get("header"), ","); name != "" && name != "-" {
+			names = append(names, name)
+			continue
+		}
+		names = append(names, headerTags(f.Type, seen)...)
 	}
+	return names
 }
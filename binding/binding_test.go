@@ -0,0 +1,121 @@
+package binding
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type user struct {
+	Name string `json:"name" form:"name" binding:"required"`
+}
+
+func newContext(body io.Reader, contentType string, contentLength int64) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/users", body)
+	c.Request.Header.Set("Content-Type", contentType)
+	c.Request.ContentLength = contentLength
+	return c, w
+}
+
+// statusOf returns the status of the Error err
+func statusOf(t *testing.T, err error) int {
+	var bindErr *Error
+	require.ErrorAs(t, err, &bindErr)
+	return bindErr.Status
+}
+
+func TestBindByContentType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var u user
+	c, _ := newContext(strings.NewReader(`{"name":"alice"}`), "application/json", -1)
+	require.NoError(t, BindByContentType(c, &u))
+	assert.Equal(t, "alice", u.Name)
+
+	// Failures abort with 400 like gin's Bind
+	c, w := newContext(strings.NewReader(`{"name":""}`), "application/json", -1)
+	assert.Equal(t, http.StatusBadRequest, statusOf(t, BindByContentType(c, &user{})))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, c.IsAborted())
+}
+
+func TestBindByContentTypeWithConfigMaxBodyBytes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"name":"alice"}`
+	config := Config{MaxBodyBytes: int64(len(body)) - 1}
+
+	// Bodies declaring a larger length are rejected with 413 before anything is read
+	reader := strings.NewReader(body)
+	c, _ := newContext(reader, "application/json", int64(len(body)))
+	err := BindByContentTypeWithConfig(c, &user{}, config)
+	assert.EqualError(t, err, "request body of 16 bytes exceeds the limit of 15 bytes")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, statusOf(t, err))
+	assert.Equal(t, len(body), reader.Len(), "the body is not read")
+	assert.False(t, c.Writer.Written(), "the response is left to the error encoder")
+
+	// Bodies of unknown length, e.g. chunked ones, fail with 413 once they read past the limit
+	for contentType, bind := range map[string]func(*gin.Context) error{
+		"application/json":       func(c *gin.Context) error { return BindByContentTypeWithConfig(c, &user{}, config) },
+		"application/x-protobuf": func(c *gin.Context) error { return BindProtobufWithConfig(c, &emptypb.Empty{}, config) },
+	} {
+		c, _ = newContext(strings.NewReader(body), contentType, -1)
+		err = bind(c)
+		var maxErr *http.MaxBytesError
+		assert.True(t, errors.As(err, &maxErr), contentType)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, statusOf(t, err), contentType)
+	}
+
+	// Malformed bodies within the limit are still rejected with 400
+	c, _ = newContext(strings.NewReader(`{"name":`), "application/json", -1)
+	assert.Equal(t, http.StatusBadRequest, statusOf(t, BindByContentTypeWithConfig(c, &user{}, config)))
+
+	// Bodies within the limit bind
+	var u user
+	c, _ = newContext(strings.NewReader(body), "application/json", int64(len(body)))
+	require.NoError(t, BindByContentTypeWithConfig(c, &u, Config{MaxBodyBytes: int64(len(body))}))
+	assert.Equal(t, "alice", u.Name)
+}
+
+func TestBindByContentTypeWithConfigMultipartMemory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	require.NoError(t, mw.WriteField("name", "alice"))
+	fw, err := mw.CreateFormFile("avatar", "avatar.png")
+	require.NoError(t, err)
+	_, err = fw.Write(bytes.Repeat([]byte{0xff}, 4096))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	// onDisk binds the form with limit and reports whether the file was spilled to disk
+	onDisk := func(limit int64) bool {
+		c, _ := newContext(bytes.NewReader(buf.Bytes()), mw.FormDataContentType(), int64(buf.Len()))
+		var u user
+		require.NoError(t, BindByContentTypeWithConfig(c, &u, Config{MaxMultipartMemory: limit}))
+		assert.Equal(t, "alice", u.Name)
+		defer c.Request.MultipartForm.RemoveAll()
+		f, err := c.Request.MultipartForm.File["avatar"][0].Open()
+		require.NoError(t, err)
+		defer f.Close()
+		_, ok := f.(*os.File)
+		return ok
+	}
+	assert.True(t, onDisk(1024), "files above the limit are written to disk")
+	assert.False(t, onDisk(0), "zero keeps gin's 32MB default")
+
+	// Malformed forms are rejected with 400
+	c, _ := newContext(strings.NewReader("not a form"), mw.FormDataContentType(), 10)
+	assert.Equal(t, http.StatusBadRequest, statusOf(t, BindByContentTypeWithConfig(c, &user{}, Config{MaxMultipartMemory: 1024})))
+}
@@ -0,0 +1,74 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// Encodings of ginpb.bytes_encoding
+const (
+	BytesBase64    = jsonfield.Base64
+	BytesBase64URL = jsonfield.Base64URL
+	BytesHex       = jsonfield.Hex
+	BytesRaw       = jsonfield.Raw
+)
+
+// EncodeBytes encodes b with one of the bytes encodings, generated clients use it for path parameters
+func EncodeBytes(b []byte, encoding string) string {
+	return jsonfield.EncodeBytes(b, encoding)
+}
+
+// DecodeBytes decodes s encoded with one of the bytes encodings
+func DecodeBytes(s, encoding string) ([]byte, error) {
+	return jsonfield.DecodeBytes(s, encoding)
+}
+
+// HexBytes is the type of bytes fields with bytes_encoding "hex" in generated gin structs. It holds the
+// decoded bytes, so length rules like len=32 count bytes.
+type HexBytes string
+
+// UnmarshalJSON decodes a hex string
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	return unmarshalBytesJSON(data, (*string)(b), BytesHex)
+}
+
+// UnmarshalParam decodes a hex query or path parameter
+func (b *HexBytes) UnmarshalParam(param string) error {
+	return unmarshalBytes(param, (*string)(b), BytesHex)
+}
+
+// Base64URLBytes is the type of bytes fields with bytes_encoding "base64url" in generated gin structs,
+// padding is optional
+type Base64URLBytes string
+
+// UnmarshalJSON decodes a URL-safe base64 string
+func (b *Base64URLBytes) UnmarshalJSON(data []byte) error {
+	return unmarshalBytesJSON(data, (*string)(b), BytesBase64URL)
+}
+
+// UnmarshalParam decodes a URL-safe base64 query or path parameter
+func (b *Base64URLBytes) UnmarshalParam(param string) error {
+	return unmarshalBytes(param, (*string)(b), BytesBase64URL)
+}
+
+func unmarshalBytesJSON(data []byte, v *string, encoding string) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid %s bytes %s: expected a JSON string", encoding, data)
+	}
+	return unmarshalBytes(s, v, encoding)
+}
+
+func unmarshalBytes(s string, v *string, encoding string) error {
+	b, err := jsonfield.DecodeBytes(s, encoding)
+	if err != nil {
+		return err
+	}
+	*v = string(b)
+	return nil
+}
@@ -0,0 +1,30 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodedBytesBinding(t *testing.T) {
+	var v struct {
+		Hash  HexBytes       `json:"hash" form:"hash" binding:"len=2"`
+		Token Base64URLBytes `json:"token" form:"token"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"hash":"FBff","token":"-_8"}`), &v))
+	assert.Equal(t, []byte{0xfb, 0xff}, []byte(v.Hash))
+	assert.Equal(t, []byte{0xfb, 0xff}, []byte(v.Token))
+	assert.NoError(t, binding.Validator.ValidateStruct(&v), "length rules count bytes")
+
+	require.NoError(t, binding.MapFormWithTag(&v, url.Values{"hash": {"00"}, "token": {"AAE="}}, "form"))
+	assert.Equal(t, []byte{0}, []byte(v.Hash))
+	assert.Equal(t, []byte{0, 1}, []byte(v.Token))
+
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"hash":"0"}`), &v), `invalid hex bytes "0"`)
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"hash":1}`), &v), "expected a JSON string")
+	assert.Equal(t, "fbff", EncodeBytes([]byte{0xfb, 0xff}, BytesHex))
+}
@@ -0,0 +1,136 @@
+package ginpb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CachePolicy is the HTTP caching of the replies of a GET method, declared with (ginpb.cache)
+type CachePolicy struct {
+	// MaxAge is how long a reply may be reused without revalidation
+	MaxAge time.Duration
+	// Private keeps shared caches from storing the reply
+	Private bool
+	// NoCache makes clients revalidate the reply before every reuse
+	NoCache bool
+	// ETag writes a strong ETag of the encoded reply and answers matching If-None-Match with 304
+	ETag bool
+}
+
+// CacheControl returns the Cache-Control value of p, e.g. "private, max-age=60"
+func (p CachePolicy) CacheControl() string {
+	directives := []string{"public"}
+	if p.Private {
+		directives[0] = "private"
+	}
+	if p.NoCache {
+		directives = append(directives, "no-cache")
+	}
+	directives = append(directives, "max-age="+strconv.FormatInt(int64(p.MaxAge/time.Second), 10))
+	return strings.Join(directives, ", ")
+}
+
+// CacheReply writes Cache-Control with the reply written until the returned function is called, generated
+// handlers defer it before writing successful replies of methods with (ginpb.cache). With p.ETag the reply is
+// buffered and its ETag computed, requests whose If-None-Match matches it get 304 without body.
+func CacheReply(c *gin.Context, p CachePolicy) func() {
+	c.Header("Cache-Control", p.CacheControl())
+	if !p.ETag {
+		return func() {}
+	}
+	w := &etagWriter{ResponseWriter: c.Writer}
+	c.Writer = w
+	return func() {
+		c.Writer = w.ResponseWriter
+		w.flush(c.Request)
+	}
+}
+
+// etagWriter buffers a reply until its ETag is known
+type etagWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *etagWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow is called by gin before writing bodies, the status is written by flush
+func (w *etagWriter) WriteHeaderNow() {}
+
+func (w *etagWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+func (w *etagWriter) WriteString(s string) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.WriteString(s)
+}
+
+func (w *etagWriter) Status() int {
+	if w.status == 0 {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *etagWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *etagWriter) Written() bool {
+	return w.status != 0
+}
+
+// flush writes the buffered reply, successful replies with their ETag or 304 when r already has it
+func (w *etagWriter) flush(r *http.Request) {
+	status := w.Status()
+	if status < 200 || status >= 300 {
+		// Errors of the encoder are not cacheable
+		w.Header().Del("Cache-Control")
+		w.writeBuffered(status)
+		return
+	}
+	sum := sha256.Sum256(w.body.Bytes())
+	etag := `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	if matchETag(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.writeBuffered(status)
+}
+
+func (w *etagWriter) writeBuffered(status int) {
+	if !w.Written() {
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
+
+// matchETag reports whether the If-None-Match value header matches etag with the weak comparison of RFC 9110
+func matchETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
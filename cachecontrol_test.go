@@ -0,0 +1,70 @@
+package ginpb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCachePolicyCacheControl(t *testing.T) {
+	assert.Equal(t, "public, max-age=60", CachePolicy{MaxAge: time.Minute}.CacheControl())
+	assert.Equal(t, "private, no-cache, max-age=0", CachePolicy{Private: true, NoCache: true, ETag: true}.CacheControl())
+}
+
+func TestCacheReply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	serve := func(p CachePolicy, ifNoneMatch string, reply func(c *gin.Context)) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/users/1", nil)
+		if ifNoneMatch != "" {
+			c.Request.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		func() {
+			defer CacheReply(c, p)()
+			reply(c)
+		}()
+		return w
+	}
+	user := func(c *gin.Context) {
+		RenderJSON(c, http.StatusOK, JSONDefault, wrapperspb.String("ada"))
+	}
+
+	w := serve(CachePolicy{MaxAge: time.Minute}, "", user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "public, max-age=60", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("ETag"))
+
+	policy := CachePolicy{Private: true, NoCache: true, ETag: true}
+	w = serve(policy, "", user)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"value":"ada"}`, w.Body.String())
+	etag := w.Header().Get("ETag")
+	assert.Regexp(t, `^"[A-Za-z0-9_-]{22}"$`, etag)
+
+	// Matching validators, also weak ones and lists, get 304 without body
+	for _, ifNoneMatch := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+		w = serve(policy, ifNoneMatch, user)
+		assert.Equal(t, http.StatusNotModified, w.Code, ifNoneMatch)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+		assert.Equal(t, "private, no-cache, max-age=0", w.Header().Get("Cache-Control"))
+		assert.Empty(t, w.Header().Get("Content-Type"))
+	}
+	w = serve(policy, `"stale"`, user)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Failed encodings are written unchanged without caching headers
+	w = serve(policy, etag, func(c *gin.Context) {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "encode"})
+	})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"encode"}`, w.Body.String())
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("ETag"))
+}
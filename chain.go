@@ -0,0 +1,44 @@
+package ginpb
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxChain is the most handlers a swappable route runs, gin allows 62 per route including group middlewares
+const maxChain = 32
+
+// chainSlots returns the handlers mounting a route whose handlers are picked per request, e.g. by verb or
+// from a RouteTable. gin only sets the handlers of a context from its tree, so the route is mounted with
+// maxChain slots: the first stores the chain pick returns under key, slot i runs handler i of that chain.
+// The handlers run on the incoming context, c.Next and c.Abort behave as on a plain route. Slots of
+// another chainSlots can be picked as a chain, they line up. pick returns nil to answer 404.
+func chainSlots(key string, pick func(*gin.Context) gin.HandlersChain) gin.HandlersChain {
+	slots := make(gin.HandlersChain, maxChain)
+	slots[0] = func(c *gin.Context) {
+		chain := pick(c)
+		if len(chain) == 0 {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		c.Set(key, chain)
+		chain[0](c)
+	}
+	for i := 1; i < maxChain; i++ {
+		slots[i] = func(c *gin.Context) {
+			if chain, _ := c.Value(key).(gin.HandlersChain); i < len(chain) {
+				chain[i](c)
+			}
+		}
+	}
+	return slots
+}
+
+// checkChain panics when handlers do not fit the slots of a swappable route
+func checkChain(method, path string, handlers gin.HandlersChain) {
+	if len(handlers) == 0 || len(handlers) > maxChain {
+		panic(fmt.Sprintf("ginpb: route %s %s has %d handlers, a swappable route takes 1 to %d", method, path, len(handlers), maxChain))
+	}
+}
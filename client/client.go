@@ -2,12 +2,19 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+	"github.com/go-kenka/ginpb/propagation"
 )
 
 // Client 是基于resty库的HTTP客户端接口
@@ -19,26 +26,49 @@ type Client interface {
 type client struct {
 	resty *resty.Client
 	opts  clientOptions
+	pool  *poolTransport
 }
 
 // clientOptions 客户端配置选项
 type clientOptions struct {
-	endpoint            string
-	timeout             time.Duration
-	userAgent           string
-	errorDecoder        ErrorDecoder
-	encoder             RequestEncoder
-	decoder             ResponseDecoder
-	transport           http.RoundTripper
-	headers             map[string]string
-	requestMiddlewares  []RestyRequestMiddleware
-	responseMiddlewares []RestyResponseMiddleware
-	errorMiddlewares    []RestyErrorMiddleware
-	retryCount          int
-	retryWaitTime       time.Duration
-	retryMaxWaitTime    time.Duration
+	endpoint              string
+	timeout               time.Duration
+	userAgent             string
+	errorDecoder          ErrorDecoder
+	encoder               RequestEncoder
+	decoder               ResponseDecoder
+	transport             http.RoundTripper
+	headers               map[string]string
+	requestMiddlewares    []RestyRequestMiddleware
+	responseMiddlewares   []RestyResponseMiddleware
+	errorMiddlewares      []RestyErrorMiddleware
+	retryCount            int
+	retryWaitTime         time.Duration
+	retryMaxWaitTime      time.Duration
+	tokenSource           TokenSource
+	operationScopes       map[string][]string
+	expectContinueTimeout time.Duration
+	rateLimit             *RateLimitConfig
+	protoJSON             bool
+	camelJSON             bool
+	protobuf              bool
+	propagateBudget       bool
+	propagateHeaders      bool
+	connPool              *ConnPoolConfig
+	regions               *RegionConfig
+	regionPinned          map[string]bool
+	idempotent            map[string]bool
+	inProcess             bool
+	bodyDigest            []string
+	envoy                 *EnvoyConfig
 }
 
+// budgetHeader 传递剩余时间预算的请求头，与 metadata.BudgetHeader 一致
+const budgetHeader = "X-Request-Budget"
+
+// TokenSource 根据操作所需的权限范围返回访问令牌
+type TokenSource func(ctx context.Context, scopes []string) (string, error)
+
 // NewClient 创建新的HTTP客户端
 func NewClient(opts ...ClientOption) Client {
 	o := clientOptions{
@@ -58,6 +88,9 @@ func NewClient(opts ...ClientOption) Client {
 	restyClient := resty.New()
 
 	// 配置基础选项
+	if o.inProcess && o.endpoint == "" {
+		o.endpoint = inProcessEndpoint
+	}
 	if o.endpoint != "" {
 		restyClient.SetBaseURL(o.endpoint)
 	}
@@ -67,6 +100,33 @@ func NewClient(opts ...ClientOption) Client {
 	if o.userAgent != "" {
 		restyClient.SetHeader("User-Agent", o.userAgent)
 	}
+	if o.expectContinueTimeout > 0 {
+		if o.transport == nil {
+			o.transport = restyClient.GetClient().Transport
+		}
+		o.transport = expectContinueTransport(o.transport, o.expectContinueTimeout)
+	}
+	var pool *poolTransport
+	if o.connPool != nil {
+		if o.transport == nil {
+			o.transport = restyClient.GetClient().Transport
+		}
+		if pool = newPoolTransport(o.transport, *o.connPool); pool != nil {
+			o.transport = pool
+		}
+	}
+	if o.rateLimit != nil {
+		if o.transport == nil {
+			o.transport = restyClient.GetClient().Transport
+		}
+		o.transport = newRateLimitTransport(o.transport, *o.rateLimit)
+	}
+	if len(o.bodyDigest) > 0 {
+		if o.transport == nil {
+			o.transport = restyClient.GetClient().Transport
+		}
+		o.transport = &digestTransport{next: o.transport, algorithms: o.bodyDigest}
+	}
 	if o.transport != nil {
 		restyClient.SetTransport(o.transport)
 	}
@@ -76,8 +136,8 @@ func NewClient(opts ...ClientOption) Client {
 		restyClient.SetHeaders(o.headers)
 	}
 
-	// 配置重试
-	if o.retryCount > 0 {
+	// 配置重试，由Envoy负责重试时不在客户端重试
+	if o.retryCount > 0 && o.envoy == nil {
 		restyClient.SetRetryCount(o.retryCount)
 		if o.retryWaitTime > 0 {
 			restyClient.SetRetryWaitTime(o.retryWaitTime)
@@ -85,12 +145,17 @@ func NewClient(opts ...ClientOption) Client {
 		if o.retryMaxWaitTime > 0 {
 			restyClient.SetRetryMaxWaitTime(o.retryMaxWaitTime)
 		}
+		// 声明了幂等操作时只重试幂等操作
+		if o.idempotent != nil {
+			restyClient.AddRetryCondition(retryCondition)
+		}
 	}
 
 	// 创建客户端实例
 	client := &client{
 		resty: restyClient,
 		opts:  o,
+		pool:  pool,
 	}
 
 	// 应用中间件
@@ -109,11 +174,88 @@ func NewClient(opts ...ClientOption) Client {
 
 // Invoke 执行HTTP请求
 func (c *client) Invoke(ctx context.Context, method, path string, args interface{}, reply interface{}, opts ...CallOption) error {
+	req, callOpts, err := c.newRequest(ctx, method, path, args, opts)
+	if err != nil {
+		return err
+	}
+
+	// 设置响应对象，protobuf消息在请求成功后按响应的Content-Type使用protobuf或protojson解码
+	msg, isMessage := reply.(proto.Message)
+	useProtoJSON := isMessage && c.opts.protoJSON
+	useProtobuf := isMessage && callOpts.protobuf
+	// 含 ginpb.bytes_encoding 字段的消息需先转换bytes字段再解码
+	recodeBytes := isMessage && jsonfield.HasEncodedBytes(msg.ProtoReflect().Descriptor())
+	if reply != nil && !useProtoJSON && !useProtobuf && !recodeBytes {
+		req.SetResult(reply)
+	}
+
+	// 设置错误响应处理
+	req.SetError(&HTTPError{})
+
+	resp, err := execute(req, method, callOpts.url(path))
+	if err != nil {
+		return err
+	}
+	if callOpts.trailer != nil {
+		*callOpts.trailer = resp.RawResponse.Trailer
+	}
+
+	// 检查HTTP状态码
+	if resp.IsError() {
+		httpErr, ok := resp.Error().(*HTTPError)
+		if !ok || httpErr == nil {
+			httpErr = &HTTPError{}
+		}
+		if httpErr.Message == "" {
+			httpErr.Message = resp.Status()
+		}
+		httpErr.Code = resp.StatusCode()
+		if httpErr.Code == http.StatusTooManyRequests {
+			httpErr.RetryAfter = retryAfter(resp.Header(), time.Now())
+		}
+		return httpErr
+	}
+
+	if (useProtoJSON || useProtobuf || recodeBytes) && len(resp.Body()) > 0 {
+		if err := c.decodeMessage(resp, msg); err != nil {
+			return fmt.Errorf("decode %s response of %s %s: %w", proto.MessageName(msg), method, path, err)
+		}
+	}
+	return nil
+}
+
+// decodeMessage 解码protobuf消息响应，服务端未返回protobuf时按JSON解码
+func (c *client) decodeMessage(resp *resty.Response, msg proto.Message) error {
+	if strings.Contains(resp.Header().Get("Content-Type"), protobufContentType) {
+		return proto.Unmarshal(resp.Body(), msg)
+	}
+	body := resp.Body()
+	if md := msg.ProtoReflect().Descriptor(); jsonfield.HasEncodedBytes(md) {
+		var err error
+		if body, err = jsonfield.RecodeBytes(body, md, true); err != nil {
+			return err
+		}
+	}
+	if c.opts.protoJSON {
+		return protoJSONUnmarshal.Unmarshal(body, msg)
+	}
+	return json.Unmarshal(body, msg)
+}
+
+// protobufContentType 是protobuf二进制请求和响应的Content-Type
+const protobufContentType = "application/x-protobuf"
+
+// protoJSONUnmarshal 忽略未知字段，服务端新增字段时旧客户端仍可解码
+var protoJSONUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// newRequest 根据调用选项创建请求
+func (c *client) newRequest(ctx context.Context, method, path string, args interface{}, opts []CallOption) (*resty.Request, *callOptions, error) {
 	// 创建调用上下文
 	callOpts := callOptions{
 		operation:    "",
 		pathTemplate: path,
 		headers:      make(map[string]string),
+		protobuf:     c.opts.protobuf,
 	}
 
 	// 应用调用选项
@@ -121,69 +263,128 @@ func (c *client) Invoke(ctx context.Context, method, path string, args interface
 		opt(&callOpts)
 	}
 
-	// 创建请求
-	req := c.resty.R().SetContext(ctx)
+	// 区域固定的操作发送到所属区域的端点
+	endpoint, err := c.opts.regionEndpoint(ctx, callOpts.operation)
+	if err != nil {
+		return nil, nil, err
+	}
+	callOpts.endpoint = endpoint
+
+	// 创建请求，ctx 中记录操作名以及本次调用是否允许自动重试
+	retryable := c.opts.retryable(method, &callOpts)
+	req := c.resty.R().SetContext(withOperation(withRetryable(ctx, retryable), callOpts.operation))
+	c.opts.setEnvoyHeaders(ctx, req, retryable)
+
+	// 转发从入站请求捕获的请求头，客户端默认请求头、访问令牌和调用级请求头优先
+	if h, ok := propagation.FromContext(ctx); ok && c.opts.propagateHeaders {
+		for name, values := range h {
+			if !c.opts.hasHeader(name) {
+				req.Header[http.CanonicalHeaderKey(name)] = values
+			}
+		}
+	}
+
+	// 按操作所需的权限范围获取访问令牌
+	if c.opts.tokenSource != nil {
+		scopes := c.opts.operationScopes[callOpts.operation]
+		token, err := c.opts.tokenSource(ctx, scopes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("token for operation %q with scopes %v: %w", callOpts.operation, scopes, err)
+		}
+		req.SetHeader("Authorization", "Bearer "+token)
+	}
+
+	// 向下游传递剩余的时间预算，预算已耗尽时不再发送请求
+	if deadline, ok := ctx.Deadline(); ok && c.opts.propagateBudget {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, nil, fmt.Errorf("request budget of %s exhausted: %w", path, context.DeadlineExceeded)
+		}
+		req.SetHeader(budgetHeader, strconv.FormatInt(remaining.Milliseconds(), 10))
+	}
 
 	// 添加调用特定的headers
 	for key, value := range callOpts.headers {
 		req.SetHeader(key, value)
 	}
 
-	// 设置请求body
-	if args != nil {
-		req.SetBody(args)
+	// protobuf模式下优先接收protobuf响应，服务端不支持时仍可返回JSON
+	if _, ok := callOpts.headers["Accept"]; callOpts.protobuf && !ok {
+		req.SetHeader("Accept", protobufContentType+", application/json;q=0.9")
 	}
 
-	// 设置响应对象
-	if reply != nil {
-		req.SetResult(reply)
+	// 设置请求body，protobuf模式下消息使用proto.Marshal编码
+	if msg, ok := args.(proto.Message); ok && callOpts.protobuf {
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encode %s request of %s as protobuf: %w", proto.MessageName(msg), path, err)
+		}
+		req.SetHeader("Content-Type", protobufContentType)
+		req.SetBody(b)
+	} else if ok && c.opts.camelJSON {
+		// lowerCamel模式下消息使用protojson编码，与 json_names=camel 生成的服务端一致
+		b, err := protojson.Marshal(msg)
+		if err == nil && jsonfield.HasEncodedBytes(msg.ProtoReflect().Descriptor()) {
+			b, err = jsonfield.RecodeBytes(b, msg.ProtoReflect().Descriptor(), false)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("encode %s request of %s: %w", proto.MessageName(msg), path, err)
+		}
+		req.SetHeader("Content-Type", "application/json")
+		req.SetBody(b)
+	} else if ok && jsonfield.HasEncodedBytes(msg.ProtoReflect().Descriptor()) {
+		// bytes字段按 ginpb.bytes_encoding 编码
+		b, err := json.Marshal(msg)
+		if err == nil {
+			b, err = jsonfield.RecodeBytes(b, msg.ProtoReflect().Descriptor(), false)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("encode %s request of %s: %w", proto.MessageName(msg), path, err)
+		}
+		req.SetHeader("Content-Type", "application/json")
+		req.SetBody(b)
+	} else if args != nil {
+		req.SetBody(args)
 	}
+	if args != nil {
+		if c.opts.expectContinueTimeout > 0 {
+			req.SetHeader("Expect", "100-continue")
+		}
+	}
+	return req, &callOpts, nil
+}
 
-	// 设置错误响应处理
-	req.SetError(&HTTPError{})
-
-	// 执行请求
-	var resp *resty.Response
-	var err error
+// expectContinueTransport 返回设置了 ExpectContinueTimeout 的传输，不修改调用方传入的 transport
+func expectContinueTransport(rt http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	t, ok := rt.(*http.Transport)
+	if !ok {
+		return rt
+	}
+	t = t.Clone()
+	t.ExpectContinueTimeout = timeout
+	return t
+}
 
+// execute 按HTTP方法执行请求
+func execute(req *resty.Request, method, path string) (*resty.Response, error) {
 	switch strings.ToUpper(method) {
 	case http.MethodGet:
-		resp, err = req.Get(path)
+		return req.Get(path)
 	case http.MethodPost:
-		resp, err = req.Post(path)
+		return req.Post(path)
 	case http.MethodPut:
-		resp, err = req.Put(path)
+		return req.Put(path)
 	case http.MethodDelete:
-		resp, err = req.Delete(path)
+		return req.Delete(path)
 	case http.MethodPatch:
-		resp, err = req.Patch(path)
+		return req.Patch(path)
 	case http.MethodHead:
-		resp, err = req.Head(path)
+		return req.Head(path)
 	case http.MethodOptions:
-		resp, err = req.Options(path)
+		return req.Options(path)
 	default:
-		return fmt.Errorf("unsupported HTTP method: %s", method)
-	}
-
-	if err != nil {
-		return err
+		return nil, fmt.Errorf("unsupported HTTP method: %s", method)
 	}
-
-	// 检查HTTP状态码
-	if resp.IsError() {
-		if errorResp := resp.Error(); errorResp != nil {
-			if httpErr, ok := errorResp.(*HTTPError); ok {
-				httpErr.Code = resp.StatusCode()
-				return httpErr
-			}
-		}
-		return &HTTPError{
-			Code:    resp.StatusCode(),
-			Message: resp.Status(),
-		}
-	}
-
-	return nil
 }
 
 // AddRequestMiddleware 添加请求中间件
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-kenka/ginpb/internal/digest"
+)
+
+// WithBodyDigest 发送请求体时计算校验和，MD5 写入 Content-MD5，SHA-256、SHA-512 写入 Digest 请求头，
+// 未指定算法时使用 SHA-256。服务端通过 middleware.BodyDigest 校验，适用于支付、文件元数据同步等对完整性敏感的集成
+func WithBodyDigest(algorithms ...string) ClientOption {
+	return func(o *clientOptions) {
+		if len(algorithms) == 0 {
+			algorithms = []string{digest.SHA256}
+		}
+		o.bodyDigest = algorithms
+	}
+}
+
+// digestTransport 在传输层按最终发送的请求体计算校验和，每次重试都会重新计算
+type digestTransport struct {
+	next       http.RoundTripper
+	algorithms []string
+}
+
+func (t *digestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for _, algorithm := range t.algorithms {
+		if digest.New(algorithm) == nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, fmt.Errorf("unsupported body digest algorithm %q, use MD5, SHA-256 or SHA-512", algorithm)
+		}
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return t.next.RoundTrip(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read request body for checksum: %w", err)
+	}
+
+	// RoundTripper 不能修改传入的请求
+	r := req.Clone(req.Context())
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	r.ContentLength = int64(len(body))
+	digest.Set(r.Header, body, t.algorithms)
+	return t.next.RoundTrip(r)
+}
+
+// CloseIdleConnections 关闭下层传输的空闲连接
+func (t *digestTransport) CloseIdleConnections() {
+	if c, ok := t.next.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Envoy路由相关的请求头
+const (
+	envoyRetryOnHeader       = "X-Envoy-Retry-On"
+	envoyMaxRetriesHeader    = "X-Envoy-Max-Retries"
+	envoyTimeoutHeader       = "X-Envoy-Upstream-Rq-Timeout-Ms"
+	envoyPerTryTimeoutHeader = "X-Envoy-Upstream-Rq-Per-Try-Timeout-Ms"
+)
+
+// EnvoyConfig 通过Envoy/Istio边车发送请求时的重试与超时配置
+type EnvoyConfig struct {
+	// RetryOn 写入 x-envoy-retry-on 的重试条件，如 "5xx,reset,connect-failure"，为空时默认 "gateway-error,reset,connect-failure"
+	RetryOn string
+	// MaxRetries 允许重试的调用写入 x-envoy-max-retries 的最大重试次数，不允许重试的调用始终为0
+	MaxRetries int
+	// PerTryTimeout 每次尝试的超时时间，为0时不限制
+	PerTryTimeout time.Duration
+}
+
+// WithEnvoy 由边车代理负责重试和超时：允许重试的调用（见 WithIdempotentOperations、Retryable）携带
+// x-envoy-retry-on 和 x-envoy-max-retries，其他调用的 x-envoy-max-retries 为0，避免路由默认的重试策略重放非幂等请求；
+// ctx设置了截止时间时写入 x-envoy-upstream-rq-timeout-ms。为避免客户端与代理的重试次数相乘，设置后 WithRetry 不再生效
+func WithEnvoy(config EnvoyConfig) ClientOption {
+	return func(o *clientOptions) {
+		if config.RetryOn == "" {
+			config.RetryOn = "gateway-error,reset,connect-failure"
+		}
+		o.envoy = &config
+	}
+}
+
+// setEnvoyHeaders 按调用是否允许重试和ctx截止时间设置Envoy请求头，剩余时间不足1毫秒时按1毫秒发送
+func (o *clientOptions) setEnvoyHeaders(ctx context.Context, req *resty.Request, retryable bool) {
+	if o.envoy == nil {
+		return
+	}
+	if retryable && o.envoy.MaxRetries > 0 {
+		req.SetHeader(envoyRetryOnHeader, o.envoy.RetryOn)
+		req.SetHeader(envoyMaxRetriesHeader, strconv.Itoa(o.envoy.MaxRetries))
+		if o.envoy.PerTryTimeout > 0 {
+			req.SetHeader(envoyPerTryTimeoutHeader, strconv.FormatInt(o.envoy.PerTryTimeout.Milliseconds(), 10))
+		}
+	} else {
+		req.SetHeader(envoyMaxRetriesHeader, "0")
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		ms := time.Until(deadline).Milliseconds()
+		if ms < 1 {
+			ms = 1
+		}
+		req.SetHeader(envoyTimeoutHeader, strconv.FormatInt(ms, 10))
+	}
+}
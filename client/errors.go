@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // HTTPError HTTP错误类型
@@ -13,6 +14,10 @@ type HTTPError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 	Details string `json:"details,omitempty"`
+	// Reason 错误码枚举的值名，如 USER_NOT_FOUND，由生成的 IsX 函数判断
+	Reason string `json:"reason,omitempty"`
+	// RetryAfter 429 响应的 Retry-After 等待时间
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error 实现error接口
@@ -87,7 +92,7 @@ func IsServerError(err error) bool {
 // IsRetryableError 检查错误是否可重试
 func IsRetryableError(err error) bool {
 	if httpErr, ok := err.(*HTTPError); ok {
-		// 5xx错误通常可重试
+		// 5xx错误通常可重试，429 需按 Retry-After 等待，见 WithRateLimit 与 IsRateLimited
 		return httpErr.Code >= 500
 	}
 	return false
@@ -23,13 +23,13 @@ func ExampleClient_basic() {
 	ctx := context.Background()
 
 	// 发送GET请求
-	var resp api.GetArticlesResp
-	err := c.Invoke(ctx, http.MethodGet, "/v1/articles", nil, &resp)
+	var resp api.ListUsersResponse
+	err := c.Invoke(ctx, http.MethodGet, "/api/v1/users", nil, &resp)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Found %d articles\n", resp.Total)
+	fmt.Printf("Found %d users\n", resp.TotalCount)
 }
 
 // 示例：使用CallOption
@@ -39,16 +39,16 @@ func ExampleClient_withCallOptions() {
 	)
 
 	ctx := context.Background()
-	req := &api.GetArticlesReq{
-		Title:    "golang",
-		Page:     1,
-		PageSize: 10,
+	req := &api.CreateUserRequest{
+		Username: "gopher",
+		Email:    "gopher@example.com",
+		Password: "secret-password",
 	}
 
-	var resp api.GetArticlesResp
-	err := c.Invoke(ctx, http.MethodGet, "/v1/articles", req, &resp,
-		client.Operation("/example.BlogService/GetArticles"),
-		client.PathTemplate("/v1/articles"),
+	var resp api.CreateUserResponse
+	err := c.Invoke(ctx, http.MethodPost, "/api/v1/users", req, &resp,
+		client.Operation(api.OperationCompleteExampleServiceCreateUser),
+		client.PathTemplate("/api/v1/users"),
 		client.ContentType("application/json"),
 		client.Header("X-Request-ID", "12345"),
 	)
@@ -56,7 +56,7 @@ func ExampleClient_withCallOptions() {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Articles: %+v\n", resp)
+	fmt.Printf("User: %+v\n", resp.GetUser())
 }
 
 // 示例：使用中间件
@@ -85,13 +85,13 @@ func ExampleClient_withMiddleware() {
 	ctx := context.Background()
 
 	// 发送请求
-	var resp api.GetArticlesResp
-	err := c.Invoke(ctx, http.MethodGet, "/v1/articles", nil, &resp)
+	var resp api.ListUsersResponse
+	err := c.Invoke(ctx, http.MethodGet, "/api/v1/users", nil, &resp)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Response: %+v\n", resp)
+	fmt.Printf("Response: %v\n", &resp)
 }
 
 // 示例：POST请求
@@ -101,14 +101,14 @@ func ExampleClient_post() {
 	)
 
 	ctx := context.Background()
-	req := &api.Article{
-		Title:    "My New Article",
-		Content:  "This is the content",
-		AuthorId: 123,
+	req := &api.CreatePostRequest{
+		UserId:  "123",
+		Title:   "My New Post",
+		Content: "This is the content",
 	}
 
-	var resp api.Article
-	err := c.Invoke(ctx, http.MethodPost, "/v1/author/123/articles", req, &resp,
+	var resp api.CreatePostResponse
+	err := c.Invoke(ctx, http.MethodPost, "/api/v1/users/123/posts", req, &resp,
 		client.ContentType("application/json"),
 		client.BearerToken("your-jwt-token"),
 	)
@@ -116,7 +116,7 @@ func ExampleClient_post() {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Created article: %s\n", resp.Title)
+	fmt.Printf("Created post: %s\n", resp.GetPost().GetTitle())
 }
 
 // 示例：错误处理
@@ -134,8 +134,8 @@ func ExampleClient_errorHandling() {
 
 	ctx := context.Background()
 
-	var resp api.GetArticlesResp
-	err := c.Invoke(ctx, http.MethodGet, "/v1/articles/999", nil, &resp)
+	var resp api.GetUserResponse
+	err := c.Invoke(ctx, http.MethodGet, "/api/v1/users/999", nil, &resp)
 	if err != nil {
 		// 检查错误类型
 		if client.IsHTTPError(err) {
@@ -174,46 +174,45 @@ func ExampleClient_customTransport() {
 	fmt.Printf("API Status: %+v\n", resp)
 }
 
-// 博客服务客户端包装示例
-type BlogServiceClient struct {
+// 用户服务客户端包装示例
+type UserServiceClient struct {
 	client client.Client
 }
 
-func NewBlogServiceClient(endpoint string) *BlogServiceClient {
+func NewUserServiceClient(endpoint string) *UserServiceClient {
 	c := client.NewClient(
 		client.WithEndpoint(endpoint),
 		client.WithTimeout(30*time.Second),
-		client.WithUserAgent("blog-service-client/1.0"),
+		client.WithUserAgent("user-service-client/1.0"),
 	)
 
-	return &BlogServiceClient{client: c}
+	return &UserServiceClient{client: c}
 }
 
-func (c *BlogServiceClient) GetArticles(ctx context.Context, req *api.GetArticlesReq) (*api.GetArticlesResp, error) {
-	var resp api.GetArticlesResp
+func (c *UserServiceClient) ListUsers(ctx context.Context, req *api.ListUsersRequest) (*api.ListUsersResponse, error) {
+	var resp api.ListUsersResponse
 
-	// 使用EncodeURL处理路径和查询参数
-	path := client.EncodeURL("/v1/articles", req, true)
-	if req.AuthorId > 0 {
-		path = client.EncodeURL("/v1/author/{author_id}/articles", req, true)
-	}
+	// 使用AppendMessageQuery把请求字段编码为查询参数
+	path := client.AppendMessageQuery("/api/v1/users", req,
+		client.QueryParam{Field: "page", Name: "page"},
+		client.QueryParam{Field: "page_size", Name: "page_size"},
+		client.QueryParam{Field: "sort_by", Name: "sort_by"},
+	)
 
 	err := c.client.Invoke(ctx, http.MethodGet, path, nil, &resp,
-		client.Operation("/example.BlogService/GetArticles"),
-		client.PathTemplate(path),
+		client.Operation(api.OperationCompleteExampleServiceListUsers),
+		client.PathTemplate("/api/v1/users"),
 	)
 
 	return &resp, err
 }
 
-func (c *BlogServiceClient) CreateArticle(ctx context.Context, req *api.Article) (*api.Article, error) {
-	var resp api.Article
-
-	path := fmt.Sprintf("/v1/author/%d/articles", req.AuthorId)
+func (c *UserServiceClient) CreateUser(ctx context.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
+	var resp api.CreateUserResponse
 
-	err := c.client.Invoke(ctx, http.MethodPost, path, req, &resp,
-		client.Operation("/example.BlogService/CreateArticle"),
-		client.PathTemplate("/v1/author/{author_id}/articles"),
+	err := c.client.Invoke(ctx, http.MethodPost, "/api/v1/users", req, &resp,
+		client.Operation(api.OperationCompleteExampleServiceCreateUser),
+		client.PathTemplate("/api/v1/users"),
 		client.ContentType("application/json"),
 	)
 
@@ -221,31 +220,31 @@ func (c *BlogServiceClient) CreateArticle(ctx context.Context, req *api.Article)
 }
 
 // 示例：使用服务客户端
-func ExampleBlogServiceClient() {
-	client := NewBlogServiceClient("http://localhost:8080")
+func ExampleUserServiceClient() {
+	client := NewUserServiceClient("http://localhost:8080")
 	ctx := context.Background()
 
-	// 获取文章
-	articles, err := client.GetArticles(ctx, &api.GetArticlesReq{
-		Title:    "golang",
+	// 查询用户
+	users, err := client.ListUsers(ctx, &api.ListUsersRequest{
 		Page:     1,
 		PageSize: 10,
+		SortBy:   "name",
 	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Found %d articles\n", articles.Total)
+	fmt.Printf("Found %d users\n", users.TotalCount)
 
-	// 创建文章
-	newArticle, err := client.CreateArticle(ctx, &api.Article{
-		Title:    "New Article",
-		Content:  "Article content",
-		AuthorId: 123,
+	// 创建用户
+	created, err := client.CreateUser(ctx, &api.CreateUserRequest{
+		Username: "gopher",
+		Email:    "gopher@example.com",
+		Password: "secret-password",
 	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("Created: %s\n", newArticle.Title)
+	fmt.Printf("Created: %s\n", created.GetUser().GetUsername())
 }
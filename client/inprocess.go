@@ -0,0 +1,127 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// inProcessEndpoint 未设置端点时进程内调用使用的地址，仅用于构造请求URL
+const inProcessEndpoint = "http://in-process"
+
+// WithInProcess 将请求直接交给同一进程中的 handler（如 gin.Engine）处理，不经过网络，
+// 适用于测试和模块化单体中服务间通过生成的客户端互相调用。未设置端点时使用 http://in-process。
+// 响应体以流的方式返回，SSE 和流式列表同样可用；WebSocket 需要劫持连接，不支持进程内调用
+func WithInProcess(handler http.Handler) ClientOption {
+	return func(o *clientOptions) {
+		o.transport = &inProcessTransport{handler: handler}
+		o.inProcess = true
+	}
+}
+
+// inProcessTransport 在当前进程内调用 handler 的 http.RoundTripper
+type inProcessTransport struct {
+	handler http.Handler
+}
+
+// RoundTrip 在新的goroutine中执行 handler，响应头写出后即返回，响应体通过管道读取
+func (t *inProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	serverReq := req.Clone(ctx)
+	if serverReq.Body == nil {
+		serverReq.Body = http.NoBody
+	}
+	if serverReq.Host == "" {
+		serverReq.Host = req.URL.Host
+	}
+	serverReq.RequestURI = req.URL.RequestURI()
+	serverReq.RemoteAddr = "127.0.0.1:0"
+
+	pr, pw := io.Pipe()
+	w := &inProcessWriter{header: make(http.Header), body: pw, ready: make(chan struct{})}
+	resp := &http.Response{
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Body:          pr,
+		ContentLength: -1,
+		Trailer:       make(http.Header),
+		Request:       req,
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = pw.CloseWithError(fmt.Errorf("in-process handler of %s %s panicked: %v", req.Method, req.URL.Path, r))
+			}
+			_ = serverReq.Body.Close()
+		}()
+		t.handler.ServeHTTP(w, serverReq)
+		w.WriteHeader(http.StatusOK)
+		// 响应体读完前写入trailer，调用方在读到EOF后可见
+		w.trailers(resp.Trailer)
+		_ = pw.Close()
+	}()
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		_ = pr.CloseWithError(ctx.Err())
+		return nil, ctx.Err()
+	}
+	resp.StatusCode = w.status
+	resp.Status = fmt.Sprintf("%d %s", w.status, http.StatusText(w.status))
+	resp.Header = w.sent
+	return resp, nil
+}
+
+// inProcessWriter 是进程内调用的 http.ResponseWriter，首次写出时将响应头交给 RoundTrip
+type inProcessWriter struct {
+	header http.Header
+	sent   http.Header
+	body   *io.PipeWriter
+	status int
+	once   sync.Once
+	ready  chan struct{}
+}
+
+func (w *inProcessWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *inProcessWriter) WriteHeader(status int) {
+	w.once.Do(func() {
+		w.status = status
+		w.sent = w.header.Clone()
+		close(w.ready)
+	})
+}
+
+func (w *inProcessWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// Flush 管道写入是同步的，只需确保响应头已写出
+func (w *inProcessWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+}
+
+// trailers 收集声明在 Trailer 头中或以 http.TrailerPrefix 开头的trailer
+func (w *inProcessWriter) trailers(trailer http.Header) {
+	for _, declared := range w.sent.Values("Trailer") {
+		for _, key := range strings.Split(declared, ",") {
+			key = http.CanonicalHeaderKey(strings.TrimSpace(key))
+			if v, ok := w.header[key]; ok {
+				trailer[key] = v
+			}
+		}
+	}
+	for key, v := range w.header {
+		if strings.HasPrefix(key, http.TrailerPrefix) {
+			trailer[http.CanonicalHeaderKey(strings.TrimPrefix(key, http.TrailerPrefix))] = v
+		}
+	}
+}
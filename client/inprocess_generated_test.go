@@ -0,0 +1,116 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/client"
+	"github.com/go-kenka/ginpb/example/api"
+)
+
+const testUserID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+// 生成的客户端通过进程内调用访问同一进程中注册的服务
+func TestInProcessGeneratedClient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var received *http.Request
+	srv := &api.CompleteExampleServiceHTTPServerMock{
+		CreateUserFunc: func(ctx context.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
+			switch req.Email {
+			case "taken@example.com":
+				return nil, api.ErrorEmailTaken()
+			case "panic@example.com":
+				panic("storage unavailable")
+			}
+			assert.Equal(t, "alice", req.Username)
+			assert.Equal(t, "alice@example.com", req.Email)
+			assert.Equal(t, []string{"go", "chess"}, req.Hobbies)
+			return &api.CreateUserResponse{
+				User:     &api.User{Id: testUserID, Username: req.Username},
+				Message:  "created",
+				Location: "/api/v1/users/" + testUserID,
+			}, nil
+		},
+	}
+	keys, err := ginpb.NewAESKeyProvider(map[string][]byte{"pii": []byte("0123456789abcdef0123456789abcdef")})
+	require.NoError(t, err)
+	r := gin.New()
+	api.RegisterCompleteExampleServiceHTTPServer(r, srv,
+		api.WithCompleteExampleServiceKeyProvider(keys),
+		api.WithCompleteExampleServiceGlobalMiddleware(func(c *gin.Context) {
+			received = c.Request
+		}),
+	)
+
+	var status int
+	var location string
+	c := api.NewCompleteExampleServiceHTTPClient(
+		client.WithInProcess(r),
+		client.WithUserAgent("inprocess-test"),
+		client.WithResponseMiddleware(func(_ *resty.Client, resp *resty.Response) error {
+			status, location = resp.StatusCode(), resp.Header().Get("Location")
+			return nil
+		}),
+	)
+	ctx := context.Background()
+
+	// 请求方向：方法、路径、请求头与JSON请求体
+	req := &api.CreateUserRequest{
+		Username:   "alice",
+		Email:      "alice@example.com",
+		Password:   "s3cret-password",
+		FullName:   "Alice Liddell",
+		Phone:      "13800138000",
+		Age:        30,
+		Gender:     "female",
+		Hobbies:    []string{"go", "chess"},
+		AgreeTerms: true,
+	}
+	resp, err := c.CreateUser(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/api/v1/users", received.URL.Path)
+	assert.Equal(t, "inprocess-test", received.Header.Get("User-Agent"))
+	assert.Contains(t, received.Header.Get("Content-Type"), "application/json")
+
+	// 响应方向：状态码、响应头与响应体
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "/api/v1/users/"+testUserID, location)
+	assert.Equal(t, testUserID, resp.GetUser().GetId())
+	assert.Equal(t, "alice", resp.GetUser().GetUsername())
+	assert.Equal(t, "created", resp.GetMessage())
+
+	// 服务返回的错误码以HTTPError返回，保留状态码、原因与消息
+	req.Email = "taken@example.com"
+	_, err = c.CreateUser(ctx, req)
+	require.Error(t, err)
+	var httpErr *client.HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusConflict, httpErr.Code)
+	assert.Equal(t, "EMAIL_TAKEN", httpErr.Reason)
+	assert.Equal(t, "email already registered", httpErr.Message)
+	assert.True(t, api.IsEmailTaken(err))
+
+	// 校验失败的请求返回400，不调用服务
+	req.Email = "not-an-email"
+	_, err = c.CreateUser(ctx, req)
+	require.Error(t, err)
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	assert.Equal(t, 2, srv.Calls(api.OperationCompleteExampleServiceCreateUser))
+
+	// handler panic 时调用方收到错误，不会随之崩溃
+	req.Email = "panic@example.com"
+	_, err = c.CreateUser(ctx, req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "in-process handler of POST /api/v1/users panicked: storage unavailable")
+}
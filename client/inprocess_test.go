@@ -0,0 +1,44 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 进程内调用的原始响应：响应头在写出时返回，响应体以流读取，trailer在读完后可见
+func TestInProcessTransport(t *testing.T) {
+	flushed := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Trailer", "X-Checksum")
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(r.Host + " " + r.RequestURI + " "))
+		w.(http.Flusher).Flush()
+		<-flushed
+		_, _ = w.Write(body)
+		w.Header().Set("X-Checksum", "abc")
+	})
+
+	c := &http.Client{Transport: &inProcessTransport{handler: handler}}
+	resp, err := c.Post("http://in-process/echo?x=1", "text/plain", strings.NewReader("payload"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// 响应体未写完时已可读取状态码与响应头
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, "202 Accepted", resp.Status)
+	assert.Equal(t, "text/plain", resp.Header.Get("Content-Type"))
+	assert.Empty(t, resp.Trailer.Get("X-Checksum"))
+	close(flushed)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "in-process /echo?x=1 payload", string(body))
+	assert.Equal(t, "abc", resp.Trailer.Get("X-Checksum"))
+}
@@ -1,12 +1,16 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+
+	"github.com/go-kenka/ginpb/report"
 )
 
 // RestyRequestMiddleware Resty请求中间件类型
@@ -61,6 +65,40 @@ func LoggingErrorMiddleware(logger func(format string, args ...interface{})) Res
 	}
 }
 
+// ReportingErrorMiddleware 错误上报中间件，将请求失败（连接错误、超时、请求中间件返回的错误等）交给 r 上报，
+// 事件中带有操作名、请求方法、URL 和状态码（收到响应时）
+func ReportingErrorMiddleware(r report.Reporter) RestyErrorMiddleware {
+	return func(req *resty.Request, err error) {
+		event := report.Event{
+			Source:    report.SourceClient,
+			Operation: operationFromContext(req.Context()),
+			Method:    req.Method,
+			URL:       req.URL,
+			RequestID: req.Header.Get("X-Request-ID"),
+		}
+		var respErr *resty.ResponseError
+		if errors.As(err, &respErr) && respErr.Response != nil {
+			event.Status = respErr.Response.StatusCode()
+			err = respErr.Err
+		}
+		report.Capture(req.Context(), r, err, event)
+	}
+}
+
+// operationKey 请求ctx中记录操作名的键
+type operationKey struct{}
+
+// withOperation 在请求ctx中记录操作名
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operationFromContext 返回请求ctx中记录的操作名
+func operationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
+
 // AuthRequestMiddleware 认证请求中间件
 func AuthRequestMiddleware(token string) RestyRequestMiddleware {
 	return func(c *resty.Client, req *resty.Request) error {
@@ -16,6 +16,18 @@ type callOptions struct {
 	operation    string
 	pathTemplate string
 	headers      map[string]string
+	trailer      *http.Header
+	endpoint     string // 区域端点，为空时使用客户端的端点
+	retryable    *bool  // 覆盖是否允许自动重试
+	protobuf     bool   // 使用protobuf二进制编码请求和响应
+}
+
+// url 返回请求地址，设置了区域端点时返回完整URL
+func (o *callOptions) url(path string) string {
+	if o.endpoint == "" {
+		return path
+	}
+	return JoinURL(o.endpoint, path)
 }
 
 // WithEndpoint 设置服务端点
@@ -76,6 +88,57 @@ func WithResponseDecoder(decoder ResponseDecoder) ClientOption {
 	}
 }
 
+// WithProtoJSON 使用protojson解码protobuf消息响应，同时兼容proto字段名和lowerCamel名称，
+// 与服务端的 WithXJSONNaming 注册选项配合使用
+func WithProtoJSON() ClientOption {
+	return func(o *clientOptions) {
+		o.protoJSON = true
+	}
+}
+
+// WithCamelCaseJSON 使用protojson以lowerCamel名称编码请求消息，并使用protojson解码响应，
+// json_names=camel 生成的客户端默认开启
+func WithCamelCaseJSON() ClientOption {
+	return func(o *clientOptions) {
+		o.protoJSON = true
+		o.camelJSON = true
+	}
+}
+
+// WithProtobuf 使用protobuf二进制编码（application/x-protobuf）发送请求消息并优先接收protobuf响应，
+// 体积和编解码开销都小于JSON；单次调用可通过 Protobuf(false) 切回JSON
+func WithProtobuf() ClientOption {
+	return func(o *clientOptions) {
+		o.protobuf = true
+	}
+}
+
+// WithBudgetPropagation 在请求头 X-Request-Budget（与 metadata.BudgetHeader 一致）中写入ctx截止时间前的剩余毫秒数，
+// 下游服务的 middleware.Budget 据此设置截止时间，避免级联调用的总耗时超出端到端SLA
+func WithBudgetPropagation() ClientOption {
+	return func(o *clientOptions) {
+		o.propagateBudget = true
+	}
+}
+
+// WithHeaderPropagation 转发 propagation.Middleware 从入站请求捕获的请求头（如 X-Request-ID、Authorization、租户头），
+// 服务内调用下游时无需逐个复制；同名的客户端默认请求头和调用级请求头优先
+func WithHeaderPropagation() ClientOption {
+	return func(o *clientOptions) {
+		o.propagateHeaders = true
+	}
+}
+
+// hasHeader 判断是否设置了同名的客户端默认请求头
+func (o *clientOptions) hasHeader(name string) bool {
+	for key := range o.headers {
+		if http.CanonicalHeaderKey(key) == http.CanonicalHeaderKey(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // WithTransport 设置HTTP传输
 func WithTransport(transport http.RoundTripper) ClientOption {
 	return func(o *clientOptions) {
@@ -90,6 +153,33 @@ func WithHeader(key, value string) ClientOption {
 	}
 }
 
+// WithTokenSource 设置按权限范围获取访问令牌的函数，令牌以Bearer方式附加到请求
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(o *clientOptions) {
+		o.tokenSource = source
+	}
+}
+
+// WithOperationScopes 设置操作到所需权限范围的映射（生成代码会自动设置）
+func WithOperationScopes(scopes map[string][]string) ClientOption {
+	return func(o *clientOptions) {
+		if o.operationScopes == nil {
+			o.operationScopes = make(map[string][]string)
+		}
+		for op, s := range scopes {
+			o.operationScopes[op] = s
+		}
+	}
+}
+
+// WithExpectContinue 为带请求体的请求发送 Expect: 100-continue，服务端在 timeout 内未响应时直接发送请求体。
+// 适用于对象存储类的大文件上传，服务端拒绝时无需传输请求体。使用 WithTransport 时仅对 *http.Transport 生效
+func WithExpectContinue(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.expectContinueTimeout = timeout
+	}
+}
+
 // Operation 设置操作名称
 func Operation(operation string) CallOption {
 	return func(o *callOptions) {
@@ -125,6 +215,13 @@ func Accept(accept string) CallOption {
 	}
 }
 
+// AcceptLanguage 设置Accept-Language头，如 "de" 或 "zh-CN, en;q=0.8"，服务端据此返回本地化的消息
+func AcceptLanguage(languages string) CallOption {
+	return func(o *callOptions) {
+		o.headers["Accept-Language"] = languages
+	}
+}
+
 // Authorization 设置Authorization头
 func Authorization(auth string) CallOption {
 	return func(o *callOptions) {
@@ -145,3 +242,17 @@ func BasicAuth(username, password string) CallOption {
 		o.headers["Authorization"] = BasicAuthValue(username, password)
 	}
 }
+
+// Protobuf 设置单次调用是否使用protobuf二进制编码，覆盖客户端的 WithProtobuf 设置
+func Protobuf(enabled bool) CallOption {
+	return func(o *callOptions) {
+		o.protobuf = enabled
+	}
+}
+
+// Trailer 在响应体读取完毕后将响应的 trailer 写入 trailer（如上传后返回的校验和）
+func Trailer(trailer *http.Header) CallOption {
+	return func(o *callOptions) {
+		o.trailer = trailer
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Paginate 逐页调用fetch并依次产出每页的元素，直到下一页令牌为空。
+// req 会被复制，调用方的请求不会被修改；fetch返回错误时产出该错误并结束迭代。
+// 生成的客户端为带 page_token / next_page_token 字段的列表方法提供 XIter 方法
+func Paginate[Req proto.Message, Item any](ctx context.Context, req Req, fetch func(context.Context, Req) ([]Item, string, error), setPageToken func(Req, string)) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		var zero Item
+		req := proto.Clone(req).(Req)
+		seen := make(map[string]bool)
+		for {
+			items, next, err := fetch(ctx, req)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == "" {
+				return
+			}
+			// 服务端重复返回同一令牌时避免死循环
+			if seen[next] {
+				yield(zero, fmt.Errorf("page token %q returned twice, stopping pagination", next))
+				return
+			}
+			seen[next] = true
+			setPageToken(req, next)
+		}
+	}
+}
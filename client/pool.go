@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// ConnPoolConfig 连接池与长连接配置，零值字段保留 Transport 原有设置
+type ConnPoolConfig struct {
+	// MaxIdleConns 所有主机的最大空闲连接数
+	MaxIdleConns int
+	// MaxIdleConnsPerHost 每个主机的最大空闲连接数
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost 每个主机的最大连接数，包括正在使用的连接
+	MaxConnsPerHost int
+	// IdleConnTimeout 空闲连接的关闭时间
+	IdleConnTimeout time.Duration
+	// KeepAlive TCP keep-alive 探测间隔，负数表示关闭探测
+	KeepAlive time.Duration
+	// MaxLifetime 单个连接的最长存活时间，到期后处理完当前请求即关闭，
+	// 使长期运行的客户端在负载均衡变更后重新建立连接。0 表示不限制，仅对 HTTP/1.1 连接生效
+	MaxLifetime time.Duration
+	// Clock 计算连接的存活时间，默认为系统时钟；测试中可传入 clock.Fake
+	Clock clock.Clock
+}
+
+// DefaultConnPoolConfig 返回默认的连接池配置
+func DefaultConnPoolConfig() ConnPoolConfig {
+	return ConnPoolConfig{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		MaxConnsPerHost:     0,
+		IdleConnTimeout:     90 * time.Second,
+		KeepAlive:           30 * time.Second,
+		MaxLifetime:         5 * time.Minute,
+		Clock:               clock.System,
+	}
+}
+
+// PoolStats 连接池统计，由 WithConnPool 创建的客户端提供
+type PoolStats struct {
+	// Open 当前打开的连接数
+	Open int64
+	// InUse 正在处理请求的连接数，HTTP/2 多路复用时为进行中的请求数
+	InUse int64
+	// Idle 空闲连接数
+	Idle int64
+	// Dialed 累计建立的连接数
+	Dialed int64
+	// Closed 累计关闭的连接数
+	Closed int64
+	// Retired 累计因超过 MaxLifetime 而关闭的连接数
+	Retired int64
+}
+
+// WithConnPool 调整连接池和长连接参数，并统计连接池状态（见 ConnPoolStats）。
+// 使用 WithTransport 时仅对 *http.Transport 生效
+func WithConnPool(config ConnPoolConfig) ClientOption {
+	return func(o *clientOptions) {
+		o.connPool = &config
+	}
+}
+
+// CloseIdleConnections 关闭客户端的空闲连接，正在使用的连接不受影响，
+// 可在服务发现或 DNS 变更后调用，使后续请求连接到新的后端
+func CloseIdleConnections(c Client) {
+	if cc, ok := c.(interface{ CloseIdleConnections() }); ok {
+		cc.CloseIdleConnections()
+	}
+}
+
+// ConnPoolStats 返回客户端的连接池统计，客户端未使用 WithConnPool 时返回 false
+func ConnPoolStats(c Client) (PoolStats, bool) {
+	if cc, ok := c.(interface{ PoolStats() (PoolStats, bool) }); ok {
+		return cc.PoolStats()
+	}
+	return PoolStats{}, false
+}
+
+// CloseIdleConnections 关闭空闲连接
+func (c *client) CloseIdleConnections() {
+	c.resty.GetClient().CloseIdleConnections()
+}
+
+// PoolStats 返回连接池统计
+func (c *client) PoolStats() (PoolStats, bool) {
+	if c.pool == nil {
+		return PoolStats{}, false
+	}
+	return c.pool.stats(), true
+}
+
+// poolTransport 统计连接并淘汰超过最长存活时间的连接
+type poolTransport struct {
+	next        *http.Transport
+	maxLifetime time.Duration
+	clock       clock.Clock
+
+	open, inUse, dialed, closed, retired atomic.Int64
+}
+
+// newPoolTransport 按配置复制 t，返回 nil 表示 rt 不是 *http.Transport
+func newPoolTransport(rt http.RoundTripper, config ConnPoolConfig) *poolTransport {
+	t, ok := rt.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	t = t.Clone()
+	if config.MaxIdleConns > 0 {
+		t.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = config.MaxConnsPerHost
+	}
+	if config.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = config.IdleConnTimeout
+	}
+	dial := t.DialContext
+	if config.KeepAlive != 0 || dial == nil {
+		dial = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: config.KeepAlive}).DialContext
+	}
+
+	p := &poolTransport{next: t, maxLifetime: config.MaxLifetime, clock: clock.OrSystem(config.Clock)}
+	t.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		p.dialed.Add(1)
+		p.open.Add(1)
+		return &trackedConn{Conn: conn, created: p.clock.Now(), pool: p}, nil
+	}
+	return p
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (p *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if p.maxLifetime > 0 {
+		// 复制请求后再修改：使用过期连接的请求携带 Connection: close，响应结束后连接不再放回连接池
+		var clone *http.Request
+		trace := &httptrace.ClientTrace{GotConn: func(info httptrace.GotConnInfo) {
+			if c := unwrapTrackedConn(info.Conn); c != nil && p.clock.Now().Sub(c.created) > p.maxLifetime {
+				clone.Close = true
+				c.retiring.Store(true)
+			}
+		}}
+		clone = req.Clone(httptrace.WithClientTrace(req.Context(), trace))
+		req = clone
+	}
+
+	p.inUse.Add(1)
+	resp, err := p.next.RoundTrip(req)
+	if err != nil {
+		p.inUse.Add(-1)
+		return nil, err
+	}
+	resp.Body = &inUseBody{ReadCloser: resp.Body, done: func() { p.inUse.Add(-1) }}
+	return resp, nil
+}
+
+// CloseIdleConnections 关闭空闲连接
+func (p *poolTransport) CloseIdleConnections() {
+	p.next.CloseIdleConnections()
+}
+
+func (p *poolTransport) stats() PoolStats {
+	s := PoolStats{
+		Open:    p.open.Load(),
+		InUse:   p.inUse.Load(),
+		Dialed:  p.dialed.Load(),
+		Closed:  p.closed.Load(),
+		Retired: p.retired.Load(),
+	}
+	s.Idle = max(s.Open-s.InUse, 0)
+	return s
+}
+
+// trackedConn 记录创建时间的连接
+type trackedConn struct {
+	net.Conn
+	created  time.Time
+	pool     *poolTransport
+	retiring atomic.Bool
+	closed   atomic.Bool
+}
+
+// Close 关闭连接并更新统计
+func (c *trackedConn) Close() error {
+	if c.closed.CompareAndSwap(false, true) {
+		c.pool.open.Add(-1)
+		c.pool.closed.Add(1)
+		if c.retiring.Load() {
+			c.pool.retired.Add(1)
+		}
+	}
+	return c.Conn.Close()
+}
+
+// unwrapTrackedConn 返回 conn 底层的 trackedConn，TLS 连接通过 NetConn 解包
+func unwrapTrackedConn(conn net.Conn) *trackedConn {
+	for conn != nil {
+		if c, ok := conn.(*trackedConn); ok {
+			return c
+		}
+		nc, ok := conn.(interface{ NetConn() net.Conn })
+		if !ok {
+			return nil
+		}
+		conn = nc.NetConn()
+	}
+	return nil
+}
+
+// inUseBody 在响应体读完或关闭时结束连接占用
+type inUseBody struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+func (b *inUseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.done)
+	}
+	return n, err
+}
+
+func (b *inUseBody) Close() error {
+	b.once.Do(b.done)
+	return b.ReadCloser.Close()
+}
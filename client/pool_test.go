@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kenka/ginpb/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPoolTransport(t *testing.T) {
+	base := &http.Transport{MaxIdleConns: 5, IdleConnTimeout: time.Second}
+	p := newPoolTransport(base, ConnPoolConfig{MaxIdleConnsPerHost: 3, MaxConnsPerHost: 8})
+	require.NotNil(t, p)
+	assert.NotSame(t, base, p.next, "the transport is copied")
+	// 零值字段保留原有设置
+	assert.Equal(t, 5, p.next.MaxIdleConns)
+	assert.Equal(t, time.Second, p.next.IdleConnTimeout)
+	assert.Equal(t, 3, p.next.MaxIdleConnsPerHost)
+	assert.Equal(t, 8, p.next.MaxConnsPerHost)
+	assert.Zero(t, base.MaxConnsPerHost)
+
+	assert.Nil(t, newPoolTransport(roundTripFunc(nil), DefaultConnPoolConfig()))
+}
+
+func TestConnPoolLifetime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	fake := clock.NewFake(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	config := DefaultConnPoolConfig()
+	config.MaxLifetime = time.Minute
+	config.Clock = fake
+	c := NewClient(WithEndpoint(srv.URL), WithTransport(&http.Transport{}), WithConnPool(config))
+	defer CloseIdleConnections(c)
+	get := func() {
+		var reply map[string]any
+		require.NoError(t, c.Invoke(context.Background(), http.MethodGet, "/v1/users", nil, &reply))
+	}
+	stats := func() PoolStats {
+		s, ok := ConnPoolStats(c)
+		require.True(t, ok)
+		return s
+	}
+
+	get()
+	get()
+	assert.Equal(t, PoolStats{Open: 1, Idle: 1, Dialed: 1}, stats(), "the connection is reused")
+
+	// 超过 MaxLifetime 的连接处理完当前请求后关闭
+	fake.Advance(2 * time.Minute)
+	get()
+	require.Eventually(t, func() bool { return stats().Open == 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, PoolStats{Dialed: 1, Closed: 1, Retired: 1}, stats())
+
+	get()
+	assert.Equal(t, PoolStats{Open: 1, Idle: 1, Dialed: 2, Closed: 1, Retired: 1}, stats())
+
+	// 关闭空闲连接不计入淘汰
+	CloseIdleConnections(c)
+	require.Eventually(t, func() bool { return stats().Open == 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, PoolStats{Dialed: 2, Closed: 2, Retired: 1}, stats())
+
+	_, ok := ConnPoolStats(NewClient(WithEndpoint(srv.URL)))
+	assert.False(t, ok, "clients without WithConnPool have no stats")
+}
+
+func TestConnPoolInUse(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	p := newPoolTransport(&http.Transport{}, ConnPoolConfig{})
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err := p.RoundTrip(req)
+	require.NoError(t, err)
+
+	// 响应体关闭前连接处于使用中
+	assert.Equal(t, PoolStats{Open: 1, InUse: 1, Dialed: 1}, p.stats())
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, int64(0), p.stats().InUse)
+	p.CloseIdleConnections()
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// QueryParam 将请求消息的字段映射到查询参数
+type QueryParam struct {
+	// Field 字段的proto名称，如 page_token
+	Field protoreflect.Name
+	// Name 查询参数名，即字段的 form 标签，如 pageToken
+	Name string
+}
+
+// AppendMessageQuery 把in中已设置的字段按params追加为查询参数，重复字段追加多个同名参数，
+// 未设置的字段省略。生成的客户端用它发送分页列表方法的过滤条件与 page_token
+func AppendMessageQuery(path string, in proto.Message, params ...QueryParam) string {
+	msg := in.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	query := url.Values{}
+	for _, p := range params {
+		fd := fields.ByName(p.Field)
+		if fd == nil || fd.IsMap() || !msg.Has(fd) {
+			continue
+		}
+		v := msg.Get(fd)
+		if !fd.IsList() {
+			if s, ok := queryValue(fd, v); ok {
+				query.Add(p.Name, s)
+			}
+			continue
+		}
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			if s, ok := queryValue(fd, list.Get(i)); ok {
+				query.Add(p.Name, s)
+			}
+		}
+	}
+	if len(query) == 0 {
+		return path
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + query.Encode()
+}
+
+// queryValue 返回字段值的查询参数形式，枚举使用值名称，Timestamp、Duration、包装类型使用其JSON形式，
+// 其他消息无法作为查询参数发送
+func queryValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, bool) {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), true
+		}
+		return strconv.Itoa(int(v.Enum())), true
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), true
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), true
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case "google.protobuf.Timestamp", "google.protobuf.Duration",
+			"google.protobuf.StringValue", "google.protobuf.BoolValue",
+			"google.protobuf.Int32Value", "google.protobuf.Int64Value",
+			"google.protobuf.UInt32Value", "google.protobuf.UInt64Value",
+			"google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+			b, err := protojson.Marshal(v.Message().Interface())
+			if err != nil {
+				return "", false
+			}
+			if s, err := strconv.Unquote(string(b)); err == nil {
+				return s, true
+			}
+			return string(b), true
+		}
+		return "", false
+	default:
+		return v.String(), true
+	}
+}
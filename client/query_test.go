@@ -0,0 +1,33 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestAppendMessageQuery(t *testing.T) {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("display name"),
+		Number: proto.Int32(3),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+	}
+	path := AppendMessageQuery("/v1/fields", field,
+		QueryParam{Field: "name", Name: "q"},
+		QueryParam{Field: "number", Name: "number"},
+		QueryParam{Field: "label", Name: "label"},
+		QueryParam{Field: "json_name", Name: "jsonName"},
+		QueryParam{Field: "options", Name: "options"},
+		QueryParam{Field: "unknown", Name: "unknown"},
+	)
+	assert.Equal(t, "/v1/fields?label=LABEL_REPEATED&number=3&q=display+name", path)
+
+	file := &descriptorpb.FileDescriptorProto{Dependency: []string{"a.proto", "b.proto"}}
+	assert.Equal(t, "/v1/files?page=2&dependency=a.proto&dependency=b.proto",
+		AppendMessageQuery("/v1/files?page=2", file, QueryParam{Field: "dependency", Name: "dependency"}))
+
+	// 未设置的字段不改变路径
+	assert.Equal(t, "/v1/files", AppendMessageQuery("/v1/files", &descriptorpb.FileDescriptorProto{}, QueryParam{Field: "dependency", Name: "dependency"}))
+}
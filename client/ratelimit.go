@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// RateLimitState 服务端通过响应头返回的限流状态
+type RateLimitState struct {
+	// Limited 为 true 表示本次响应为 429
+	Limited bool
+	// Limit 窗口内的配额，未知时为 -1
+	Limit int
+	// Remaining 窗口内剩余配额，未知时为 -1
+	Remaining int
+	// Reset 配额重置时间，未知时为零值
+	Reset time.Time
+	// RetryAfter 服务端建议的等待时间，来自 Retry-After 或配额重置时间
+	RetryAfter time.Duration
+}
+
+// RateLimitConfig 429 退避配置
+type RateLimitConfig struct {
+	// MaxRetries 收到 429 后的最大重试次数
+	MaxRetries int
+	// MaxWait 单次等待上限，服务端要求等待更久时直接返回 429 错误，0 表示不限制
+	MaxWait time.Duration
+	// DefaultWait 429 响应未携带等待时间时的等待时间
+	DefaultWait time.Duration
+	// Queue 为 true 时，限流期间（429 或剩余配额为 0）后续请求排队等待配额重置，而不是继续发送
+	Queue bool
+	// OnRateLimit 在响应携带限流头或返回 429 时回调，可用于监控或自适应限速
+	OnRateLimit func(RateLimitState)
+	// Clock 计算与等待退避时间，默认为系统时钟；测试中可传入 clock.Fake 避免真实等待
+	Clock clock.Clock
+}
+
+// DefaultRateLimitConfig 返回默认的 429 退避配置
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		MaxRetries:  3,
+		MaxWait:     time.Minute,
+		DefaultWait: time.Second,
+		Queue:       false,
+		OnRateLimit: nil,
+		Clock:       clock.System,
+	}
+}
+
+// WithRateLimit 按 Retry-After / RateLimit-Reset 响应头对 429 退避重试，与 WithRetry 的通用重试相互独立。
+// 等待时间计入 WithTimeout 设置的请求超时
+func WithRateLimit(config RateLimitConfig) ClientOption {
+	return func(o *clientOptions) {
+		o.rateLimit = &config
+	}
+}
+
+// IsRateLimited 检查错误是否为 429，等待时间见 HTTPError.RetryAfter
+func IsRateLimited(err error) bool {
+	return GetHTTPStatusCode(err) == http.StatusTooManyRequests
+}
+
+// rateLimitTransport 在传输层处理 429 退避与排队
+type rateLimitTransport struct {
+	next   http.RoundTripper
+	config RateLimitConfig
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+func newRateLimitTransport(next http.RoundTripper, config RateLimitConfig) *rateLimitTransport {
+	if config.DefaultWait <= 0 {
+		config.DefaultWait = time.Second
+	}
+	config.Clock = clock.OrSystem(config.Clock)
+	return &rateLimitTransport{next: next, config: config}
+}
+
+// CloseIdleConnections 关闭下层传输的空闲连接
+func (t *rateLimitTransport) CloseIdleConnections() {
+	if c, ok := t.next.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if t.config.Queue {
+			if err := sleepContext(req.Context(), t.config.Clock, t.waitTime()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		state, ok := parseRateLimit(resp, t.config.Clock.Now())
+		if ok && t.config.OnRateLimit != nil {
+			t.config.OnRateLimit(state)
+		}
+		if t.config.Queue && (state.Limited || state.Remaining == 0) && !state.Reset.IsZero() {
+			t.block(state.Reset)
+		}
+		if !state.Limited {
+			return resp, nil
+		}
+
+		wait := state.RetryAfter
+		if wait <= 0 {
+			wait = t.config.DefaultWait
+		}
+		if t.config.Queue {
+			t.block(t.config.Clock.Now().Add(wait))
+		}
+		// 无法重放请求体时直接返回 429
+		if attempt >= t.config.MaxRetries || (t.config.MaxWait > 0 && wait > t.config.MaxWait) ||
+			(req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleepContext(req.Context(), t.config.Clock, wait); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *rateLimitTransport) block(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until.After(t.blockedUntil) {
+		t.blockedUntil = until
+	}
+}
+
+func (t *rateLimitTransport) waitTime() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.blockedUntil.Sub(t.config.Clock.Now())
+}
+
+// sleepContext 按时钟 c 等待 d 或 ctx 结束
+func sleepContext(ctx context.Context, c clock.Clock, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.After(d):
+		return nil
+	}
+}
+
+// parseRateLimit 解析 Retry-After、RateLimit-* 与 X-RateLimit-* 响应头，ok 表示响应携带了限流信息
+func parseRateLimit(resp *http.Response, now time.Time) (state RateLimitState, ok bool) {
+	h := resp.Header
+	state = RateLimitState{
+		Limited:   resp.StatusCode == http.StatusTooManyRequests,
+		Limit:     headerInt(h, "RateLimit-Limit", "X-RateLimit-Limit"),
+		Remaining: headerInt(h, "RateLimit-Remaining", "X-RateLimit-Remaining"),
+	}
+	if reset := headerInt(h, "RateLimit-Reset", "X-RateLimit-Reset"); reset >= 0 {
+		// RateLimit-Reset 为秒数，部分服务的 X-RateLimit-Reset 为 Unix 时间戳
+		if reset > 1e9 {
+			state.Reset = time.Unix(int64(reset), 0)
+		} else {
+			state.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	state.RetryAfter = retryAfter(h, now)
+	if state.RetryAfter <= 0 && state.Limited && !state.Reset.IsZero() {
+		state.RetryAfter = state.Reset.Sub(now)
+	}
+	ok = state.Limited || state.Limit >= 0 || state.Remaining >= 0 || !state.Reset.IsZero()
+	return state, ok
+}
+
+// retryAfter 解析秒数或 HTTP 日期格式的 Retry-After
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+func headerInt(h http.Header, names ...string) int {
+	for _, name := range names {
+		if v, err := strconv.Atoi(h.Get(name)); err == nil {
+			return v
+		}
+	}
+	return -1
+}
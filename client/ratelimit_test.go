@@ -0,0 +1,161 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kenka/ginpb/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc 把函数适配为 http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// stubResponse 返回带状态码和响应头的响应
+func stubResponse(status int, header ...string) *http.Response {
+	h := http.Header{}
+	for i := 0; i+1 < len(header); i += 2 {
+		h.Set(header[i], header[i+1])
+	}
+	return &http.Response{StatusCode: status, Header: h, Body: io.NopCloser(strings.NewReader(http.StatusText(status)))}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	state, ok := parseRateLimit(stubResponse(http.StatusOK), now)
+	assert.False(t, ok)
+	assert.Equal(t, RateLimitState{Limit: -1, Remaining: -1}, state)
+
+	state, ok = parseRateLimit(stubResponse(http.StatusOK, "RateLimit-Limit", "100", "RateLimit-Remaining", "0", "RateLimit-Reset", "30"), now)
+	assert.True(t, ok)
+	assert.Equal(t, RateLimitState{Limit: 100, Remaining: 0, Reset: now.Add(30 * time.Second)}, state)
+
+	// 429 未携带 Retry-After 时等待到配额重置，X-RateLimit-Reset 可为 Unix 时间戳
+	state, ok = parseRateLimit(stubResponse(http.StatusTooManyRequests, "X-RateLimit-Reset", "1704208000"), now)
+	assert.True(t, ok)
+	assert.True(t, state.Limited)
+	assert.Equal(t, time.Unix(1704208000, 0), state.Reset)
+	assert.Equal(t, time.Unix(1704208000, 0).Sub(now), state.RetryAfter)
+
+	// Retry-After 优先于配额重置时间，支持秒数与 HTTP 日期
+	state, _ = parseRateLimit(stubResponse(http.StatusTooManyRequests, "Retry-After", "7", "RateLimit-Reset", "30"), now)
+	assert.Equal(t, 7*time.Second, state.RetryAfter)
+	state, _ = parseRateLimit(stubResponse(http.StatusTooManyRequests, "Retry-After", now.Add(time.Minute).Format(http.TimeFormat)), now)
+	assert.Equal(t, time.Minute, state.RetryAfter)
+	state, _ = parseRateLimit(stubResponse(http.StatusTooManyRequests, "Retry-After", "soon"), now)
+	assert.Zero(t, state.RetryAfter)
+}
+
+func TestRateLimitTransportRetries(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	var bodies []string
+	var states []RateLimitState
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		b, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			return stubResponse(http.StatusTooManyRequests, "Retry-After", "2"), nil
+		}
+		return stubResponse(http.StatusOK), nil
+	})
+	transport := newRateLimitTransport(next, RateLimitConfig{
+		MaxRetries:  3,
+		Clock:       fake,
+		OnRateLimit: func(s RateLimitState) { states = append(states, s) },
+	})
+
+	req, err := http.NewRequest(http.MethodPost, "http://svc/v1/users", bytes.NewReader([]byte(`{"name":"ada"}`)))
+	require.NoError(t, err)
+	done := make(chan *http.Response)
+	go func() {
+		resp, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		done <- resp
+	}()
+
+	// 等待按 Retry-After 退避后重放请求体
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	fake.Advance(time.Second)
+	assert.Equal(t, 1, fake.Waiters(), "the retry waits for Retry-After")
+	fake.Advance(time.Second)
+	resp := <-done
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"ada"}`, `{"name":"ada"}`}, bodies)
+	require.Len(t, states, 1)
+	assert.True(t, states[0].Limited)
+	assert.Equal(t, 2*time.Second, states[0].RetryAfter)
+}
+
+func TestRateLimitTransportGivesUp(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	calls := 0
+	next := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		return stubResponse(http.StatusTooManyRequests, "Retry-After", "120"), nil
+	})
+
+	// 服务端要求的等待超过 MaxWait 时直接返回 429
+	transport := newRateLimitTransport(next, RateLimitConfig{MaxRetries: 3, MaxWait: time.Minute, Clock: fake})
+	resp, err := transport.RoundTrip(getRequest(t))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+
+	// 重试次数用尽后返回最后一次的 429
+	calls = 0
+	transport = newRateLimitTransport(next, RateLimitConfig{MaxRetries: 0, Clock: fake})
+	resp, err = transport.RoundTrip(getRequest(t))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRateLimitTransportQueue(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	var mu sync.Mutex
+	calls := 0
+	next := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return stubResponse(http.StatusOK, "RateLimit-Remaining", "0", "RateLimit-Reset", "5"), nil
+	})
+	transport := newRateLimitTransport(next, RateLimitConfig{Queue: true, Clock: fake})
+	count := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+
+	_, err := transport.RoundTrip(getRequest(t))
+	require.NoError(t, err)
+
+	// 配额用尽后后续请求排队等待配额重置
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := transport.RoundTrip(getRequest(t))
+		assert.NoError(t, err)
+	}()
+	require.Eventually(t, func() bool { return fake.Waiters() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, 1, count())
+	fake.Advance(5 * time.Second)
+	<-done
+	assert.Equal(t, 2, count())
+}
+
+// getRequest 返回不带请求体的 GET 请求
+func getRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "http://svc/v1/users", nil)
+	require.NoError(t, err)
+	return req
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// RegionConfig 多区域部署的路由配置，区域固定（ginpb.region_pinned）的操作只发送到所属区域的端点
+type RegionConfig struct {
+	// Endpoints 区域到服务端点的映射，如 {"eu": "https://eu.api.example.com"}
+	Endpoints map[string]string
+	// Resolver 在ctx未通过 WithRegion 指定区域时解析区域，如根据租户属性查询数据驻留区域，
+	// 返回空字符串表示无法确定区域
+	Resolver func(ctx context.Context) (string, error)
+}
+
+// regionKey WithRegion 在ctx中使用的键
+type regionKey struct{}
+
+// WithRegion 返回指定了区域的ctx，优先于 RegionConfig.Resolver
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey{}, region)
+}
+
+// RegionFromContext 返回 WithRegion 设置的区域
+func RegionFromContext(ctx context.Context) (string, bool) {
+	region, ok := ctx.Value(regionKey{}).(string)
+	return region, ok && region != ""
+}
+
+// WithRegions 设置区域路由，区域固定的操作发送到ctx所属区域的端点，其他操作仍使用 WithEndpoint 设置的端点。
+// 区域固定的操作无法确定区域或区域没有端点时直接返回错误，不会回退到默认端点
+func WithRegions(config RegionConfig) ClientOption {
+	return func(o *clientOptions) {
+		o.regions = &config
+	}
+}
+
+// WithRegionPinnedOperations 标记区域固定的操作（生成代码会自动设置）
+func WithRegionPinnedOperations(operations ...string) ClientOption {
+	return func(o *clientOptions) {
+		if o.regionPinned == nil {
+			o.regionPinned = make(map[string]bool)
+		}
+		for _, op := range operations {
+			o.regionPinned[op] = true
+		}
+	}
+}
+
+// regionEndpoint 返回区域固定操作的区域端点，其他操作返回空字符串
+func (o *clientOptions) regionEndpoint(ctx context.Context, operation string) (string, error) {
+	if !o.regionPinned[operation] {
+		return "", nil
+	}
+	if o.regions == nil {
+		return "", fmt.Errorf("operation %q is region-pinned but no regions are configured, use client.WithRegions", operation)
+	}
+	region, ok := RegionFromContext(ctx)
+	if !ok && o.regions.Resolver != nil {
+		var err error
+		if region, err = o.regions.Resolver(ctx); err != nil {
+			return "", fmt.Errorf("resolve region of operation %q: %w", operation, err)
+		}
+	}
+	if region == "" {
+		return "", fmt.Errorf("operation %q is region-pinned but the request has no region, use client.WithRegion", operation)
+	}
+	endpoint, ok := o.regions.Endpoints[region]
+	if !ok || endpoint == "" {
+		return "", fmt.Errorf("operation %q is region-pinned but region %q has no endpoint", operation, region)
+	}
+	return endpoint, nil
+}
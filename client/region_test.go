@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRegionServer 返回记录请求次数的服务端
+func newRegionServer(t *testing.T) (*httptest.Server, *int) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+// 区域固定的操作发送到ctx所属区域的端点，其他操作使用默认端点
+func TestRegionPinnedEndpoint(t *testing.T) {
+	def, defHits := newRegionServer(t)
+	eu, euHits := newRegionServer(t)
+	us, usHits := newRegionServer(t)
+	c := NewClient(
+		WithEndpoint(def.URL),
+		WithRegions(RegionConfig{
+			Endpoints: map[string]string{"eu": eu.URL, "us": us.URL},
+			Resolver: func(ctx context.Context) (string, error) {
+				return "us", nil
+			},
+		}),
+		WithRegionPinnedOperations("/example.Svc/GetUser"),
+	)
+	invoke := func(ctx context.Context, operation string) error {
+		return c.Invoke(ctx, http.MethodGet, "/v1/users/1", nil, nil, Operation(operation))
+	}
+
+	// WithRegion 指定的区域优先于 Resolver
+	require.NoError(t, invoke(WithRegion(context.Background(), "eu"), "/example.Svc/GetUser"))
+	assert.Equal(t, 1, *euHits)
+
+	// 未指定区域时使用 Resolver 解析的区域
+	require.NoError(t, invoke(context.Background(), "/example.Svc/GetUser"))
+	assert.Equal(t, 1, *usHits)
+
+	// 未标记区域固定的操作忽略区域，发送到默认端点
+	require.NoError(t, invoke(WithRegion(context.Background(), "eu"), "/example.Svc/ListUsers"))
+	assert.Equal(t, 1, *defHits)
+	assert.Equal(t, 1, *euHits)
+}
+
+// 区域固定的操作无法确定区域或区域没有端点时返回错误，不会回退到默认端点
+func TestRegionPinnedNoMatch(t *testing.T) {
+	def, defHits := newRegionServer(t)
+	eu, euHits := newRegionServer(t)
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		resolver func(ctx context.Context) (string, error)
+		err      string
+	}{
+		{
+			name: "区域没有端点",
+			ctx:  WithRegion(context.Background(), "ap"),
+			err:  `operation "/example.Svc/GetUser" is region-pinned but region "ap" has no endpoint`,
+		},
+		{
+			name: "无法确定区域",
+			ctx:  context.Background(),
+			resolver: func(ctx context.Context) (string, error) {
+				return "", nil
+			},
+			err: `operation "/example.Svc/GetUser" is region-pinned but the request has no region, use client.WithRegion`,
+		},
+		{
+			name: "解析区域失败",
+			ctx:  context.Background(),
+			resolver: func(ctx context.Context) (string, error) {
+				return "", errors.New("tenant not found")
+			},
+			err: `resolve region of operation "/example.Svc/GetUser": tenant not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(
+				WithEndpoint(def.URL),
+				WithRegions(RegionConfig{Endpoints: map[string]string{"eu": eu.URL}, Resolver: tt.resolver}),
+				WithRegionPinnedOperations("/example.Svc/GetUser"),
+			)
+			err := c.Invoke(tt.ctx, http.MethodGet, "/v1/users/1", nil, nil, Operation("/example.Svc/GetUser"))
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+	assert.Zero(t, *defHits)
+	assert.Zero(t, *euHits)
+
+	// 未配置区域路由时区域固定的操作同样返回错误
+	c := NewClient(WithEndpoint(def.URL), WithRegionPinnedOperations("/example.Svc/GetUser"))
+	err := c.Invoke(context.Background(), http.MethodGet, "/v1/users/1", nil, nil, Operation("/example.Svc/GetUser"))
+	assert.EqualError(t, err, `operation "/example.Svc/GetUser" is region-pinned but no regions are configured, use client.WithRegions`)
+	assert.Zero(t, *defHits)
+}
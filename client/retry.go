@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// retryableKey 请求ctx中记录本次调用是否允许自动重试的键
+type retryableKey struct{}
+
+// WithIdempotentOperations 声明幂等的操作（生成代码会自动设置，来自 ginpb.idempotent 与 idempotency_level）。
+// 设置后 WithRetry 只对幂等操作以及 GET、HEAD、OPTIONS 请求生效，其他操作从不自动重试；未设置时沿用对所有请求重试的行为
+func WithIdempotentOperations(operations ...string) ClientOption {
+	return func(o *clientOptions) {
+		if o.idempotent == nil {
+			o.idempotent = make(map[string]bool)
+		}
+		for _, op := range operations {
+			o.idempotent[op] = true
+		}
+	}
+}
+
+// Retryable 覆盖本次调用是否允许自动重试，如携带幂等键的非幂等操作
+func Retryable(retryable bool) CallOption {
+	return func(o *callOptions) {
+		o.retryable = &retryable
+	}
+}
+
+// retryable 返回本次调用是否允许自动重试，GET、HEAD、OPTIONS 请求按 HTTP 语义视为幂等
+func (o *clientOptions) retryable(method string, callOpts *callOptions) bool {
+	if callOpts.retryable != nil {
+		return *callOpts.retryable
+	}
+	return o.idempotent == nil || o.idempotent[callOpts.operation] || safeMethod(method)
+}
+
+// safeMethod 判断是否为没有副作用的 HTTP 方法
+func safeMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryCondition 只重试允许重试的调用：传输错误以及网关类的 502、503、504 响应
+func retryCondition(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil {
+		return false
+	}
+	if ok, _ := resp.Request.Context().Value(retryableKey{}).(bool); !ok {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode() {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// withRetryable 在请求ctx中记录本次调用是否允许自动重试
+func withRetryable(ctx context.Context, retryable bool) context.Context {
+	return context.WithValue(ctx, retryableKey{}, retryable)
+}
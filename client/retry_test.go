@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryCondition(t *testing.T) {
+	response := func(retryable bool, status int) *resty.Response {
+		req := resty.New().R().SetContext(withRetryable(context.Background(), retryable))
+		return &resty.Response{Request: req, RawResponse: &http.Response{StatusCode: status}}
+	}
+
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		assert.True(t, retryCondition(response(true, status), nil), status)
+		assert.False(t, retryCondition(response(false, status), nil), status)
+	}
+	for _, status := range []int{http.StatusOK, http.StatusBadRequest, http.StatusInternalServerError, http.StatusTooManyRequests} {
+		assert.False(t, retryCondition(response(true, status), nil), status)
+	}
+	// 传输错误只在允许重试时重试
+	assert.True(t, retryCondition(response(true, 0), errors.New("connection reset")))
+	assert.False(t, retryCondition(response(false, 0), errors.New("connection reset")))
+	assert.False(t, retryCondition(nil, errors.New("connection reset")))
+}
+
+func TestIdempotentOperations(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	c := NewClient(
+		WithEndpoint(srv.URL),
+		WithRetry(2, time.Millisecond, time.Millisecond),
+		WithIdempotentOperations("/example.Svc/UpdateUser"),
+	)
+	invoke := func(method, operation string, opts ...CallOption) int32 {
+		calls.Store(0)
+		err := c.Invoke(context.Background(), method, "/v1/users", nil, nil, append([]CallOption{Operation(operation)}, opts...)...)
+		assert.Error(t, err)
+		return calls.Load()
+	}
+
+	// GET 请求与声明幂等的操作重试，其他操作只发送一次
+	assert.Equal(t, int32(3), invoke(http.MethodGet, "/example.Svc/ListUsers"))
+	assert.Equal(t, int32(3), invoke(http.MethodPut, "/example.Svc/UpdateUser"))
+	assert.Equal(t, int32(1), invoke(http.MethodPost, "/example.Svc/CreateUser"))
+
+	// 调用级选项优先
+	assert.Equal(t, int32(3), invoke(http.MethodPost, "/example.Svc/CreateUser", Retryable(true)))
+	assert.Equal(t, int32(1), invoke(http.MethodGet, "/example.Svc/ListUsers", Retryable(false)))
+}
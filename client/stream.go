@@ -0,0 +1,214 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Streamer 支持流式读取响应体的客户端
+type Streamer interface {
+	Stream(ctx context.Context, method, path string, args interface{}, fn func(body io.Reader) error, opts ...CallOption) error
+}
+
+// Stream 执行HTTP请求并将未缓冲的响应体交给fn处理
+func (c *client) Stream(ctx context.Context, method, path string, args interface{}, fn func(body io.Reader) error, opts ...CallOption) error {
+	req, callOpts, err := c.newRequest(ctx, method, path, args, opts)
+	if err != nil {
+		return err
+	}
+	req.SetDoNotParseResponse(true)
+
+	resp, err := execute(req, method, callOpts.url(path))
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	// 检查HTTP状态码
+	if resp.IsError() {
+		httpErr := &HTTPError{}
+		if json.NewDecoder(body).Decode(httpErr) != nil || httpErr.Message == "" {
+			httpErr.Message = resp.Status()
+		}
+		httpErr.Code = resp.StatusCode()
+		return httpErr
+	}
+	if err := fn(body); err != nil {
+		return err
+	}
+	if callOpts.trailer != nil {
+		// trailer 仅在响应体读取到EOF后可用
+		_, _ = io.Copy(io.Discard, body)
+		*callOpts.trailer = resp.RawResponse.Trailer
+	}
+	return nil
+}
+
+// StreamList 流式读取JSON数组或NDJSON格式的列表响应，对每个元素调用fn
+func StreamList[T any](ctx context.Context, c Client, method, path string, args interface{}, fn func(*T) error, opts ...CallOption) error {
+	s, ok := c.(Streamer)
+	if !ok {
+		return fmt.Errorf("client %T does not implement client.Streamer, streaming responses are unsupported", c)
+	}
+	protoJSON := false
+	if cc, ok := c.(*client); ok {
+		protoJSON = cc.opts.protoJSON
+	}
+	return s.Stream(ctx, method, path, args, func(body io.Reader) error {
+		return decodeList(body, fn, protoJSON)
+	}, opts...)
+}
+
+// decodeList 逐个解码JSON数组或NDJSON中的元素，protoJSON为true时使用protojson解码protobuf消息
+func decodeList[T any](body io.Reader, fn func(*T) error, protoJSON bool) error {
+	br := bufio.NewReader(body)
+	first, err := peekNonSpace(br)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(br)
+	if first != '[' {
+		// NDJSON：逐行解码直到EOF
+		for {
+			item, err := decodeItem[T](dec, protoJSON)
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+	}
+
+	// JSON数组：缺少结尾的 "]" 说明服务端中途出错
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		item, err := decodeItem[T](dec, protoJSON)
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("truncated list response: %w", err)
+	}
+	return nil
+}
+
+// decodeItem 解码下一个元素
+func decodeItem[T any](dec *json.Decoder, protoJSON bool) (*T, error) {
+	item := new(T)
+	msg, ok := any(item).(proto.Message)
+	if !protoJSON || !ok {
+		return item, dec.Decode(item)
+	}
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return item, protoJSONUnmarshal.Unmarshal(raw, msg)
+}
+
+// peekNonSpace 跳过空白字符并返回下一个字节（不消费）
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return 0, err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+		default:
+			return b[0], nil
+		}
+	}
+}
+
+// StreamEvents 读取服务端流式方法的 Server-Sent Events 响应，对每条消息调用fn。
+// 收到 "error" 事件时返回服务端错误，连接在 "end" 事件之前断开时返回截断错误
+func StreamEvents[T any](ctx context.Context, c Client, method, path string, args interface{}, fn func(*T) error, opts ...CallOption) error {
+	s, ok := c.(Streamer)
+	if !ok {
+		return fmt.Errorf("client %T does not implement client.Streamer, streaming responses are unsupported", c)
+	}
+	protoJSON := false
+	if cc, ok := c.(*client); ok {
+		protoJSON = cc.opts.protoJSON
+	}
+	opts = append([]CallOption{Accept("text/event-stream")}, opts...)
+	return s.Stream(ctx, method, path, args, func(body io.Reader) error {
+		return decodeEvents(body, fn, protoJSON)
+	}, opts...)
+}
+
+// decodeEvents 按 SSE 格式逐个解析事件
+func decodeEvents[T any](body io.Reader, fn func(*T) error, protoJSON bool) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+	event, data := "", []string(nil)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				event = value
+			case "data":
+				data = append(data, value)
+			}
+			continue
+		}
+		// 空行表示事件结束
+		payload := strings.Join(data, "\n")
+		switch event {
+		case "end":
+			return nil
+		case "error":
+			httpErr := &HTTPError{Code: http.StatusInternalServerError}
+			var body struct {
+				Error string `json:"error"`
+			}
+			if json.Unmarshal([]byte(payload), &body) == nil && body.Error != "" {
+				httpErr.Message = body.Error
+			} else {
+				httpErr.Message = payload
+			}
+			return httpErr
+		case "", "message":
+			if len(data) > 0 {
+				item, err := decodeItem[T](json.NewDecoder(strings.NewReader(payload)), protoJSON)
+				if err != nil {
+					return err
+				}
+				if err := fn(item); err != nil {
+					return err
+				}
+			}
+		}
+		event, data = "", nil
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("truncated event stream: %w", io.ErrUnexpectedEOF)
+}
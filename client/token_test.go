@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 按操作所需的权限范围获取令牌，令牌以Bearer方式发送
+func TestTokenSourceScopes(t *testing.T) {
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	var requested [][]string
+	c := NewClient(
+		WithEndpoint(srv.URL),
+		WithOperationScopes(map[string][]string{
+			"/example.Svc/ListUsers":  {"users.read"},
+			"/example.Svc/DeleteUser": {"users.read", "users.write"},
+		}),
+		WithTokenSource(func(ctx context.Context, scopes []string) (string, error) {
+			requested = append(requested, scopes)
+			if len(scopes) == 0 {
+				return "anonymous", nil
+			}
+			return "token-" + strings.Join(scopes, "+"), nil
+		}),
+	)
+	invoke := func(method, operation string) (string, error) {
+		authorization = ""
+		err := c.Invoke(context.Background(), method, "/v1/users", nil, nil, Operation(operation))
+		return authorization, err
+	}
+
+	header, err := invoke(http.MethodGet, "/example.Svc/ListUsers")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-users.read", header)
+
+	header, err = invoke(http.MethodDelete, "/example.Svc/DeleteUser")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-users.read+users.write", header)
+
+	// 未声明权限范围的操作以空范围获取令牌
+	header, err = invoke(http.MethodPost, "/example.Svc/Ping")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer anonymous", header)
+	assert.Equal(t, [][]string{{"users.read"}, {"users.read", "users.write"}, nil}, requested)
+}
+
+// 获取令牌失败时不发送请求，错误包含操作名与权限范围
+func TestTokenSourceError(t *testing.T) {
+	sent := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+	}))
+	defer srv.Close()
+
+	c := NewClient(
+		WithEndpoint(srv.URL),
+		WithOperationScopes(map[string][]string{"/example.Svc/DeleteUser": {"users.write"}}),
+		WithTokenSource(func(ctx context.Context, scopes []string) (string, error) {
+			return "", errors.New("consent required")
+		}),
+	)
+	err := c.Invoke(context.Background(), http.MethodDelete, "/v1/users/1", nil, nil, Operation("/example.Svc/DeleteUser"))
+	assert.EqualError(t, err, `token for operation "/example.Svc/DeleteUser" with scopes [users.write]: consent required`)
+	assert.False(t, sent)
+}
@@ -176,6 +176,28 @@ func ReplacePathParams(pathTemplate string, params map[string]string) string {
 	return result
 }
 
+// EscapePath 转义多段路径参数的值，如 {name=shelves/*} 的 "shelves/1"，逐段转义并保留分隔的 "/"
+func EscapePath(value string) string {
+	segments := strings.Split(value, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// AppendQuery 将以逗号连接的values作为查询参数key追加到路径，values为空时路径不变，
+// 生成的客户端用它发送 read_mask 等字段掩码
+func AppendQuery(path, key string, values ...string) string {
+	if len(values) == 0 {
+		return path
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + url.QueryEscape(key) + "=" + url.QueryEscape(strings.Join(values, ","))
+}
+
 // IsValidHTTPMethod 检查是否为有效的HTTP方法
 func IsValidHTTPMethod(method string) bool {
 	validMethods := []string{
@@ -0,0 +1,29 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+	assert.Equal(t, start, f.Now())
+
+	late, early := f.After(2*time.Second), f.After(time.Second)
+	assert.Equal(t, 2, f.Waiters())
+	f.Advance(time.Second)
+	assert.Equal(t, start.Add(time.Second), <-early)
+	assert.Empty(t, late, "waiters fire only once due")
+	assert.Equal(t, 1, f.Waiters())
+
+	f.Set(start.Add(time.Minute))
+	assert.Equal(t, start.Add(time.Minute), <-late)
+	assert.Zero(t, f.Waiters())
+	assert.Equal(t, start.Add(time.Minute), <-f.After(0))
+
+	assert.Equal(t, System, OrSystem(nil))
+	assert.Equal(t, Clock(f), OrSystem(f))
+}
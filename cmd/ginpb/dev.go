@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// routePrefix marks the route lines printed by ginpb.DumpRoutes
+const routePrefix = "[GINPB] "
+
+// skippedDirs are never watched
+var skippedDirs = map[string]bool{".git": true, "vendor": true, "node_modules": true, "bin": true}
+
+type devOptions struct {
+	dir      string
+	generate string
+	pkg      string
+	interval time.Duration
+	args     []string
+}
+
+func runDev(args []string) error {
+	flags := flag.NewFlagSet("dev", flag.ContinueOnError)
+	opts := devOptions{}
+	flags.StringVar(&opts.dir, "dir", ".", "project directory to watch")
+	flags.StringVar(&opts.generate, "gen", "buf generate", "command regenerating code after .proto changes, empty to disable")
+	flags.StringVar(&opts.pkg, "pkg", ".", "main package to build and run")
+	flags.DurationVar(&opts.interval, "interval", 500*time.Millisecond, "polling interval")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	// Arguments after -- are passed to the server
+	opts.args = flags.Args()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return newDevServer(opts).run(ctx)
+}
+
+type devServer struct {
+	opts   devOptions
+	binary string
+	proc   *exec.Cmd
+	exited chan struct{}
+
+	mu     sync.Mutex
+	routes []string
+}
+
+func newDevServer(opts devOptions) *devServer {
+	return &devServer{opts: opts}
+}
+
+func (d *devServer) run(ctx context.Context) error {
+	tmp, err := os.MkdirTemp("", "ginpb-dev")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	d.binary = filepath.Join(tmp, "server")
+
+	d.rebuild(ctx, true)
+	snapshot, err := scanSources(d.opts.dir)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(d.opts.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			d.stop()
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := scanSources(d.opts.dir)
+		if err != nil {
+			d.logf("scan failed: %v", err)
+			continue
+		}
+		changed := changedFiles(snapshot, current)
+		if len(changed) == 0 {
+			continue
+		}
+		d.logf("changed: %s", strings.Join(changed, ", "))
+		d.rebuild(ctx, hasProto(changed))
+		// Take the snapshot after generation so generated files do not trigger another round
+		snapshot, _ = scanSources(d.opts.dir)
+	}
+}
+
+// rebuild regenerates code if needed, builds the server and restarts it.
+// On failure the previous server keeps running so the loop can continue.
+func (d *devServer) rebuild(ctx context.Context, generate bool) {
+	if generate && d.opts.generate != "" {
+		d.logf("generating: %s", d.opts.generate)
+		fields := strings.Fields(d.opts.generate)
+		if err := d.command(ctx, fields[0], fields[1:]...).Run(); err != nil {
+			d.logf("generation failed, keeping the running server: %v", err)
+			return
+		}
+	}
+
+	d.logf("building %s", d.opts.pkg)
+	if err := d.command(ctx, "go", "build", "-o", d.binary, d.opts.pkg).Run(); err != nil {
+		d.logf("build failed, keeping the running server: %v", err)
+		return
+	}
+
+	d.stop()
+	if err := d.start(); err != nil {
+		d.logf("start failed: %v", err)
+	}
+}
+
+func (d *devServer) command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = d.opts.dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd
+}
+
+func (d *devServer) start() error {
+	cmd := exec.Command(d.binary, d.opts.args...)
+	cmd.Dir = d.opts.dir
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	d.proc = cmd
+	d.exited = make(chan struct{})
+	go d.forward(stdout)
+	go func(exited chan struct{}) {
+		_ = cmd.Wait()
+		close(exited)
+	}(d.exited)
+	d.logf("started server (pid %d)", cmd.Process.Pid)
+	return nil
+}
+
+// stop interrupts the running server and kills it if it does not exit in time
+func (d *devServer) stop() {
+	if d.proc == nil {
+		return
+	}
+	_ = d.proc.Process.Signal(os.Interrupt)
+	select {
+	case <-d.exited:
+	case <-time.After(5 * time.Second):
+		_ = d.proc.Process.Kill()
+		<-d.exited
+	}
+	d.proc = nil
+}
+
+// forward copies server output and prints the route diff once DumpRoutes has finished
+func (d *devServer) forward(r io.Reader) {
+	var (
+		routes []string
+		timer  *time.Timer
+	)
+	report := func(routes []string) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		for _, line := range diffRoutes(d.routes, routes) {
+			d.logf("%s", line)
+		}
+		d.routes = routes
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fmt.Println(line)
+		if !strings.HasPrefix(line, routePrefix) {
+			continue
+		}
+		routes = append(routes, strings.TrimPrefix(line, routePrefix))
+		if timer != nil {
+			timer.Stop()
+		}
+		snapshot := append([]string(nil), routes...)
+		timer = time.AfterFunc(200*time.Millisecond, func() { report(snapshot) })
+	}
+}
+
+func (d *devServer) logf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "\u001B[36m[ginpb dev]\u001B[m "+format+"\n", args...)
+}
+
+// scanSources returns the modification times of the watched .proto and .go files under dir
+func scanSources(dir string) (map[string]time.Time, error) {
+	res := make(map[string]time.Time)
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may vanish while editors save
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if e.IsDir() {
+			if path != dir && (skippedDirs[e.Name()] || strings.HasPrefix(e.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := filepath.Ext(path); ext != ".proto" && ext != ".go" {
+			return nil
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil
+		}
+		res[path] = info.ModTime()
+		return nil
+	})
+	return res, err
+}
+
+// changedFiles lists files added, removed or modified between two scans
+func changedFiles(before, after map[string]time.Time) []string {
+	var res []string
+	for path, mod := range after {
+		if prev, ok := before[path]; !ok || !prev.Equal(mod) {
+			res = append(res, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			res = append(res, path)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func hasProto(files []string) bool {
+	for _, f := range files {
+		if filepath.Ext(f) == ".proto" {
+			return true
+		}
+	}
+	return false
+}
+
+// diffRoutes returns the added (+) and removed (-) route lines, routes are keyed by method and path
+func diffRoutes(before, after []string) []string {
+	key := func(line string) string {
+		if i := strings.Index(line, " --> "); i >= 0 {
+			return strings.Join(strings.Fields(line[:i]), " ")
+		}
+		return line
+	}
+	index := func(lines []string) map[string]string {
+		m := make(map[string]string, len(lines))
+		for _, l := range lines {
+			m[key(l)] = l
+		}
+		return m
+	}
+	prev, next := index(before), index(after)
+
+	var res []string
+	for k, line := range next {
+		if old, ok := prev[k]; !ok {
+			res = append(res, "+ "+line)
+		} else if old != line {
+			res = append(res, "~ "+line)
+		}
+	}
+	for k, line := range prev {
+		if _, ok := next[k]; !ok {
+			res = append(res, "- "+line)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i][2:] < res[j][2:] })
+	return res
+}
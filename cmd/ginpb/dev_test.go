@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRoutes(t *testing.T) {
+	before := []string{
+		"GET     /v1/users      --> /user.v1.UserService/ListUsers (0 middlewares)",
+		"DELETE  /v1/users/:id  --> /user.v1.UserService/DeleteUser (0 middlewares)",
+		"GET     /v1/users/:id  --> /user.v1.UserService/GetUser (0 middlewares)",
+	}
+	after := []string{
+		"GET     /v1/users      --> /user.v1.UserService/ListUsers (0 middlewares)",
+		"GET     /v1/users/:id  --> /user.v1.UserService/GetUser (1 middlewares)",
+		"POST    /v1/users      --> /user.v1.UserService/CreateUser (0 middlewares)",
+	}
+	assert.Equal(t, []string{
+		"- DELETE  /v1/users/:id  --> /user.v1.UserService/DeleteUser (0 middlewares)",
+		"~ GET     /v1/users/:id  --> /user.v1.UserService/GetUser (1 middlewares)",
+		"+ POST    /v1/users      --> /user.v1.UserService/CreateUser (0 middlewares)",
+	}, diffRoutes(before, after))
+}
+
+func TestChangedFiles(t *testing.T) {
+	now := time.Now()
+	before := map[string]time.Time{"a.go": now, "b.proto": now, "c.go": now}
+	after := map[string]time.Time{"a.go": now, "b.proto": now.Add(time.Second), "d.go": now}
+	changed := changedFiles(before, after)
+	assert.Equal(t, []string{"b.proto", "c.go", "d.go"}, changed)
+	assert.True(t, hasProto(changed))
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// change is an API change between two descriptor sets
+type change struct {
+	Kind     string `json:"kind"`     // e.g. route_removed
+	Breaking bool   `json:"breaking"` // whether existing clients may fail
+	Subject  string `json:"subject"`  // e.g. GET /v1/users/{id} or example.User.email
+	Message  string `json:"message"`
+}
+
+// apiReport is the machine-readable output of ginpb diff
+type apiReport struct {
+	Breaking int       `json:"breaking"`
+	Changes  []*change `json:"changes"`
+}
+
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	format := flags.String("format", "text", "report format, text or json")
+	allowBreaking := flags.Bool("allow_breaking", false, "exit successfully even when breaking changes are found")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return errors.New("want the old and the new descriptor sets, e.g. ginpb diff old.desc new.desc")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q, want text or json", *format)
+	}
+
+	oldFiles, err := loadDescriptorSet(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	newFiles, err := loadDescriptorSet(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	report := diffAPIs(oldFiles, newFiles)
+	if err := writeReport(os.Stdout, report, *format); err != nil {
+		return err
+	}
+	if report.Breaking > 0 && !*allowBreaking {
+		return fmt.Errorf("%d breaking changes", report.Breaking)
+	}
+	return nil
+}
+
+// loadDescriptorSet reads a FileDescriptorSet written by protoc --descriptor_set_out --include_imports
+// or buf build -o
+func loadDescriptorSet(path string) (*protoregistry.Files, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(b, set); err != nil {
+		return nil, fmt.Errorf("%s is not a descriptor set: %w", path, err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w, build it with --include_imports", path, err)
+	}
+	return files, nil
+}
+
+// diffAPIs compares the services, routes and messages of two descriptor sets
+func diffAPIs(oldFiles, newFiles *protoregistry.Files) *apiReport {
+	d := &apiDiff{report: &apiReport{}, visited: make(map[string]bool), seen: make(map[string]bool)}
+	oldMethods, newMethods := collectMethods(oldFiles), collectMethods(newFiles)
+	oldRoutes, newRoutes := collectRoutes(oldMethods), collectRoutes(newMethods)
+
+	for _, name := range sortedKeys(oldMethods) {
+		om, nm := oldMethods[name], newMethods[name]
+		if nm == nil {
+			d.add("method_removed", true, name, "method removed")
+			continue
+		}
+		if om.Input().FullName() != nm.Input().FullName() {
+			d.add("request_changed", true, name, fmt.Sprintf("request changed from %s to %s", om.Input().FullName(), nm.Input().FullName()))
+		} else {
+			d.diffMessage(om.Input(), nm.Input(), true)
+		}
+		if om.Output().FullName() != nm.Output().FullName() {
+			d.add("response_changed", true, name, fmt.Sprintf("response changed from %s to %s", om.Output().FullName(), nm.Output().FullName()))
+		} else {
+			d.diffMessage(om.Output(), nm.Output(), false)
+		}
+		if om.IsStreamingClient() != nm.IsStreamingClient() || om.IsStreamingServer() != nm.IsStreamingServer() {
+			d.add("streaming_changed", true, name, "streaming mode changed")
+		}
+	}
+	for _, name := range sortedKeys(newMethods) {
+		if oldMethods[name] == nil {
+			d.add("method_added", false, name, "method added")
+		}
+	}
+
+	for _, key := range sortedKeys(oldRoutes) {
+		if r, ok := newRoutes[key]; !ok {
+			d.add("route_removed", true, oldRoutes[key].String(), "route removed")
+		} else if r.method != oldRoutes[key].method {
+			d.add("route_changed", true, r.String(), fmt.Sprintf("route moved from %s to %s", oldRoutes[key].method, r.method))
+		}
+	}
+	for _, key := range sortedKeys(newRoutes) {
+		if _, ok := oldRoutes[key]; !ok {
+			d.add("route_added", false, newRoutes[key].String(), "route added")
+		}
+	}
+
+	sort.SliceStable(d.report.Changes, func(i, j int) bool {
+		return d.report.Changes[i].Breaking && !d.report.Changes[j].Breaking
+	})
+	return d.report
+}
+
+type apiDiff struct {
+	report  *apiReport
+	visited map[string]bool // messages compared as requests or responses
+	seen    map[string]bool // reported changes, messages shared by requests and responses are reported once
+}
+
+func (d *apiDiff) add(kind string, breaking bool, subject, message string) {
+	if d.seen[kind+" "+subject] {
+		return
+	}
+	d.seen[kind+" "+subject] = true
+	if breaking {
+		d.report.Breaking++
+	}
+	d.report.Changes = append(d.report.Changes, &change{Kind: kind, Breaking: breaking, Subject: subject, Message: message})
+}
+
+// diffMessage compares the fields of a message and the messages reachable from it by field number, new
+// required fields only break request messages
+func (d *apiDiff) diffMessage(om, nm protoreflect.MessageDescriptor, request bool) {
+	key := fmt.Sprintf("%s %t", nm.FullName(), request)
+	if d.visited[key] {
+		return
+	}
+	d.visited[key] = true
+
+	oldFields, newFields := om.Fields(), nm.Fields()
+	for i := 0; i < oldFields.Len(); i++ {
+		of := oldFields.Get(i)
+		nf := newFields.ByNumber(of.Number())
+		if nf == nil {
+			d.add("field_removed", true, string(of.FullName()), "field removed")
+			continue
+		}
+		subject := string(nf.FullName())
+		if of.Name() != nf.Name() {
+			d.add("field_renamed", true, subject, fmt.Sprintf("field %d renamed from %s to %s", of.Number(), of.Name(), nf.Name()))
+		}
+		if oldType, newType := fieldType(of), fieldType(nf); oldType != newType {
+			kind, verb := "field_type_changed", "changed"
+			if narrowed(of.Kind(), nf.Kind()) {
+				kind, verb = "field_narrowed", "narrowed"
+			}
+			d.add(kind, true, subject, fmt.Sprintf("type %s from %s to %s", verb, oldType, newType))
+			continue
+		}
+		if request && !fieldRequired(of) && fieldRequired(nf) {
+			d.add("field_required", true, subject, "field became required")
+		}
+		if of.Message() != nil && !of.IsMap() {
+			d.diffMessage(of.Message(), nf.Message(), request)
+		} else if of.IsMap() && of.MapValue().Message() != nil {
+			d.diffMessage(of.MapValue().Message(), nf.MapValue().Message(), request)
+		}
+	}
+	for i := 0; i < newFields.Len(); i++ {
+		nf := newFields.Get(i)
+		if oldFields.ByNumber(nf.Number()) != nil {
+			continue
+		}
+		if request && fieldRequired(nf) {
+			d.add("required_field_added", true, string(nf.FullName()), "new required field")
+		} else {
+			d.add("field_added", false, string(nf.FullName()), "field added")
+		}
+	}
+}
+
+// fieldType describes the wire and JSON shape of a field, e.g. repeated int64 or map<string, example.User>
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", fieldType(fd.MapKey()), fieldType(fd.MapValue()))
+	}
+	name := fd.Kind().String()
+	switch {
+	case fd.Message() != nil:
+		name = string(fd.Message().FullName())
+	case fd.Enum() != nil:
+		name = string(fd.Enum().FullName())
+	}
+	if fd.IsList() {
+		return "repeated " + name
+	}
+	return name
+}
+
+// narrowing lists the kinds whose range shrinks when a field changes from the key to the value kinds
+var narrowing = map[protoreflect.Kind][]protoreflect.Kind{
+	protoreflect.Int64Kind:  {protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.BoolKind, protoreflect.EnumKind},
+	protoreflect.Uint64Kind: {protoreflect.Uint32Kind, protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.BoolKind},
+	protoreflect.Int32Kind:  {protoreflect.Uint32Kind, protoreflect.BoolKind, protoreflect.EnumKind},
+	protoreflect.Uint32Kind: {protoreflect.Int32Kind, protoreflect.BoolKind},
+	protoreflect.DoubleKind: {protoreflect.FloatKind, protoreflect.Int64Kind, protoreflect.Int32Kind},
+	protoreflect.FloatKind:  {protoreflect.Int32Kind},
+	protoreflect.StringKind: {protoreflect.EnumKind, protoreflect.Int64Kind, protoreflect.Int32Kind, protoreflect.BoolKind},
+}
+
+func narrowed(from, to protoreflect.Kind) bool {
+	for _, k := range narrowing[from] {
+		if k == to {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldRequired reports whether clients must set the field, from proto2 required, (google.api.field_behavior)
+// REQUIRED or a required binding tag
+func fieldRequired(fd protoreflect.FieldDescriptor) bool {
+	if fd.Cardinality() == protoreflect.Required {
+		return true
+	}
+	behaviors, _ := proto.GetExtension(fd.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, b := range behaviors {
+		if b == annotations.FieldBehavior_REQUIRED {
+			return true
+		}
+	}
+	binding, _ := proto.GetExtension(fd.Options(), ginext.E_BindingTag).(string)
+	if tags, _ := proto.GetExtension(fd.Options(), ginext.E_Tags).(*ginext.FieldTags); tags.GetBinding() != "" {
+		binding = tags.GetBinding()
+	}
+	for _, rule := range strings.Split(binding, ",") {
+		if strings.TrimSpace(rule) == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// collectMethods indexes the methods of all services by full name
+func collectMethods(files *protoregistry.Files) map[string]protoreflect.MethodDescriptor {
+	methods := make(map[string]protoreflect.MethodDescriptor)
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			for j := 0; j < sd.Methods().Len(); j++ {
+				md := sd.Methods().Get(j)
+				methods[string(md.FullName())] = md
+			}
+		}
+		return true
+	})
+	return methods
+}
+
+// apiRoute is an HTTP binding of a method from (google.api.http)
+type apiRoute struct {
+	verb   string
+	path   string
+	method string // full name of the bound method
+}
+
+func (r apiRoute) String() string { return r.verb + " " + r.path }
+
+// pathVariable matches the variables of an HTTP rule path, e.g. {id} or {name=projects/*}
+var pathVariable = regexp.MustCompile(`\{[^}]*\}`)
+
+// collectRoutes indexes the HTTP routes of methods by verb and path, variable names are ignored because
+// renaming them does not change the URLs clients call
+func collectRoutes(methods map[string]protoreflect.MethodDescriptor) map[string]apiRoute {
+	routes := make(map[string]apiRoute)
+	for name, md := range methods {
+		rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule == nil {
+			continue
+		}
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			verb, path := httpRulePattern(r)
+			if path == "" {
+				continue
+			}
+			routes[verb+" "+pathVariable.ReplaceAllString(path, "{}")] = apiRoute{verb: verb, path: path, method: name}
+		}
+	}
+	return routes
+}
+
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "GET", p.Get
+	case *annotations.HttpRule_Post:
+		return "POST", p.Post
+	case *annotations.HttpRule_Put:
+		return "PUT", p.Put
+	case *annotations.HttpRule_Delete:
+		return "DELETE", p.Delete
+	case *annotations.HttpRule_Patch:
+		return "PATCH", p.Patch
+	case *annotations.HttpRule_Custom:
+		return strings.ToUpper(p.Custom.GetKind()), p.Custom.GetPath()
+	}
+	return "", ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeReport writes the report as aligned text lines or JSON
+func writeReport(w io.Writer, report *apiReport, format string) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	if len(report.Changes) == 0 {
+		_, err := fmt.Fprintln(w, "no API changes")
+		return err
+	}
+	for _, c := range report.Changes {
+		level := "info"
+		if c.Breaking {
+			level = "BREAKING"
+		}
+		if _, err := fmt.Fprintf(w, "%-8s  %s: %s\n", level, c.Subject, c.Message); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "\n%d changes, %d breaking\n", len(report.Changes), report.Breaking)
+	return err
+}
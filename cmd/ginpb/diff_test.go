@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const diffOldProto = `syntax = "proto3";
+package example;
+import "google/api/annotations.proto";
+
+service UserService {
+  rpc GetUser(GetUserRequest) returns (User) { option (google.api.http) = { get: "/v1/users/{id}" }; }
+  rpc DeleteUser(GetUserRequest) returns (User) { option (google.api.http) = { delete: "/v1/users/{id}" }; }
+}
+message GetUserRequest { int64 id = 1; }
+message User { int64 id = 1; string email = 2; string nickname = 3; }
+`
+
+const diffNewProto = `syntax = "proto3";
+package example;
+import "google/api/annotations.proto";
+import "google/api/field_behavior.proto";
+import "tag/tags.proto";
+
+service UserService {
+  rpc GetUser(GetUserRequest) returns (User) { option (google.api.http) = { get: "/v1/users/{user_id}" }; }
+  rpc ListUsers(GetUserRequest) returns (User) { option (google.api.http) = { get: "/v1/users" }; }
+}
+message GetUserRequest {
+  int64 id = 1 [(tag.binding_tag) = "required,min=1"];
+  string tenant = 2 [(google.api.field_behavior) = REQUIRED];
+  string trace = 3;
+}
+message User { int32 id = 1; string email = 2; bool active = 4; }
+`
+
+// writeDescriptorSet compiles source as example.proto and writes it with its imports as a descriptor set
+func writeDescriptorSet(t *testing.T, name, source string) string {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(protocompile.CompositeResolver{
+			&protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(map[string]string{"example.proto": source})},
+			&protocompile.SourceResolver{ImportPaths: []string{"../../third_party"}},
+		}),
+	}
+	res, err := compiler.Compile(context.Background(), "example.proto")
+	require.NoError(t, err)
+
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(res[0])
+	b, err := proto.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, b, 0o644))
+	return path
+}
+
+func TestDiffAPIs(t *testing.T) {
+	oldFiles, err := loadDescriptorSet(writeDescriptorSet(t, "old.desc", diffOldProto))
+	require.NoError(t, err)
+	newFiles, err := loadDescriptorSet(writeDescriptorSet(t, "new.desc", diffNewProto))
+	require.NoError(t, err)
+
+	report := diffAPIs(oldFiles, newFiles)
+	changes := make(map[string]*change)
+	for _, c := range report.Changes {
+		changes[c.Kind+" "+c.Subject] = c
+	}
+	for _, key := range []string{
+		"method_removed example.UserService.DeleteUser",
+		"route_removed DELETE /v1/users/{id}",
+		"field_required example.GetUserRequest.id",
+		"required_field_added example.GetUserRequest.tenant",
+		"field_narrowed example.User.id",
+		"field_removed example.User.nickname",
+	} {
+		if assert.Contains(t, changes, key) {
+			assert.True(t, changes[key].Breaking, key)
+		}
+	}
+	for _, key := range []string{
+		"method_added example.UserService.ListUsers",
+		"route_added GET /v1/users",
+		"field_added example.GetUserRequest.trace",
+		"field_added example.User.active",
+	} {
+		if assert.Contains(t, changes, key) {
+			assert.False(t, changes[key].Breaking, key)
+		}
+	}
+	assert.NotContains(t, changes, "route_removed GET /v1/users/{id}", "renaming path variables keeps the route")
+	assert.Equal(t, 6, report.Breaking)
+	assert.True(t, report.Changes[0].Breaking, "breaking changes are listed first")
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReport(&buf, report, "json"))
+	decoded := &apiReport{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	assert.Equal(t, report, decoded)
+
+	buf.Reset()
+	require.NoError(t, writeReport(&buf, diffAPIs(oldFiles, oldFiles), "text"))
+	assert.Equal(t, "no API changes\n", buf.String())
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	thirdparty "github.com/go-kenka/ginpb/third_party"
+)
+
+// plugins are the protoc plugins run by generate with the packages installing them
+var plugins = []struct {
+	name string
+	pkg  string
+}{
+	{"protoc-gen-go", "google.golang.org/protobuf/cmd/protoc-gen-go"},
+	{"protoc-gen-gin", "github.com/go-kenka/ginpb/cmd/protoc-gen-gin"},
+}
+
+type generateOptions struct {
+	mode     string
+	includes []string
+	out      string
+	goOpt    string
+	ginOpt   string
+	protoc   string
+	files    []string
+}
+
+// stringsFlag is a flag that can be repeated
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ",") }
+
+func (f *stringsFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	opts := generateOptions{}
+	var includes stringsFlag
+	flags.StringVar(&opts.mode, "mode", "auto", "protoc, buf, or auto to use buf when buf.gen.yaml exists")
+	flags.Var(&includes, "I", "proto include path, can be repeated, defaults to the working directory")
+	flags.StringVar(&opts.out, "out", ".", "output directory of protoc")
+	flags.StringVar(&opts.goOpt, "go_opt", "paths=source_relative", "protoc-gen-go options")
+	flags.StringVar(&opts.ginOpt, "gin_opt", "paths=source_relative", "protoc-gen-gin options, e.g. paths=source_relative,openapi=true")
+	flags.StringVar(&opts.protoc, "protoc", "protoc", "protoc executable")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	opts.includes = includes
+	if len(opts.includes) == 0 {
+		opts.includes = []string{"."}
+	}
+	opts.files = flags.Args()
+
+	paths := make(map[string]string)
+	for _, p := range plugins {
+		path, err := findPlugin(p.name)
+		if err != nil {
+			return fmt.Errorf("%w, install it with go install %s@latest", err, p.pkg)
+		}
+		paths[p.name] = path
+	}
+
+	mode := opts.mode
+	if mode == "auto" {
+		mode = "protoc"
+		if _, err := os.Stat("buf.gen.yaml"); err == nil {
+			mode = "buf"
+		}
+	}
+	switch mode {
+	case "buf":
+		return generateBuf(paths, opts.files)
+	case "protoc":
+		return generateProtoc(opts, paths)
+	default:
+		return fmt.Errorf("unknown mode %q, use protoc, buf or auto", opts.mode)
+	}
+}
+
+// generateProtoc runs protoc with the bundled third-party protos on the include path
+func generateProtoc(opts generateOptions, paths map[string]string) error {
+	if len(opts.files) == 0 {
+		files, err := findProtos(opts.includes[0])
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("no .proto files found in %s", opts.includes[0])
+		}
+		opts.files = files
+	}
+	bundled, err := os.MkdirTemp("", "ginpb-protos")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(bundled)
+	if err := os.CopyFS(bundled, thirdparty.Protos); err != nil {
+		return fmt.Errorf("extract bundled protos: %w", err)
+	}
+	if err := os.MkdirAll(opts.out, 0o755); err != nil {
+		return err
+	}
+	return run(opts.protoc, protocArgs(opts, paths, bundled)...)
+}
+
+// protocArgs returns the protoc arguments generating opts.files, bundled protos come after the user includes
+// so projects can pin their own copies
+func protocArgs(opts generateOptions, paths map[string]string, bundled string) []string {
+	var args []string
+	for _, dir := range append(opts.includes, bundled) {
+		args = append(args, "-I", dir)
+	}
+	args = append(args,
+		"--plugin=protoc-gen-go="+paths["protoc-gen-go"],
+		"--go_out="+opts.out,
+		"--go_opt="+opts.goOpt,
+		"--plugin=protoc-gen-gin="+paths["protoc-gen-gin"],
+		"--gin_out="+opts.out,
+		"--gin_opt="+opts.ginOpt,
+	)
+	return append(args, opts.files...)
+}
+
+// generateBuf runs buf generate with the plugins first on PATH, so local plugins of buf.gen.yaml resolve to them
+func generateBuf(paths map[string]string, args []string) error {
+	var dirs []string
+	for _, p := range plugins {
+		dirs = append(dirs, filepath.Dir(paths[p.name]))
+	}
+	dirs = append(dirs, os.Getenv("PATH"))
+	cmd := exec.Command("buf", append([]string{"generate"}, args...)...)
+	cmd.Env = append(os.Environ(), "PATH="+strings.Join(dirs, string(os.PathListSeparator)))
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("buf generate: %w", err)
+	}
+	return nil
+}
+
+// findPlugin looks up a plugin on PATH, next to the ginpb executable and in GOBIN or GOPATH/bin
+func findPlugin(name string) (string, error) {
+	if path, err := exec.LookPath(name); err == nil {
+		return filepath.Abs(path)
+	}
+	var dirs []string
+	if exe, err := os.Executable(); err == nil {
+		dirs = append(dirs, filepath.Dir(exe))
+	}
+	if gobin := os.Getenv("GOBIN"); gobin != "" {
+		dirs = append(dirs, gobin)
+	}
+	if gopath := os.Getenv("GOPATH"); gopath != "" {
+		dirs = append(dirs, filepath.Join(filepath.SplitList(gopath)[0], "bin"))
+	} else if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, "go", "bin"))
+	}
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s not found on PATH, next to ginpb or in GOPATH/bin", name)
+}
+
+// findProtos returns the .proto files under root, skipping third_party and the directories
+// ginpb dev does not watch
+func findProtos(root string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != root && (skippedDirs[d.Name()] || d.Name() == "third_party") {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".proto") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("include path %s does not exist", root)
+	}
+	sort.Strings(files)
+	return files, err
+}
+
+// run runs a command with the output of ginpb
+func run(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProtos(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"api/user/v1/user.proto", "api/order.proto", "third_party/google/api/http.proto", "vendor/x.proto", "main.go"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+	}
+	files, err := findProtos(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "api/order.proto"), filepath.Join(dir, "api/user/v1/user.proto")}, files)
+}
+
+func TestProtocArgs(t *testing.T) {
+	opts := generateOptions{includes: []string{"api"}, out: "gen", goOpt: "paths=source_relative", ginOpt: "openapi=true", files: []string{"api/user.proto"}}
+	paths := map[string]string{"protoc-gen-go": "/bin/protoc-gen-go", "protoc-gen-gin": "/bin/protoc-gen-gin"}
+	assert.Equal(t, []string{
+		"-I", "api", "-I", "/tmp/protos",
+		"--plugin=protoc-gen-go=/bin/protoc-gen-go", "--go_out=gen", "--go_opt=paths=source_relative",
+		"--plugin=protoc-gen-gin=/bin/protoc-gen-gin", "--gin_out=gen", "--gin_opt=openapi=true",
+		"api/user.proto",
+	}, protocArgs(opts, paths, "/tmp/protos"))
+}
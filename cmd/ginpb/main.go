@@ -0,0 +1,61 @@
+// Command ginpb is the developer CLI for proto-first gin services.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-kenka/ginpb/internal/gen"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "new", usage: "new <name> [-module path] [-dir dir]  scaffold a new service", run: runNew},
+	{name: "generate", usage: "generate [-mode protoc|buf] [-I dir] [-out dir] [-gin_opt opts] [files...]  run protoc or buf with the plugins and bundled googleapis protos", run: runGenerate},
+	{name: "dev", usage: "dev [-gen cmd] [-pkg path] [-- args]  rebuild and restart the server on .proto and .go changes", run: runDev},
+	{name: "publish", usage: "publish [-registry url] [-version tag] files...  upload OpenAPI and route metadata to a schema registry", run: runPublish},
+	{name: "diff", usage: "diff [-format text|json] [-allow_breaking] old.desc new.desc  report API changes between two descriptor sets, failing on breaking ones", run: runDiff},
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	name, args := flag.Arg(0), flag.Args()[1:]
+	if name == "version" {
+		fmt.Printf("ginpb %v\n", gen.Release)
+		return
+	}
+	for _, cmd := range commands {
+		if cmd.name == name {
+			if err := cmd.run(args); err != nil {
+				fmt.Fprintf(os.Stderr, "ginpb %s: %v\n", name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "ginpb: unknown command %q\n\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: ginpb <command> [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %s\n", cmd.usage)
+	}
+	fmt.Fprintln(os.Stderr, "  version  print the version")
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/new/*.tmpl
+var newTemplates embed.FS
+
+var serviceNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// scaffold is the data passed to the new service templates
+type scaffold struct {
+	Name      string // user_profile
+	GoName    string // UserProfile
+	Plural    string // user_profiles
+	GoPlural  string // UserProfiles
+	Module    string // github.com/acme/user_profile
+	GoPackage string // user_profilev1
+}
+
+// scaffoldFiles maps templates to the files they generate, paths are templates too
+var scaffoldFiles = []struct {
+	template string
+	path     string
+}{
+	{"go.mod.tmpl", "go.mod"},
+	{"Makefile.tmpl", "Makefile"},
+	{"buf.yaml.tmpl", "buf.yaml"},
+	{"buf.gen.yaml.tmpl", "buf.gen.yaml"},
+	{"ginpb.yaml.tmpl", "ginpb.yaml"},
+	{"gitignore.tmpl", ".gitignore"},
+	{"service.proto.tmpl", "api/{{.Name}}/v1/{{.Name}}.proto"},
+	{"main.go.tmpl", "main.go"},
+}
+
+func runNew(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ContinueOnError)
+	module := fs.String("module", "", "Go module path, defaults to the service name")
+	dir := fs.String("dir", "", "output directory, defaults to the service name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	// Allow flags after the name as well
+	if fs.NArg() == 0 {
+		return errors.New("missing service name, usage: ginpb new <name> [-module path] [-dir dir]")
+	}
+	name := fs.Arg(0)
+	if err := fs.Parse(fs.Args()[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %v", fs.Args())
+	}
+
+	if !serviceNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid service name %q, use lower case letters, digits and underscores starting with a letter", name)
+	}
+	if *module == "" {
+		*module = name
+	}
+	if *dir == "" {
+		*dir = name
+	}
+
+	if err := scaffoldService(*dir, newScaffold(name, *module)); err != nil {
+		return err
+	}
+	fmt.Printf("created %s in %s\n\nnext steps:\n  cd %s\n  make init\n  make run\n", name, *dir, *dir)
+	return nil
+}
+
+func newScaffold(name, module string) *scaffold {
+	plural := pluralize(name)
+	return &scaffold{
+		Name:      name,
+		GoName:    camelCase(name),
+		Plural:    plural,
+		GoPlural:  camelCase(plural),
+		Module:    module,
+		GoPackage: strings.ReplaceAll(name, "_", "") + "v1",
+	}
+}
+
+// scaffoldService renders all scaffold files into dir, which must not exist or be empty
+func scaffoldService(dir string, data *scaffold) error {
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("directory %s already exists and is not empty, choose another name or -dir", dir)
+	}
+
+	for _, f := range scaffoldFiles {
+		path, err := render(f.path, f.path, data)
+		if err != nil {
+			return err
+		}
+		text, err := newTemplates.ReadFile("templates/new/" + f.template)
+		if err != nil {
+			return err
+		}
+		content, err := render(f.template, string(text), data)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(path, ".go") {
+			formatted, err := format.Source([]byte(content))
+			if err != nil {
+				return fmt.Errorf("format %s: %w", path, err)
+			}
+			content = string(formatted)
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func render(name, text string, data *scaffold) (string, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// camelCase converts snake_case to CamelCase
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// pluralize returns the English plural of a lower case noun for the common cases
+func pluralize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "z"),
+		strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !strings.ContainsRune("aeiou", rune(s[len(s)-2])):
+		return s[:len(s)-1] + "ies"
+	default:
+		return s + "s"
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffoldService(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, scaffoldService(dir, newScaffold("user_entry", "example.com/userentry")))
+
+	proto, err := os.ReadFile(filepath.Join(dir, "api/user_entry/v1/user_entry.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(proto), `option go_package = "example.com/userentry/api/user_entry/v1;userentryv1";`)
+	assert.Contains(t, string(proto), `get: "/v1/user_entries/{id}"`)
+
+	main, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(main), "userentryv1.RegisterUserEntryServiceHTTPServer(r, &server{})")
+
+	assert.Error(t, scaffoldService(dir, newScaffold("user_entry", "example.com/userentry")), "non-empty directory")
+}
+
+func TestPluralize(t *testing.T) {
+	for in, want := range map[string]string{"user": "users", "entry": "entries", "key": "keys", "address": "addresses", "box": "boxes"} {
+		assert.Equal(t, want, pluralize(in))
+	}
+}
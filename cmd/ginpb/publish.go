@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Environment variables read by ginpb publish, so CI can configure the registry without flags
+const (
+	registryEnv      = "GINPB_REGISTRY"
+	registryTokenEnv = "GINPB_REGISTRY_TOKEN"
+)
+
+// schema is a generated API description published to the registry
+type schema struct {
+	Name        string // API name, e.g. user
+	Version     string // version tag, e.g. v1.2.0
+	File        string // base name of the file, e.g. openapi.yaml
+	ContentType string
+	Data        []byte
+}
+
+// schemaRegistry stores published schemas, e.g. an org-wide API catalog
+type schemaRegistry interface {
+	Publish(ctx context.Context, s *schema) error
+}
+
+// httpRegistry PUTs schemas to a URL template with {name}, {version} and {file} placeholders
+type httpRegistry struct {
+	url     string
+	headers http.Header
+	client  *http.Client
+}
+
+func (r *httpRegistry) Publish(ctx context.Context, s *schema) error {
+	url := strings.NewReplacer("{name}", s.Name, "{version}", s.Version, "{file}", s.File).Replace(r.url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(s.Data))
+	if err != nil {
+		return fmt.Errorf("invalid registry url %q: %w", url, err)
+	}
+	for k, vs := range r.headers {
+		req.Header[k] = vs
+	}
+	req.Header.Set("Content-Type", s.ContentType)
+	req.Header.Set("X-Schema-Name", s.Name)
+	req.Header.Set("X-Schema-Version", s.Version)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("publish %s: %w", s.File, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("publish %s to %s: registry answered %s: %s", s.File, url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// headerFlags collects repeated -H "Key: Value" flags
+type headerFlags http.Header
+
+func (h headerFlags) String() string { return "" }
+
+func (h headerFlags) Set(v string) error {
+	k, val, ok := strings.Cut(v, ":")
+	if !ok || strings.TrimSpace(k) == "" {
+		return fmt.Errorf("invalid header %q, use \"Key: Value\"", v)
+	}
+	http.Header(h).Add(strings.TrimSpace(k), strings.TrimSpace(val))
+	return nil
+}
+
+func runPublish(args []string) error {
+	fs := flag.NewFlagSet("publish", flag.ContinueOnError)
+	registry := fs.String("registry", os.Getenv(registryEnv), "registry URL template with {name}, {version} and {file}, defaults to $"+registryEnv)
+	name := fs.String("name", "", "API name, defaults to the name of the working directory")
+	version := fs.String("version", "", "version tag, defaults to git describe --tags --always --dirty")
+	timeout := fs.Duration("timeout", 30*time.Second, "timeout of each upload")
+	headers := headerFlags{}
+	fs.Var(headers, "H", `extra request header "Key: Value", repeatable`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *registry == "" {
+		return fmt.Errorf("missing registry, set -registry or $%s", registryEnv)
+	}
+	files := fs.Args()
+	if len(files) == 0 {
+		return errors.New("missing schema files, usage: ginpb publish [-registry url] [-version tag] openapi.yaml [routes.json...]")
+	}
+	if *name == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		*name = filepath.Base(wd)
+	}
+	if *version == "" {
+		v, err := gitVersion()
+		if err != nil {
+			return fmt.Errorf("cannot derive the version, set -version: %w", err)
+		}
+		*version = v
+	}
+	if token := os.Getenv(registryTokenEnv); token != "" && http.Header(headers).Get("Authorization") == "" {
+		http.Header(headers).Set("Authorization", "Bearer "+token)
+	}
+
+	reg := &httpRegistry{url: *registry, headers: http.Header(headers), client: &http.Client{Timeout: *timeout}}
+	return publishSchemas(context.Background(), reg, *name, *version, files)
+}
+
+// publishSchemas reads all files before uploading any, so a missing file publishes nothing
+func publishSchemas(ctx context.Context, reg schemaRegistry, name, version string, files []string) error {
+	schemas := make([]*schema, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		schemas = append(schemas, &schema{
+			Name:        name,
+			Version:     version,
+			File:        filepath.Base(file),
+			ContentType: schemaContentType(file),
+			Data:        data,
+		})
+	}
+	for _, s := range schemas {
+		if err := reg.Publish(ctx, s); err != nil {
+			return err
+		}
+		fmt.Printf("published %s %s %s\n", s.Name, s.Version, s.File)
+	}
+	return nil
+}
+
+func schemaContentType(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return "application/yaml"
+	case ".json":
+		return "application/json"
+	}
+	return "application/octet-stream"
+}
+
+func gitVersion() (string, error) {
+	out, err := exec.Command("git", "describe", "--tags", "--always", "--dirty").Output()
+	if err != nil {
+		return "", fmt.Errorf("git describe: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishSchemas(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "v1.2.0", r.Header.Get("X-Schema-Version"))
+		if r.URL.Path == "/apis/user/v1.2.0/routes.json" {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "[]", string(body))
+		}
+		if r.URL.Path == "/apis/user/v1.2.0/broken.yaml" {
+			http.Error(w, "invalid schema", http.StatusUnprocessableEntity)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+		return path
+	}
+	openapi, routes, broken := write("openapi.yaml", "openapi: 3.0.3"), write("routes.json", "[]"), write("broken.yaml", "x")
+
+	reg := &httpRegistry{url: srv.URL + "/apis/{name}/{version}/{file}", headers: http.Header{"Authorization": {"Bearer secret"}}, client: srv.Client()}
+	require.NoError(t, publishSchemas(context.Background(), reg, "user", "v1.2.0", []string{openapi, routes}))
+	assert.Equal(t, []string{"/apis/user/v1.2.0/openapi.yaml", "/apis/user/v1.2.0/routes.json"}, paths)
+
+	err := publishSchemas(context.Background(), reg, "user", "v1.2.0", []string{broken})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "422 Unprocessable Entity: invalid schema")
+
+	paths = nil
+	assert.Error(t, publishSchemas(context.Background(), reg, "user", "v1.2.0", []string{openapi, filepath.Join(dir, "missing.json")}))
+	assert.Empty(t, paths, "nothing is published when a file is missing")
+}
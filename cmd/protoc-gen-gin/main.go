@@ -6,12 +6,24 @@ import (
 
 	"github.com/go-kenka/ginpb/internal/gen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var (
 	showVersion = flag.Bool("version", false, "print the version and exit")
 	omitempty   = flag.Bool("omitempty", true, "omit if google.api is empty")
+	buildTags   = flag.Bool("build_tags", false, "split server and client into files guarded by !ginpb_no_server and !ginpb_no_client")
+	splitFiles  = flag.Bool("split_files", false, "write server, client and shared types to xxx_gin_server.pb.go, xxx_gin_client.pb.go and xxx_gin_types.pb.go")
+	k6          = flag.Bool("k6", false, "write a k6 load test script per service")
+	openapi     = flag.Bool("openapi", false, "write an OpenAPI 3 document per proto file")
+	websocket   = flag.Bool("websocket", false, "serve client and bidirectional streaming methods over WebSocket")
+	validate    = flag.Bool("validate", false, "validate bound requests with the registered ginpb.Validator before calling the service")
+	metadataOut = flag.String("metadata_out", "", "write the route model of all generated services as JSON to this file, e.g. routes.json")
+	jsonNames   = flag.String("json_names", "", "JSON field naming of gin struct tags and replies: default (encoding/json), proto or camel (protojson with proto or lowerCamel names)")
+	emitUnpop   = flag.Bool("emit_unpopulated", false, "write zero values of replies encoded with protojson")
+	templates   = flag.String("templates", "", "directory of operations.tmpl, server.tmpl, client.tmpl and tags.tmpl overriding the built-in templates")
+	configFile  = flag.String("config", "", "path to a ginpb.yaml or ginpb.toml config file, defaults to ginpb.yaml in the working directory")
 )
 
 func main() {
@@ -23,14 +35,51 @@ func main() {
 	protogen.Options{
 		ParamFunc: flag.CommandLine.Set,
 	}.Run(func(plugin *protogen.Plugin) error {
-		plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		config, err := gen.LoadConfig(*configFile)
+		if err != nil {
+			return err
+		}
+		// Explicit plugin params win over the config file
+		flag.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "omitempty":
+				config.Omitempty = omitempty
+			case "build_tags":
+				config.BuildTags = buildTags
+			case "split_files":
+				config.SplitFiles = splitFiles
+			case "k6":
+				config.K6 = k6
+			case "openapi":
+				config.OpenAPI = openapi
+			case "websocket":
+				config.WebSocket = websocket
+			case "validate":
+				config.Validate = validate
+			case "json_names":
+				config.JSONNames = *jsonNames
+			case "emit_unpopulated":
+				config.EmitUnpopulated = emitUnpop
+			case "templates":
+				config.Templates = *templates
+			case "metadata_out":
+				config.MetadataOut = *metadataOut
+			}
+		})
+
+		plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+			pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		plugin.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		plugin.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
 		for _, f := range plugin.Files {
 			if !f.Generate {
 				continue
 			}
 
-			gen.GenerateFile(plugin, f, *omitempty)
+			if _, err := gen.GenerateFile(plugin, f, config); err != nil {
+				return err
+			}
 		}
-		return nil
+		return gen.GenerateMetadata(plugin, config)
 	})
 }
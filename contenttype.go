@@ -0,0 +1,121 @@
+package ginpb
+
+import (
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CodeUnsupportedMediaType is the error of request bodies whose Content-Type is not declared with
+// (ginpb.content_types), generated handlers return it before binding
+var CodeUnsupportedMediaType = &ErrorCode{Status: http.StatusUnsupportedMediaType, Reason: "UNSUPPORTED_MEDIA_TYPE", Message: "content type %q is not supported, send one of: %s"}
+
+// CheckContentType rejects requests whose Content-Type does not match allowed, e.g. "application/json" or
+// "image/*". Requests without Content-Type are bound as JSON and pass when JSON is allowed. Rejected POST
+// and PATCH requests announce the allowed types in Accept-Post or Accept-Patch.
+func CheckContentType(ctx *gin.Context, allowed ...string) error {
+	contentType := ctx.GetHeader("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if contentType == "" {
+		mediaType = gin.MIMEJSON
+	} else if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	if matchMediaType(mediaType, allowed) {
+		return nil
+	}
+	if name := acceptHeader(ctx.Request.Method); name != "" {
+		ctx.Header(name, strings.Join(allowed, ", "))
+	}
+	return CodeUnsupportedMediaType.New(contentType, strings.Join(allowed, ", "))
+}
+
+// matchMediaType matches a lower case media type against patterns such as "application/json" and "image/*"
+func matchMediaType(mediaType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == mediaType || pattern == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptHeader returns the header advertising the content types of method, empty when there is none
+func acceptHeader(method string) string {
+	switch method {
+	case http.MethodPost:
+		return "Accept-Post"
+	case http.MethodPatch:
+		return "Accept-Patch"
+	}
+	return ""
+}
+
+// Options returns a middleware answering OPTIONS requests for the paths of the routes recorded on routers,
+// see Routes, with 204, Allow listing their methods, and Accept-Post and Accept-Patch listing the content
+// types declared with (ginpb.content_types). gin runs it for OPTIONS requests without route, use it after
+// middleware.CORS so preflight requests are answered by CORS.
+func Options(routers ...gin.IRouter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodOptions {
+			c.Next()
+			return
+		}
+		var recorded []RouteInfo
+		for _, r := range routers {
+			recorded = append(recorded, Routes(r)...)
+		}
+		var methods []string
+		for _, route := range recorded {
+			if !matchRoutePath(route.Path, c.Request.URL.Path) || slices.Contains(methods, route.Method) {
+				continue
+			}
+			methods = append(methods, route.Method)
+			if name := acceptHeader(route.Method); name != "" && len(route.ContentTypes) > 0 {
+				c.Header(name, strings.Join(route.ContentTypes, ", "))
+			}
+		}
+		if len(methods) == 0 {
+			c.Next()
+			return
+		}
+		c.Header("Allow", strings.Join(append(methods, http.MethodOptions), ", "))
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
+
+// matchRoutePath matches a request path against a gin route path with :params, a trailing *wildcard and
+// custom verbs, e.g. /v1/operations/:name:cancel
+func matchRoutePath(pattern, path string) bool {
+	patterns, segments := strings.Split(pattern, "/"), strings.Split(path, "/")
+	for i, p := range patterns {
+		if strings.HasPrefix(p, "*") {
+			return i < len(segments)
+		}
+		if i >= len(segments) {
+			return false
+		}
+		s := segments[i]
+		switch {
+		case strings.HasPrefix(p, ":"):
+			if j := strings.Index(p[1:], ":"); j >= 0 {
+				verb := p[j+1:]
+				if !strings.HasSuffix(s, verb) || len(s) == len(verb) {
+					return false
+				}
+			} else if s == "" {
+				return false
+			}
+		case p != s:
+			return false
+		}
+	}
+	return len(patterns) == len(segments)
+}
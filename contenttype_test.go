@@ -0,0 +1,94 @@
+package ginpb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckContentType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	check := func(method, contentType string, allowed ...string) (error, http.Header) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(method, "/", strings.NewReader("{}"))
+		if contentType != "" {
+			c.Request.Header.Set("Content-Type", contentType)
+		}
+		return CheckContentType(c, allowed...), w.Header()
+	}
+
+	for contentType, allowed := range map[string][]string{
+		"application/json; charset=utf-8":   {"application/json"},
+		"":                                  {"application/json", "multipart/form-data"},
+		"Image/PNG":                         {"image/*"},
+		"multipart/form-data; boundary=xyz": {"application/json", "multipart/form-data"},
+		"text/csv":                          {"*/*"},
+	} {
+		err, _ := check(http.MethodPost, contentType, allowed...)
+		assert.NoError(t, err, contentType)
+	}
+
+	err, header := check(http.MethodPost, "text/xml", "application/json", "application/x-www-form-urlencoded")
+	assert.True(t, IsErrorReason(err, "UNSUPPORTED_MEDIA_TYPE"))
+	assert.EqualError(t, err, `content type "text/xml" is not supported, send one of: application/json, application/x-www-form-urlencoded`)
+	assert.Equal(t, "application/json, application/x-www-form-urlencoded", header.Get("Accept-Post"))
+
+	err, header = check(http.MethodPatch, "", "multipart/form-data")
+	assert.Error(t, err)
+	assert.Equal(t, "multipart/form-data", header.Get("Accept-Patch"))
+}
+
+func TestOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	g := e.Group("/feedback-api")
+	e.Use(Options(g))
+	AddRoute(g, RouteInfo{Operation: "/example.Svc/SubmitFeedback", Method: "POST", Path: "/feedback", ContentTypes: []string{"application/json"}})
+	AddRoute(g, RouteInfo{Operation: "/example.Svc/ListFeedback", Method: "GET", Path: "/feedback"})
+	AddRoute(g, RouteInfo{Operation: "/example.Svc/UpdateFeedback", Method: "PATCH", Path: "/feedback/:id", ContentTypes: []string{"multipart/form-data"}})
+	AddRoute(g, RouteInfo{Operation: "/example.Svc/CloseFeedback", Method: "POST", Path: "/feedback/:id:close"})
+	t.Cleanup(func() { ReleaseRoutes(g) })
+
+	options := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodOptions, path, nil))
+		return w
+	}
+	w := options("/feedback-api/feedback")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "GET, POST, OPTIONS", w.Header().Get("Allow"))
+	assert.Equal(t, "application/json", w.Header().Get("Accept-Post"))
+
+	w = options("/feedback-api/feedback/42")
+	assert.Equal(t, "PATCH, OPTIONS", w.Header().Get("Allow"))
+	assert.Equal(t, "multipart/form-data", w.Header().Get("Accept-Patch"))
+	assert.Empty(t, w.Header().Get("Accept-Post"))
+
+	w = options("/feedback-api/feedback/42:close")
+	assert.Equal(t, "PATCH, POST, OPTIONS", w.Header().Get("Allow"))
+
+	assert.Equal(t, http.StatusNotFound, options("/feedback-api/unknown").Code)
+}
+
+func TestMatchRoutePath(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/v1/users", "/v1/users", true},
+		{"/v1/users/:id", "/v1/users/42", true},
+		{"/v1/users/:id", "/v1/users/", false},
+		{"/v1/users/:id", "/v1/users/42/posts", false},
+		{"/v1/operations/:name:cancel", "/v1/operations/op1:cancel", true},
+		{"/v1/operations/:name:cancel", "/v1/operations/:cancel", false},
+		{"/v1/files/*path", "/v1/files/a/b", true},
+		{"/v1/things:batchGet", "/v1/things:batchGet", true},
+	} {
+		assert.Equal(t, tc.want, matchRoutePath(tc.pattern, tc.path), tc.pattern+" "+tc.path)
+	}
+}
@@ -0,0 +1,54 @@
+package ginpb
+
+// ConvertList converts the elements of in with f, generated gin binding structs use it for repeated messages
+func ConvertList[T, P any](in []T, f func(T) P) []P {
+	if in == nil {
+		return nil
+	}
+	out := make([]P, len(in))
+	for i, v := range in {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// ConvertMap converts the values of in with f, generated gin binding structs use it for maps of messages
+func ConvertMap[K comparable, T, P any](in map[K]T, f func(T) P) map[K]P {
+	if in == nil {
+		return nil
+	}
+	out := make(map[K]P, len(in))
+	for k, v := range in {
+		out[k] = f(v)
+	}
+	return out
+}
+
+// integer is the underlying type of int64 and enum fields and their gin types
+type integer interface {
+	~int32 | ~int64 | ~uint64
+}
+
+// ConvertInts converts integer slices, generated gin structs use it for repeated int64 and enum fields
+func ConvertInts[P integer, T integer](in []T) []P {
+	if in == nil {
+		return nil
+	}
+	out := make([]P, len(in))
+	for i, v := range in {
+		out[i] = P(v)
+	}
+	return out
+}
+
+// ConvertIntMap converts integer map values, generated gin structs use it for maps of int64 and enum values
+func ConvertIntMap[P integer, K comparable, T integer](in map[K]T) map[K]P {
+	if in == nil {
+		return nil
+	}
+	out := make(map[K]P, len(in))
+	for k, v := range in {
+		out[k] = P(v)
+	}
+	return out
+}
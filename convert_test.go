@@ -0,0 +1,15 @@
+package ginpb
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, ConvertList([]int{1, 2}, strconv.Itoa))
+	assert.Nil(t, ConvertList([]int(nil), strconv.Itoa))
+	assert.Equal(t, map[string]string{"a": "1"}, ConvertMap(map[string]int{"a": 1}, strconv.Itoa))
+	assert.Nil(t, ConvertMap(map[string]int(nil), strconv.Itoa))
+}
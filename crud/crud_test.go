@@ -0,0 +1,78 @@
+package crud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/apipb"
+
+	"github.com/go-kenka/ginpb"
+)
+
+var apiResource = &Resource{Type: "example.com/Api", Pattern: "projects/{project}/apis/{api}", Plural: "apis", NameField: "name"}
+
+func TestResource(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	var name, parent string
+	r.GET("/v1/projects/:project/apis/:api", func(c *gin.Context) {
+		name, parent = apiResource.Name(c), apiResource.Parent(c)
+	})
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/projects/p1/apis/a1", nil))
+	assert.Equal(t, "projects/p1/apis/a1", name)
+	assert.Equal(t, "projects/p1", parent)
+	assert.Equal(t, "projects/p1/apis/", apiResource.Collection(parent))
+	assert.Equal(t, "api_id", apiResource.IDParam())
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	s := &Service[*apipb.Api]{Resource: apiResource, Store: NewMemoryStore[*apipb.Api]()}
+
+	created, err := s.Create(ctx, "projects/p1", "a1", &apipb.Api{Name: "ignored", Version: "v1"})
+	require.NoError(t, err)
+	assert.Equal(t, "projects/p1/apis/a1", created.Name)
+	_, err = s.Create(ctx, "projects/p1", "a1", &apipb.Api{})
+	assert.True(t, ginpb.IsErrorReason(err, "ALREADY_EXISTS"))
+	_, err = s.Create(ctx, "projects/p1", "a/b", &apipb.Api{})
+	assert.True(t, ginpb.IsErrorReason(err, "INVALID_ARGUMENT"))
+	generated, err := s.Create(ctx, "projects/p1", "", &apipb.Api{})
+	require.NoError(t, err)
+	assert.Regexp(t, `^projects/p1/apis/[0-9a-f]{16}$`, generated.Name)
+	require.NoError(t, s.Delete(ctx, generated.Name))
+	_, err = s.Create(ctx, "projects/p1", "a2", &apipb.Api{})
+	require.NoError(t, err)
+	_, err = s.Create(ctx, "projects/p2", "a1", &apipb.Api{})
+	require.NoError(t, err)
+
+	// Pages only cover the resources of the parent
+	page, err := s.List(ctx, "projects/p1", 1, "")
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "projects/p1/apis/a1", page.Items[0].Name)
+	page, err = s.List(ctx, "projects/p1", 1, page.NextPageToken)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "projects/p1/apis/a2", page.Items[0].Name)
+	assert.Empty(t, page.NextPageToken)
+
+	// The mask selects the updated fields, unset selected fields are cleared and the name is kept
+	_, err = s.Update(ctx, "projects/p1/apis/a1", &apipb.Api{Name: "other", Syntax: 1}, []string{"name", "version", "syntax"})
+	require.NoError(t, err)
+	got, err := s.Get(ctx, "projects/p1/apis/a1")
+	require.NoError(t, err)
+	assert.Equal(t, "projects/p1/apis/a1", got.Name)
+	assert.Empty(t, got.Version)
+	assert.EqualValues(t, 1, got.Syntax)
+	_, err = s.Update(ctx, "projects/p1/apis/a1", &apipb.Api{}, []string{"unknown"})
+	assert.True(t, ginpb.IsErrorReason(err, "INVALID_ARGUMENT"))
+
+	require.NoError(t, s.Delete(ctx, "projects/p1/apis/a1"))
+	_, err = s.Get(ctx, "projects/p1/apis/a1")
+	assert.True(t, ginpb.IsErrorReason(err, "NOT_FOUND"))
+}
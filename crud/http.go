@@ -0,0 +1,83 @@
+package crud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb"
+)
+
+// DecodeBody decodes the JSON body of c into v with protojson, ignoring unknown fields
+func DecodeBody(c *gin.Context, v proto.Message) error {
+	b, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return CodeInvalidArgument.New(fmt.Sprintf("read body: %v", err))
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, v); err != nil {
+		return CodeInvalidArgument.New(fmt.Sprintf("decode %s: %v", proto.MessageName(v), err))
+	}
+	return nil
+}
+
+// ListParams returns the page_size and page_token query parameters of c
+func ListParams(c *gin.Context) (pageSize int, pageToken string, err error) {
+	if s := c.Query("page_size"); s != "" {
+		if pageSize, err = strconv.Atoi(s); err != nil {
+			return 0, "", CodeInvalidArgument.New(fmt.Sprintf("page_size %q is not an integer", s))
+		}
+	}
+	return pageSize, c.Query("page_token"), nil
+}
+
+// UpdateMask returns the comma separated field names of the update_mask query parameter of c
+func UpdateMask(c *gin.Context) []string {
+	var mask []string
+	for _, path := range strings.Split(c.Query("update_mask"), ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			mask = append(mask, path)
+		}
+	}
+	return mask
+}
+
+// Render writes v with protojson and proto field names, or err with ginpb.RenderError
+func Render(c *gin.Context, v proto.Message, err error) {
+	if err != nil {
+		ginpb.RenderError(c, err)
+		return
+	}
+	ginpb.RenderJSON(c, http.StatusOK, ginpb.JSONProtoNames, v)
+}
+
+// RenderList writes page as {"<plural>": [...], "next_page_token": "..."}, or err with ginpb.RenderError
+func RenderList[T proto.Message](c *gin.Context, plural string, page *Page[T], err error) {
+	if err != nil {
+		ginpb.RenderError(c, err)
+		return
+	}
+	items := make([]json.RawMessage, len(page.Items))
+	for i, item := range page.Items {
+		b, err := ginpb.JSONProtoNames.MarshalOptions().Marshal(item)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		items[i] = b
+	}
+	reply := gin.H{plural: items}
+	if page.NextPageToken != "" {
+		reply["next_page_token"] = page.NextPageToken
+	}
+	c.JSON(http.StatusOK, reply)
+}
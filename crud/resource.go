@@ -0,0 +1,72 @@
+// Package crud implements the standard methods of AIP-style resources declared with google.api.resource
+// and (ginpb.crud): resource names, storage and a default service. The generator writes a typed
+// XCRUDServer interface, a DefaultXCRUDServer on Service and a RegisterXCRUDHTTPServer function per resource.
+package crud
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Resource describes a resource type and its name pattern
+type Resource struct {
+	Type      string // example.com/Book
+	Pattern   string // publishers/{publisher}/books/{book}
+	Plural    string // books, the key of the items in List replies
+	NameField string // name, the string field holding the resource name
+}
+
+// segments returns the segments of the pattern, alternately a collection and a {variable}
+func (r *Resource) segments() []string {
+	return strings.Split(r.Pattern, "/")
+}
+
+// IDParam returns the query parameter choosing the id of a created resource, e.g. book_id
+func (r *Resource) IDParam() string {
+	segs := r.segments()
+	v, _ := variable(segs[len(segs)-1])
+	return v + "_id"
+}
+
+// Name returns the name of the resource addressed by the path parameters of c, e.g. publishers/p1/books/b1
+func (r *Resource) Name(c *gin.Context) string {
+	return r.expand(c, r.segments())
+}
+
+// Parent returns the name of the parent addressed by the path parameters of c, e.g. publishers/p1,
+// empty for top-level resources
+func (r *Resource) Parent(c *gin.Context) string {
+	segs := r.segments()
+	return r.expand(c, segs[:len(segs)-2])
+}
+
+// Collection returns the prefix of the names of the resources under parent, e.g. publishers/p1/books/
+func (r *Resource) Collection(parent string) string {
+	segs := r.segments()
+	collection := segs[len(segs)-2] + "/"
+	if parent == "" {
+		return collection
+	}
+	return parent + "/" + collection
+}
+
+// expand replaces the variables of segs with the path parameters of c
+func (r *Resource) expand(c *gin.Context, segs []string) string {
+	res := make([]string, len(segs))
+	for i, seg := range segs {
+		res[i] = seg
+		if v, ok := variable(seg); ok {
+			res[i] = c.Param(v)
+		}
+	}
+	return strings.Join(res, "/")
+}
+
+// variable returns the name of a {variable} segment
+func variable(seg string) (string, bool) {
+	if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+		return seg[1 : len(seg)-1], true
+	}
+	return "", false
+}
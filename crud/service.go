@@ -0,0 +1,121 @@
+package crud
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// DefaultPageSize is the page size of List requests without page_size
+	DefaultPageSize = 50
+	// MaxPageSize caps the page size of List requests
+	MaxPageSize = 1000
+)
+
+// Service implements the standard methods of a resource on a Store, the generated DefaultXCRUDServer
+// delegates to it
+type Service[T proto.Message] struct {
+	Resource *Resource
+	Store    Store[T]
+}
+
+// Create stores v under parent with the name built from id, a random id when empty, and returns it
+func (s *Service[T]) Create(ctx context.Context, parent, id string, v T) (T, error) {
+	var zero T
+	if id == "" {
+		id = newID()
+	} else if strings.Contains(id, "/") {
+		return zero, CodeInvalidArgument.New(fmt.Sprintf("%s %q must not contain /", s.Resource.IDParam(), id))
+	}
+	name := s.Resource.Collection(parent) + id
+	if err := s.setName(v, name); err != nil {
+		return zero, err
+	}
+	if err := s.Store.Create(ctx, name, v); err != nil {
+		return zero, err
+	}
+	return v, nil
+}
+
+// Get returns the resource name
+func (s *Service[T]) Get(ctx context.Context, name string) (T, error) {
+	return s.Store.Get(ctx, name)
+}
+
+// List returns a page of the resources under parent, pageSize zero uses DefaultPageSize
+func (s *Service[T]) List(ctx context.Context, parent string, pageSize int, pageToken string) (*Page[T], error) {
+	switch {
+	case pageSize < 0:
+		return nil, CodeInvalidArgument.New(fmt.Sprintf("page_size must not be negative, got %d", pageSize))
+	case pageSize == 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+	return s.Store.List(ctx, s.Resource.Collection(parent), pageSize, pageToken)
+}
+
+// Update applies the fields of v selected by updateMask to the resource name and returns the result.
+// An empty mask applies every populated field of v. The name field is never changed.
+func (s *Service[T]) Update(ctx context.Context, name string, v T, updateMask []string) (T, error) {
+	var zero T
+	current, err := s.Store.Get(ctx, name)
+	if err != nil {
+		return zero, err
+	}
+	dst, src := current.ProtoReflect(), v.ProtoReflect()
+	fields := src.Descriptor().Fields()
+	if len(updateMask) == 0 {
+		src.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			updateMask = append(updateMask, string(fd.Name()))
+			return true
+		})
+	}
+	for _, path := range updateMask {
+		fd := fields.ByName(protoreflect.Name(path))
+		if fd == nil {
+			return zero, CodeInvalidArgument.New(fmt.Sprintf("update_mask path %q is not a field of %s", path, src.Descriptor().FullName()))
+		}
+		if string(fd.Name()) == s.Resource.NameField {
+			continue
+		}
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+	if err := s.Store.Update(ctx, name, current); err != nil {
+		return zero, err
+	}
+	return current, nil
+}
+
+// Delete removes the resource name
+func (s *Service[T]) Delete(ctx context.Context, name string) error {
+	return s.Store.Delete(ctx, name)
+}
+
+// setName sets the name field of v
+func (s *Service[T]) setName(v T, name string) error {
+	m := v.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(s.Resource.NameField))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("resource %s has no string name field %q", s.Resource.Type, s.Resource.NameField)
+	}
+	m.Set(fd, protoreflect.ValueOfString(name))
+	return nil
+}
+
+// newID returns a random 64-bit hex id
+func newID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,119 @@
+package crud
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb"
+)
+
+var (
+	// CodeNotFound is returned for unknown resource names
+	CodeNotFound = &ginpb.ErrorCode{Status: http.StatusNotFound, Reason: "NOT_FOUND", Message: "%s not found"}
+	// CodeAlreadyExists is returned when a created resource name is taken
+	CodeAlreadyExists = &ginpb.ErrorCode{Status: http.StatusConflict, Reason: "ALREADY_EXISTS", Message: "%s already exists"}
+	// CodeInvalidArgument is returned for malformed requests
+	CodeInvalidArgument = &ginpb.ErrorCode{Status: http.StatusBadRequest, Reason: "INVALID_ARGUMENT", Message: "%s"}
+)
+
+// Page is a page of List results
+type Page[T proto.Message] struct {
+	Items []T
+	// NextPageToken continues the listing, empty on the last page
+	NextPageToken string
+}
+
+// Store persists resources by name, e.g. in a database table keyed by name.
+// Errors for unknown or taken names should be CodeNotFound and CodeAlreadyExists errors.
+type Store[T proto.Message] interface {
+	// Create stores a new resource
+	Create(ctx context.Context, name string, v T) error
+	// Get returns a resource
+	Get(ctx context.Context, name string) (T, error)
+	// List returns the resources whose names start with collection, ordered by name
+	List(ctx context.Context, collection string, pageSize int, pageToken string) (*Page[T], error)
+	// Update replaces a resource
+	Update(ctx context.Context, name string, v T) error
+	// Delete removes a resource
+	Delete(ctx context.Context, name string) error
+}
+
+// MemoryStore is an in-process Store for prototypes and tests, its page tokens are resource names
+type MemoryStore[T proto.Message] struct {
+	mu    sync.RWMutex
+	items map[string]T
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore[T proto.Message]() *MemoryStore[T] {
+	return &MemoryStore[T]{items: make(map[string]T)}
+}
+
+func (m *MemoryStore[T]) Create(_ context.Context, name string, v T) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[name]; ok {
+		return CodeAlreadyExists.New(name)
+	}
+	m.items[name] = proto.Clone(v).(T)
+	return nil
+}
+
+func (m *MemoryStore[T]) Get(_ context.Context, name string) (T, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.items[name]
+	if !ok {
+		var zero T
+		return zero, CodeNotFound.New(name)
+	}
+	return proto.Clone(v).(T), nil
+}
+
+func (m *MemoryStore[T]) List(_ context.Context, collection string, pageSize int, pageToken string) (*Page[T], error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var names []string
+	for name := range m.items {
+		// Only direct children, not the resources nested in them
+		rest, ok := strings.CutPrefix(name, collection)
+		if ok && !strings.Contains(rest, "/") && name > pageToken {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	page := &Page[T]{}
+	if len(names) > pageSize {
+		names = names[:pageSize]
+		page.NextPageToken = names[pageSize-1]
+	}
+	for _, name := range names {
+		page.Items = append(page.Items, proto.Clone(m.items[name]).(T))
+	}
+	return page, nil
+}
+
+func (m *MemoryStore[T]) Update(_ context.Context, name string, v T) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[name]; !ok {
+		return CodeNotFound.New(name)
+	}
+	m.items[name] = proto.Clone(v).(T)
+	return nil
+}
+
+func (m *MemoryStore[T]) Delete(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[name]; !ok {
+		return CodeNotFound.New(name)
+	}
+	delete(m.items, name)
+	return nil
+}
@@ -0,0 +1,203 @@
+package ginpb
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// DefaultDedupTTL is the window in which duplicate deliveries are dropped when Deduplicator.TTL is zero
+const DefaultDedupTTL = 24 * time.Hour
+
+// DedupReplayedHeader is set on responses replayed to duplicate deliveries
+const DedupReplayedHeader = "X-Dedup-Replayed"
+
+// DedupResponse is the stored response of the first delivery of a message
+type DedupResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// DedupStore stores the responses of delivered messages, e.g. backed by Redis to deduplicate across instances
+type DedupStore interface {
+	// Get returns the response stored for key, ok is false for unknown or expired keys
+	Get(ctx context.Context, key string) (resp *DedupResponse, ok bool, err error)
+	// Set stores the response of key for ttl
+	Set(ctx context.Context, key string, resp *DedupResponse, ttl time.Duration) error
+}
+
+// Deduplicator drops duplicate deliveries of methods whose request has a field annotated with (ginpb.message_id),
+// e.g. webhooks and queue consumers delivering at least once. The response of the first successful delivery is
+// stored and replayed to duplicates arriving within TTL, so the service method runs once per message id.
+// Pass it with the generated WithXDeduplicator option or RegisterConfig.Deduplicator.
+type Deduplicator struct {
+	// Store keeps the responses, nil uses an in-memory store
+	Store DedupStore
+	// TTL is the deduplication window, zero uses DefaultDedupTTL
+	TTL time.Duration
+	// Clock expires the responses of the default in-memory store, the system clock when nil
+	Clock clock.Clock
+
+	once     sync.Once
+	mu       sync.Mutex
+	inflight map[string]chan struct{}
+}
+
+// defaultDeduplicator is used by services without a registered Deduplicator
+var defaultDeduplicator = &Deduplicator{}
+
+// Deduplicate replays the stored response when the message id of operation was already handled and returns
+// replayed true, the handler must then return. Otherwise the handler continues and must call done once the
+// response is written, successful responses are stored. Concurrent deliveries of the same message wait for
+// the first one. Requests without message id are never deduplicated, store errors fail open.
+func Deduplicate(c *gin.Context, d *Deduplicator, operation, messageID string) (replayed bool, done func()) {
+	if messageID == "" {
+		return false, func() {}
+	}
+	if d == nil {
+		d = defaultDeduplicator
+	}
+	d.init()
+	key := operation + "\x00" + messageID
+	ctx := c.Request.Context()
+	for {
+		resp, ok, err := d.Store.Get(ctx, key)
+		if err != nil {
+			_ = c.Error(err)
+		} else if ok {
+			c.Header(DedupReplayedHeader, "true")
+			c.Data(resp.Status, resp.ContentType, resp.Body)
+			c.Abort()
+			return true, nil
+		}
+		wait, first := d.acquire(key)
+		if first {
+			break
+		}
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			_ = c.AbortWithError(http.StatusServiceUnavailable, ctx.Err())
+			return true, nil
+		}
+	}
+
+	w := &dedupWriter{ResponseWriter: c.Writer}
+	c.Writer = w
+	return false, func() {
+		defer d.release(key)
+		c.Writer = w.ResponseWriter
+		status := w.Status()
+		if c.IsAborted() || len(c.Errors) > 0 || status < 200 || status >= 300 {
+			return
+		}
+		resp := &DedupResponse{Status: status, ContentType: w.Header().Get("Content-Type"), Body: w.body.Bytes()}
+		if err := d.Store.Set(ctx, key, resp, d.TTL); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+func (d *Deduplicator) init() {
+	d.once.Do(func() {
+		if d.Store == nil {
+			d.Store = NewMemoryDedupStoreWithClock(d.Clock)
+		}
+		if d.TTL <= 0 {
+			d.TTL = DefaultDedupTTL
+		}
+		d.inflight = make(map[string]chan struct{})
+	})
+}
+
+// acquire marks key in flight, first is false with a channel closed on release when another delivery holds it
+func (d *Deduplicator) acquire(key string) (wait chan struct{}, first bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if wait, ok := d.inflight[key]; ok {
+		return wait, false
+	}
+	d.inflight[key] = make(chan struct{})
+	return nil, true
+}
+
+func (d *Deduplicator) release(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	close(d.inflight[key])
+	delete(d.inflight, key)
+}
+
+// dedupWriter records the body written by the handler
+type dedupWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *dedupWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *dedupWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// memoryDedupStore keeps responses in memory, expired entries are removed on Set
+type memoryDedupStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryDedupEntry
+	sweep   time.Time
+	clock   clock.Clock
+}
+
+type memoryDedupEntry struct {
+	resp    *DedupResponse
+	expires time.Time
+}
+
+// NewMemoryDedupStore returns a DedupStore keeping responses in process memory,
+// for single instance deployments and tests
+func NewMemoryDedupStore() DedupStore {
+	return NewMemoryDedupStoreWithClock(nil)
+}
+
+// NewMemoryDedupStoreWithClock returns an in-memory DedupStore expiring responses with c, e.g. a clock.Fake in tests
+func NewMemoryDedupStoreWithClock(c clock.Clock) DedupStore {
+	return &memoryDedupStore{entries: make(map[string]memoryDedupEntry), clock: clock.OrSystem(c)}
+}
+
+func (s *memoryDedupStore) Get(_ context.Context, key string) (*DedupResponse, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || s.clock.Now().After(e.expires) {
+		return nil, false, nil
+	}
+	return e.resp, true, nil
+}
+
+func (s *memoryDedupStore) Set(_ context.Context, key string, resp *DedupResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	// Remove expired entries at most once a minute
+	if now.Sub(s.sweep) > time.Minute {
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.sweep = now
+	}
+	s.entries[key] = memoryDedupEntry{resp: resp, expires: now.Add(ttl)}
+	return nil
+}
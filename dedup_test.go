@@ -0,0 +1,86 @@
+package ginpb
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-kenka/ginpb/clock"
+)
+
+func TestDeduplicate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var calls atomic.Int32
+	d := &Deduplicator{TTL: time.Minute}
+	e := gin.New()
+	e.POST("/events/:id", func(c *gin.Context) {
+		replayed, done := Deduplicate(c, d, "Events", c.Param("id"))
+		if replayed {
+			return
+		}
+		defer done()
+		n := calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		if c.Query("fail") != "" {
+			_ = c.Error(errors.New("failed"))
+			c.Status(500)
+			return
+		}
+		c.JSON(201, gin.H{"call": n})
+	})
+	post := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest("POST", path, nil))
+		return w
+	}
+
+	w := post("/events/a")
+	assert.Equal(t, 201, w.Code)
+	assert.JSONEq(t, `{"call":1}`, w.Body.String())
+	assert.Empty(t, w.Header().Get(DedupReplayedHeader))
+
+	// Duplicates get the original response without calling the handler again
+	w = post("/events/a")
+	assert.Equal(t, 201, w.Code)
+	assert.JSONEq(t, `{"call":1}`, w.Body.String())
+	assert.Equal(t, "true", w.Header().Get(DedupReplayedHeader))
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.EqualValues(t, 1, calls.Load())
+
+	// Failed deliveries are not stored, the redelivery is handled again
+	assert.Equal(t, 500, post("/events/b?fail=1").Code)
+	assert.Equal(t, 201, post("/events/b").Code)
+	assert.EqualValues(t, 3, calls.Load())
+
+	// Concurrent deliveries of one message wait for the first
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := post("/events/c")
+			assert.JSONEq(t, `{"call":4}`, w.Body.String())
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 4, calls.Load())
+}
+
+func TestMemoryDedupStoreExpires(t *testing.T) {
+	fake := clock.NewFake(time.Now())
+	s := NewMemoryDedupStoreWithClock(fake)
+	assert.NoError(t, s.Set(context.Background(), "k", &DedupResponse{Status: 200}, time.Minute))
+	_, ok, _ := s.Get(context.Background(), "k")
+	assert.True(t, ok)
+	fake.Advance(time.Minute + time.Second)
+	_, ok, err := s.Get(context.Background(), "k")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
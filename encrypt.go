@@ -0,0 +1,227 @@
+package ginpb
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// KeyProvider encrypts and decrypts field values annotated with (ginpb.encrypt), e.g. backed by a KMS.
+// Pass it with the generated WithXKeyProvider option or RegisterConfig.KeyProvider.
+type KeyProvider interface {
+	Encrypt(ctx context.Context, alias string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, alias string, ciphertext []byte) ([]byte, error)
+}
+
+// aesKeyProvider encrypts with AES-GCM and local keys, the nonce is prepended to the ciphertext
+type aesKeyProvider struct {
+	keys map[string]cipher.AEAD
+}
+
+// NewAESKeyProvider returns a KeyProvider encrypting with AES-GCM keys of 16, 24 or 32 bytes by alias,
+// for development and services holding their keys locally
+func NewAESKeyProvider(keys map[string][]byte) (KeyProvider, error) {
+	p := &aesKeyProvider{keys: make(map[string]cipher.AEAD, len(keys))}
+	for alias, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", alias, err)
+		}
+		if p.keys[alias], err = cipher.NewGCM(block); err != nil {
+			return nil, fmt.Errorf("key %q: %w", alias, err)
+		}
+	}
+	return p, nil
+}
+
+func (p *aesKeyProvider) aead(alias string) (cipher.AEAD, error) {
+	aead, ok := p.keys[alias]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %q", alias)
+	}
+	return aead, nil
+}
+
+func (p *aesKeyProvider) Encrypt(_ context.Context, alias string, plaintext []byte) ([]byte, error) {
+	aead, err := p.aead(alias)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(alias)), nil
+}
+
+func (p *aesKeyProvider) Decrypt(_ context.Context, alias string, ciphertext []byte) ([]byte, error) {
+	aead, err := p.aead(alias)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(alias))
+}
+
+// EncryptFields encrypts the fields of m annotated with (ginpb.encrypt) in place, including those of nested messages.
+// String values are replaced by their base64 encoded ciphertext, bytes values by the ciphertext.
+// It fails without provider when m has such fields, so they are never sent in plaintext by accident.
+func EncryptFields(ctx context.Context, p KeyProvider, m proto.Message) error {
+	if err := checkKeyProvider(p, m); err != nil {
+		return err
+	}
+	return transformFields(m.ProtoReflect(), func(alias string, v []byte) ([]byte, error) {
+		return p.Encrypt(ctx, alias, v)
+	}, true)
+}
+
+// DecryptFields decrypts the fields of m annotated with (ginpb.encrypt) in place, generated handlers call it
+// after binding requests
+func DecryptFields(ctx context.Context, p KeyProvider, m proto.Message) error {
+	if err := checkKeyProvider(p, m); err != nil {
+		return err
+	}
+	return transformFields(m.ProtoReflect(), func(alias string, v []byte) ([]byte, error) {
+		return p.Decrypt(ctx, alias, v)
+	}, false)
+}
+
+// checkKeyProvider fails when m has encrypted fields but no provider is registered
+func checkKeyProvider(p KeyProvider, m proto.Message) error {
+	if p == nil && hasEncryptedFields(m.ProtoReflect().Descriptor()) {
+		return fmt.Errorf("%s has fields annotated with ginpb.encrypt but no KeyProvider is registered", m.ProtoReflect().Descriptor().FullName())
+	}
+	return nil
+}
+
+// encryptedMessages caches whether messages hold encrypted fields, by full name
+var encryptedMessages sync.Map
+
+// hasEncryptedFields reports whether md or a message reachable from it has fields annotated with (ginpb.encrypt)
+func hasEncryptedFields(md protoreflect.MessageDescriptor) bool {
+	if v, ok := encryptedMessages.Load(md.FullName()); ok {
+		return v.(bool)
+	}
+	// Assume false while visiting to end recursion of self-referencing messages
+	encryptedMessages.Store(md.FullName(), false)
+	found := false
+	fields := md.Fields()
+	for i := 0; i < fields.Len() && !found; i++ {
+		fd := fields.Get(i)
+		if encryptAlias(fd) != "" {
+			found = true
+		} else if msg := fieldMessage(fd); msg != nil {
+			found = hasEncryptedFields(msg)
+		}
+	}
+	encryptedMessages.Store(md.FullName(), found)
+	return found
+}
+
+// fieldMessage returns the message of message fields and of map values, nil otherwise
+func fieldMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	return fd.Message()
+}
+
+func encryptAlias(fd protoreflect.FieldDescriptor) string {
+	alias, _ := proto.GetExtension(fd.Options(), ginext.E_Encrypt).(string)
+	return alias
+}
+
+// transformFields applies fn to the values of encrypted fields of m, encoding strings as base64 ciphertext
+func transformFields(m protoreflect.Message, fn func(alias string, v []byte) ([]byte, error), encrypt bool) error {
+	if !m.IsValid() || !hasEncryptedFields(m.Descriptor()) {
+		return nil
+	}
+	// Collect the populated fields first, m is modified while transforming
+	var fds []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	for _, fd := range fds {
+		v := m.Get(fd)
+		var err error
+		if alias := encryptAlias(fd); alias != "" {
+			err = transformField(m, fd, v, func(v protoreflect.Value) (protoreflect.Value, error) {
+				return transformValue(fd, v, func(b []byte) ([]byte, error) { return fn(alias, b) }, encrypt)
+			})
+		} else if fieldMessage(fd) != nil {
+			err = transformField(m, fd, v, func(v protoreflect.Value) (protoreflect.Value, error) {
+				return v, transformFields(v.Message(), fn, encrypt)
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("field %s: %w", fd.FullName(), err)
+		}
+	}
+	return nil
+}
+
+// transformField applies fn to the value of a singular field, the items of a list or the values of a map
+func transformField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value, fn func(protoreflect.Value) (protoreflect.Value, error)) error {
+	var err error
+	switch {
+	case fd.IsList():
+		list := v.List()
+		for i := 0; i < list.Len() && err == nil; i++ {
+			var item protoreflect.Value
+			if item, err = fn(list.Get(i)); err == nil {
+				list.Set(i, item)
+			}
+		}
+	case fd.IsMap():
+		v.Map().Range(func(k protoreflect.MapKey, item protoreflect.Value) bool {
+			if item, err = fn(item); err == nil {
+				v.Map().Set(k, item)
+			}
+			return err == nil
+		})
+	default:
+		if v, err = fn(v); err == nil {
+			m.Set(fd, v)
+		}
+	}
+	return err
+}
+
+// transformValue applies fn to a string or bytes value
+func transformValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, fn func([]byte) ([]byte, error), encrypt bool) (protoreflect.Value, error) {
+	kind := fd.Kind()
+	if fd.IsMap() {
+		kind = fd.MapValue().Kind()
+	}
+	switch kind {
+	case protoreflect.BytesKind:
+		b, err := fn(v.Bytes())
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.StringKind:
+		if encrypt {
+			b, err := fn([]byte(v.String()))
+			return protoreflect.ValueOfString(base64.StdEncoding.EncodeToString(b)), err
+		}
+		ciphertext, err := base64.StdEncoding.DecodeString(v.String())
+		if err != nil {
+			return v, errors.New("encrypted value is not base64")
+		}
+		b, err := fn(ciphertext)
+		return protoreflect.ValueOfString(string(b)), err
+	}
+	return v, fmt.Errorf("(ginpb.encrypt) supports string and bytes fields only, not %s", kind)
+}
@@ -0,0 +1,38 @@
+package ginpb_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/example/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptFields(t *testing.T) {
+	ctx := context.Background()
+	p, err := ginpb.NewAESKeyProvider(map[string][]byte{"pii": bytes.Repeat([]byte{1}, 32)})
+	require.NoError(t, err)
+
+	reply := &api.ListUsersResponse{Users: []*api.User{{Id: "1", Phone: "13800000000"}, {Id: "2"}}}
+	require.NoError(t, ginpb.EncryptFields(ctx, p, reply))
+	assert.NotEqual(t, "13800000000", reply.Users[0].Phone, "nested repeated fields are encrypted")
+	assert.Equal(t, "1", reply.Users[0].Id)
+	assert.Empty(t, reply.Users[1].Phone, "empty values stay empty")
+
+	require.NoError(t, ginpb.DecryptFields(ctx, p, reply))
+	assert.Equal(t, "13800000000", reply.Users[0].Phone)
+
+	// Fields are never sent in plaintext without provider
+	assert.ErrorContains(t, ginpb.EncryptFields(ctx, nil, &api.User{Phone: "1"}), "no KeyProvider is registered")
+	assert.NoError(t, ginpb.EncryptFields(ctx, nil, &api.GetUserRequest{}), "messages without encrypted fields need no provider")
+
+	other, err := ginpb.NewAESKeyProvider(map[string][]byte{"pii": bytes.Repeat([]byte{2}, 32)})
+	require.NoError(t, err)
+	user := &api.User{Phone: "13800000000"}
+	require.NoError(t, ginpb.EncryptFields(ctx, p, user))
+	assert.ErrorContains(t, ginpb.DecryptFields(ctx, other, user), "field example.User.phone")
+	assert.ErrorContains(t, ginpb.DecryptFields(ctx, p, &api.User{Phone: "not base64!"}), "not base64")
+}
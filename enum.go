@@ -0,0 +1,38 @@
+package ginpb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnmarshalEnumJSON decodes the name, e.g. "STATUS_ACTIVE", or the number of a value of the enum desc into v,
+// generated gin types of enums call it to bind JSON bodies
+func UnmarshalEnumJSON(b []byte, v *int32, desc protoreflect.EnumDescriptor) error {
+	s := strings.TrimSpace(string(b))
+	if s == "null" {
+		return nil
+	}
+	if name, err := strconv.Unquote(s); err == nil {
+		s = name
+	}
+	return UnmarshalEnumParam(s, v, desc)
+}
+
+// UnmarshalEnumParam parses the name or the number of a value of the enum desc into v,
+// generated gin types of enums call it to bind query and path parameters
+func UnmarshalEnumParam(param string, v *int32, desc protoreflect.EnumDescriptor) error {
+	if value := desc.Values().ByName(protoreflect.Name(param)); value != nil {
+		*v = int32(value.Number())
+		return nil
+	}
+	// Unknown numbers are kept like protobuf does for open enums
+	n, err := strconv.ParseInt(param, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid value %q for enum %s: expected the name or the number of a value", param, desc.FullName())
+	}
+	*v = int32(n)
+	return nil
+}
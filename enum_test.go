@@ -0,0 +1,28 @@
+package ginpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestUnmarshalEnum(t *testing.T) {
+	desc := descriptorpb.FieldDescriptorProto_TYPE_STRING.Descriptor()
+	var v int32
+	require.NoError(t, UnmarshalEnumJSON([]byte(`"TYPE_BOOL"`), &v, desc))
+	assert.Equal(t, int32(descriptorpb.FieldDescriptorProto_TYPE_BOOL), v)
+	require.NoError(t, UnmarshalEnumJSON([]byte(`9`), &v, desc))
+	assert.Equal(t, int32(descriptorpb.FieldDescriptorProto_TYPE_STRING), v)
+	require.NoError(t, UnmarshalEnumJSON([]byte(`null`), &v, desc))
+	assert.Equal(t, int32(9), v)
+
+	require.NoError(t, UnmarshalEnumParam("TYPE_INT64", &v, desc))
+	assert.Equal(t, int32(descriptorpb.FieldDescriptorProto_TYPE_INT64), v)
+	require.NoError(t, UnmarshalEnumParam("99", &v, desc), "unknown numbers are kept")
+	assert.Equal(t, int32(99), v)
+
+	err := UnmarshalEnumParam("TYPE_NOPE", &v, desc)
+	assert.EqualError(t, err, `invalid value "TYPE_NOPE" for enum google.protobuf.FieldDescriptorProto.Type: expected the name or the number of a value`)
+}
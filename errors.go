@@ -0,0 +1,121 @@
+package ginpb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+
+	"github.com/go-kenka/ginpb/binding"
+	"github.com/go-kenka/ginpb/client"
+	"github.com/go-kenka/ginpb/metadata"
+)
+
+// ErrorCode is a value of an error code enum declared with ginpb.default_status, generated as a package
+// variable behind the ErrorX constructor and the IsX helper of the value
+type ErrorCode struct {
+	Status int    // HTTP status, e.g. 404
+	Reason string // enum value name, e.g. "USER_NOT_FOUND"
+	// Message is the default message, a fmt format of the constructor arguments
+	Message string
+	// Messages translates Message by BCP 47 language tag, e.g. "zh"
+	Messages map[string]string
+
+	once    sync.Once
+	matcher language.Matcher
+	formats []string // formats of the tags of matcher, the default message first
+}
+
+// CodeUnimplemented is the error of methods a service does not implement, returned by the methods of the
+// generated UnimplementedXHTTPServer with the operation as argument
+var CodeUnimplemented = &ErrorCode{Status: http.StatusNotImplemented, Reason: "UNIMPLEMENTED", Message: "method %s is not implemented"}
+
+// New returns an error of the code formatting its messages with args
+func (c *ErrorCode) New(args ...any) *Error {
+	return &Error{Code: c, Args: args}
+}
+
+// format returns the message format of the code for locale, the default message when there is no translation
+func (c *ErrorCode) format(locale language.Tag) string {
+	c.once.Do(func() {
+		tags := []language.Tag{language.Und}
+		c.formats = []string{c.Message}
+		for lang, message := range c.Messages {
+			if tag, err := language.Parse(lang); err == nil {
+				tags = append(tags, tag)
+				c.formats = append(c.formats, message)
+			}
+		}
+		c.matcher = language.NewMatcher(tags)
+	})
+	_, index, confidence := c.matcher.Match(locale)
+	if confidence == language.No {
+		return c.Message
+	}
+	return c.formats[index]
+}
+
+// Error is an error of an ErrorCode, generated handlers write it as ErrorResponse with the status of the code
+type Error struct {
+	Code *ErrorCode
+	Args []any
+}
+
+// Error returns the default message
+func (e *Error) Error() string {
+	return e.format(e.Code.Message)
+}
+
+// Localize returns the message translated into locale
+func (e *Error) Localize(locale language.Tag) string {
+	return e.format(e.Code.format(locale))
+}
+
+func (e *Error) format(message string) string {
+	if len(e.Args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, e.Args...)
+}
+
+// ErrorResponse is the body written for an Error, decoded by clients into client.HTTPError
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// RenderError adds err to c, errors of an ErrorCode are also written as ErrorResponse with the status of
+// their code and the message in the locale of the request, see metadata.Locale. Requests that could not
+// be bound, see binding.Error, are aborted with their status like gin's binding does.
+func RenderError(c *gin.Context, err error) {
+	var bindErr *binding.Error
+	if errors.As(err, &bindErr) {
+		_ = c.AbortWithError(bindErr.Status, err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	_ = c.Error(err)
+	var e *Error
+	if !errors.As(err, &e) {
+		return
+	}
+	message := e.Error()
+	if locale, ok := metadata.Locale(c); ok {
+		message = e.Localize(locale)
+	}
+	c.AbortWithStatusJSON(e.Code.Status, ErrorResponse{Code: e.Code.Status, Reason: e.Code.Reason, Message: message})
+}
+
+// IsErrorReason reports whether err is an Error with reason, returned by a service, or a client.HTTPError
+// with reason received from a server
+func IsErrorReason(err error, reason string) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code.Reason == reason
+	}
+	var httpErr *client.HTTPError
+	return errors.As(err, &httpErr) && httpErr.Reason == reason
+}
@@ -0,0 +1,61 @@
+package ginpb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/go-kenka/ginpb/client"
+	"github.com/go-kenka/ginpb/metadata"
+)
+
+var testNotFound = &ErrorCode{
+	Status:   http.StatusNotFound,
+	Reason:   "USER_NOT_FOUND",
+	Message:  "user %s not found",
+	Messages: map[string]string{"zh": "用户 %s 不存在"},
+}
+
+func TestRenderError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set(metadata.LocaleKey, language.SimplifiedChinese)
+	RenderError(c, fmt.Errorf("get user: %w", testNotFound.New("42")))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"code":404,"reason":"USER_NOT_FOUND","message":"用户 42 不存在"}`, w.Body.String())
+	assert.Len(t, c.Errors, 1)
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	RenderError(c, CodeUnimplemented.New("/user.v1.UserService/GetUser"))
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.JSONEq(t, `{"code":501,"reason":"UNIMPLEMENTED","message":"method /user.v1.UserService/GetUser is not implemented"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	RenderError(c, errors.New("boom"))
+	assert.Empty(t, w.Body.String(), "other errors are left to middlewares")
+	assert.Len(t, c.Errors, 1)
+}
+
+func TestErrorLocalize(t *testing.T) {
+	err := testNotFound.New("42")
+	assert.Equal(t, "user 42 not found", err.Error())
+	assert.Equal(t, "用户 42 不存在", err.Localize(language.MustParse("zh-TW")))
+	assert.Equal(t, "user 42 not found", err.Localize(language.French))
+}
+
+func TestIsErrorReason(t *testing.T) {
+	assert.True(t, IsErrorReason(testNotFound.New(), "USER_NOT_FOUND"))
+	assert.True(t, IsErrorReason(fmt.Errorf("call: %w", &client.HTTPError{Code: 404, Reason: "USER_NOT_FOUND"}), "USER_NOT_FOUND"))
+	assert.False(t, IsErrorReason(&client.HTTPError{Code: 404}, "USER_NOT_FOUND"))
+	assert.False(t, IsErrorReason(errors.New("USER_NOT_FOUND"), "USER_NOT_FOUND"))
+}
@@ -11,11 +11,18 @@ import (
 	fmt "fmt"
 	gin "github.com/gin-gonic/gin"
 	binding "github.com/gin-gonic/gin/binding"
+	ginpb "github.com/go-kenka/ginpb"
 	binding1 "github.com/go-kenka/ginpb/binding"
 	client "github.com/go-kenka/ginpb/client"
 	metadata "github.com/go-kenka/ginpb/metadata"
 	middleware "github.com/go-kenka/ginpb/middleware"
+	slo "github.com/go-kenka/ginpb/slo"
+	iter "iter"
+	multipart "mime/multipart"
+	http "net/http"
+	url "net/url"
 	strings "strings"
+	time "time"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -29,11 +36,15 @@ var _ = binding1.BindByContentType
 var _ = middleware.Chain
 var _ = fmt.Sprintf
 var _ = strings.ReplaceAll
+var _ = ginpb.AddRoute
+var _ = new(http.Handler)
 
 const OperationCompleteExampleServiceBatchDeleteUsers = "/example.CompleteExampleService/BatchDeleteUsers"
+const OperationCompleteExampleServiceChatWithUsers = "/example.CompleteExampleService/ChatWithUsers"
 const OperationCompleteExampleServiceCreatePost = "/example.CompleteExampleService/CreatePost"
 const OperationCompleteExampleServiceCreateUser = "/example.CompleteExampleService/CreateUser"
 const OperationCompleteExampleServiceDeleteUser = "/example.CompleteExampleService/DeleteUser"
+const OperationCompleteExampleServiceExportUsers = "/example.CompleteExampleService/ExportUsers"
 const OperationCompleteExampleServiceGetPostComments = "/example.CompleteExampleService/GetPostComments"
 const OperationCompleteExampleServiceGetUser = "/example.CompleteExampleService/GetUser"
 const OperationCompleteExampleServiceGetUserProfile = "/example.CompleteExampleService/GetUserProfile"
@@ -43,21 +54,343 @@ const OperationCompleteExampleServiceRegisterUser = "/example.CompleteExampleSer
 const OperationCompleteExampleServiceSearchUsers = "/example.CompleteExampleService/SearchUsers"
 const OperationCompleteExampleServiceUpdateProfile = "/example.CompleteExampleService/UpdateProfile"
 const OperationCompleteExampleServiceUpdateUser = "/example.CompleteExampleService/UpdateUser"
+const OperationCompleteExampleServiceUploadAvatar = "/example.CompleteExampleService/UploadAvatar"
+const OperationCompleteExampleServiceWatchUsers = "/example.CompleteExampleService/WatchUsers"
+
+// CompleteExampleServiceOperations lists all operations of example.CompleteExampleService
+var CompleteExampleServiceOperations = []string{
+	OperationCompleteExampleServiceBatchDeleteUsers,
+	OperationCompleteExampleServiceChatWithUsers,
+	OperationCompleteExampleServiceCreatePost,
+	OperationCompleteExampleServiceCreateUser,
+	OperationCompleteExampleServiceDeleteUser,
+	OperationCompleteExampleServiceExportUsers,
+	OperationCompleteExampleServiceGetPostComments,
+	OperationCompleteExampleServiceGetUser,
+	OperationCompleteExampleServiceGetUserProfile,
+	OperationCompleteExampleServiceListUsers,
+	OperationCompleteExampleServicePatchUser,
+	OperationCompleteExampleServiceRegisterUser,
+	OperationCompleteExampleServiceSearchUsers,
+	OperationCompleteExampleServiceUpdateProfile,
+	OperationCompleteExampleServiceUpdateUser,
+	OperationCompleteExampleServiceUploadAvatar,
+	OperationCompleteExampleServiceWatchUsers,
+}
+
+// CompleteExampleServiceOperationScopes maps operations of example.CompleteExampleService to the auth scopes they require
+var CompleteExampleServiceOperationScopes = map[string][]string{
+	OperationCompleteExampleServiceBatchDeleteUsers: {"users.admin"},
+	OperationCompleteExampleServiceCreateUser:       {"users.write"},
+	OperationCompleteExampleServiceDeleteUser:       {"users.admin"},
+	OperationCompleteExampleServiceUpdateUser:       {"users.write"},
+}
+
+// CompleteExampleServiceIdempotentOperations lists operations of example.CompleteExampleService that clients may retry, marked with
+// ginpb.idempotent or an idempotency_level
+var CompleteExampleServiceIdempotentOperations = []string{
+	OperationCompleteExampleServiceGetUser,
+	OperationCompleteExampleServiceListUsers,
+	OperationCompleteExampleServiceUpdateUser,
+}
+
+// CompleteExampleServiceOperationSLOs maps operations of example.CompleteExampleService to the service level objectives declared with ginpb.slo
+var CompleteExampleServiceOperationSLOs = map[string]slo.Objective{
+	OperationCompleteExampleServiceGetUser: {Availability: 0.999, Latency: 100 * time.Millisecond, LatencyTarget: 0.99, BurnRate: 14.4},
+}
+
+// CompleteExampleServiceCacheInvalidations maps operations of example.CompleteExampleService to the operations whose cached responses
+// they make stale, declared with ginpb.invalidates. Pass it to middleware.CacheConfig.
+var CompleteExampleServiceCacheInvalidations = map[string][]string{
+	OperationCompleteExampleServiceCreateUser: {OperationCompleteExampleServiceListUsers},
+	OperationCompleteExampleServiceDeleteUser: {OperationCompleteExampleServiceListUsers, OperationCompleteExampleServiceGetUser},
+	OperationCompleteExampleServiceUpdateUser: {OperationCompleteExampleServiceListUsers, OperationCompleteExampleServiceGetUser},
+}
+
+// CompleteExampleServiceMaskedFields lists the reply fields of example.CompleteExampleService hidden per masking profile, declared with
+// ginpb.mask. Handlers apply it with the profile selected by ginpb.SetMaskProfile, e.g. through middleware.MaskProfile.
+var CompleteExampleServiceMaskedFields = ginpb.MaskTable{
+	"example.User": {
+		{Name: "email", Profiles: []string{"public", "partner"}, Style: ginpb.MaskPartial},
+		{Name: "address", Profiles: []string{"public"}, Style: ginpb.MaskClear},
+	},
+}
+
+// CompleteExampleServiceRegionPinnedOperations lists operations of example.CompleteExampleService marked with ginpb.region_pinned
+var CompleteExampleServiceRegionPinnedOperations = []string{
+	OperationCompleteExampleServiceGetUserProfile,
+}
 
 type CompleteExampleServiceHTTPServer interface {
+	// DELETE请求 - 批量操作
 	BatchDeleteUsers(context.Context, *BatchDeleteUsersRequest) (*BatchDeleteUsersResponse, error)
+	// GET请求 - 双向流 (WebSocket，需开启 websocket 插件参数)
+	ChatWithUsers(context.Context, *ginpb.WebSocketStream[ChatMessage, ChatMessage]) error
+	// POST请求 - 混合参数 (路径 + 查询 + Body + Headers)
 	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	// POST请求 - JSON Body
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// DELETE请求
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// GET请求 - 流式导出 (NDJSON)
+	ExportUsers(context.Context, *ListUsersRequest, func(*User) error) error
+	// 嵌套路径参数
 	GetPostComments(context.Context, *GetPostCommentsRequest) (*GetPostCommentsResponse, error)
+	// GET请求 - 路径参数
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// 多种绑定路径 (Additional Bindings)
 	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	// 分页查询用户列表
+	//
+	// 简单GET请求 - 查询参数
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// PATCH请求 - 部分更新
 	PatchUser(context.Context, *PatchUserRequest) (*PatchUserResponse, error)
+	// POST请求 - Form Body
 	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	// GET请求 - 复杂查询 + Header参数
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// PUT请求 - 部分Body
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// PUT请求 - 完整更新
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// POST请求 - 上传头像（multipart/form-data）
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarResponse, error)
+	// GET请求 - 服务端流 (Server-Sent Events)
+	WatchUsers(context.Context, *WatchUsersRequest, *ginpb.EventStream[*UserEvent]) error
+}
+
+// UnimplementedCompleteExampleServiceHTTPServer can be embedded to have forward compatible implementations,
+// methods it provides answer 501 Not Implemented
+type UnimplementedCompleteExampleServiceHTTPServer struct{}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) BatchDeleteUsers(context.Context, *BatchDeleteUsersRequest) (*BatchDeleteUsersResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceBatchDeleteUsers)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) ChatWithUsers(context.Context, *ginpb.WebSocketStream[ChatMessage, ChatMessage]) error {
+	return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceChatWithUsers)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceCreatePost)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceCreateUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceDeleteUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) ExportUsers(context.Context, *ListUsersRequest, func(*User) error) error {
+	return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceExportUsers)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) GetPostComments(context.Context, *GetPostCommentsRequest) (*GetPostCommentsResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetPostComments)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetUserProfile)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceListUsers)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) PatchUser(context.Context, *PatchUserRequest) (*PatchUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServicePatchUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceRegisterUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceSearchUsers)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUpdateProfile)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUpdateUser)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUploadAvatar)
+}
+
+func (UnimplementedCompleteExampleServiceHTTPServer) WatchUsers(context.Context, *WatchUsersRequest, *ginpb.EventStream[*UserEvent]) error {
+	return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceWatchUsers)
+}
+
+// CompleteExampleServiceHTTPServerMock implements CompleteExampleServiceHTTPServer with a function field per method to unit test
+// the wiring and middlewares of the service, methods without function answer 501 Not Implemented
+type CompleteExampleServiceHTTPServerMock struct {
+	ginpb.MockCalls
+	BatchDeleteUsersFunc func(context.Context, *BatchDeleteUsersRequest) (*BatchDeleteUsersResponse, error)
+	ChatWithUsersFunc    func(context.Context, *ginpb.WebSocketStream[ChatMessage, ChatMessage]) error
+	CreatePostFunc       func(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	CreateUserFunc       func(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	DeleteUserFunc       func(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	ExportUsersFunc      func(context.Context, *ListUsersRequest, func(*User) error) error
+	GetPostCommentsFunc  func(context.Context, *GetPostCommentsRequest) (*GetPostCommentsResponse, error)
+	GetUserFunc          func(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	GetUserProfileFunc   func(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	ListUsersFunc        func(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	PatchUserFunc        func(context.Context, *PatchUserRequest) (*PatchUserResponse, error)
+	RegisterUserFunc     func(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	SearchUsersFunc      func(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	UpdateProfileFunc    func(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	UpdateUserFunc       func(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	UploadAvatarFunc     func(context.Context, *UploadAvatarRequest) (*UploadAvatarResponse, error)
+	WatchUsersFunc       func(context.Context, *WatchUsersRequest, *ginpb.EventStream[*UserEvent]) error
+}
+
+var _ CompleteExampleServiceHTTPServer = (*CompleteExampleServiceHTTPServerMock)(nil)
+
+func (m *CompleteExampleServiceHTTPServerMock) BatchDeleteUsers(ctx context.Context, req *BatchDeleteUsersRequest) (*BatchDeleteUsersResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceBatchDeleteUsers)
+	if m.BatchDeleteUsersFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceBatchDeleteUsers)
+	}
+	return m.BatchDeleteUsersFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) ChatWithUsers(ctx context.Context, stream *ginpb.WebSocketStream[ChatMessage, ChatMessage]) error {
+	m.MockCalls.Record(OperationCompleteExampleServiceChatWithUsers)
+	if m.ChatWithUsersFunc == nil {
+		return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceChatWithUsers)
+	}
+	return m.ChatWithUsersFunc(ctx, stream)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) CreatePost(ctx context.Context, req *CreatePostRequest) (*CreatePostResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceCreatePost)
+	if m.CreatePostFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceCreatePost)
+	}
+	return m.CreatePostFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceCreateUser)
+	if m.CreateUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceCreateUser)
+	}
+	return m.CreateUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceDeleteUser)
+	if m.DeleteUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceDeleteUser)
+	}
+	return m.DeleteUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) ExportUsers(ctx context.Context, req *ListUsersRequest, send func(*User) error) error {
+	m.MockCalls.Record(OperationCompleteExampleServiceExportUsers)
+	if m.ExportUsersFunc == nil {
+		return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceExportUsers)
+	}
+	return m.ExportUsersFunc(ctx, req, send)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) GetPostComments(ctx context.Context, req *GetPostCommentsRequest) (*GetPostCommentsResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceGetPostComments)
+	if m.GetPostCommentsFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetPostComments)
+	}
+	return m.GetPostCommentsFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) GetUser(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceGetUser)
+	if m.GetUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetUser)
+	}
+	return m.GetUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) GetUserProfile(ctx context.Context, req *GetUserProfileRequest) (*GetUserProfileResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceGetUserProfile)
+	if m.GetUserProfileFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceGetUserProfile)
+	}
+	return m.GetUserProfileFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceListUsers)
+	if m.ListUsersFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceListUsers)
+	}
+	return m.ListUsersFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) PatchUser(ctx context.Context, req *PatchUserRequest) (*PatchUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServicePatchUser)
+	if m.PatchUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServicePatchUser)
+	}
+	return m.PatchUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) RegisterUser(ctx context.Context, req *RegisterUserRequest) (*RegisterUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceRegisterUser)
+	if m.RegisterUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceRegisterUser)
+	}
+	return m.RegisterUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) SearchUsers(ctx context.Context, req *SearchUsersRequest) (*SearchUsersResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceSearchUsers)
+	if m.SearchUsersFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceSearchUsers)
+	}
+	return m.SearchUsersFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) UpdateProfile(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceUpdateProfile)
+	if m.UpdateProfileFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUpdateProfile)
+	}
+	return m.UpdateProfileFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceUpdateUser)
+	if m.UpdateUserFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUpdateUser)
+	}
+	return m.UpdateUserFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) UploadAvatar(ctx context.Context, req *UploadAvatarRequest) (*UploadAvatarResponse, error) {
+	m.MockCalls.Record(OperationCompleteExampleServiceUploadAvatar)
+	if m.UploadAvatarFunc == nil {
+		return nil, ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceUploadAvatar)
+	}
+	return m.UploadAvatarFunc(ctx, req)
+}
+
+func (m *CompleteExampleServiceHTTPServerMock) WatchUsers(ctx context.Context, req *WatchUsersRequest, stream *ginpb.EventStream[*UserEvent]) error {
+	m.MockCalls.Record(OperationCompleteExampleServiceWatchUsers)
+	if m.WatchUsersFunc == nil {
+		return ginpb.CodeUnimplemented.New(OperationCompleteExampleServiceWatchUsers)
+	}
+	return m.WatchUsersFunc(ctx, req, stream)
 }
 
 // RegisterOption defines registration options
@@ -67,6 +400,19 @@ type CompleteExampleServiceRegisterOption func(*CompleteExampleServiceRegisterOp
 type CompleteExampleServiceRegisterOptions struct {
 	globalMiddlewares    []gin.HandlerFunc
 	operationMiddlewares map[string][]gin.HandlerFunc
+	responseRewriters    map[string]*ginpb.ResponseRewriter
+	bindConfig           binding1.Config
+	exposures            []string
+	jsonNaming           ginpb.JSONNaming
+	jsonEngine           ginpb.JSONEngine
+	routeTable           *ginpb.RouteTable
+	keyProvider          ginpb.KeyProvider
+	errorEncoder         ginpb.ErrorEncoder
+	responseEncoder      ginpb.ResponseEncoder
+	engine               func(*gin.Engine)
+	webSocketUpgrader    *ginpb.WebSocketUpgrader
+	validator            ginpb.Validator
+	deduplicator         *ginpb.Deduplicator
 }
 
 // WithGlobalMiddleware adds global middleware
@@ -98,18 +444,206 @@ func WithCompleteExampleServiceOperationMiddlewares(middlewares map[string][]gin
 	}
 }
 
+// WithCompleteExampleServiceResponseRewriter rewrites the replies of operation, e.g. to serve legacy field names
+// to old clients during a migration. Streamed replies are not rewritten.
+func WithCompleteExampleServiceResponseRewriter(operation string, rw *ginpb.ResponseRewriter) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if o.responseRewriters == nil {
+			o.responseRewriters = make(map[string]*ginpb.ResponseRewriter)
+		}
+		o.responseRewriters[operation] = rw
+	}
+}
+
+// WithCompleteExampleServiceResponseRewriters sets the response rewriters of multiple operations
+func WithCompleteExampleServiceResponseRewriters(rewriters map[string]*ginpb.ResponseRewriter) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		for operation, rw := range rewriters {
+			WithCompleteExampleServiceResponseRewriter(operation, rw)(o)
+		}
+	}
+}
+
+// WithCompleteExampleServiceBindConfig sets request body binding limits such as the body size and multipart memory
+func WithCompleteExampleServiceBindConfig(config binding1.Config) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.bindConfig = config
+	}
+}
+
+// WithCompleteExampleServiceExposure sets the exposures of the deployment, methods annotated with
+// another (ginpb.expose) are not registered, e.g. internal-only methods on a public gateway
+func WithCompleteExampleServiceExposure(exposures ...string) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.exposures = append(o.exposures, exposures...)
+	}
+}
+
+// WithCompleteExampleServiceJSONNaming encodes replies with protojson using proto field names or lowerCamel JSON names,
+// configure clients with client.WithProtoJSON to decode them. Combine it with ginpb.JSONInt64AsString or
+// ginpb.JSONInt64AsNumber to choose how 64-bit integers are written.
+func WithCompleteExampleServiceJSONNaming(naming ginpb.JSONNaming) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.jsonNaming = naming
+	}
+}
+
+// WithCompleteExampleServiceJSONEngine marshals replies and streamed list items with e where encoding/json would be used,
+// e.g. jsonengine.Sonic for large list replies. Replies encoded with protojson are not affected.
+func WithCompleteExampleServiceJSONEngine(e ginpb.JSONEngine) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if e != nil {
+			o.jsonEngine = e
+		}
+	}
+}
+
+// WithCompleteExampleServiceRouteTable mounts the routes through t, so registering the service again replaces
+// its handlers and t.Unregister(CompleteExampleServiceOperations...) removes them at runtime
+func WithCompleteExampleServiceRouteTable(t *ginpb.RouteTable) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.routeTable = t
+	}
+}
+
+// WithCompleteExampleServiceKeyProvider sets the provider encrypting and decrypting fields annotated with ginpb.encrypt,
+// requests and replies of methods with such fields fail without it
+func WithCompleteExampleServiceKeyProvider(p ginpb.KeyProvider) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.keyProvider = p
+	}
+}
+
+// WithCompleteExampleServiceWebSocketUpgrader sets the upgrader of methods served over WebSocket, e.g. to check the
+// origin of cross-origin requests. Without it only same-origin requests are accepted.
+func WithCompleteExampleServiceWebSocketUpgrader(u *ginpb.WebSocketUpgrader) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.webSocketUpgrader = u
+	}
+}
+
+// WithCompleteExampleServiceValidator sets the validator of bound requests, e.g. protovalidate wrapped in a ginpb.ValidatorFunc.
+// Without it requests are validated with their generated Validate methods (ginpb.DefaultValidator).
+func WithCompleteExampleServiceValidator(v ginpb.Validator) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if v != nil {
+			o.validator = v
+		}
+	}
+}
+
+// WithCompleteExampleServiceDeduplicator sets the store and window deduplicating deliveries by ginpb.message_id.
+// Without it duplicates are dropped for ginpb.DefaultDedupTTL with an in-memory store.
+func WithCompleteExampleServiceDeduplicator(d *ginpb.Deduplicator) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if d != nil {
+			o.deduplicator = d
+		}
+	}
+}
+
+// WithCompleteExampleServiceErrorEncoder sets how errors are written, e.g. to map domain errors to statuses: requests
+// that could not be bound as *binding.Error with their 400 or 413 status, content types and read masks the
+// method rejects, and errors returned by unary methods. Without it they are written with ginpb.RenderError. Path parameters violating their rules, requests failing (ginpb.validate) with their
+// field violations and failed WebSocket handshakes are answered before and without it.
+func WithCompleteExampleServiceErrorEncoder(e ginpb.ErrorEncoder) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if e != nil {
+			o.errorEncoder = e
+		}
+	}
+}
+
+// WithCompleteExampleServiceResponseEncoder sets how replies of unary methods are written, e.g. in an envelope.
+// Without it they are written as JSON or protobuf with the JSON naming and the response rewriters.
+func WithCompleteExampleServiceResponseEncoder(e ginpb.ResponseEncoder) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		if e != nil {
+			o.responseEncoder = e
+		}
+	}
+}
+
+// WithCompleteExampleServiceEngine configures the engine built by NewCompleteExampleServiceHandler before the routes are
+// registered, e.g. to add recovery and logging middleware or set trusted proxies. Without it the engine
+// recovers panics with gin.Recovery. RegisterCompleteExampleServiceHTTPServer ignores it.
+func WithCompleteExampleServiceEngine(configure func(*gin.Engine)) CompleteExampleServiceRegisterOption {
+	return func(o *CompleteExampleServiceRegisterOptions) {
+		o.engine = configure
+	}
+}
+
+// CompleteExampleServiceHTTPRoutes lists the routes of example.CompleteExampleService, e.g. to label metrics or configure API gateways
+var CompleteExampleServiceHTTPRoutes = []ginpb.RouteInfo{
+	{Operation: OperationCompleteExampleServiceListUsers, Method: "GET", Path: "/api/v1/users", RequestType: "example.ListUsersRequest", ReplyType: "example.ListUsersResponse"},
+	{Operation: OperationCompleteExampleServiceExportUsers, Method: "GET", Path: "/api/v1/users/export", RequestType: "example.ListUsersRequest", ReplyType: "example.ListUsersResponse"},
+	{Operation: OperationCompleteExampleServiceWatchUsers, Method: "GET", Path: "/api/v1/users/watch", RequestType: "example.WatchUsersRequest", ReplyType: "example.UserEvent"},
+	{Operation: OperationCompleteExampleServiceChatWithUsers, Method: "GET", Path: "/api/v1/users/chat", RequestType: "example.ChatMessage", ReplyType: "example.ChatMessage"},
+	{Operation: OperationCompleteExampleServiceGetUser, Method: "GET", Path: "/api/v1/users/:user_id", RequestType: "example.GetUserRequest", ReplyType: "example.GetUserResponse"},
+	{Operation: OperationCompleteExampleServiceSearchUsers, Method: "GET", Path: "/api/v1/users/search", RequestType: "example.SearchUsersRequest", ReplyType: "example.SearchUsersResponse"},
+	{Operation: OperationCompleteExampleServiceCreateUser, Method: "POST", Path: "/api/v1/users", RequestType: "example.CreateUserRequest", ReplyType: "example.CreateUserResponse"},
+	{Operation: OperationCompleteExampleServiceRegisterUser, Method: "POST", Path: "/api/v1/users/register", RequestType: "example.RegisterUserRequest", ReplyType: "example.RegisterUserResponse"},
+	{Operation: OperationCompleteExampleServiceCreatePost, Method: "POST", Path: "/api/v1/users/:user_id/posts", RequestType: "example.CreatePostRequest", ReplyType: "example.CreatePostResponse"},
+	{Operation: OperationCompleteExampleServiceUpdateUser, Method: "PUT", Path: "/api/v1/users/:user_id", RequestType: "example.UpdateUserRequest", ReplyType: "example.UpdateUserResponse"},
+	{Operation: OperationCompleteExampleServiceUpdateProfile, Method: "PUT", Path: "/api/v1/users/:user_id/profile", RequestType: "example.UpdateProfileRequest", ReplyType: "example.UpdateProfileResponse"},
+	{Operation: OperationCompleteExampleServiceUploadAvatar, Method: "POST", Path: "/api/v1/users/:user_id/avatar", RequestType: "example.UploadAvatarRequest", ReplyType: "example.UploadAvatarResponse", ContentTypes: []string{"multipart/form-data"}},
+	{Operation: OperationCompleteExampleServicePatchUser, Method: "PATCH", Path: "/api/v1/users/:user_id", RequestType: "example.PatchUserRequest", ReplyType: "example.PatchUserResponse"},
+	{Operation: OperationCompleteExampleServiceDeleteUser, Method: "DELETE", Path: "/api/v1/users/:user_id", RequestType: "example.DeleteUserRequest", ReplyType: "example.DeleteUserResponse"},
+	{Operation: OperationCompleteExampleServiceBatchDeleteUsers, Method: "DELETE", Path: "/api/v1/users", RequestType: "example.BatchDeleteUsersRequest", ReplyType: "example.BatchDeleteUsersResponse"},
+	{Operation: OperationCompleteExampleServiceGetPostComments, Method: "GET", Path: "/api/v1/users/:user_id/posts/:post_id/comments", RequestType: "example.GetPostCommentsRequest", ReplyType: "example.GetPostCommentsResponse"},
+	{Operation: OperationCompleteExampleServiceGetUserProfile, Method: "GET", Path: "/api/v1/profiles/:user_id", RequestType: "example.GetUserProfileRequest", ReplyType: "example.GetUserProfileResponse"},
+	{Operation: OperationCompleteExampleServiceGetUserProfile, Method: "GET", Path: "/api/v1/users/:user_id/profile", RequestType: "example.GetUserProfileRequest", ReplyType: "example.GetUserProfileResponse"},
+}
+
 // RegisterCompleteExampleServiceHTTPServer registers HTTP server with function options pattern
 func RegisterCompleteExampleServiceHTTPServer(r gin.IRouter, srv CompleteExampleServiceHTTPServer, opts ...CompleteExampleServiceRegisterOption) {
-	options := &CompleteExampleServiceRegisterOptions{}
+	options := &CompleteExampleServiceRegisterOptions{
+		bindConfig:   binding1.DefaultConfig(),
+		errorEncoder: ginpb.RenderError,
+	}
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	// Fail fast on middleware and rewriters bound to operations this service does not define
+	referenced := make([]string, 0, len(options.operationMiddlewares)+len(options.responseRewriters))
+	for operation := range options.operationMiddlewares {
+		referenced = append(referenced, operation)
+	}
+	for operation := range options.responseRewriters {
+		referenced = append(referenced, operation)
+	}
+	if err := ginpb.ValidateOperations(CompleteExampleServiceOperations, referenced...); err != nil {
+		panic(err)
+	}
+
 	// Helper function to register route with middleware support
-	registerRoute := func(method, path, operation string, handler gin.HandlerFunc) {
+	var verbs *ginpb.VerbRoutes
+	registerRoute := func(method, path, verb, operation, expose string, wildcards []ginpb.PathWildcard, params []ginpb.PathParam, example *ginpb.RouteExample, contentTypes []string, handler gin.HandlerFunc) {
+		// Skip methods not exposed in this deployment
+		if !ginpb.Exposed(expose, options.exposures) {
+			return
+		}
 		var finalHandlers []gin.HandlerFunc
 
-		// Add global middlewares first
+		// Set the interned operation before any middleware runs
+		op := ginpb.Intern(operation)
+		finalHandlers = append(finalHandlers, func(ctx *gin.Context) {
+			ctx.Set(ginpb.OperationKey, op)
+		})
+
+		// Join multi-segment path variables before they are validated and bound
+		if len(wildcards) > 0 {
+			finalHandlers = append(finalHandlers, ginpb.JoinPathWildcards(wildcards...))
+		}
+
+		// Reject path parameters violating their binding rules before anything else runs
+		if len(params) > 0 {
+			finalHandlers = append(finalHandlers, ginpb.ValidatePathParams(params...))
+		}
+		middlewares := len(options.globalMiddlewares) + len(options.operationMiddlewares[operation])
+
+		// Add global middlewares
 		finalHandlers = append(finalHandlers, options.globalMiddlewares...)
 
 		// Add operation-specific middlewares
@@ -120,462 +654,1013 @@ func RegisterCompleteExampleServiceHTTPServer(r gin.IRouter, srv CompleteExample
 		// Add the handler at the end
 		finalHandlers = append(finalHandlers, handler)
 
-		// Register the route
-		r.Handle(method, path, finalHandlers...)
-	}
-	registerRoute("GET", "/api/v1/users", OperationCompleteExampleServiceListUsers, _CompleteExampleService_ListUsers0_HTTP_Handler(srv))
-	registerRoute("GET", "/api/v1/users/:user_id", OperationCompleteExampleServiceGetUser, _CompleteExampleService_GetUser0_HTTP_Handler(srv))
-	registerRoute("GET", "/api/v1/users/search", OperationCompleteExampleServiceSearchUsers, _CompleteExampleService_SearchUsers0_HTTP_Handler(srv))
-	registerRoute("POST", "/api/v1/users", OperationCompleteExampleServiceCreateUser, _CompleteExampleService_CreateUser0_HTTP_Handler(srv))
-	registerRoute("POST", "/api/v1/users/register", OperationCompleteExampleServiceRegisterUser, _CompleteExampleService_RegisterUser0_HTTP_Handler(srv))
-	registerRoute("POST", "/api/v1/users/:user_id/posts", OperationCompleteExampleServiceCreatePost, _CompleteExampleService_CreatePost0_HTTP_Handler(srv))
-	registerRoute("PUT", "/api/v1/users/:user_id", OperationCompleteExampleServiceUpdateUser, _CompleteExampleService_UpdateUser0_HTTP_Handler(srv))
-	registerRoute("PUT", "/api/v1/users/:user_id/profile", OperationCompleteExampleServiceUpdateProfile, _CompleteExampleService_UpdateProfile0_HTTP_Handler(srv))
-	registerRoute("PATCH", "/api/v1/users/:user_id", OperationCompleteExampleServicePatchUser, _CompleteExampleService_PatchUser0_HTTP_Handler(srv))
-	registerRoute("DELETE", "/api/v1/users/:user_id", OperationCompleteExampleServiceDeleteUser, _CompleteExampleService_DeleteUser0_HTTP_Handler(srv))
-	registerRoute("DELETE", "/api/v1/users", OperationCompleteExampleServiceBatchDeleteUsers, _CompleteExampleService_BatchDeleteUsers0_HTTP_Handler(srv))
-	registerRoute("GET", "/api/v1/users/:user_id/posts/:post_id/comments", OperationCompleteExampleServiceGetPostComments, _CompleteExampleService_GetPostComments0_HTTP_Handler(srv))
-	registerRoute("GET", "/api/v1/profiles/:user_id", OperationCompleteExampleServiceGetUserProfile, _CompleteExampleService_GetUserProfile0_HTTP_Handler(srv))
-	registerRoute("GET", "/api/v1/users/:user_id/profile", OperationCompleteExampleServiceGetUserProfile, _CompleteExampleService_GetUserProfile1_HTTP_Handler(srv))
-}
-
-func _CompleteExampleService_ListUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
-	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceListUsers)
+		// Custom verbs share the route of their path and are dispatched by verb
+		if verb != "" {
+			if verbs == nil {
+				verbs = ginpb.NewVerbRoutes()
+			}
+			finalHandlers = verbs.Handle(r, method, path, verb, finalHandlers...)
+		}
+
+		// Register the route, a verb route only once
+		if options.routeTable != nil && len(finalHandlers) > 0 {
+			options.routeTable.Handle(r, method, path, operation, finalHandlers...)
+		} else if len(finalHandlers) > 0 {
+			r.Handle(method, path, finalHandlers...)
+		}
+		ginpb.AddRoute(r, ginpb.RouteInfo{Operation: operation, Method: method, Path: ginpb.VerbPath(path, verb), Middlewares: middlewares, Example: example, ContentTypes: contentTypes})
+	}
+	registerRoute("GET", "/api/v1/users", "", OperationCompleteExampleServiceListUsers, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users", Query: "created_after=2024-01-02&created_before=2024-01-02&include_deleted=true&include_stats=true&page=1&page_size=1&roles=sampleRoles&sort_by=id&sort_order=asc&status=sampleStatus"}, nil, _CompleteExampleService_ListUsers0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/export", "", OperationCompleteExampleServiceExportUsers, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/export", Query: "created_after=2024-01-02&created_before=2024-01-02&include_deleted=true&include_stats=true&page=1&page_size=1&roles=sampleRoles&sort_by=id&sort_order=asc&status=sampleStatus"}, nil, _CompleteExampleService_ExportUsers0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/watch", "", OperationCompleteExampleServiceWatchUsers, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/watch", Query: "status=sampleStatus"}, nil, _CompleteExampleService_WatchUsers0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/chat", "", OperationCompleteExampleServiceChatWithUsers, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/chat"}, nil, _CompleteExampleService_ChatWithUsers0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/:user_id", "", OperationCompleteExampleServiceGetUser, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", Query: "fields=sampleFields&include_posts=true&include_profile=true"}, nil, _CompleteExampleService_GetUser0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/search", "", OperationCompleteExampleServiceSearchUsers, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/search", Query: "city=sampleCity&country=sampleCountry&lat=-90&limit=1&lng=-180&max_age=0&min_age=0&page_token=samplePageToken&q=sampleQuery&radius=1&search_fields=sampleSearchFields", Header: map[string]string{"User-Agent": "sampleUserAgent", "X-API-Key": "sampleApiKeysampleApiKeysampleApiKeysampleApiKey", "X-Client-ID": "sampleClientId", "X-Request-ID": "sampleRequestId"}}, nil, _CompleteExampleService_SearchUsers0_HTTP_Handler(srv, options))
+	registerRoute("POST", "/api/v1/users", "", OperationCompleteExampleServiceCreateUser, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users", Header: map[string]string{"Content-Type": "application/json"}, Body: `{"address":{},"age":13,"agree_terms":true,"bio":"sampleBio","email":"user@example.com","full_name":"sampleFullName","gender":"male","hobbies":["sampleHobbies"],"languages":["sampleLanguages"],"password":"samplePassword","phone":"12345678901","preferences":{},"referral_code":"sampleReferralCode","request_id":"sampleRequestId","settings":{},"social_links":{},"subscribe_newsletter":true,"tags":["sampleTags"],"username":"sampleUsername"}`}, nil, _CompleteExampleService_CreateUser0_HTTP_Handler(srv, options))
+	registerRoute("POST", "/api/v1/users/register", "", OperationCompleteExampleServiceRegisterUser, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/register", Header: map[string]string{"Content-Type": "application/json"}, Body: `{"birth_date":"2024-01-02","captcha_response":"sample","confirm_password":"sampleConfirmPassword","country":"sa","email":"user@example.com","first_name":"sampleFirstName","gender":"male","interests":["sampleInterests"],"invite_code":"sampleInviteCode","last_name":"sampleLastName","marketing_emails":true,"newsletter_frequency":"never","password":"samplePassword","phone":"12345678901","referrer_url":"https://example.com/resource","skills":["sampleSkills"],"timezone":"sampleTimezone","username":"sampleUsername","utm_campaign":"sampleUtmCampaign","utm_medium":"sampleUtmMedium","utm_source":"sampleUtmSource"}`}, nil, _CompleteExampleService_RegisterUser0_HTTP_Handler(srv, options))
+	registerRoute("POST", "/api/v1/users/:user_id/posts", "", OperationCompleteExampleServiceCreatePost, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts", Header: map[string]string{"Authorization": "Bearer sample", "Content-Type": "application/json", "User-Agent": "sampleUserAgent", "X-Client-Version": "sampleClientVersion", "X-Request-ID": "sampleRequestId"}, Body: `{"allow_comments":true,"attachments":["sampleAttachmentUrls"],"category":"sampleCategory","content":"sampleContentsampleContentsampleContentsampleContent","custom_fields":{},"draft":true,"excerpt":"sampleExcerpt","external_id":"sampleExternalId","images":["sampleImageUrls"],"meta_description":"sampleMetaDescription","meta_title":"sampleMetaTitle","notify_followers":true,"publish_at":"2024-01-02T15:04:05Z","seo_keywords":["sampleSeoKeywords"],"source":"web","tags":["sampleTags"],"title":"sampleTitle","visibility":"public"}`}, nil, _CompleteExampleService_CreatePost0_HTTP_Handler(srv, options))
+	registerRoute("PUT", "/api/v1/users/:user_id", "", OperationCompleteExampleServiceUpdateUser, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", Header: map[string]string{"Authorization": "sampleAuthorization", "Content-Type": "application/json", "If-Match": "sampleIfMatch"}, Body: `{"address":{},"age":13,"bio":"sampleBio","email":"user@example.com","full_name":"sampleFullName","phone":"12345678901","roles":["sampleRoles"],"send_notification":true,"settings":{},"social_links":{},"status":"active","update_reason":"sampleUpdateReason","updated_at":"2024-01-02T15:04:05Z","username":"sampleUsername","version":1}`}, nil, _CompleteExampleService_UpdateUser0_HTTP_Handler(srv, options))
+	registerRoute("PUT", "/api/v1/users/:user_id/profile", "", OperationCompleteExampleServiceUpdateProfile, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/profile", Header: map[string]string{"Content-Type": "application/json"}, Body: `{}`}, nil, _CompleteExampleService_UpdateProfile0_HTTP_Handler(srv, options))
+	registerRoute("POST", "/api/v1/users/:user_id/avatar", "", OperationCompleteExampleServiceUploadAvatar, "", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users/sampleUserId/avatar", Header: map[string]string{"Content-Type": "multipart/form-data; boundary=ginpb-sample-boundary"}, Body: "--ginpb-sample-boundary\r\nContent-Disposition: form-data; name=\"caption\"\r\n\r\nsampleCaption\r\n--ginpb-sample-boundary\r\nContent-Disposition: form-data; name=\"avatar\"; filename=\"sample.txt\"\r\nContent-Type: application/octet-stream\r\n\r\nsample\r\n--ginpb-sample-boundary--\r\n"}, []string{"multipart/form-data"}, _CompleteExampleService_UploadAvatar0_HTTP_Handler(srv, options))
+	registerRoute("PATCH", "/api/v1/users/:user_id", "", OperationCompleteExampleServicePatchUser, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", Header: map[string]string{"Authorization": "sampleAuthorization", "Content-Type": "application/json", "If-Match": "sampleIfMatch", "If-Unmodified-Since": "sampleIfUnmodifiedSince", "X-Patch-Source": "samplePatchSource"}, Body: `{"add_roles":["sampleAddRoles"],"add_tags":["sampleAddTags"],"address_patches":{},"bio":"sampleBio","email":"user@example.com","full_name":"sampleFullName","patch_metadata":{},"patch_reason":"samplePatchReason","phone":"12345678901","profile_patches":{},"remove_roles":["sampleRemoveRoles"],"remove_tags":["sampleRemoveTags"],"settings_patches":{},"status":"active","username":"sampleUsername"}`}, nil, _CompleteExampleService_PatchUser0_HTTP_Handler(srv, options))
+	registerRoute("DELETE", "/api/v1/users/:user_id", "", OperationCompleteExampleServiceDeleteUser, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", Query: "hard_delete=true&reason=sampleDeleteReason&transfer_data=true&transfer_to=3fa85f64-5717-4562-b3fc-2c963f66afa6", Header: map[string]string{"Authorization": "sampleAuthorization", "X-Admin-Token": "sampleAdminToken", "X-Confirm-Delete": "sampleConfirmation"}}, nil, _CompleteExampleService_DeleteUser0_HTTP_Handler(srv, options))
+	registerRoute("DELETE", "/api/v1/users", "", OperationCompleteExampleServiceBatchDeleteUsers, "internal", nil, nil, &ginpb.RouteExample{Path: "/api/v1/users", Query: "hard_delete=true&reason=sampleDeleteReason&user_ids=sampleUserIds", Header: map[string]string{"Authorization": "sampleAuthorization", "X-Batch-Confirm": "sampleBatchConfirmation", "X-Operation-ID": "sampleOperationId"}}, nil, _CompleteExampleService_BatchDeleteUsers0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/:user_id/posts/:post_id/comments", "", OperationCompleteExampleServiceGetPostComments, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}, {Name: "post_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts/3fa85f64-5717-4562-b3fc-2c963f66afa6/comments", Query: "include_hidden=true&include_replies=true&order=asc&page=1&per_page=1&since=2024-01-02T15%3A04%3A05Z&sort=created_at&status=all&until=2024-01-02T15%3A04%3A05Z", Header: map[string]string{"X-Client-Timezone": "sampleClientTimezone", "X-User-Context": "sampleUserContext"}}, nil, _CompleteExampleService_GetPostComments0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/profiles/:user_id", "", OperationCompleteExampleServiceGetUserProfile, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/profiles/3fa85f64-5717-4562-b3fc-2c963f66afa6", Query: "context=public&include_followers=true&include_posts=true&include_stats=true&sections=sampleSections", Header: map[string]string{"X-Access-Token": "sampleAccessToken", "X-Viewer-ID": "sampleViewerId"}}, nil, _CompleteExampleService_GetUserProfile0_HTTP_Handler(srv, options))
+	registerRoute("GET", "/api/v1/users/:user_id/profile", "", OperationCompleteExampleServiceGetUserProfile, "", nil, []ginpb.PathParam{{Name: "user_id", Kind: ginpb.ParamString, Rule: "required,uuid"}}, &ginpb.RouteExample{Path: "/api/v1/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/profile", Query: "context=public&include_followers=true&include_posts=true&include_stats=true&sections=sampleSections", Header: map[string]string{"X-Access-Token": "sampleAccessToken", "X-Viewer-ID": "sampleViewerId"}}, nil, _CompleteExampleService_GetUserProfile1_HTTP_Handler(srv, options))
+}
+
+// NewCompleteExampleServiceHandler returns a self-contained http.Handler serving example.CompleteExampleService on its own gin engine
+func NewCompleteExampleServiceHandler(srv CompleteExampleServiceHTTPServer, opts ...CompleteExampleServiceRegisterOption) http.Handler {
+	var options CompleteExampleServiceRegisterOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	e := gin.New()
+	if options.engine != nil {
+		options.engine(e)
+	} else {
+		e.Use(gin.Recovery())
+	}
+	RegisterCompleteExampleServiceHTTPServer(e, srv, opts...)
+	return e
+}
 
+// CompleteExampleServiceRegistration returns a registration of example.CompleteExampleService for ginpb.RegisterAll
+func CompleteExampleServiceRegistration(srv CompleteExampleServiceHTTPServer, opts ...CompleteExampleServiceRegisterOption) ginpb.Registration {
+	return ginpb.Registration{
+		Operations: CompleteExampleServiceOperations,
+		Register: func(r gin.IRouter, config ginpb.RegisterConfig) {
+			defaults := []CompleteExampleServiceRegisterOption{
+				WithCompleteExampleServiceGlobalMiddleware(config.Middlewares...),
+				WithCompleteExampleServiceOperationMiddlewares(config.OperationMiddlewaresFor(CompleteExampleServiceOperations)),
+				WithCompleteExampleServiceResponseRewriters(config.ResponseRewritersFor(CompleteExampleServiceOperations)),
+				WithCompleteExampleServiceExposure(config.Exposures...),
+				WithCompleteExampleServiceJSONNaming(config.JSONNaming),
+				WithCompleteExampleServiceJSONEngine(config.JSONEngine),
+				WithCompleteExampleServiceRouteTable(config.RouteTable),
+				WithCompleteExampleServiceKeyProvider(config.KeyProvider),
+				WithCompleteExampleServiceErrorEncoder(config.ErrorEncoder),
+				WithCompleteExampleServiceResponseEncoder(config.ResponseEncoder),
+				WithCompleteExampleServiceValidator(config.Validator),
+				WithCompleteExampleServiceDeduplicator(config.Deduplicator),
+			}
+			RegisterCompleteExampleServiceHTTPServer(r, srv, append(defaults, opts...)...)
+		},
+	}
+}
+
+// 分页查询用户列表
+//
+// 简单GET请求 - 查询参数
+func _CompleteExampleService_ListUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
 		var ginReq _ListUsersGinRequest
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toListUsersRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.ListUsers(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		ctx.Header("X-Api-Version", "v1")
+		if v := reply.GetTotalCount(); v != 0 {
+			ctx.Header("X-Total-Count", fmt.Sprint(v))
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceListUsers], reply)
 	}
 }
 
-func _CompleteExampleService_GetUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// GET请求 - 流式导出 (NDJSON)
+func _CompleteExampleService_ExportUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceGetUser)
+		var ginReq _ExportUsersGinRequest
+		// query
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 
-		var ginReq _GetUserGinRequest
+		// Convert gin request to protobuf request
+		in := ginReq.toExportUsersRequest()
+
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
+		// Use new context for metadata passing, including request, writer and route params
+		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		// Stream Users items as they are produced
+		w := ginpb.NewListWriterWithNaming(ctx, "ndjson", options.jsonNaming).WithEngine(options.jsonEngine)
+		err := srv.ExportUsers(newCtx, in, func(item *User) error {
+			ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, item)
+			if err := ginpb.EncryptFields(newCtx, options.keyProvider, item); err != nil {
+				return err
+			}
+			return w.Send(item)
+		})
+		w.Close(err)
+	}
+}
+
+// GET请求 - 服务端流 (Server-Sent Events)
+func _CompleteExampleService_WatchUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		var ginReq _WatchUsersGinRequest
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+
+		// Convert gin request to protobuf request
+		in := ginReq.toWatchUsersRequest()
+
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
+		// Use new context for metadata passing, including request, writer and route params
+		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		// Send replies as Server-Sent Events, the request context is cancelled when the client disconnects
+		stream := ginpb.NewEventStream[*UserEvent](ctx, options.jsonNaming)
+		stream.Prepare(func(reply *UserEvent) error {
+			ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+			return ginpb.EncryptFields(newCtx, options.keyProvider, reply)
+		})
+		stream.Close(srv.WatchUsers(newCtx, in, stream))
+	}
+}
+
+// GET请求 - 双向流 (WebSocket，需开启 websocket 插件参数)
+func _CompleteExampleService_ChatWithUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		// Self-test requests end before the connection is upgraded
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
+		newCtx := metadata.NewContext(ctx)
+		// Requests and replies are exchanged as WebSocket messages
+		stream, err := ginpb.UpgradeWebSocket[ChatMessage, ChatMessage](ctx, options.jsonNaming, options.webSocketUpgrader)
+		if err != nil {
+			// The upgrader has answered the handshake already
 			ctx.Error(err)
 			return
 		}
+		stream.Close(srv.ChatWithUsers(newCtx, stream))
+	}
+}
+
+// GET请求 - 路径参数
+func _CompleteExampleService_GetUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		var ginReq _GetUserGinRequest
+		// query
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toGetUserRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		// Reject read_mask paths that are not fields of the reply before calling the service
+		if err := ginpb.CheckFieldMask((*GetUserResponse)(nil), in.GetReadMask()); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Bound the service call by the method timeout
+		newCtx, cancel := context.WithTimeout(newCtx, 2*time.Second)
+		defer cancel()
 		reply, err := srv.GetUser(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, ginpb.TimeoutError(newCtx, err, OperationCompleteExampleServiceGetUser, 2*time.Second))
+			return
+		}
+		// Prune the reply to the paths of read_mask
+		ginpb.PruneFields(reply, in.GetReadMask())
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Write Cache-Control, the ETag of the encoded reply and 304 for matching If-None-Match
+		defer ginpb.CacheReply(ctx, ginpb.CachePolicy{MaxAge: 30 * time.Second, Private: true, ETag: true})()
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceGetUser], reply)
 	}
 }
 
-func _CompleteExampleService_SearchUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// GET请求 - 复杂查询 + Header参数
+func _CompleteExampleService_SearchUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceSearchUsers)
-
 		var ginReq _SearchUsersGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toSearchUsersRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.SearchUsers(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceSearchUsers], reply)
 	}
 }
 
-func _CompleteExampleService_CreateUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// POST请求 - JSON Body
+func _CompleteExampleService_CreateUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceCreateUser)
-
 		var ginReq _CreateUserGinRequest
 		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body CreateUserRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromCreateUserRequest(&body)
+			if err := binding1.Validate(ctx, &ginReq); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toCreateUserRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		// Replay the original response to duplicate deliveries of the same message
+		replayed, done := ginpb.Deduplicate(ctx, options.deduplicator, OperationCompleteExampleServiceCreateUser, in.GetRequestId())
+		if replayed {
+			return
+		}
+		defer done()
 		reply, err := srv.CreateUser(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if v := reply.GetLocation(); v != "" {
+			ctx.Header("Location", v)
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 201, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 201, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceCreateUser], reply)
 	}
 }
 
-func _CompleteExampleService_RegisterUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// POST请求 - Form Body
+func _CompleteExampleService_RegisterUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceRegisterUser)
-
 		var ginReq _RegisterUserGinRequest
 		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body RegisterUserRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromRegisterUserRequest(&body)
+			if err := binding1.Validate(ctx, &ginReq); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toRegisterUserRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.RegisterUser(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceRegisterUser], reply)
 	}
 }
 
-func _CompleteExampleService_CreatePost0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// POST请求 - 混合参数 (路径 + 查询 + Body + Headers)
+func _CompleteExampleService_CreatePost0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceCreatePost)
-
 		var ginReq _CreatePostGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body CreatePostRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromCreatePostRequest(&body)
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toCreatePostRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.CreatePost(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 201, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 201, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceCreatePost], reply)
 	}
 }
 
-func _CompleteExampleService_UpdateUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// PUT请求 - 完整更新
+func _CompleteExampleService_UpdateUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceUpdateUser)
-
 		var ginReq _UpdateUserGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body UpdateUserRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromUpdateUserRequest(&body)
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+
+		// Convert gin request to protobuf request
+		in := ginReq.toUpdateUserRequest()
+
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
+		// Use new context for metadata passing, including request, writer and route params
+		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		reply, err := srv.UpdateUser(newCtx, in)
+		if err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceUpdateUser], reply)
+	}
+}
+
+// PUT请求 - 部分Body
+func _CompleteExampleService_UpdateProfile0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		var ginReq _UpdateProfileGinRequest
+		// body binding with automatic Content-Type detection
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body UserProfile
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.Profile = _UpdateProfileGinUserProfileFromProto(&body)
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// query
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+
+		// params
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
-		in := ginReq.toUpdateUserRequest()
+		in := ginReq.toUpdateProfileRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
-		reply, err := srv.UpdateUser(newCtx, in)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		reply, err := srv.UpdateProfile(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceUpdateProfile], reply)
 	}
 }
 
-func _CompleteExampleService_UpdateProfile0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// POST请求 - 上传头像（multipart/form-data）
+func _CompleteExampleService_UploadAvatar0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceUpdateProfile)
-
-		var ginReq _UpdateProfileGinRequest
-		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		var ginReq _UploadAvatarGinRequest
+		// Reject content types the method does not accept before binding the body
+		if err := ginpb.CheckContentType(ctx, "multipart/form-data"); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
-		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		// body binding with automatic Content-Type detection
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body UploadAvatarRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromUploadAvatarRequest(&body)
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
-		in := ginReq.toUpdateProfileRequest()
+		in := ginReq.toUploadAvatarRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
-		reply, err := srv.UpdateProfile(newCtx, in)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
+		reply, err := srv.UploadAvatar(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceUploadAvatar], reply)
 	}
 }
 
-func _CompleteExampleService_PatchUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// PATCH请求 - 部分更新
+func _CompleteExampleService_PatchUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServicePatchUser)
-
 		var ginReq _PatchUserGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// body binding with automatic Content-Type detection
-		if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-			ctx.Error(err)
+		if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body PatchUserRequest
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			ginReq.fromPatchUserRequest(&body)
+		} else if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toPatchUserRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.PatchUser(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServicePatchUser], reply)
 	}
 }
 
-func _CompleteExampleService_DeleteUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// DELETE请求
+func _CompleteExampleService_DeleteUser0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceDeleteUser)
-
 		var ginReq _DeleteUserGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toDeleteUserRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.DeleteUser(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
-		ctx.JSON(200, reply)
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
+			return
+		}
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceDeleteUser], reply)
 	}
 }
 
-func _CompleteExampleService_BatchDeleteUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// DELETE请求 - 批量操作
+func _CompleteExampleService_BatchDeleteUsers0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceBatchDeleteUsers)
-
 		var ginReq _BatchDeleteUsersGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toBatchDeleteUsersRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.BatchDeleteUsers(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceBatchDeleteUsers], reply)
 	}
 }
 
-func _CompleteExampleService_GetPostComments0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// 嵌套路径参数
+func _CompleteExampleService_GetPostComments0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceGetPostComments)
-
 		var ginReq _GetPostCommentsGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toGetPostCommentsRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.GetPostComments(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceGetPostComments], reply)
 	}
 }
 
-func _CompleteExampleService_GetUserProfile0_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// 多种绑定路径 (Additional Bindings)
+func _CompleteExampleService_GetUserProfile0_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceGetUserProfile)
-
 		var ginReq _GetUserProfileGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toGetUserProfileRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.GetUserProfile(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceGetUserProfile], reply)
 	}
 }
 
-func _CompleteExampleService_GetUserProfile1_HTTP_Handler(srv CompleteExampleServiceHTTPServer) func(ctx *gin.Context) {
+// 多种绑定路径 (Additional Bindings)
+func _CompleteExampleService_GetUserProfile1_HTTP_Handler(srv CompleteExampleServiceHTTPServer, options *CompleteExampleServiceRegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", OperationCompleteExampleServiceGetUserProfile)
-
 		var ginReq _GetUserProfileGinRequest
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
 		// query
-		if err := ctx.BindQuery(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// params
-		if err := ctx.BindUri(&ginReq); err != nil {
-			ctx.Error(err)
+		if err := binding1.BindUri(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
 			return
 		}
 
 		// Convert gin request to protobuf request
 		in := ginReq.toGetUserProfileRequest()
 
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, in) {
+			return
+		}
 		reply, err := srv.GetUserProfile(newCtx, in)
 		if err != nil {
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
+			return
+		}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, CompleteExampleServiceMaskedFields, reply)
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, 200, reply)
 			return
 		}
-		ctx.JSON(200, reply)
+		ginpb.RenderReply(ctx, 200, options.jsonNaming, options.jsonEngine, options.responseRewriters[OperationCompleteExampleServiceGetUserProfile], reply)
 	}
 }
 
-type CompleteExampleServiceHTTPClient interface {
+// CompleteExampleServiceAdminHTTPClient is the "admin" client group of example.CompleteExampleService
+type CompleteExampleServiceAdminHTTPClient interface {
+	// DELETE请求 - 批量操作
 	BatchDeleteUsers(ctx context.Context, req *BatchDeleteUsersRequest, opts ...client.CallOption) (rsp *BatchDeleteUsersResponse, err error)
+	// DELETE请求
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...client.CallOption) (rsp *DeleteUserResponse, err error)
+}
+
+type CompleteExampleServiceHTTPClient interface {
+	CompleteExampleServiceAdminHTTPClient
+	// POST请求 - 混合参数 (路径 + 查询 + Body + Headers)
 	CreatePost(ctx context.Context, req *CreatePostRequest, opts ...client.CallOption) (rsp *CreatePostResponse, err error)
+	// POST请求 - JSON Body
 	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...client.CallOption) (rsp *CreateUserResponse, err error)
-	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...client.CallOption) (rsp *DeleteUserResponse, err error)
+	// GET请求 - 流式导出 (NDJSON)
+	ExportUsers(ctx context.Context, req *ListUsersRequest, fn func(*User) error, opts ...client.CallOption) error
+	// 嵌套路径参数
 	GetPostComments(ctx context.Context, req *GetPostCommentsRequest, opts ...client.CallOption) (rsp *GetPostCommentsResponse, err error)
+	// GET请求 - 路径参数
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...client.CallOption) (rsp *GetUserResponse, err error)
+	// 多种绑定路径 (Additional Bindings)
 	GetUserProfile(ctx context.Context, req *GetUserProfileRequest, opts ...client.CallOption) (rsp *GetUserProfileResponse, err error)
+	// 分页查询用户列表
+	//
+	// 简单GET请求 - 查询参数
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...client.CallOption) (rsp *ListUsersResponse, err error)
+	// PATCH请求 - 部分更新
 	PatchUser(ctx context.Context, req *PatchUserRequest, opts ...client.CallOption) (rsp *PatchUserResponse, err error)
+	// POST请求 - Form Body
 	RegisterUser(ctx context.Context, req *RegisterUserRequest, opts ...client.CallOption) (rsp *RegisterUserResponse, err error)
+	// GET请求 - 复杂查询 + Header参数
 	SearchUsers(ctx context.Context, req *SearchUsersRequest, opts ...client.CallOption) (rsp *SearchUsersResponse, err error)
+	SearchUsersIter(ctx context.Context, req *SearchUsersRequest, opts ...client.CallOption) iter.Seq2[*User, error]
+	// PUT请求 - 部分Body
 	UpdateProfile(ctx context.Context, req *UpdateProfileRequest, opts ...client.CallOption) (rsp *UpdateProfileResponse, err error)
+	// PUT请求 - 完整更新
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...client.CallOption) (rsp *UpdateUserResponse, err error)
+	// POST请求 - 上传头像（multipart/form-data）
+	UploadAvatar(ctx context.Context, req *UploadAvatarRequest, opts ...client.CallOption) (rsp *UploadAvatarResponse, err error)
+	// GET请求 - 服务端流 (Server-Sent Events)
+	WatchUsers(ctx context.Context, req *WatchUsersRequest, fn func(*UserEvent) error, opts ...client.CallOption) error
 }
 
 type CompleteExampleServiceHTTPClientImpl struct {
@@ -583,12 +1668,18 @@ type CompleteExampleServiceHTTPClientImpl struct {
 }
 
 func NewCompleteExampleServiceHTTPClient(opts ...client.ClientOption) CompleteExampleServiceHTTPClient {
-	c := client.NewClient(opts...)
+	c := client.NewClient(append([]client.ClientOption{
+		client.WithOperationScopes(CompleteExampleServiceOperationScopes),
+		client.WithIdempotentOperations(CompleteExampleServiceIdempotentOperations...),
+		client.WithRegionPinnedOperations(CompleteExampleServiceRegionPinnedOperations...),
+	}, opts...)...)
 	return &CompleteExampleServiceHTTPClientImpl{client: c}
 }
 
+// DELETE请求 - 批量操作
 func (c *CompleteExampleServiceHTTPClientImpl) BatchDeleteUsers(ctx context.Context, in *BatchDeleteUsersRequest, opts ...client.CallOption) (*BatchDeleteUsersResponse, error) {
 	var out BatchDeleteUsersResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceBatchDeleteUsers)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users"
@@ -601,13 +1692,15 @@ func (c *CompleteExampleServiceHTTPClientImpl) BatchDeleteUsers(ctx context.Cont
 	return &out, nil
 }
 
+// POST请求 - 混合参数 (路径 + 查询 + Body + Headers)
 func (c *CompleteExampleServiceHTTPClientImpl) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...client.CallOption) (*CreatePostResponse, error) {
 	var out CreatePostResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceCreatePost)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}/posts"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// POST request
 	err := c.client.Invoke(ctx, "POST", path, in, &out, opts...)
 
@@ -617,8 +1710,10 @@ func (c *CompleteExampleServiceHTTPClientImpl) CreatePost(ctx context.Context, i
 	return &out, nil
 }
 
+// POST请求 - JSON Body
 func (c *CompleteExampleServiceHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...client.CallOption) (*CreateUserResponse, error) {
 	var out CreateUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceCreateUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users"
@@ -631,13 +1726,15 @@ func (c *CompleteExampleServiceHTTPClientImpl) CreateUser(ctx context.Context, i
 	return &out, nil
 }
 
+// DELETE请求
 func (c *CompleteExampleServiceHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...client.CallOption) (*DeleteUserResponse, error) {
 	var out DeleteUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceDeleteUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// DELETE request
 	err := c.client.Invoke(ctx, "DELETE", path, nil, &out, opts...)
 
@@ -647,14 +1744,30 @@ func (c *CompleteExampleServiceHTTPClientImpl) DeleteUser(ctx context.Context, i
 	return &out, nil
 }
 
+// GET请求 - 流式导出 (NDJSON)
+func (c *CompleteExampleServiceHTTPClientImpl) ExportUsers(ctx context.Context, in *ListUsersRequest, fn func(*User) error, opts ...client.CallOption) error {
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceExportUsers)}, opts...)
+
+	// Build request path
+	path := "/api/v1/users/export"
+	// Stream Users items
+	err := client.StreamList(ctx, c.client, "GET", path, nil, fn, opts...)
+	if err != nil {
+		return fmt.Errorf("GET /api/v1/users/export failed: %w", err)
+	}
+	return nil
+}
+
+// 嵌套路径参数
 func (c *CompleteExampleServiceHTTPClientImpl) GetPostComments(ctx context.Context, in *GetPostCommentsRequest, opts ...client.CallOption) (*GetPostCommentsResponse, error) {
 	var out GetPostCommentsResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceGetPostComments)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}/posts/{post_id}/comments"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
-	path = strings.ReplaceAll(path, "{post_id}", fmt.Sprintf("%v", in.PostId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
+	path = strings.ReplaceAll(path, "{post_id}", url.PathEscape(fmt.Sprintf("%v", in.PostId)))
 	// GET request
 	err := c.client.Invoke(ctx, "GET", path, nil, &out, opts...)
 
@@ -664,13 +1777,16 @@ func (c *CompleteExampleServiceHTTPClientImpl) GetPostComments(ctx context.Conte
 	return &out, nil
 }
 
+// GET请求 - 路径参数
 func (c *CompleteExampleServiceHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...client.CallOption) (*GetUserResponse, error) {
 	var out GetUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceGetUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
+	path = client.AppendQuery(path, "read_mask", in.GetReadMask().GetPaths()...)
 	// GET request
 	err := c.client.Invoke(ctx, "GET", path, nil, &out, opts...)
 
@@ -680,13 +1796,15 @@ func (c *CompleteExampleServiceHTTPClientImpl) GetUser(ctx context.Context, in *
 	return &out, nil
 }
 
+// 多种绑定路径 (Additional Bindings)
 func (c *CompleteExampleServiceHTTPClientImpl) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...client.CallOption) (*GetUserProfileResponse, error) {
 	var out GetUserProfileResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceGetUserProfile)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}/profile"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// GET request
 	err := c.client.Invoke(ctx, "GET", path, nil, &out, opts...)
 
@@ -696,8 +1814,12 @@ func (c *CompleteExampleServiceHTTPClientImpl) GetUserProfile(ctx context.Contex
 	return &out, nil
 }
 
+// 分页查询用户列表
+//
+// 简单GET请求 - 查询参数
 func (c *CompleteExampleServiceHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...client.CallOption) (*ListUsersResponse, error) {
 	var out ListUsersResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceListUsers)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users"
@@ -710,13 +1832,15 @@ func (c *CompleteExampleServiceHTTPClientImpl) ListUsers(ctx context.Context, in
 	return &out, nil
 }
 
+// PATCH请求 - 部分更新
 func (c *CompleteExampleServiceHTTPClientImpl) PatchUser(ctx context.Context, in *PatchUserRequest, opts ...client.CallOption) (*PatchUserResponse, error) {
 	var out PatchUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServicePatchUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// PATCH request
 	err := c.client.Invoke(ctx, "PATCH", path, in, &out, opts...)
 
@@ -726,8 +1850,10 @@ func (c *CompleteExampleServiceHTTPClientImpl) PatchUser(ctx context.Context, in
 	return &out, nil
 }
 
+// POST请求 - Form Body
 func (c *CompleteExampleServiceHTTPClientImpl) RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...client.CallOption) (*RegisterUserResponse, error) {
 	var out RegisterUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceRegisterUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/register"
@@ -740,11 +1866,27 @@ func (c *CompleteExampleServiceHTTPClientImpl) RegisterUser(ctx context.Context,
 	return &out, nil
 }
 
+// GET请求 - 复杂查询 + Header参数
 func (c *CompleteExampleServiceHTTPClientImpl) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...client.CallOption) (*SearchUsersResponse, error) {
 	var out SearchUsersResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceSearchUsers)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/search"
+	// Send the filters with the page token, every page of the iterator is queried alike
+	path = client.AppendMessageQuery(path, in,
+		client.QueryParam{Field: "query", Name: "q"},
+		client.QueryParam{Field: "search_fields", Name: "search_fields"},
+		client.QueryParam{Field: "limit", Name: "limit"},
+		client.QueryParam{Field: "latitude", Name: "lat"},
+		client.QueryParam{Field: "longitude", Name: "lng"},
+		client.QueryParam{Field: "radius_km", Name: "radius"},
+		client.QueryParam{Field: "min_age", Name: "min_age"},
+		client.QueryParam{Field: "max_age", Name: "max_age"},
+		client.QueryParam{Field: "country", Name: "country"},
+		client.QueryParam{Field: "city", Name: "city"},
+		client.QueryParam{Field: "page_token", Name: "page_token"},
+	)
 	// GET request
 	err := c.client.Invoke(ctx, "GET", path, nil, &out, opts...)
 
@@ -754,13 +1896,25 @@ func (c *CompleteExampleServiceHTTPClientImpl) SearchUsers(ctx context.Context,
 	return &out, nil
 }
 
+// SearchUsersIter calls SearchUsers page by page following next_page_token and yields the Users of all pages
+func (c *CompleteExampleServiceHTTPClientImpl) SearchUsersIter(ctx context.Context, in *SearchUsersRequest, opts ...client.CallOption) iter.Seq2[*User, error] {
+	return client.Paginate(ctx, in, func(ctx context.Context, req *SearchUsersRequest) ([]*User, string, error) {
+		rsp, err := c.SearchUsers(ctx, req, opts...)
+		return rsp.GetUsers(), rsp.GetNextPageToken(), err
+	}, func(req *SearchUsersRequest, token string) {
+		req.PageToken = token
+	})
+}
+
+// PUT请求 - 部分Body
 func (c *CompleteExampleServiceHTTPClientImpl) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...client.CallOption) (*UpdateProfileResponse, error) {
 	var out UpdateProfileResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceUpdateProfile)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}/profile"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// PUT request
 	err := c.client.Invoke(ctx, "PUT", path, in.Profile, &out, opts...)
 
@@ -770,13 +1924,15 @@ func (c *CompleteExampleServiceHTTPClientImpl) UpdateProfile(ctx context.Context
 	return &out, nil
 }
 
+// PUT请求 - 完整更新
 func (c *CompleteExampleServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...client.CallOption) (*UpdateUserResponse, error) {
 	var out UpdateUserResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceUpdateUser)}, opts...)
 
 	// Build request path
 	path := "/api/v1/users/{user_id}"
 	// Replace path parameters
-	path = strings.ReplaceAll(path, "{user_id}", fmt.Sprintf("%v", in.UserId))
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
 	// PUT request
 	err := c.client.Invoke(ctx, "PUT", path, in, &out, opts...)
 
@@ -786,6 +1942,38 @@ func (c *CompleteExampleServiceHTTPClientImpl) UpdateUser(ctx context.Context, i
 	return &out, nil
 }
 
+// POST请求 - 上传头像（multipart/form-data）
+func (c *CompleteExampleServiceHTTPClientImpl) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...client.CallOption) (*UploadAvatarResponse, error) {
+	var out UploadAvatarResponse
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceUploadAvatar)}, opts...)
+
+	// Build request path
+	path := "/api/v1/users/{user_id}/avatar"
+	// Replace path parameters
+	path = strings.ReplaceAll(path, "{user_id}", url.PathEscape(fmt.Sprintf("%v", in.UserId)))
+	// POST request
+	err := c.client.Invoke(ctx, "POST", path, in, &out, opts...)
+
+	if err != nil {
+		return nil, fmt.Errorf("POST /api/v1/users/{user_id}/avatar failed: %w", err)
+	}
+	return &out, nil
+}
+
+// GET请求 - 服务端流 (Server-Sent Events)
+func (c *CompleteExampleServiceHTTPClientImpl) WatchUsers(ctx context.Context, in *WatchUsersRequest, fn func(*UserEvent) error, opts ...client.CallOption) error {
+	opts = append([]client.CallOption{client.Operation(OperationCompleteExampleServiceWatchUsers)}, opts...)
+
+	// Build request path
+	path := "/api/v1/users/watch"
+	// Receive replies sent as Server-Sent Events
+	err := client.StreamEvents(ctx, c.client, "GET", path, nil, fn, opts...)
+	if err != nil {
+		return fmt.Errorf("GET /api/v1/users/watch failed: %w", err)
+	}
+	return nil
+}
+
 // Internal structs with gin binding tags for protobuf messages
 
 // _BatchDeleteUsersGinRequest provides gin binding tags for BatchDeleteUsersRequest
@@ -810,6 +1998,16 @@ func (r *_BatchDeleteUsersGinRequest) toBatchDeleteUsersRequest() *BatchDeleteUs
 	}
 }
 
+// fromBatchDeleteUsersRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_BatchDeleteUsersGinRequest) fromBatchDeleteUsersRequest(in *BatchDeleteUsersRequest) {
+	r.UserIds = in.UserIds
+	r.HardDelete = in.HardDelete
+	r.DeleteReason = in.DeleteReason
+	r.BatchConfirmation = in.BatchConfirmation
+	r.Authorization = in.Authorization
+	r.OperationId = in.OperationId
+}
+
 // _CreatePostGinRequest provides gin binding tags for CreatePostRequest
 type _CreatePostGinRequest struct {
 	UserId          string            `json:"user_id" uri:"user_id" binding:"required,uuid"`
@@ -821,21 +2019,21 @@ type _CreatePostGinRequest struct {
 	UserAgent       string            `json:"user_agent" header:"User-Agent"`
 	ClientVersion   string            `json:"client_version" header:"X-Client-Version"`
 	RequestId       string            `json:"request_id" header:"X-Request-ID"`
-	Title           string            `json:"title" binding:"required,min=5,max=200"`
-	Content         string            `json:"content" binding:"required,min=50,max=50000"`
-	Excerpt         string            `json:"excerpt" binding:"max=500"`
-	Category        string            `json:"category" binding:"required"`
-	Tags            []string          `json:"tags" binding:"min=1,max=10"`
-	Visibility      string            `json:"visibility" binding:"required,oneof=public private draft"`
-	AllowComments   bool              `json:"allow_comments"`
-	PublishAt       string            `json:"publish_at" binding:"datetime=2006-01-02T15:04:05Z07:00"`
-	MetaTitle       string            `json:"meta_title" binding:"max=60"`
-	MetaDescription string            `json:"meta_description" binding:"max=160"`
-	SeoKeywords     []string          `json:"seo_keywords" binding:"max=10"`
-	ImageUrls       []string          `json:"images" binding:"max=20"`
-	AttachmentUrls  []string          `json:"attachments" binding:"max=10"`
-	CustomFields    map[string]string `json:"custom_fields" xml:"validate:post_custom_fields"`
-	ExternalId      string            `json:"external_id" xml:"external_id_format"`
+	Title           string            `json:"title" form:"title" binding:"required,min=5,max=200"`
+	Content         string            `json:"content" form:"content" binding:"required,min=50,max=50000"`
+	Excerpt         string            `json:"excerpt" form:"excerpt" binding:"max=500"`
+	Category        string            `json:"category" form:"category" binding:"required"`
+	Tags            []string          `json:"tags" form:"tags" binding:"min=1,max=10"`
+	Visibility      string            `json:"visibility" form:"visibility" binding:"required,oneof=public private draft"`
+	AllowComments   bool              `json:"allow_comments" form:"allow_comments"`
+	PublishAt       string            `json:"publish_at" form:"publish_at" binding:"datetime=2006-01-02T15:04:05Z07:00"`
+	MetaTitle       string            `json:"meta_title" form:"meta_title" binding:"max=60"`
+	MetaDescription string            `json:"meta_description" form:"meta_description" binding:"max=160"`
+	SeoKeywords     []string          `json:"seo_keywords" form:"seo_keywords" binding:"max=10"`
+	ImageUrls       []string          `json:"images" form:"image_urls" binding:"max=20"`
+	AttachmentUrls  []string          `json:"attachments" form:"attachment_urls" binding:"max=10"`
+	CustomFields    map[string]string `json:"custom_fields" validate:"post_custom_fields"`
+	ExternalId      string            `json:"external_id" form:"external_id"`
 }
 
 // convertCreatePostGinRequest converts from gin request struct to protobuf struct
@@ -868,26 +2066,55 @@ func (r *_CreatePostGinRequest) toCreatePostRequest() *CreatePostRequest {
 	}
 }
 
+// fromCreatePostRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_CreatePostGinRequest) fromCreatePostRequest(in *CreatePostRequest) {
+	r.UserId = in.UserId
+	r.Draft = in.Draft
+	r.Source = in.Source
+	r.NotifyFollowers = in.NotifyFollowers
+	r.Authorization = in.Authorization
+	r.ContentType = in.ContentType
+	r.UserAgent = in.UserAgent
+	r.ClientVersion = in.ClientVersion
+	r.RequestId = in.RequestId
+	r.Title = in.Title
+	r.Content = in.Content
+	r.Excerpt = in.Excerpt
+	r.Category = in.Category
+	r.Tags = in.Tags
+	r.Visibility = in.Visibility
+	r.AllowComments = in.AllowComments
+	r.PublishAt = in.PublishAt
+	r.MetaTitle = in.MetaTitle
+	r.MetaDescription = in.MetaDescription
+	r.SeoKeywords = in.SeoKeywords
+	r.ImageUrls = in.ImageUrls
+	r.AttachmentUrls = in.AttachmentUrls
+	r.CustomFields = in.CustomFields
+	r.ExternalId = in.ExternalId
+}
+
 // _CreateUserGinRequest provides gin binding tags for CreateUserRequest
 type _CreateUserGinRequest struct {
-	Username            string            `json:"username" binding:"required,min=3,max=50,alphanum"`
-	Email               string            `json:"email" binding:"required,email"`
-	Password            string            `json:"password" binding:"required,min=8,max=128"`
-	FullName            string            `json:"full_name" binding:"min=2,max=100"`
-	Phone               string            `json:"phone" binding:"len=11,numeric"`
-	Age                 int32             `json:"age" binding:"min=13,max=120"`
-	Gender              string            `json:"gender" binding:"oneof=male female other"`
-	Bio                 string            `json:"bio" binding:"max=500"`
-	Address             *Address          `json:"address"`
-	Hobbies             []string          `json:"hobbies" binding:"min=1,max=10"`
-	Languages           []string          `json:"languages" binding:"max=20"`
-	SocialLinks         map[string]string `json:"social_links"`
-	Preferences         map[string]string `json:"preferences"`
-	Settings            *UserSettings     `json:"settings"`
-	AgreeTerms          bool              `json:"agree_terms" binding:"required,eq=true"`
-	SubscribeNewsletter bool              `json:"subscribe_newsletter"`
-	ReferralCode        string            `json:"referral_code" xml:"referral_format"`
-	Tags                []string          `json:"tags" xml:"max_length:20"`
+	Username            string                      `json:"username" form:"username" binding:"required,min=3,max=50,alphanum"`
+	Email               string                      `json:"email" form:"email" binding:"required,email"`
+	Password            string                      `json:"password" form:"password" binding:"required,min=8,max=128"`
+	FullName            string                      `json:"full_name" form:"full_name" binding:"min=2,max=100"`
+	Phone               string                      `json:"phone" form:"phone" binding:"len=11,numeric"`
+	Age                 int32                       `json:"age" form:"age" binding:"min=13,max=120"`
+	Gender              string                      `json:"gender" form:"gender" binding:"oneof=male female other"`
+	Bio                 string                      `json:"bio" form:"bio" binding:"max=500"`
+	Address             *_CreateUserGinAddress      `json:"address"`
+	Hobbies             []string                    `json:"hobbies" form:"hobbies" binding:"min=1,max=10"`
+	Languages           []string                    `json:"languages" form:"languages" binding:"max=20"`
+	SocialLinks         map[string]string           `json:"social_links"`
+	Preferences         map[string]string           `json:"preferences"`
+	Settings            *_CreateUserGinUserSettings `json:"settings"`
+	AgreeTerms          bool                        `json:"agree_terms" form:"agree_terms" binding:"required,eq=true"`
+	SubscribeNewsletter bool                        `json:"subscribe_newsletter" form:"subscribe_newsletter"`
+	ReferralCode        string                      `json:"referral_code" form:"referral_code"`
+	Tags                []string                    `json:"tags" form:"tags" max_length:"20"`
+	RequestId           string                      `json:"request_id" form:"request_id"`
 }
 
 // convertCreateUserGinRequest converts from gin request struct to protobuf struct
@@ -901,16 +2128,159 @@ func (r *_CreateUserGinRequest) toCreateUserRequest() *CreateUserRequest {
 		Age:                 r.Age,
 		Gender:              r.Gender,
 		Bio:                 r.Bio,
-		Address:             r.Address,
+		Address:             r.Address.toProto(),
 		Hobbies:             r.Hobbies,
 		Languages:           r.Languages,
 		SocialLinks:         r.SocialLinks,
 		Preferences:         r.Preferences,
-		Settings:            r.Settings,
+		Settings:            r.Settings.toProto(),
 		AgreeTerms:          r.AgreeTerms,
 		SubscribeNewsletter: r.SubscribeNewsletter,
 		ReferralCode:        r.ReferralCode,
 		Tags:                r.Tags,
+		RequestId:           r.RequestId,
+	}
+}
+
+// fromCreateUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_CreateUserGinRequest) fromCreateUserRequest(in *CreateUserRequest) {
+	r.Username = in.Username
+	r.Email = in.Email
+	r.Password = in.Password
+	r.FullName = in.FullName
+	r.Phone = in.Phone
+	r.Age = in.Age
+	r.Gender = in.Gender
+	r.Bio = in.Bio
+	r.Address = _CreateUserGinAddressFromProto(in.Address)
+	r.Hobbies = in.Hobbies
+	r.Languages = in.Languages
+	r.SocialLinks = in.SocialLinks
+	r.Preferences = in.Preferences
+	r.Settings = _CreateUserGinUserSettingsFromProto(in.Settings)
+	r.AgreeTerms = in.AgreeTerms
+	r.SubscribeNewsletter = in.SubscribeNewsletter
+	r.ReferralCode = in.ReferralCode
+	r.Tags = in.Tags
+	r.RequestId = in.RequestId
+}
+
+// _CreateUserGinAddress provides gin binding tags for Address
+type _CreateUserGinAddress struct {
+	Street      string  `json:"street" form:"street" binding:"required"`
+	Street2     string  `json:"street2" form:"street2"`
+	City        string  `json:"city" form:"city" binding:"required"`
+	State       string  `json:"state" form:"state" binding:"required"`
+	Country     string  `json:"country" form:"country" binding:"required"`
+	PostalCode  string  `json:"postal_code" form:"postal_code" binding:"required"`
+	Latitude    float64 `json:"latitude" form:"latitude" binding:"min=-90,max=90"`
+	Longitude   float64 `json:"longitude" form:"longitude" binding:"min=-180,max=180"`
+	IsPrimary   bool    `json:"is_primary" form:"is_primary"`
+	AddressType string  `json:"type" form:"address_type" binding:"oneof=home work other"`
+}
+
+// toProto converts from gin struct to protobuf struct
+func (r *_CreateUserGinAddress) toProto() *Address {
+	if r == nil {
+		return nil
+	}
+	return &Address{
+		Street:      r.Street,
+		Street2:     r.Street2,
+		City:        r.City,
+		State:       r.State,
+		Country:     r.Country,
+		PostalCode:  r.PostalCode,
+		Latitude:    r.Latitude,
+		Longitude:   r.Longitude,
+		IsPrimary:   r.IsPrimary,
+		AddressType: r.AddressType,
+	}
+}
+
+// _CreateUserGinAddressFromProto converts from protobuf struct to gin struct
+func _CreateUserGinAddressFromProto(in *Address) *_CreateUserGinAddress {
+	if in == nil {
+		return nil
+	}
+	return &_CreateUserGinAddress{
+		Street:      in.Street,
+		Street2:     in.Street2,
+		City:        in.City,
+		State:       in.State,
+		Country:     in.Country,
+		PostalCode:  in.PostalCode,
+		Latitude:    in.Latitude,
+		Longitude:   in.Longitude,
+		IsPrimary:   in.IsPrimary,
+		AddressType: in.AddressType,
+	}
+}
+
+// _CreateUserGinUserSettings provides gin binding tags for UserSettings
+type _CreateUserGinUserSettings struct {
+	EmailNotifications bool              `json:"email_notifications" form:"email_notifications"`
+	PushNotifications  bool              `json:"push_notifications" form:"push_notifications"`
+	SmsNotifications   bool              `json:"sms_notifications" form:"sms_notifications"`
+	Theme              string            `json:"theme" form:"theme"`
+	Language           string            `json:"language" form:"language"`
+	Timezone           string            `json:"timezone" form:"timezone"`
+	DateFormat         string            `json:"date_format" form:"date_format"`
+	TimeFormat         string            `json:"time_format" form:"time_format"`
+	TwoFactorEnabled   bool              `json:"two_factor_enabled" form:"two_factor_enabled"`
+	PrivacyLevel       string            `json:"privacy_level" form:"privacy_level"`
+	ShowOnlineStatus   bool              `json:"show_online_status" form:"show_online_status"`
+	AllowMessages      bool              `json:"allow_messages" form:"allow_messages"`
+	Preferences        map[string]string `json:"preferences"`
+	BlockedUsers       []string          `json:"blocked_users" form:"blocked_users"`
+	MutedKeywords      []string          `json:"muted_keywords" form:"muted_keywords"`
+}
+
+// toProto converts from gin struct to protobuf struct
+func (r *_CreateUserGinUserSettings) toProto() *UserSettings {
+	if r == nil {
+		return nil
+	}
+	return &UserSettings{
+		EmailNotifications: r.EmailNotifications,
+		PushNotifications:  r.PushNotifications,
+		SmsNotifications:   r.SmsNotifications,
+		Theme:              r.Theme,
+		Language:           r.Language,
+		Timezone:           r.Timezone,
+		DateFormat:         r.DateFormat,
+		TimeFormat:         r.TimeFormat,
+		TwoFactorEnabled:   r.TwoFactorEnabled,
+		PrivacyLevel:       r.PrivacyLevel,
+		ShowOnlineStatus:   r.ShowOnlineStatus,
+		AllowMessages:      r.AllowMessages,
+		Preferences:        r.Preferences,
+		BlockedUsers:       r.BlockedUsers,
+		MutedKeywords:      r.MutedKeywords,
+	}
+}
+
+// _CreateUserGinUserSettingsFromProto converts from protobuf struct to gin struct
+func _CreateUserGinUserSettingsFromProto(in *UserSettings) *_CreateUserGinUserSettings {
+	if in == nil {
+		return nil
+	}
+	return &_CreateUserGinUserSettings{
+		EmailNotifications: in.EmailNotifications,
+		PushNotifications:  in.PushNotifications,
+		SmsNotifications:   in.SmsNotifications,
+		Theme:              in.Theme,
+		Language:           in.Language,
+		Timezone:           in.Timezone,
+		DateFormat:         in.DateFormat,
+		TimeFormat:         in.TimeFormat,
+		TwoFactorEnabled:   in.TwoFactorEnabled,
+		PrivacyLevel:       in.PrivacyLevel,
+		ShowOnlineStatus:   in.ShowOnlineStatus,
+		AllowMessages:      in.AllowMessages,
+		Preferences:        in.Preferences,
+		BlockedUsers:       in.BlockedUsers,
+		MutedKeywords:      in.MutedKeywords,
 	}
 }
 
@@ -940,6 +2310,62 @@ func (r *_DeleteUserGinRequest) toDeleteUserRequest() *DeleteUserRequest {
 	}
 }
 
+// fromDeleteUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_DeleteUserGinRequest) fromDeleteUserRequest(in *DeleteUserRequest) {
+	r.UserId = in.UserId
+	r.HardDelete = in.HardDelete
+	r.DeleteReason = in.DeleteReason
+	r.TransferData = in.TransferData
+	r.TransferToUser = in.TransferToUser
+	r.Confirmation = in.Confirmation
+	r.Authorization = in.Authorization
+	r.AdminToken = in.AdminToken
+}
+
+// _ExportUsersGinRequest provides gin binding tags for ListUsersRequest
+type _ExportUsersGinRequest struct {
+	Page           int32    `json:"page" form:"page" binding:"min=1"`
+	PageSize       int32    `json:"page_size" form:"page_size" binding:"min=1,max=100"`
+	SortBy         string   `json:"sort_by" form:"sort_by" binding:"oneof=id name email created_at"`
+	SortOrder      string   `json:"sort_order" form:"sort_order" binding:"oneof=asc desc"`
+	Status         []string `json:"status" form:"status"`
+	Roles          []string `json:"roles" form:"roles"`
+	IncludeDeleted bool     `json:"include_deleted" form:"include_deleted"`
+	IncludeStats   bool     `json:"include_stats" form:"include_stats"`
+	CreatedAfter   string   `json:"created_after" form:"created_after" binding:"datetime=2006-01-02"`
+	CreatedBefore  string   `json:"created_before" form:"created_before" binding:"datetime=2006-01-02"`
+}
+
+// convertExportUsersGinRequest converts from gin request struct to protobuf struct
+func (r *_ExportUsersGinRequest) toExportUsersRequest() *ListUsersRequest {
+	return &ListUsersRequest{
+		Page:           r.Page,
+		PageSize:       r.PageSize,
+		SortBy:         r.SortBy,
+		SortOrder:      r.SortOrder,
+		Status:         r.Status,
+		Roles:          r.Roles,
+		IncludeDeleted: r.IncludeDeleted,
+		IncludeStats:   r.IncludeStats,
+		CreatedAfter:   r.CreatedAfter,
+		CreatedBefore:  r.CreatedBefore,
+	}
+}
+
+// fromExportUsersRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_ExportUsersGinRequest) fromExportUsersRequest(in *ListUsersRequest) {
+	r.Page = in.Page
+	r.PageSize = in.PageSize
+	r.SortBy = in.SortBy
+	r.SortOrder = in.SortOrder
+	r.Status = in.Status
+	r.Roles = in.Roles
+	r.IncludeDeleted = in.IncludeDeleted
+	r.IncludeStats = in.IncludeStats
+	r.CreatedAfter = in.CreatedAfter
+	r.CreatedBefore = in.CreatedBefore
+}
+
 // _GetPostCommentsGinRequest provides gin binding tags for GetPostCommentsRequest
 type _GetPostCommentsGinRequest struct {
 	UserId         string `json:"user_id" uri:"user_id" binding:"required,uuid"`
@@ -976,12 +2402,30 @@ func (r *_GetPostCommentsGinRequest) toGetPostCommentsRequest() *GetPostComments
 	}
 }
 
+// fromGetPostCommentsRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_GetPostCommentsGinRequest) fromGetPostCommentsRequest(in *GetPostCommentsRequest) {
+	r.UserId = in.UserId
+	r.PostId = in.PostId
+	r.Page = in.Page
+	r.PerPage = in.PerPage
+	r.Sort = in.Sort
+	r.Order = in.Order
+	r.Status = in.Status
+	r.IncludeReplies = in.IncludeReplies
+	r.IncludeHidden = in.IncludeHidden
+	r.Since = in.Since
+	r.Until = in.Until
+	r.UserContext = in.UserContext
+	r.ClientTimezone = in.ClientTimezone
+}
+
 // _GetUserGinRequest provides gin binding tags for GetUserRequest
 type _GetUserGinRequest struct {
-	UserId         string   `json:"user_id" uri:"user_id" binding:"required,uuid"`
-	Fields         []string `json:"fields" form:"fields"`
-	IncludeProfile bool     `json:"include_profile" form:"include_profile"`
-	IncludePosts   bool     `json:"include_posts" form:"include_posts"`
+	UserId         string           `json:"user_id" uri:"user_id" binding:"required,uuid"`
+	Fields         []string         `json:"fields" form:"fields"`
+	IncludeProfile bool             `json:"include_profile" form:"include_profile"`
+	IncludePosts   bool             `json:"include_posts" form:"include_posts"`
+	ReadMask       *ginpb.FieldMask `json:"read_mask" form:"read_mask"`
 }
 
 // convertGetUserGinRequest converts from gin request struct to protobuf struct
@@ -991,9 +2435,19 @@ func (r *_GetUserGinRequest) toGetUserRequest() *GetUserRequest {
 		Fields:         r.Fields,
 		IncludeProfile: r.IncludeProfile,
 		IncludePosts:   r.IncludePosts,
+		ReadMask:       ginpb.FieldMaskProto(r.ReadMask),
 	}
 }
 
+// fromGetUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_GetUserGinRequest) fromGetUserRequest(in *GetUserRequest) {
+	r.UserId = in.UserId
+	r.Fields = in.Fields
+	r.IncludeProfile = in.IncludeProfile
+	r.IncludePosts = in.IncludePosts
+	r.ReadMask = ginpb.FieldMaskFromProto(in.ReadMask)
+}
+
 // _GetUserProfileGinRequest provides gin binding tags for GetUserProfileRequest
 type _GetUserProfileGinRequest struct {
 	UserId           string   `json:"user_id" uri:"user_id" binding:"required,uuid"`
@@ -1020,6 +2474,18 @@ func (r *_GetUserProfileGinRequest) toGetUserProfileRequest() *GetUserProfileReq
 	}
 }
 
+// fromGetUserProfileRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_GetUserProfileGinRequest) fromGetUserProfileRequest(in *GetUserProfileRequest) {
+	r.UserId = in.UserId
+	r.Sections = in.Sections
+	r.IncludeStats = in.IncludeStats
+	r.IncludePosts = in.IncludePosts
+	r.IncludeFollowers = in.IncludeFollowers
+	r.ViewerContext = in.ViewerContext
+	r.ViewerId = in.ViewerId
+	r.AccessToken = in.AccessToken
+}
+
 // _ListUsersGinRequest provides gin binding tags for ListUsersRequest
 type _ListUsersGinRequest struct {
 	Page           int32    `json:"page" form:"page" binding:"min=1"`
@@ -1050,6 +2516,20 @@ func (r *_ListUsersGinRequest) toListUsersRequest() *ListUsersRequest {
 	}
 }
 
+// fromListUsersRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_ListUsersGinRequest) fromListUsersRequest(in *ListUsersRequest) {
+	r.Page = in.Page
+	r.PageSize = in.PageSize
+	r.SortBy = in.SortBy
+	r.SortOrder = in.SortOrder
+	r.Status = in.Status
+	r.Roles = in.Roles
+	r.IncludeDeleted = in.IncludeDeleted
+	r.IncludeStats = in.IncludeStats
+	r.CreatedAfter = in.CreatedAfter
+	r.CreatedBefore = in.CreatedBefore
+}
+
 // _PatchUserGinRequest provides gin binding tags for PatchUserRequest
 type _PatchUserGinRequest struct {
 	UserId            string            `json:"user_id" uri:"user_id" binding:"required,uuid"`
@@ -1057,20 +2537,20 @@ type _PatchUserGinRequest struct {
 	IfUnmodifiedSince string            `json:"if_unmodified_since" header:"If-Unmodified-Since"`
 	Authorization     string            `json:"authorization" header:"Authorization" binding:"required"`
 	PatchSource       string            `json:"patch_source" header:"X-Patch-Source"`
-	Username          string            `json:"username" binding:"min=3,max=50"`
-	Email             string            `json:"email" binding:"email"`
-	FullName          string            `json:"full_name" binding:"min=2,max=100"`
-	Phone             string            `json:"phone" binding:"len=11,numeric"`
-	Bio               string            `json:"bio" binding:"max=1000"`
-	Status            string            `json:"status" binding:"oneof=active inactive suspended"`
+	Username          string            `json:"username" form:"username" binding:"min=3,max=50"`
+	Email             string            `json:"email" form:"email" binding:"email"`
+	FullName          string            `json:"full_name" form:"full_name" binding:"min=2,max=100"`
+	Phone             string            `json:"phone" form:"phone" binding:"len=11,numeric"`
+	Bio               string            `json:"bio" form:"bio" binding:"max=1000"`
+	Status            string            `json:"status" form:"status" binding:"oneof=active inactive suspended"`
 	ProfilePatches    map[string]string `json:"profile_patches"`
 	SettingsPatches   map[string]string `json:"settings_patches"`
 	AddressPatches    map[string]string `json:"address_patches"`
-	AddRoles          []string          `json:"add_roles"`
-	RemoveRoles       []string          `json:"remove_roles"`
-	AddTags           []string          `json:"add_tags"`
-	RemoveTags        []string          `json:"remove_tags"`
-	PatchReason       string            `json:"patch_reason" binding:"max=200"`
+	AddRoles          []string          `json:"add_roles" form:"add_roles"`
+	RemoveRoles       []string          `json:"remove_roles" form:"remove_roles"`
+	AddTags           []string          `json:"add_tags" form:"add_tags"`
+	RemoveTags        []string          `json:"remove_tags" form:"remove_tags"`
+	PatchReason       string            `json:"patch_reason" form:"patch_reason" binding:"max=200"`
 	PatchMetadata     map[string]string `json:"patch_metadata"`
 }
 
@@ -1100,6 +2580,30 @@ func (r *_PatchUserGinRequest) toPatchUserRequest() *PatchUserRequest {
 	}
 }
 
+// fromPatchUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_PatchUserGinRequest) fromPatchUserRequest(in *PatchUserRequest) {
+	r.UserId = in.UserId
+	r.IfMatch = in.IfMatch
+	r.IfUnmodifiedSince = in.IfUnmodifiedSince
+	r.Authorization = in.Authorization
+	r.PatchSource = in.PatchSource
+	r.Username = in.Username
+	r.Email = in.Email
+	r.FullName = in.FullName
+	r.Phone = in.Phone
+	r.Bio = in.Bio
+	r.Status = in.Status
+	r.ProfilePatches = in.ProfilePatches
+	r.SettingsPatches = in.SettingsPatches
+	r.AddressPatches = in.AddressPatches
+	r.AddRoles = in.AddRoles
+	r.RemoveRoles = in.RemoveRoles
+	r.AddTags = in.AddTags
+	r.RemoveTags = in.RemoveTags
+	r.PatchReason = in.PatchReason
+	r.PatchMetadata = in.PatchMetadata
+}
+
 // _RegisterUserGinRequest provides gin binding tags for RegisterUserRequest
 type _RegisterUserGinRequest struct {
 	Username            string   `json:"username" form:"username" binding:"required,min=3,max=30,alphanum"`
@@ -1152,6 +2656,31 @@ func (r *_RegisterUserGinRequest) toRegisterUserRequest() *RegisterUserRequest {
 	}
 }
 
+// fromRegisterUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_RegisterUserGinRequest) fromRegisterUserRequest(in *RegisterUserRequest) {
+	r.Username = in.Username
+	r.Email = in.Email
+	r.Password = in.Password
+	r.ConfirmPassword = in.ConfirmPassword
+	r.FirstName = in.FirstName
+	r.LastName = in.LastName
+	r.BirthDate = in.BirthDate
+	r.Phone = in.Phone
+	r.Gender = in.Gender
+	r.Country = in.Country
+	r.Timezone = in.Timezone
+	r.Interests = in.Interests
+	r.Skills = in.Skills
+	r.NewsletterFrequency = in.NewsletterFrequency
+	r.MarketingEmails = in.MarketingEmails
+	r.CaptchaResponse = in.CaptchaResponse
+	r.InviteCode = in.InviteCode
+	r.UtmSource = in.UtmSource
+	r.UtmMedium = in.UtmMedium
+	r.UtmCampaign = in.UtmCampaign
+	r.ReferrerUrl = in.ReferrerUrl
+}
+
 // _SearchUsersGinRequest provides gin binding tags for SearchUsersRequest
 type _SearchUsersGinRequest struct {
 	Query        string   `json:"query" form:"q" binding:"required,min=2,max=100"`
@@ -1168,6 +2697,7 @@ type _SearchUsersGinRequest struct {
 	MaxAge       int32    `json:"max_age" form:"max_age" binding:"min=0,max=150"`
 	Country      string   `json:"country" form:"country"`
 	City         string   `json:"city" form:"city"`
+	PageToken    string   `json:"page_token" form:"page_token"`
 }
 
 // convertSearchUsersGinRequest converts from gin request struct to protobuf struct
@@ -1187,43 +2717,136 @@ func (r *_SearchUsersGinRequest) toSearchUsersRequest() *SearchUsersRequest {
 		MaxAge:       r.MaxAge,
 		Country:      r.Country,
 		City:         r.City,
+		PageToken:    r.PageToken,
 	}
 }
 
+// fromSearchUsersRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_SearchUsersGinRequest) fromSearchUsersRequest(in *SearchUsersRequest) {
+	r.Query = in.Query
+	r.SearchFields = in.SearchFields
+	r.Limit = in.Limit
+	r.ClientId = in.ClientId
+	r.RequestId = in.RequestId
+	r.UserAgent = in.UserAgent
+	r.ApiKey = in.ApiKey
+	r.Latitude = in.Latitude
+	r.Longitude = in.Longitude
+	r.RadiusKm = in.RadiusKm
+	r.MinAge = in.MinAge
+	r.MaxAge = in.MaxAge
+	r.Country = in.Country
+	r.City = in.City
+	r.PageToken = in.PageToken
+}
+
 // _UpdateProfileGinRequest provides gin binding tags for UpdateProfileRequest
 type _UpdateProfileGinRequest struct {
-	UserId  string       `json:"user_id" uri:"user_id" binding:"required,uuid"`
-	Profile *UserProfile `json:"profile"`
+	UserId  string                        `json:"user_id" uri:"user_id" binding:"required,uuid"`
+	Profile *_UpdateProfileGinUserProfile `json:"profile" binding:"required"`
 }
 
 // convertUpdateProfileGinRequest converts from gin request struct to protobuf struct
 func (r *_UpdateProfileGinRequest) toUpdateProfileRequest() *UpdateProfileRequest {
 	return &UpdateProfileRequest{
 		UserId:  r.UserId,
-		Profile: r.Profile,
+		Profile: r.Profile.toProto(),
+	}
+}
+
+// fromUpdateProfileRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_UpdateProfileGinRequest) fromUpdateProfileRequest(in *UpdateProfileRequest) {
+	r.UserId = in.UserId
+	r.Profile = _UpdateProfileGinUserProfileFromProto(in.Profile)
+}
+
+// _UpdateProfileGinUserProfile provides gin binding tags for UserProfile
+type _UpdateProfileGinUserProfile struct {
+	Bio              string            `json:"bio" form:"bio"`
+	AvatarUrl        string            `json:"avatar_url" form:"avatar_url"`
+	CoverImageUrl    string            `json:"cover_image_url" form:"cover_image_url"`
+	Website          string            `json:"website" form:"website"`
+	Location         string            `json:"location" form:"location"`
+	BirthDate        string            `json:"birth_date" form:"birth_date"`
+	Occupation       string            `json:"occupation" form:"occupation"`
+	Company          string            `json:"company" form:"company"`
+	Education        string            `json:"education" form:"education"`
+	Interests        []string          `json:"interests" form:"interests"`
+	Skills           []string          `json:"skills" form:"skills"`
+	ContactInfo      map[string]string `json:"contact_info"`
+	IsPublic         bool              `json:"is_public" form:"is_public"`
+	Verified         bool              `json:"verified" form:"verified"`
+	VerificationType string            `json:"verification_type" form:"verification_type"`
+}
+
+// toProto converts from gin struct to protobuf struct
+func (r *_UpdateProfileGinUserProfile) toProto() *UserProfile {
+	if r == nil {
+		return nil
+	}
+	return &UserProfile{
+		Bio:              r.Bio,
+		AvatarUrl:        r.AvatarUrl,
+		CoverImageUrl:    r.CoverImageUrl,
+		Website:          r.Website,
+		Location:         r.Location,
+		BirthDate:        r.BirthDate,
+		Occupation:       r.Occupation,
+		Company:          r.Company,
+		Education:        r.Education,
+		Interests:        r.Interests,
+		Skills:           r.Skills,
+		ContactInfo:      r.ContactInfo,
+		IsPublic:         r.IsPublic,
+		Verified:         r.Verified,
+		VerificationType: r.VerificationType,
+	}
+}
+
+// _UpdateProfileGinUserProfileFromProto converts from protobuf struct to gin struct
+func _UpdateProfileGinUserProfileFromProto(in *UserProfile) *_UpdateProfileGinUserProfile {
+	if in == nil {
+		return nil
+	}
+	return &_UpdateProfileGinUserProfile{
+		Bio:              in.Bio,
+		AvatarUrl:        in.AvatarUrl,
+		CoverImageUrl:    in.CoverImageUrl,
+		Website:          in.Website,
+		Location:         in.Location,
+		BirthDate:        in.BirthDate,
+		Occupation:       in.Occupation,
+		Company:          in.Company,
+		Education:        in.Education,
+		Interests:        in.Interests,
+		Skills:           in.Skills,
+		ContactInfo:      in.ContactInfo,
+		IsPublic:         in.IsPublic,
+		Verified:         in.Verified,
+		VerificationType: in.VerificationType,
 	}
 }
 
 // _UpdateUserGinRequest provides gin binding tags for UpdateUserRequest
 type _UpdateUserGinRequest struct {
-	UserId           string            `json:"user_id" uri:"user_id" binding:"required,uuid"`
-	SendNotification bool              `json:"send_notification" form:"send_notification"`
-	UpdateReason     string            `json:"update_reason" form:"reason"`
-	IfMatch          string            `json:"if_match" header:"If-Match"`
-	Authorization    string            `json:"authorization" header:"Authorization" binding:"required"`
-	Username         string            `json:"username" binding:"required,min=3,max=50"`
-	Email            string            `json:"email" binding:"required,email"`
-	FullName         string            `json:"full_name" binding:"required,min=2,max=100"`
-	Phone            string            `json:"phone" binding:"len=11,numeric"`
-	Age              int32             `json:"age" binding:"min=13,max=120"`
-	Bio              string            `json:"bio" binding:"max=1000"`
-	Status           string            `json:"status" binding:"required,oneof=active inactive suspended banned"`
-	Roles            []string          `json:"roles" binding:"min=1"`
-	Address          *Address          `json:"address"`
-	SocialLinks      map[string]string `json:"social_links"`
-	Settings         *UserSettings     `json:"settings"`
-	UpdatedAt        string            `json:"updated_at" binding:"required,datetime=2006-01-02T15:04:05Z07:00"`
-	Version          int32             `json:"version" binding:"required,min=1"`
+	UserId           string                      `json:"user_id" uri:"user_id" binding:"required,uuid"`
+	SendNotification bool                        `json:"send_notification" form:"send_notification"`
+	UpdateReason     string                      `json:"update_reason" form:"reason"`
+	IfMatch          string                      `json:"if_match" header:"If-Match"`
+	Authorization    string                      `json:"authorization" header:"Authorization" binding:"required"`
+	Username         string                      `json:"username" form:"username" binding:"required,min=3,max=50"`
+	Email            string                      `json:"email" form:"email" binding:"required,email"`
+	FullName         string                      `json:"full_name" form:"full_name" binding:"required,min=2,max=100"`
+	Phone            string                      `json:"phone" form:"phone" binding:"len=11,numeric"`
+	Age              int32                       `json:"age" form:"age" binding:"min=13,max=120"`
+	Bio              string                      `json:"bio" form:"bio" binding:"max=1000"`
+	Status           string                      `json:"status" form:"status" binding:"required,oneof=active inactive suspended banned"`
+	Roles            []string                    `json:"roles" form:"roles" binding:"min=1"`
+	Address          *_UpdateUserGinAddress      `json:"address"`
+	SocialLinks      map[string]string           `json:"social_links"`
+	Settings         *_UpdateUserGinUserSettings `json:"settings"`
+	UpdatedAt        string                      `json:"updated_at" form:"updated_at" binding:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Version          int32                       `json:"version" form:"version" binding:"required,min=1"`
 }
 
 // convertUpdateUserGinRequest converts from gin request struct to protobuf struct
@@ -1242,10 +2865,189 @@ func (r *_UpdateUserGinRequest) toUpdateUserRequest() *UpdateUserRequest {
 		Bio:              r.Bio,
 		Status:           r.Status,
 		Roles:            r.Roles,
-		Address:          r.Address,
+		Address:          r.Address.toProto(),
 		SocialLinks:      r.SocialLinks,
-		Settings:         r.Settings,
+		Settings:         r.Settings.toProto(),
 		UpdatedAt:        r.UpdatedAt,
 		Version:          r.Version,
 	}
 }
+
+// fromUpdateUserRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_UpdateUserGinRequest) fromUpdateUserRequest(in *UpdateUserRequest) {
+	r.UserId = in.UserId
+	r.SendNotification = in.SendNotification
+	r.UpdateReason = in.UpdateReason
+	r.IfMatch = in.IfMatch
+	r.Authorization = in.Authorization
+	r.Username = in.Username
+	r.Email = in.Email
+	r.FullName = in.FullName
+	r.Phone = in.Phone
+	r.Age = in.Age
+	r.Bio = in.Bio
+	r.Status = in.Status
+	r.Roles = in.Roles
+	r.Address = _UpdateUserGinAddressFromProto(in.Address)
+	r.SocialLinks = in.SocialLinks
+	r.Settings = _UpdateUserGinUserSettingsFromProto(in.Settings)
+	r.UpdatedAt = in.UpdatedAt
+	r.Version = in.Version
+}
+
+// _UpdateUserGinAddress provides gin binding tags for Address
+type _UpdateUserGinAddress struct {
+	Street      string  `json:"street" form:"street" binding:"required"`
+	Street2     string  `json:"street2" form:"street2"`
+	City        string  `json:"city" form:"city" binding:"required"`
+	State       string  `json:"state" form:"state" binding:"required"`
+	Country     string  `json:"country" form:"country" binding:"required"`
+	PostalCode  string  `json:"postal_code" form:"postal_code" binding:"required"`
+	Latitude    float64 `json:"latitude" form:"latitude" binding:"min=-90,max=90"`
+	Longitude   float64 `json:"longitude" form:"longitude" binding:"min=-180,max=180"`
+	IsPrimary   bool    `json:"is_primary" form:"is_primary"`
+	AddressType string  `json:"type" form:"address_type" binding:"oneof=home work other"`
+}
+
+// toProto converts from gin struct to protobuf struct
+func (r *_UpdateUserGinAddress) toProto() *Address {
+	if r == nil {
+		return nil
+	}
+	return &Address{
+		Street:      r.Street,
+		Street2:     r.Street2,
+		City:        r.City,
+		State:       r.State,
+		Country:     r.Country,
+		PostalCode:  r.PostalCode,
+		Latitude:    r.Latitude,
+		Longitude:   r.Longitude,
+		IsPrimary:   r.IsPrimary,
+		AddressType: r.AddressType,
+	}
+}
+
+// _UpdateUserGinAddressFromProto converts from protobuf struct to gin struct
+func _UpdateUserGinAddressFromProto(in *Address) *_UpdateUserGinAddress {
+	if in == nil {
+		return nil
+	}
+	return &_UpdateUserGinAddress{
+		Street:      in.Street,
+		Street2:     in.Street2,
+		City:        in.City,
+		State:       in.State,
+		Country:     in.Country,
+		PostalCode:  in.PostalCode,
+		Latitude:    in.Latitude,
+		Longitude:   in.Longitude,
+		IsPrimary:   in.IsPrimary,
+		AddressType: in.AddressType,
+	}
+}
+
+// _UpdateUserGinUserSettings provides gin binding tags for UserSettings
+type _UpdateUserGinUserSettings struct {
+	EmailNotifications bool              `json:"email_notifications" form:"email_notifications"`
+	PushNotifications  bool              `json:"push_notifications" form:"push_notifications"`
+	SmsNotifications   bool              `json:"sms_notifications" form:"sms_notifications"`
+	Theme              string            `json:"theme" form:"theme"`
+	Language           string            `json:"language" form:"language"`
+	Timezone           string            `json:"timezone" form:"timezone"`
+	DateFormat         string            `json:"date_format" form:"date_format"`
+	TimeFormat         string            `json:"time_format" form:"time_format"`
+	TwoFactorEnabled   bool              `json:"two_factor_enabled" form:"two_factor_enabled"`
+	PrivacyLevel       string            `json:"privacy_level" form:"privacy_level"`
+	ShowOnlineStatus   bool              `json:"show_online_status" form:"show_online_status"`
+	AllowMessages      bool              `json:"allow_messages" form:"allow_messages"`
+	Preferences        map[string]string `json:"preferences"`
+	BlockedUsers       []string          `json:"blocked_users" form:"blocked_users"`
+	MutedKeywords      []string          `json:"muted_keywords" form:"muted_keywords"`
+}
+
+// toProto converts from gin struct to protobuf struct
+func (r *_UpdateUserGinUserSettings) toProto() *UserSettings {
+	if r == nil {
+		return nil
+	}
+	return &UserSettings{
+		EmailNotifications: r.EmailNotifications,
+		PushNotifications:  r.PushNotifications,
+		SmsNotifications:   r.SmsNotifications,
+		Theme:              r.Theme,
+		Language:           r.Language,
+		Timezone:           r.Timezone,
+		DateFormat:         r.DateFormat,
+		TimeFormat:         r.TimeFormat,
+		TwoFactorEnabled:   r.TwoFactorEnabled,
+		PrivacyLevel:       r.PrivacyLevel,
+		ShowOnlineStatus:   r.ShowOnlineStatus,
+		AllowMessages:      r.AllowMessages,
+		Preferences:        r.Preferences,
+		BlockedUsers:       r.BlockedUsers,
+		MutedKeywords:      r.MutedKeywords,
+	}
+}
+
+// _UpdateUserGinUserSettingsFromProto converts from protobuf struct to gin struct
+func _UpdateUserGinUserSettingsFromProto(in *UserSettings) *_UpdateUserGinUserSettings {
+	if in == nil {
+		return nil
+	}
+	return &_UpdateUserGinUserSettings{
+		EmailNotifications: in.EmailNotifications,
+		PushNotifications:  in.PushNotifications,
+		SmsNotifications:   in.SmsNotifications,
+		Theme:              in.Theme,
+		Language:           in.Language,
+		Timezone:           in.Timezone,
+		DateFormat:         in.DateFormat,
+		TimeFormat:         in.TimeFormat,
+		TwoFactorEnabled:   in.TwoFactorEnabled,
+		PrivacyLevel:       in.PrivacyLevel,
+		ShowOnlineStatus:   in.ShowOnlineStatus,
+		AllowMessages:      in.AllowMessages,
+		Preferences:        in.Preferences,
+		BlockedUsers:       in.BlockedUsers,
+		MutedKeywords:      in.MutedKeywords,
+	}
+}
+
+// _UploadAvatarGinRequest provides gin binding tags for UploadAvatarRequest
+type _UploadAvatarGinRequest struct {
+	UserId  string                `json:"user_id" form:"user_id" uri:"user_id"`
+	Avatar  *multipart.FileHeader `json:"-" form:"avatar" multipart:"avatar" binding:"required"`
+	Caption string                `json:"caption" form:"caption"`
+}
+
+// convertUploadAvatarGinRequest converts from gin request struct to protobuf struct
+func (r *_UploadAvatarGinRequest) toUploadAvatarRequest() *UploadAvatarRequest {
+	return &UploadAvatarRequest{
+		UserId:  r.UserId,
+		Caption: r.Caption,
+	}
+}
+
+// fromUploadAvatarRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_UploadAvatarGinRequest) fromUploadAvatarRequest(in *UploadAvatarRequest) {
+	r.UserId = in.UserId
+	r.Caption = in.Caption
+}
+
+// _WatchUsersGinRequest provides gin binding tags for WatchUsersRequest
+type _WatchUsersGinRequest struct {
+	Status []string `json:"status" form:"status"`
+}
+
+// convertWatchUsersGinRequest converts from gin request struct to protobuf struct
+func (r *_WatchUsersGinRequest) toWatchUsersRequest() *WatchUsersRequest {
+	return &WatchUsersRequest{
+		Status: r.Status,
+	}
+}
+
+// fromWatchUsersRequest copies a protobuf request decoded from the body into the gin struct
+func (r *_WatchUsersGinRequest) fromWatchUsersRequest(in *WatchUsersRequest) {
+	r.Status = in.Status
+}
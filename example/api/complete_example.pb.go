@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.0
+// 	protoc-gen-go v1.36.7
 // 	protoc        v3.12.4
 // source: complete_example.proto
 
@@ -11,8 +11,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,6 +24,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ErrorReason 错误码
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND           ErrorReason = 1
+	ErrorReason_EMAIL_TAKEN              ErrorReason = 2
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "ERROR_REASON_UNSPECIFIED",
+		1: "USER_NOT_FOUND",
+		2: "EMAIL_TAKEN",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED": 0,
+		"USER_NOT_FOUND":           1,
+		"EMAIL_TAKEN":              2,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_complete_example_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_complete_example_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{0}
+}
+
 type ListUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 分页参数 - Form绑定 + 验证
@@ -219,6 +271,305 @@ func (x *ListUsersResponse) GetHasNext() bool {
 	return false
 }
 
+type WatchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 查询参数 - 只推送指定状态的用户变更
+	Status        []string `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchUsersRequest) Reset() {
+	*x = WatchUsersRequest{}
+	mi := &file_complete_example_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUsersRequest) ProtoMessage() {}
+
+func (x *WatchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUsersRequest.ProtoReflect.Descriptor instead.
+func (*WatchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchUsersRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UserEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // created, updated 或 deleted
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_complete_example_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 标签资源，由 ginpb.crud 生成标准的增删改查接口
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_complete_example_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// Webhook 事件，订阅方按事件类型接收
+type UserCreatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCreatedEvent) Reset() {
+	*x = UserCreatedEvent{}
+	mi := &file_complete_example_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreatedEvent) ProtoMessage() {}
+
+func (x *UserCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreatedEvent.ProtoReflect.Descriptor instead.
+func (*UserCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserCreatedEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletedEvent) Reset() {
+	*x = UserDeletedEvent{}
+	mi := &file_complete_example_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletedEvent) ProtoMessage() {}
+
+func (x *UserDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletedEvent.ProtoReflect.Descriptor instead.
+func (*UserDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserDeletedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_complete_example_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatMessage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 type GetUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 路径参数 - UUID验证
@@ -227,13 +578,15 @@ type GetUserRequest struct {
 	Fields         []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
 	IncludeProfile bool     `protobuf:"varint,3,opt,name=include_profile,json=includeProfile,proto3" json:"include_profile,omitempty"`
 	IncludePosts   bool     `protobuf:"varint,4,opt,name=include_posts,json=includePosts,proto3" json:"include_posts,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 响应字段掩码 - 仅返回所列字段，如 read_mask=user.name,user.email
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[2]
+	mi := &file_complete_example_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +598,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[2]
+	mi := &file_complete_example_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +611,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{2}
+	return file_complete_example_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserRequest) GetUserId() string {
@@ -289,6 +642,13 @@ func (x *GetUserRequest) GetIncludePosts() bool {
 	return false
 }
 
+func (x *GetUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 type GetUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -301,7 +661,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[3]
+	mi := &file_complete_example_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +673,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[3]
+	mi := &file_complete_example_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +686,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{3}
+	return file_complete_example_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -374,17 +734,19 @@ type SearchUsersRequest struct {
 	Longitude float64 `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	RadiusKm  int32   `protobuf:"varint,10,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
 	// 高级过滤
-	MinAge        int32  `protobuf:"varint,11,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
-	MaxAge        int32  `protobuf:"varint,12,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
-	Country       string `protobuf:"bytes,13,opt,name=country,proto3" json:"country,omitempty"`
-	City          string `protobuf:"bytes,14,opt,name=city,proto3" json:"city,omitempty"`
+	MinAge  int32  `protobuf:"varint,11,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge  int32  `protobuf:"varint,12,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Country string `protobuf:"bytes,13,opt,name=country,proto3" json:"country,omitempty"`
+	City    string `protobuf:"bytes,14,opt,name=city,proto3" json:"city,omitempty"`
+	// 分页令牌 - 生成的客户端提供 SearchUsersIter 迭代器
+	PageToken     string `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_complete_example_proto_msgTypes[4]
+	mi := &file_complete_example_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +758,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[4]
+	mi := &file_complete_example_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +771,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{4}
+	return file_complete_example_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SearchUsersRequest) GetQuery() string {
@@ -510,6 +872,13 @@ func (x *SearchUsersRequest) GetCity() string {
 	return ""
 }
 
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type SearchUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -517,13 +886,14 @@ type SearchUsersResponse struct {
 	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
 	SearchTime    float64                `protobuf:"fixed64,4,opt,name=search_time,json=searchTime,proto3" json:"search_time,omitempty"`
 	Suggestions   []string               `protobuf:"bytes,5,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_complete_example_proto_msgTypes[5]
+	mi := &file_complete_example_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +905,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[5]
+	mi := &file_complete_example_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +918,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{5}
+	return file_complete_example_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*User {
@@ -586,6 +956,13 @@ func (x *SearchUsersResponse) GetSuggestions() []string {
 	return nil
 }
 
+func (x *SearchUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CreateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 基本信息 - 必需字段 + 格式验证
@@ -612,15 +989,17 @@ type CreateUserRequest struct {
 	AgreeTerms          bool `protobuf:"varint,15,opt,name=agree_terms,json=agreeTerms,proto3" json:"agree_terms,omitempty"`
 	SubscribeNewsletter bool `protobuf:"varint,16,opt,name=subscribe_newsletter,json=subscribeNewsletter,proto3" json:"subscribe_newsletter,omitempty"`
 	// 自定义标签示例
-	ReferralCode  string   `protobuf:"bytes,17,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
-	Tags          []string `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReferralCode string   `protobuf:"bytes,17,opt,name=referral_code,json=referralCode,proto3" json:"referral_code,omitempty"`
+	Tags         []string `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 请求ID - 重复投递的请求直接返回首次响应
+	RequestId     string `protobuf:"bytes,19,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[6]
+	mi := &file_complete_example_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +1011,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[6]
+	mi := &file_complete_example_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +1024,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{6}
+	return file_complete_example_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateUserRequest) GetUsername() string {
@@ -774,19 +1153,28 @@ func (x *CreateUserRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateUserRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	User            *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	ActivationToken string                 `protobuf:"bytes,3,opt,name=activation_token,json=activationToken,proto3" json:"activation_token,omitempty"`
 	Warnings        []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 新建用户的地址，写入 201 响应的 Location 头
+	Location      string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[7]
+	mi := &file_complete_example_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +1186,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[7]
+	mi := &file_complete_example_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +1199,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{7}
+	return file_complete_example_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateUserResponse) GetUser() *User {
@@ -842,6 +1230,13 @@ func (x *CreateUserResponse) GetWarnings() []string {
 	return nil
 }
 
+func (x *CreateUserResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
 type RegisterUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Form字段 - 表单提交场景
@@ -878,7 +1273,7 @@ type RegisterUserRequest struct {
 
 func (x *RegisterUserRequest) Reset() {
 	*x = RegisterUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[8]
+	mi := &file_complete_example_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1285,7 @@ func (x *RegisterUserRequest) String() string {
 func (*RegisterUserRequest) ProtoMessage() {}
 
 func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[8]
+	mi := &file_complete_example_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1298,7 @@ func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
 func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{8}
+	return file_complete_example_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RegisterUserRequest) GetUsername() string {
@@ -1067,7 +1462,7 @@ type RegisterUserResponse struct {
 
 func (x *RegisterUserResponse) Reset() {
 	*x = RegisterUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[9]
+	mi := &file_complete_example_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1079,7 +1474,7 @@ func (x *RegisterUserResponse) String() string {
 func (*RegisterUserResponse) ProtoMessage() {}
 
 func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[9]
+	mi := &file_complete_example_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1487,7 @@ func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserResponse.ProtoReflect.Descriptor instead.
 func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{9}
+	return file_complete_example_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RegisterUserResponse) GetSuccess() bool {
@@ -1178,7 +1573,7 @@ type CreatePostRequest struct {
 
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_complete_example_proto_msgTypes[10]
+	mi := &file_complete_example_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1585,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[10]
+	mi := &file_complete_example_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1598,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{10}
+	return file_complete_example_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreatePostRequest) GetUserId() string {
@@ -1388,7 +1783,7 @@ type CreatePostResponse struct {
 
 func (x *CreatePostResponse) Reset() {
 	*x = CreatePostResponse{}
-	mi := &file_complete_example_proto_msgTypes[11]
+	mi := &file_complete_example_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +1795,7 @@ func (x *CreatePostResponse) String() string {
 func (*CreatePostResponse) ProtoMessage() {}
 
 func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[11]
+	mi := &file_complete_example_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1808,7 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
 func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{11}
+	return file_complete_example_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreatePostResponse) GetPost() *Post {
@@ -1492,7 +1887,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[12]
+	mi := &file_complete_example_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1504,7 +1899,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[12]
+	mi := &file_complete_example_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1912,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{12}
+	return file_complete_example_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateUserRequest) GetUserId() string {
@@ -1659,7 +2054,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[13]
+	mi := &file_complete_example_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1671,7 +2066,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[13]
+	mi := &file_complete_example_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,7 +2079,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{13}
+	return file_complete_example_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateUserResponse) GetUser() *User {
@@ -1727,14 +2122,16 @@ type UpdateProfileRequest struct {
 	// 路径参数
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Body只包含profile字段 - 部分Body示例
-	Profile       *UserProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile *UserProfile `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// 只读字段 - 由服务端设置，不参与请求绑定
+	UpdateTime    string `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_complete_example_proto_msgTypes[14]
+	mi := &file_complete_example_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1746,7 +2143,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[14]
+	mi := &file_complete_example_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +2156,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{14}
+	return file_complete_example_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateProfileRequest) GetUserId() string {
@@ -1776,6 +2173,128 @@ func (x *UpdateProfileRequest) GetProfile() *UserProfile {
 	return nil
 }
 
+func (x *UpdateProfileRequest) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+type UploadAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 路径参数
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 头像文件 - 绑定为 *multipart.FileHeader，服务中通过 metadata.FormFile(ctx, "avatar") 读取
+	Avatar []byte `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	// 普通表单字段
+	Caption       string `protobuf:"bytes,3,opt,name=caption,proto3" json:"caption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_complete_example_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadAvatarRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadAvatarRequest) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *UploadAvatarRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+type UploadAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
+	mi := &file_complete_example_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_complete_example_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_complete_example_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadAvatarResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UploadAvatarResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 type UpdateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Profile       *UserProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
@@ -1787,7 +2306,7 @@ type UpdateProfileResponse struct {
 
 func (x *UpdateProfileResponse) Reset() {
 	*x = UpdateProfileResponse{}
-	mi := &file_complete_example_proto_msgTypes[15]
+	mi := &file_complete_example_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1799,7 +2318,7 @@ func (x *UpdateProfileResponse) String() string {
 func (*UpdateProfileResponse) ProtoMessage() {}
 
 func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[15]
+	mi := &file_complete_example_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,7 +2331,7 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{15}
+	return file_complete_example_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateProfileResponse) GetProfile() *UserProfile {
@@ -1870,7 +2389,7 @@ type PatchUserRequest struct {
 
 func (x *PatchUserRequest) Reset() {
 	*x = PatchUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[16]
+	mi := &file_complete_example_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1882,7 +2401,7 @@ func (x *PatchUserRequest) String() string {
 func (*PatchUserRequest) ProtoMessage() {}
 
 func (x *PatchUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[16]
+	mi := &file_complete_example_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2414,7 @@ func (x *PatchUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchUserRequest.ProtoReflect.Descriptor instead.
 func (*PatchUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{16}
+	return file_complete_example_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PatchUserRequest) GetUserId() string {
@@ -2051,7 +2570,7 @@ type PatchUserResponse struct {
 
 func (x *PatchUserResponse) Reset() {
 	*x = PatchUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[17]
+	mi := &file_complete_example_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2063,7 +2582,7 @@ func (x *PatchUserResponse) String() string {
 func (*PatchUserResponse) ProtoMessage() {}
 
 func (x *PatchUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[17]
+	mi := &file_complete_example_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,7 +2595,7 @@ func (x *PatchUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchUserResponse.ProtoReflect.Descriptor instead.
 func (*PatchUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{17}
+	return file_complete_example_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PatchUserResponse) GetUser() *User {
@@ -2133,7 +2652,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_complete_example_proto_msgTypes[18]
+	mi := &file_complete_example_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2145,7 +2664,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[18]
+	mi := &file_complete_example_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +2677,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{18}
+	return file_complete_example_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DeleteUserRequest) GetUserId() string {
@@ -2231,7 +2750,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_complete_example_proto_msgTypes[19]
+	mi := &file_complete_example_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2243,7 +2762,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[19]
+	mi := &file_complete_example_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2256,7 +2775,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{19}
+	return file_complete_example_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteUserResponse) GetSuccess() bool {
@@ -2317,7 +2836,7 @@ type BatchDeleteUsersRequest struct {
 
 func (x *BatchDeleteUsersRequest) Reset() {
 	*x = BatchDeleteUsersRequest{}
-	mi := &file_complete_example_proto_msgTypes[20]
+	mi := &file_complete_example_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2329,7 +2848,7 @@ func (x *BatchDeleteUsersRequest) String() string {
 func (*BatchDeleteUsersRequest) ProtoMessage() {}
 
 func (x *BatchDeleteUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[20]
+	mi := &file_complete_example_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2861,7 @@ func (x *BatchDeleteUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchDeleteUsersRequest.ProtoReflect.Descriptor instead.
 func (*BatchDeleteUsersRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{20}
+	return file_complete_example_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BatchDeleteUsersRequest) GetUserIds() []string {
@@ -2402,7 +2921,7 @@ type BatchDeleteUsersResponse struct {
 
 func (x *BatchDeleteUsersResponse) Reset() {
 	*x = BatchDeleteUsersResponse{}
-	mi := &file_complete_example_proto_msgTypes[21]
+	mi := &file_complete_example_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2414,7 +2933,7 @@ func (x *BatchDeleteUsersResponse) String() string {
 func (*BatchDeleteUsersResponse) ProtoMessage() {}
 
 func (x *BatchDeleteUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[21]
+	mi := &file_complete_example_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2946,7 @@ func (x *BatchDeleteUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchDeleteUsersResponse.ProtoReflect.Descriptor instead.
 func (*BatchDeleteUsersResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{21}
+	return file_complete_example_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BatchDeleteUsersResponse) GetTotalRequested() int32 {
@@ -2505,7 +3024,7 @@ type GetPostCommentsRequest struct {
 
 func (x *GetPostCommentsRequest) Reset() {
 	*x = GetPostCommentsRequest{}
-	mi := &file_complete_example_proto_msgTypes[22]
+	mi := &file_complete_example_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2517,7 +3036,7 @@ func (x *GetPostCommentsRequest) String() string {
 func (*GetPostCommentsRequest) ProtoMessage() {}
 
 func (x *GetPostCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[22]
+	mi := &file_complete_example_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2530,7 +3049,7 @@ func (x *GetPostCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostCommentsRequest.ProtoReflect.Descriptor instead.
 func (*GetPostCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{22}
+	return file_complete_example_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetPostCommentsRequest) GetUserId() string {
@@ -2638,7 +3157,7 @@ type GetPostCommentsResponse struct {
 
 func (x *GetPostCommentsResponse) Reset() {
 	*x = GetPostCommentsResponse{}
-	mi := &file_complete_example_proto_msgTypes[23]
+	mi := &file_complete_example_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2650,7 +3169,7 @@ func (x *GetPostCommentsResponse) String() string {
 func (*GetPostCommentsResponse) ProtoMessage() {}
 
 func (x *GetPostCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[23]
+	mi := &file_complete_example_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +3182,7 @@ func (x *GetPostCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostCommentsResponse.ProtoReflect.Descriptor instead.
 func (*GetPostCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{23}
+	return file_complete_example_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetPostCommentsResponse) GetComments() []*Comment {
@@ -2728,7 +3247,7 @@ type GetUserProfileRequest struct {
 
 func (x *GetUserProfileRequest) Reset() {
 	*x = GetUserProfileRequest{}
-	mi := &file_complete_example_proto_msgTypes[24]
+	mi := &file_complete_example_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2740,7 +3259,7 @@ func (x *GetUserProfileRequest) String() string {
 func (*GetUserProfileRequest) ProtoMessage() {}
 
 func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[24]
+	mi := &file_complete_example_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2753,7 +3272,7 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{24}
+	return file_complete_example_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetUserProfileRequest) GetUserId() string {
@@ -2828,7 +3347,7 @@ type GetUserProfileResponse struct {
 
 func (x *GetUserProfileResponse) Reset() {
 	*x = GetUserProfileResponse{}
-	mi := &file_complete_example_proto_msgTypes[25]
+	mi := &file_complete_example_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2840,7 +3359,7 @@ func (x *GetUserProfileResponse) String() string {
 func (*GetUserProfileResponse) ProtoMessage() {}
 
 func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[25]
+	mi := &file_complete_example_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,7 +3372,7 @@ func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{25}
+	return file_complete_example_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetUserProfileResponse) GetUser() *User {
@@ -2916,15 +3435,15 @@ type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // 对外只显示末 4 位
 	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"` // 响应中加密返回
 	Age           int32                  `protobuf:"varint,6,opt,name=age,proto3" json:"age,omitempty"`
 	Gender        string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
 	Bio           string                 `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
 	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
 	Roles         []string               `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"`
-	Address       *Address               `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Address       *Address               `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"` // 公开接口不返回地址
 	Profile       *UserProfile           `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
 	Settings      *UserSettings          `protobuf:"bytes,13,opt,name=settings,proto3" json:"settings,omitempty"`
 	SocialLinks   map[string]string      `protobuf:"bytes,14,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -2940,7 +3459,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_complete_example_proto_msgTypes[26]
+	mi := &file_complete_example_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2952,7 +3471,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[26]
+	mi := &file_complete_example_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2965,7 +3484,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{26}
+	return file_complete_example_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *User) GetId() string {
@@ -3131,7 +3650,7 @@ type UserProfile struct {
 
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
-	mi := &file_complete_example_proto_msgTypes[27]
+	mi := &file_complete_example_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3143,7 +3662,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[27]
+	mi := &file_complete_example_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,7 +3675,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{27}
+	return file_complete_example_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UserProfile) GetBio() string {
@@ -3287,7 +3806,7 @@ type UserSettings struct {
 
 func (x *UserSettings) Reset() {
 	*x = UserSettings{}
-	mi := &file_complete_example_proto_msgTypes[28]
+	mi := &file_complete_example_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3299,7 +3818,7 @@ func (x *UserSettings) String() string {
 func (*UserSettings) ProtoMessage() {}
 
 func (x *UserSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[28]
+	mi := &file_complete_example_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3312,7 +3831,7 @@ func (x *UserSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
 func (*UserSettings) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{28}
+	return file_complete_example_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UserSettings) GetEmailNotifications() bool {
@@ -3438,7 +3957,7 @@ type Address struct {
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_complete_example_proto_msgTypes[29]
+	mi := &file_complete_example_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3450,7 +3969,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[29]
+	mi := &file_complete_example_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3463,7 +3982,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{29}
+	return file_complete_example_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Address) GetStreet() string {
@@ -3568,7 +4087,7 @@ type Post struct {
 
 func (x *Post) Reset() {
 	*x = Post{}
-	mi := &file_complete_example_proto_msgTypes[30]
+	mi := &file_complete_example_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3580,7 +4099,7 @@ func (x *Post) String() string {
 func (*Post) ProtoMessage() {}
 
 func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[30]
+	mi := &file_complete_example_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3593,7 +4112,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{30}
+	return file_complete_example_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Post) GetId() string {
@@ -3785,7 +4304,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_complete_example_proto_msgTypes[31]
+	mi := &file_complete_example_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3797,7 +4316,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[31]
+	mi := &file_complete_example_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3810,7 +4329,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{31}
+	return file_complete_example_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Comment) GetId() string {
@@ -3920,7 +4439,7 @@ type UserStats struct {
 
 func (x *UserStats) Reset() {
 	*x = UserStats{}
-	mi := &file_complete_example_proto_msgTypes[32]
+	mi := &file_complete_example_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3932,7 +4451,7 @@ func (x *UserStats) String() string {
 func (*UserStats) ProtoMessage() {}
 
 func (x *UserStats) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[32]
+	mi := &file_complete_example_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,7 +4464,7 @@ func (x *UserStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
 func (*UserStats) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{32}
+	return file_complete_example_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UserStats) GetPostCount() int32 {
@@ -4018,7 +4537,7 @@ type CommentStats struct {
 
 func (x *CommentStats) Reset() {
 	*x = CommentStats{}
-	mi := &file_complete_example_proto_msgTypes[33]
+	mi := &file_complete_example_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4030,7 +4549,7 @@ func (x *CommentStats) String() string {
 func (*CommentStats) ProtoMessage() {}
 
 func (x *CommentStats) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[33]
+	mi := &file_complete_example_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4043,7 +4562,7 @@ func (x *CommentStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentStats.ProtoReflect.Descriptor instead.
 func (*CommentStats) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{33}
+	return file_complete_example_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CommentStats) GetTotalComments() int32 {
@@ -4100,7 +4619,7 @@ type BatchError struct {
 
 func (x *BatchError) Reset() {
 	*x = BatchError{}
-	mi := &file_complete_example_proto_msgTypes[34]
+	mi := &file_complete_example_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4112,7 +4631,7 @@ func (x *BatchError) String() string {
 func (*BatchError) ProtoMessage() {}
 
 func (x *BatchError) ProtoReflect() protoreflect.Message {
-	mi := &file_complete_example_proto_msgTypes[34]
+	mi := &file_complete_example_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4125,7 +4644,7 @@ func (x *BatchError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
 func (*BatchError) Descriptor() ([]byte, []int) {
-	return file_complete_example_proto_rawDescGZIP(), []int{34}
+	return file_complete_example_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *BatchError) GetId() string {
@@ -4158,1393 +4677,798 @@ func (x *BatchError) GetDetails() map[string]string {
 
 var File_complete_example_proto protoreflect.FileDescriptor
 
-var file_complete_example_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0e, 0x74, 0x61, 0x67, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xdb, 0x04, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x2a, 0x05,
-	0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1e,
-	0x8a, 0xb5, 0x18, 0x1a, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x2a,
-	0x0d, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
-	0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x8a, 0xb5, 0x18, 0x29, 0x0a,
-	0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x2a, 0x1e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d,
-	0x69, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
-	0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x73,
-	0x63, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x0a, 0x92, 0xb5, 0x18, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0x92, 0xb5, 0x18, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x52,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x13, 0x92, 0xb5, 0x18, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0xb5, 0x18,
-	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x28, 0x8a, 0xb5, 0x18, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x2a, 0x13, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x52, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0e, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x29, 0x8a, 0xb5, 0x18, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x2a, 0x13, 0x64, 0x61, 0x74, 0x65, 0x74,
-	0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x52, 0x0d,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xa5, 0x01,
-	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61,
-	0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61,
-	0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x0a, 0x92, 0xb5, 0x18, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x13, 0x92, 0xb5,
-	0x18, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
-	0xf0, 0x05, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x8a, 0xb5, 0x18, 0x1b, 0x0a, 0x01, 0x71, 0x2a, 0x16,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d,
-	0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x36, 0x0a,
-	0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x2a, 0x0c, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x22,
-	0x0b, 0x58, 0x2d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x49, 0x44, 0x2a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xa2, 0xb5, 0x18, 0x0c, 0x58, 0x2d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2d, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xa2, 0xb5, 0x18, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x2d,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x12, 0x40, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x27, 0x8a, 0xb5, 0x18, 0x23, 0x22, 0x09, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2d, 0x4b,
-	0x65, 0x79, 0x2a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e,
-	0x3d, 0x33, 0x32, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x36, 0x34, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b,
-	0x65, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x2a,
-	0x0e, 0x6d, 0x69, 0x6e, 0x3d, 0x2d, 0x39, 0x30, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x39, 0x30, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
-	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1b, 0x8a, 0xb5,
-	0x18, 0x17, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x2a, 0x10, 0x6d, 0x69, 0x6e, 0x3d, 0x2d, 0x31, 0x38,
-	0x30, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x38, 0x30, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6b,
-	0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x0a, 0x06, 0x72,
-	0x61, 0x64, 0x69, 0x75, 0x73, 0x2a, 0x0e, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78,
-	0x3d, 0x31, 0x30, 0x30, 0x30, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x12,
-	0x35, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x2a,
-	0x0d, 0x6d, 0x69, 0x6e, 0x3d, 0x30, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x35, 0x30, 0x52, 0x06,
-	0x6d, 0x69, 0x6e, 0x41, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x0a, 0x07, 0x6d,
-	0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x2a, 0x0d, 0x6d, 0x69, 0x6e, 0x3d, 0x30, 0x2c, 0x6d, 0x61,
-	0x78, 0x3d, 0x31, 0x35, 0x30, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x25, 0x0a,
-	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
-	0x92, 0xb5, 0x18, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0x92, 0xb5, 0x18, 0x04, 0x63, 0x69, 0x74, 0x79, 0x52, 0x04, 0x63, 0x69,
-	0x74, 0x79, 0x22, 0xb4, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x99, 0x0a, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x4a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2e, 0x8a, 0xb5, 0x18, 0x2a, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x2a, 0x1e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d,
-	0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x2c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x6e, 0x75,
-	0x6d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17,
-	0x1a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x2c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x42,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x26, 0x8a, 0xb5, 0x18, 0x22, 0x1a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x2a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x38,
-	0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x32, 0x38, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x8a, 0xb5, 0x18, 0x1a, 0x1a, 0x09, 0x66, 0x75, 0x6c,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x0d, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d, 0x61,
-	0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x31, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
-	0x8a, 0xb5, 0x18, 0x17, 0x1a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2a, 0x0e, 0x6c, 0x65, 0x6e,
-	0x3d, 0x31, 0x31, 0x2c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x19, 0x8a, 0xb5, 0x18, 0x15, 0x1a, 0x03, 0x61, 0x67, 0x65, 0x2a, 0x0e, 0x6d, 0x69, 0x6e, 0x3d,
-	0x31, 0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x32, 0x30, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12,
-	0x3d, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x25, 0x8a, 0xb5, 0x18, 0x21, 0x1a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2a, 0x17, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x6d, 0x61, 0x6c, 0x65, 0x20, 0x66, 0x65, 0x6d, 0x61, 0x6c, 0x65,
-	0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x24,
-	0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0x8a, 0xb5, 0x18,
-	0x0e, 0x1a, 0x03, 0x62, 0x69, 0x6f, 0x2a, 0x07, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x52,
-	0x03, 0x62, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x8a, 0xb5, 0x18, 0x09, 0x1a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x35, 0x0a, 0x07, 0x68, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x1a, 0x07, 0x68, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x2a,
-	0x0c, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x52, 0x07, 0x68,
-	0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x17, 0x8a, 0xb5, 0x18, 0x13, 0x1a,
-	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x2a, 0x06, 0x6d, 0x61, 0x78, 0x3d,
-	0x32, 0x30, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x62, 0x0a,
-	0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x1a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c,
-	0x69, 0x6e, 0x6b, 0x73, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b,
-	0x73, 0x12, 0x60, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x1a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0e, 0x8a, 0xb5,
-	0x18, 0x0a, 0x1a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x5f,
-	0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x42, 0x23, 0x8a, 0xb5, 0x18,
-	0x1f, 0x1a, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x2a, 0x10,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x65, 0x71, 0x3d, 0x74, 0x72, 0x75, 0x65,
-	0x52, 0x0a, 0x61, 0x67, 0x72, 0x65, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x14,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x8a, 0xb5, 0x18, 0x16,
-	0x1a, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x73,
-	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0d, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x24, 0x8a, 0xb5, 0x18, 0x20, 0x1a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
-	0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x12,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x1a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x3a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a, 0x32, 0x30, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c,
-	0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0xc5, 0x0a, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x8a, 0xb5, 0x18, 0x2a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x1e, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x33,
-	0x30, 0x2c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x6e, 0x75, 0x6d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x8a, 0xb5, 0x18, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x38, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x5c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x8a,
-	0xb5, 0x18, 0x2d, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c,
-	0x65, 0x71, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x46, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x8a, 0xb5, 0x18, 0x23, 0x0a, 0x0a, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x8a, 0xb5,
-	0x18, 0x22, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x15, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d, 0x61,
-	0x78, 0x3d, 0x35, 0x30, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4d,
-	0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x2e, 0x8a, 0xb5, 0x18, 0x2a, 0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x2a, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x64,
-	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d,
-	0x30, 0x32, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x8a, 0xb5,
-	0x18, 0x20, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x2c, 0x6c, 0x65, 0x6e, 0x3d, 0x31, 0x31, 0x2c, 0x6e, 0x75, 0x6d, 0x65, 0x72,
-	0x69, 0x63, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x67, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x8a, 0xb5, 0x18, 0x33, 0x0a,
-	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2a, 0x29, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x6d,
-	0x61, 0x6c, 0x65, 0x20, 0x66, 0x65, 0x6d, 0x61, 0x6c, 0x65, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72,
-	0x20, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x73,
-	0x61, 0x79, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5, 0x18,
-	0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2a, 0x14, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x32,
-	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18,
-	0x14, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x2a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
-	0x2b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x0d, 0x92, 0xb5, 0x18, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
-	0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0x92, 0xb5,
-	0x18, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
-	0x12, 0x65, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x66,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
-	0x8a, 0xb5, 0x18, 0x2e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x2a, 0x20, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x20, 0x64, 0x61,
-	0x69, 0x6c, 0x79, 0x20, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x20, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
-	0x6c, 0x79, 0x52, 0x13, 0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x46, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x14, 0x92, 0xb5, 0x18, 0x10, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x61, 0x70, 0x74,
-	0x63, 0x68, 0x61, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1d, 0x8a, 0xb5, 0x18, 0x19, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
-	0x61, 0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6c, 0x65, 0x6e, 0x3d,
-	0x36, 0x52, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x92, 0xb5, 0x18, 0x0b, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x74, 0x6d, 0x5f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0xb5, 0x18, 0x0a, 0x75, 0x74,
-	0x6d, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x75, 0x74, 0x6d, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x74, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75,
-	0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0xb5, 0x18, 0x0a, 0x75, 0x74, 0x6d,
-	0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x09, 0x75, 0x74, 0x6d, 0x4d, 0x65, 0x64, 0x69,
-	0x75, 0x6d, 0x12, 0x33, 0x0a, 0x0c, 0x75, 0x74, 0x6d, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
-	0x67, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0xb5, 0x18, 0x0c, 0x75, 0x74,
-	0x6d, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x0b, 0x75, 0x74, 0x6d, 0x43,
-	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x2f, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x72, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x92,
-	0xb5, 0x18, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x72, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x22, 0xd3, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xec,
-	0x0c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x75,
-	0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x64,
-	0x72, 0x61, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x92, 0xb5, 0x18, 0x05,
-	0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x3e, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x8a, 0xb5,
-	0x18, 0x22, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2a, 0x18, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x3d, 0x77, 0x65, 0x62, 0x20, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x20, 0x61, 0x70, 0x70,
-	0x20, 0x61, 0x70, 0x69, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x10,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x92, 0xb5, 0x18, 0x10, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a,
-	0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x8a, 0xb5, 0x18, 0x2c, 0x22, 0x0d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x2c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x77, 0x69, 0x74, 0x68, 0x3d, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18,
-	0x18, 0x22, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2d, 0x54, 0x79, 0x70, 0x65, 0x2a,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xa2, 0xb5, 0x18, 0x0a,
-	0x55, 0x73, 0x65, 0x72, 0x2d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xa2,
-	0xb5, 0x18, 0x10, 0x58, 0x2d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xa2, 0xb5, 0x18, 0x0c, 0x58, 0x2d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2d, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5, 0x18, 0x1f, 0x1a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x2a,
-	0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x35, 0x2c,
-	0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x30, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x42,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x28, 0x8a, 0xb5, 0x18, 0x24, 0x1a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x19,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x35, 0x30, 0x2c,
-	0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x30, 0x30, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0x8a, 0xb5, 0x18, 0x12, 0x1a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72,
-	0x70, 0x74, 0x2a, 0x07, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x52, 0x07, 0x65, 0x78, 0x63,
-	0x65, 0x72, 0x70, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18, 0x14, 0x1a, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18, 0x14, 0x1a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x2a, 0x0c, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d,
-	0x31, 0x30, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x8a, 0xb5,
-	0x18, 0x31, 0x1a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2a, 0x23,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x20, 0x64, 0x72,
-	0x61, 0x66, 0x74, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
-	0x3b, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x8a, 0xb5, 0x18, 0x10, 0x1a, 0x0e, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x34, 0x8a, 0xb5, 0x18, 0x30, 0x1a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
-	0x61, 0x74, 0x2a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30,
-	0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x54, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35,
-	0x5a, 0x30, 0x37, 0x3a, 0x30, 0x30, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41,
-	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18, 0x14, 0x1a, 0x0a, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x2a, 0x06, 0x6d, 0x61, 0x78, 0x3d, 0x36, 0x30, 0x52,
-	0x09, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x6d, 0x65,
-	0x74, 0x61, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x8a, 0xb5, 0x18, 0x1b, 0x1a, 0x10, 0x6d, 0x65, 0x74, 0x61,
-	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x07, 0x6d, 0x61,
-	0x78, 0x3d, 0x31, 0x36, 0x30, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x6f, 0x5f, 0x6b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x8a, 0xb5,
-	0x18, 0x16, 0x1a, 0x0c, 0x73, 0x65, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x2a, 0x06, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x52, 0x0b, 0x73, 0x65, 0x6f, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x8a, 0xb5, 0x18, 0x10, 0x1a,
-	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x2a, 0x06, 0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x52,
-	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x61, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x16, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x1a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x06, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x52, 0x0e,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x83,
-	0x01, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x30, 0x8a, 0xb5, 0x18, 0x2c, 0x1a, 0x0d, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3a, 0x1b, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x3a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x8a, 0xb5, 0x18, 0x21, 0x1a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x3a, 0x12, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x1a, 0x3f, 0x0a, 0x11,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x64, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0xaa, 0x09, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a,
-	0xb5, 0x18, 0x18, 0x12, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15,
-	0x92, 0xb5, 0x18, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0x92, 0xb5, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x69, 0x66, 0x5f, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xa2, 0xb5, 0x18, 0x08,
-	0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x8a, 0xb5, 0x18, 0x19, 0x22, 0x0d,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x8a, 0xb5, 0x18, 0x21, 0x1a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x1a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x44, 0x0a, 0x09,
-	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x27, 0x8a, 0xb5, 0x18, 0x23, 0x1a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x2a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x32,
-	0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x1a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2a, 0x0e,
-	0x6c, 0x65, 0x6e, 0x3d, 0x31, 0x31, 0x2c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x52, 0x05,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x1a, 0x03, 0x61, 0x67, 0x65, 0x2a, 0x0e, 0x6d,
-	0x69, 0x6e, 0x3d, 0x31, 0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x32, 0x30, 0x52, 0x03, 0x61,
-	0x67, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x1a, 0x03, 0x62, 0x69, 0x6f, 0x2a, 0x08, 0x6d, 0x61, 0x78, 0x3d,
-	0x31, 0x30, 0x30, 0x30, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x55, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x8a, 0xb5, 0x18, 0x39, 0x1a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x2c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x69,
-	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x20, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x28, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x1a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2a, 0x05, 0x6d, 0x69,
-	0x6e, 0x3d, 0x31, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x8a,
-	0xb5, 0x18, 0x09, 0x1a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x62, 0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69,
-	0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x1a, 0x0c,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x0b, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x42, 0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x1a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x5c, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x3d, 0x8a, 0xb5, 0x18, 0x39, 0x1a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x2a, 0x2b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x64, 0x61, 0x74,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32,
-	0x54, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35, 0x5a, 0x30, 0x37, 0x3a, 0x30, 0x30, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1d, 0x8a, 0xb5, 0x18,
-	0x19, 0x1a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x0e, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e,
-	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
-	0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7d, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0xc5, 0x0c, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x27, 0x0a, 0x08, 0x69, 0x66, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xa2, 0xb5, 0x18, 0x08, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x47, 0x0a, 0x13, 0x69, 0x66, 0x5f, 0x75,
-	0x6e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xa2, 0xb5, 0x18, 0x13, 0x49, 0x66, 0x2d, 0x55, 0x6e,
-	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2d, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x11,
-	0x69, 0x66, 0x55, 0x6e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63,
-	0x65, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x8a, 0xb5, 0x18, 0x19, 0x22, 0x0d,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xa2, 0xb5,
-	0x18, 0x0e, 0x58, 0x2d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x0b, 0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x38, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x1a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2a,
-	0x0c, 0x6d, 0x69, 0x6e, 0x3d, 0x33, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x1a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x2a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x3b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x8a, 0xb5, 0x18, 0x1a, 0x1a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x0d, 0x6d, 0x69, 0x6e, 0x3d, 0x32, 0x2c, 0x6d, 0x61, 0x78,
-	0x3d, 0x31, 0x30, 0x30, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a,
-	0xb5, 0x18, 0x17, 0x1a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2a, 0x0e, 0x6c, 0x65, 0x6e, 0x3d,
-	0x31, 0x31, 0x2c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13,
-	0x8a, 0xb5, 0x18, 0x0f, 0x1a, 0x03, 0x62, 0x69, 0x6f, 0x2a, 0x08, 0x6d, 0x61, 0x78, 0x3d, 0x31,
-	0x30, 0x30, 0x30, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x8a, 0xb5, 0x18, 0x29, 0x1a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x1f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x20, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0x73, 0x75,
-	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x6d, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x1a, 0x0f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x71,
-	0x0a, 0x10, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0x8a, 0xb5, 0x18, 0x12, 0x1a, 0x10,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x52, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x12, 0x6d, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x1a,
-	0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x52, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x12, 0x2c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0f, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x0f, 0x8a, 0xb5, 0x18, 0x0b, 0x1a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x72,
-	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x08, 0x61, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x35,
-	0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x10,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x1a, 0x0c, 0x72, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x1a, 0x08, 0x61,
-	0x64, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x12, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x1a, 0x0b, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x54, 0x61, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17,
-	0x1a, 0x0c, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x07,
-	0x6d, 0x61, 0x78, 0x3d, 0x32, 0x30, 0x30, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0x8a, 0xb5, 0x18, 0x10,
-	0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x0d, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x41, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x50, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc2, 0x01, 0x0a, 0x11,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0xfc, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a,
-	0x0b, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x0f, 0x92, 0xb5, 0x18, 0x0b, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x0a, 0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
-	0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x07, 0x6d, 0x61, 0x78, 0x3d, 0x35, 0x30, 0x30, 0x52, 0x0c, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
-	0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x8a,
-	0xb5, 0x18, 0x13, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x6f,
-	0x2a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x8a, 0xb5,
-	0x18, 0x26, 0x22, 0x10, 0x58, 0x2d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x2d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x2a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x65,
-	0x71, 0x3d, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x8a,
-	0xb5, 0x18, 0x19, 0x22, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0d, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0xa2, 0xb5, 0x18, 0x0d, 0x58, 0x2d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2d, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0xe4, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x73, 0x5f,
-	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x61,
-	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x03, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x26, 0x8a, 0xb5, 0x18, 0x22, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x73, 0x2a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6d,
-	0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0f, 0x92, 0xb5, 0x18, 0x0b,
-	0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x0a, 0x68, 0x61, 0x72,
-	0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0x92, 0xb5, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x12, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x8a, 0xb5, 0x18, 0x1b, 0x22, 0x0f, 0x58, 0x2d, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x2d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x2a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0x8a, 0xb5, 0x18, 0x19, 0x22, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0d,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
-	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x12, 0xa2, 0xb5, 0x18, 0x0e, 0x58, 0x2d, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x49, 0x44, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb5, 0x02, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a,
-	0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xad, 0x06, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35,
-	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x2a, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x2a,
-	0x05, 0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x08,
-	0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1d,
-	0x8a, 0xb5, 0x18, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x2a, 0x0d,
-	0x6d, 0x69, 0x6e, 0x3d, 0x31, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x30, 0x30, 0x52, 0x07, 0x70,
-	0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x8a, 0xb5, 0x18, 0x31, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
-	0x2a, 0x29, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x20, 0x6c, 0x69,
-	0x6b, 0x65, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x04, 0x73, 0x6f, 0x72,
-	0x74, 0x12, 0x31, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2a, 0x0e, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x73, 0x63, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x8a, 0xb5, 0x18, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2a, 0x22, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x61, 0x6c, 0x6c, 0x20, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x20, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x20, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c,
-	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x13, 0x92, 0xb5, 0x18, 0x0f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x0e, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0e,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0x92, 0xb5, 0x18, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x8a, 0xb5, 0x18, 0x2b, 0x0a, 0x05, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x2a, 0x22, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30,
-	0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x54, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30,
-	0x35, 0x5a, 0x30, 0x37, 0x3a, 0x30, 0x30, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x45,
-	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x8a,
-	0xb5, 0x18, 0x2b, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x2a, 0x22, 0x64, 0x61, 0x74, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x3d, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x54,
-	0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35, 0x5a, 0x30, 0x37, 0x3a, 0x30, 0x30, 0x52, 0x05,
-	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xa2, 0xb5, 0x18,
-	0x0e, 0x58, 0x2d, 0x55, 0x73, 0x65, 0x72, 0x2d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
-	0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x0f,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xa2, 0xb5, 0x18, 0x11, 0x58, 0x2d, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2d, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x0e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xdf, 0x01, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70,
-	0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f,
-	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72,
-	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xea,
-	0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x12,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x2c, 0x75, 0x75, 0x69, 0x64, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x28, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x0c, 0x92, 0xb5, 0x18, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0xb5, 0x18, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0x92, 0xb5, 0x18, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x10, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x57, 0x0a,
-	0x0e, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x8a, 0xb5, 0x18, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x2a, 0x21, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x20, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x20, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xa2, 0xb5, 0x18, 0x0b, 0x58,
-	0x2d, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2d, 0x49, 0x44, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xa2, 0xb5, 0x18, 0x0e,
-	0x58, 0x2d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe7, 0x02, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xab, 0x05, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x62, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x0c,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x68, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x68, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f,
-	0x67, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61,
-	0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e,
-	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xb9, 0x04, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77,
-	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x65,
-	0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x65, 0x64, 0x75, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12,
-	0x48, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x1a,
-	0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xa9, 0x05, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70,
-	0x75, 0x73, 0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x6d, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x6d, 0x73,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68,
-	0x65, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68,
-	0x6f, 0x77, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x75,
-	0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x50,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9e, 0x04, 0x0a, 0x07,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x8a, 0xb5, 0x18, 0x12, 0x1a, 0x06, 0x73,
-	0x74, 0x72, 0x65, 0x65, 0x74, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52,
-	0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x8a, 0xb5, 0x18, 0x09, 0x1a, 0x07,
-	0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x32, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x32,
-	0x12, 0x28, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0x8a, 0xb5, 0x18, 0x10, 0x1a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x1a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x8a, 0xb5, 0x18, 0x13, 0x1a, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x6f,
-	0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x1a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x2a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x0a, 0x70, 0x6f,
-	0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1e, 0x8a, 0xb5, 0x18, 0x1a,
-	0x1a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x2a, 0x0e, 0x6d, 0x69, 0x6e, 0x3d,
-	0x2d, 0x39, 0x30, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x39, 0x30, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x21, 0x8a, 0xb5, 0x18, 0x1d, 0x1a, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x2a, 0x10, 0x6d, 0x69, 0x6e, 0x3d, 0x2d, 0x31,
-	0x38, 0x30, 0x2c, 0x6d, 0x61, 0x78, 0x3d, 0x31, 0x38, 0x30, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0x8a, 0xb5, 0x18, 0x0c, 0x1a,
-	0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x69, 0x73, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x8a, 0xb5,
-	0x18, 0x1d, 0x1a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x15, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3d,
-	0x68, 0x6f, 0x6d, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52,
-	0x0b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x22, 0xca, 0x06, 0x0a,
-	0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6f,
-	0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x6f, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0d,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x10, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xef, 0x02, 0x0a, 0x07, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb1, 0x02, 0x0a, 0x09,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
-	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69,
-	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x65, 0x77, 0x73, 0x22,
-	0xfe, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x66,
-	0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a,
-	0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xd6, 0x0b, 0x0a, 0x16,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x5d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x66, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x5f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x6e, 0x0a, 0x0c, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
-	0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x6f, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x69, 0x0a, 0x0a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x07,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x66, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x32, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x66, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a,
-	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x5a, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x65, 0x6e, 0x6b, 0x61, 0x2f, 0x67, 0x69, 0x6e, 0x70,
-	0x62, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70,
-	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_complete_example_proto_rawDesc = "" +
+	"\n" +
+	"\x16complete_example.proto\x12\aexample\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x0etag/tags.proto\x1a\x11tag/options.proto\"\xdb\x04\n" +
+	"\x10ListUsersRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\x05B\x11\x8a\xb5\x18\r\n" +
+	"\x04page*\x05min=1R\x04page\x12;\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x1e\x8a\xb5\x18\x1a\n" +
+	"\tpage_size*\rmin=1,max=100R\bpageSize\x12F\n" +
+	"\asort_by\x18\x03 \x01(\tB-\x8a\xb5\x18)\n" +
+	"\asort_by*\x1eoneof=id name email created_atR\x06sortBy\x12?\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\tB \x8a\xb5\x18\x1c\n" +
+	"\n" +
+	"sort_order*\x0eoneof=asc descR\tsortOrder\x12\"\n" +
+	"\x06status\x18\x05 \x03(\tB\n" +
+	"\x92\xb5\x18\x06statusR\x06status\x12\x1f\n" +
+	"\x05roles\x18\x06 \x03(\tB\t\x92\xb5\x18\x05rolesR\x05roles\x12<\n" +
+	"\x0finclude_deleted\x18\a \x01(\bB\x13\x92\xb5\x18\x0finclude_deletedR\x0eincludeDeleted\x126\n" +
+	"\rinclude_stats\x18\b \x01(\bB\x11\x92\xb5\x18\rinclude_statsR\fincludeStats\x12M\n" +
+	"\rcreated_after\x18\t \x01(\tB(\x8a\xb5\x18$\n" +
+	"\rcreated_after*\x13datetime=2006-01-02R\fcreatedAfter\x12P\n" +
+	"\x0ecreated_before\x18\n" +
+	" \x01(\tB)\x8a\xb5\x18%\n" +
+	"\x0ecreated_before*\x13datetime=2006-01-02R\rcreatedBefore\"\xa5\x01\n" +
+	"\x11ListUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.example.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\"7\n" +
+	"\x11WatchUsersRequest\x12\"\n" +
+	"\x06status\x18\x01 \x03(\tB\n" +
+	"\x92\xb5\x18\x06statusR\x06status\"B\n" +
+	"\tUserEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\x04user\x18\x02 \x01(\v2\r.example.UserR\x04user\"\x81\x01\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color:-\xeaA\x1d\n" +
+	"\x0fexample.com/Tag\x12\n" +
+	"tags/{tag}\xd2\xda\x18\t\n" +
+	"\a/api/v1\"G\n" +
+	"\x10UserCreatedEvent\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user:\x10\xca\xda\x18\fuser.created\"=\n" +
+	"\x10UserDeletedEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId:\x10\xca\xda\x18\fuser.deleted\":\n" +
+	"\vChatMessage\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x9a\x02\n" +
+	"\x0eGetUserRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x12\"\n" +
+	"\x06fields\x18\x02 \x03(\tB\n" +
+	"\x92\xb5\x18\x06fieldsR\x06fields\x12<\n" +
+	"\x0finclude_profile\x18\x03 \x01(\bB\x13\x92\xb5\x18\x0finclude_profileR\x0eincludeProfile\x126\n" +
+	"\rinclude_posts\x18\x04 \x01(\bB\x11\x92\xb5\x18\rinclude_postsR\fincludePosts\x127\n" +
+	"\tread_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xb3\x01\n" +
+	"\x0fGetUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user\x12.\n" +
+	"\aprofile\x18\x02 \x01(\v2\x14.example.UserProfileR\aprofile\x12#\n" +
+	"\x05posts\x18\x03 \x03(\v2\r.example.PostR\x05posts\x12(\n" +
+	"\x05stats\x18\x04 \x01(\v2\x12.example.UserStatsR\x05stats\"\x9f\x06\n" +
+	"\x12SearchUsersRequest\x125\n" +
+	"\x05query\x18\x01 \x01(\tB\x1f\x8a\xb5\x18\x1b\n" +
+	"\x01q*\x16required,min=2,max=100R\x05query\x126\n" +
+	"\rsearch_fields\x18\x02 \x03(\tB\x11\x92\xb5\x18\rsearch_fieldsR\fsearchFields\x12/\n" +
+	"\x05limit\x18\x03 \x01(\x05B\x19\x8a\xb5\x18\x15\n" +
+	"\x05limit*\fmin=1,max=50R\x05limit\x128\n" +
+	"\tclient_id\x18\x04 \x01(\tB\x1b\x8a\xb5\x18\x17\"\vX-Client-ID*\brequiredR\bclientId\x12/\n" +
+	"\n" +
+	"request_id\x18\x05 \x01(\tB\x10\xa2\xb5\x18\fX-Request-IDR\trequestId\x12-\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tB\x0e\xa2\xb5\x18\n" +
+	"User-AgentR\tuserAgent\x12@\n" +
+	"\aapi_key\x18\a \x01(\tB'\x8a\xb5\x18#\"\tX-API-Key*\x16required,min=32,max=64R\x06apiKey\x125\n" +
+	"\blatitude\x18\b \x01(\x01B\x19\x8a\xb5\x18\x15\n" +
+	"\x03lat*\x0emin=-90,max=90R\blatitude\x129\n" +
+	"\tlongitude\x18\t \x01(\x01B\x1b\x8a\xb5\x18\x17\n" +
+	"\x03lng*\x10min=-180,max=180R\tlongitude\x129\n" +
+	"\tradius_km\x18\n" +
+	" \x01(\x05B\x1c\x8a\xb5\x18\x18\n" +
+	"\x06radius*\x0emin=1,max=1000R\bradiusKm\x125\n" +
+	"\amin_age\x18\v \x01(\x05B\x1c\x8a\xb5\x18\x18\n" +
+	"\amin_age*\rmin=0,max=150R\x06minAge\x125\n" +
+	"\amax_age\x18\f \x01(\x05B\x1c\x8a\xb5\x18\x18\n" +
+	"\amax_age*\rmin=0,max=150R\x06maxAge\x12%\n" +
+	"\acountry\x18\r \x01(\tB\v\x92\xb5\x18\acountryR\acountry\x12\x1c\n" +
+	"\x04city\x18\x0e \x01(\tB\b\x92\xb5\x18\x04cityR\x04city\x12-\n" +
+	"\n" +
+	"page_token\x18\x0f \x01(\tB\x0e\x92\xb5\x18\n" +
+	"page_tokenR\tpageToken\"\xdc\x01\n" +
+	"\x13SearchUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.example.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1f\n" +
+	"\vsearch_time\x18\x04 \x01(\x01R\n" +
+	"searchTime\x12 \n" +
+	"\vsuggestions\x18\x05 \x03(\tR\vsuggestions\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"\xce\n" +
+	"\n" +
+	"\x11CreateUserRequest\x12J\n" +
+	"\busername\x18\x01 \x01(\tB.\x8a\xb5\x18*\x1a\busername*\x1erequired,min=3,max=50,alphanumR\busername\x121\n" +
+	"\x05email\x18\x02 \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x05email*\x0erequired,emailR\x05email\x12B\n" +
+	"\bpassword\x18\x03 \x01(\tB&\x8a\xb5\x18\"\x1a\bpassword*\x16required,min=8,max=128R\bpassword\x12;\n" +
+	"\tfull_name\x18\x04 \x01(\tB\x1e\x8a\xb5\x18\x1a\x1a\tfull_name*\rmin=2,max=100R\bfullName\x121\n" +
+	"\x05phone\x18\x05 \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x05phone*\x0elen=11,numericR\x05phone\x12+\n" +
+	"\x03age\x18\x06 \x01(\x05B\x19\x8a\xb5\x18\x15\x1a\x03age*\x0emin=13,max=120R\x03age\x12=\n" +
+	"\x06gender\x18\a \x01(\tB%\x8a\xb5\x18!\x1a\x06gender*\x17oneof=male female otherR\x06gender\x12$\n" +
+	"\x03bio\x18\b \x01(\tB\x12\x8a\xb5\x18\x0e\x1a\x03bio*\amax=500R\x03bio\x129\n" +
+	"\aaddress\x18\t \x01(\v2\x10.example.AddressB\r\x8a\xb5\x18\t\x1a\aaddressR\aaddress\x125\n" +
+	"\ahobbies\x18\n" +
+	" \x03(\tB\x1b\x8a\xb5\x18\x17\x1a\ahobbies*\fmin=1,max=10R\ahobbies\x125\n" +
+	"\tlanguages\x18\v \x03(\tB\x17\x8a\xb5\x18\x13\x1a\tlanguages*\x06max=20R\tlanguages\x12b\n" +
+	"\fsocial_links\x18\f \x03(\v2+.example.CreateUserRequest.SocialLinksEntryB\x12\x8a\xb5\x18\x0e\x1a\fsocial_linksR\vsocialLinks\x12`\n" +
+	"\vpreferences\x18\r \x03(\v2+.example.CreateUserRequest.PreferencesEntryB\x11\x8a\xb5\x18\r\x1a\vpreferencesR\vpreferences\x12A\n" +
+	"\bsettings\x18\x0e \x01(\v2\x15.example.UserSettingsB\x0e\x8a\xb5\x18\n" +
+	"\x1a\bsettingsR\bsettings\x12D\n" +
+	"\vagree_terms\x18\x0f \x01(\bB#\x8a\xb5\x18\x1f\x1a\vagree_terms*\x10required,eq=trueR\n" +
+	"agreeTerms\x12M\n" +
+	"\x14subscribe_newsletter\x18\x10 \x01(\bB\x1a\x8a\xb5\x18\x16\x1a\x14subscribe_newsletterR\x13subscribeNewsletter\x12I\n" +
+	"\rreferral_code\x18\x11 \x01(\tB$\x8a\xb5\x18 \x1a\rreferral_codej\x0freferral_formatR\freferralCode\x12-\n" +
+	"\x04tags\x18\x12 \x03(\tB\x19\x8a\xb5\x18\x15\x1a\x04tagsj\rmax_length:20R\x04tags\x123\n" +
+	"\n" +
+	"request_id\x18\x13 \x01(\tB\x14\x8a\xb5\x18\f\x1a\n" +
+	"request_id\xf0\xc7\x18\x01R\trequestId\x1a>\n" +
+	"\x10SocialLinksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10PreferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc2\x01\n" +
+	"\x12CreateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x10activation_token\x18\x03 \x01(\tR\x0factivationToken\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12(\n" +
+	"\blocation\x18\x05 \x01(\tB\f\x8a\xc8\x18\bLocationR\blocation\"\xc5\n" +
+	"\n" +
+	"\x13RegisterUserRequest\x12J\n" +
+	"\busername\x18\x01 \x01(\tB.\x8a\xb5\x18*\n" +
+	"\busername*\x1erequired,min=3,max=30,alphanumR\busername\x121\n" +
+	"\x05email\x18\x02 \x01(\tB\x1b\x8a\xb5\x18\x17\n" +
+	"\x05email*\x0erequired,emailR\x05email\x12:\n" +
+	"\bpassword\x18\x03 \x01(\tB\x1e\x8a\xb5\x18\x1a\n" +
+	"\bpassword*\x0erequired,min=8R\bpassword\x12\\\n" +
+	"\x10confirm_password\x18\x04 \x01(\tB1\x8a\xb5\x18-\n" +
+	"\x10confirm_password*\x19required,eqfield=PasswordR\x0fconfirmPassword\x12F\n" +
+	"\n" +
+	"first_name\x18\x05 \x01(\tB'\x8a\xb5\x18#\n" +
+	"\n" +
+	"first_name*\x15required,min=2,max=50R\tfirstName\x12C\n" +
+	"\tlast_name\x18\x06 \x01(\tB&\x8a\xb5\x18\"\n" +
+	"\tlast_name*\x15required,min=2,max=50R\blastName\x12M\n" +
+	"\n" +
+	"birth_date\x18\a \x01(\tB.\x8a\xb5\x18*\n" +
+	"\n" +
+	"birth_date*\x1crequired,datetime=2006-01-02R\tbirthDate\x12:\n" +
+	"\x05phone\x18\b \x01(\tB$\x8a\xb5\x18 \n" +
+	"\x05phone*\x17required,len=11,numericR\x05phone\x12O\n" +
+	"\x06gender\x18\t \x01(\tB7\x8a\xb5\x183\n" +
+	"\x06gender*)oneof=male female other prefer_not_to_sayR\x06gender\x12=\n" +
+	"\acountry\x18\n" +
+	" \x01(\tB#\x8a\xb5\x18\x1f\n" +
+	"\acountry*\x14required,min=2,max=2R\acountry\x124\n" +
+	"\btimezone\x18\v \x01(\tB\x18\x8a\xb5\x18\x14\n" +
+	"\btimezone*\brequiredR\btimezone\x12+\n" +
+	"\tinterests\x18\f \x03(\tB\r\x92\xb5\x18\tinterestsR\tinterests\x12\"\n" +
+	"\x06skills\x18\r \x03(\tB\n" +
+	"\x92\xb5\x18\x06skillsR\x06skills\x12e\n" +
+	"\x14newsletter_frequency\x18\x0e \x01(\tB2\x8a\xb5\x18.\n" +
+	"\n" +
+	"newsletter* oneof=never daily weekly monthlyR\x13newsletterFrequency\x12?\n" +
+	"\x10marketing_emails\x18\x0f \x01(\bB\x14\x92\xb5\x18\x10marketing_emailsR\x0fmarketingEmails\x12H\n" +
+	"\x10captcha_response\x18\x10 \x01(\tB\x1d\x8a\xb5\x18\x19\n" +
+	"\acaptcha*\x0erequired,len=6R\x0fcaptchaResponse\x120\n" +
+	"\vinvite_code\x18\x11 \x01(\tB\x0f\x92\xb5\x18\vinvite_codeR\n" +
+	"inviteCode\x12-\n" +
+	"\n" +
+	"utm_source\x18\x12 \x01(\tB\x0e\x92\xb5\x18\n" +
+	"utm_sourceR\tutmSource\x12-\n" +
+	"\n" +
+	"utm_medium\x18\x13 \x01(\tB\x0e\x92\xb5\x18\n" +
+	"utm_mediumR\tutmMedium\x123\n" +
+	"\futm_campaign\x18\x14 \x01(\tB\x10\x92\xb5\x18\futm_campaignR\vutmCampaign\x12/\n" +
+	"\freferrer_url\x18\x15 \x01(\tB\f\x92\xb5\x18\breferrerR\vreferrerUrl\"\xd3\x01\n" +
+	"\x14RegisterUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0eactivation_url\x18\x03 \x01(\tR\ractivationUrl\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12+\n" +
+	"\x11validation_errors\x18\x05 \x03(\tR\x10validationErrors\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\"\xec\f\n" +
+	"\x11CreatePostRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x12\x1f\n" +
+	"\x05draft\x18\x02 \x01(\bB\t\x92\xb5\x18\x05draftR\x05draft\x12>\n" +
+	"\x06source\x18\x03 \x01(\tB&\x8a\xb5\x18\"\n" +
+	"\x06source*\x18oneof=web mobile app apiR\x06source\x12?\n" +
+	"\x10notify_followers\x18\x04 \x01(\bB\x14\x92\xb5\x18\x10notify_followersR\x0fnotifyFollowers\x12V\n" +
+	"\rauthorization\x18\x05 \x01(\tB0\x8a\xb5\x18,\"\rAuthorization*\x1brequired,startswith=Bearer R\rauthorization\x12?\n" +
+	"\fcontent_type\x18\x06 \x01(\tB\x1c\x8a\xb5\x18\x18\"\fContent-Type*\brequiredR\vcontentType\x12-\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tB\x0e\xa2\xb5\x18\n" +
+	"User-AgentR\tuserAgent\x12;\n" +
+	"\x0eclient_version\x18\b \x01(\tB\x14\xa2\xb5\x18\x10X-Client-VersionR\rclientVersion\x12/\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tB\x10\xa2\xb5\x18\fX-Request-IDR\trequestId\x129\n" +
+	"\x05title\x18\n" +
+	" \x01(\tB#\x8a\xb5\x18\x1f\x1a\x05title*\x16required,min=5,max=200R\x05title\x12B\n" +
+	"\acontent\x18\v \x01(\tB(\x8a\xb5\x18$\x1a\acontent*\x19required,min=50,max=50000R\acontent\x120\n" +
+	"\aexcerpt\x18\f \x01(\tB\x16\x8a\xb5\x18\x12\x1a\aexcerpt*\amax=500R\aexcerpt\x124\n" +
+	"\bcategory\x18\r \x01(\tB\x18\x8a\xb5\x18\x14\x1a\bcategory*\brequiredR\bcategory\x12,\n" +
+	"\x04tags\x18\x0e \x03(\tB\x18\x8a\xb5\x18\x14\x1a\x04tags*\fmin=1,max=10R\x04tags\x12U\n" +
+	"\n" +
+	"visibility\x18\x0f \x01(\tB5\x8a\xb5\x181\x1a\n" +
+	"visibility*#required,oneof=public private draftR\n" +
+	"visibility\x12;\n" +
+	"\x0eallow_comments\x18\x10 \x01(\bB\x14\x8a\xb5\x18\x10\x1a\x0eallow_commentsR\rallowComments\x12S\n" +
+	"\n" +
+	"publish_at\x18\x11 \x01(\tB4\x8a\xb5\x180\x1a\n" +
+	"publish_at*\"datetime=2006-01-02T15:04:05Z07:00R\tpublishAt\x127\n" +
+	"\n" +
+	"meta_title\x18\x12 \x01(\tB\x18\x8a\xb5\x18\x14\x1a\n" +
+	"meta_title*\x06max=60R\tmetaTitle\x12J\n" +
+	"\x10meta_description\x18\x13 \x01(\tB\x1f\x8a\xb5\x18\x1b\x1a\x10meta_description*\amax=160R\x0fmetaDescription\x12=\n" +
+	"\fseo_keywords\x18\x14 \x03(\tB\x1a\x8a\xb5\x18\x16\x1a\fseo_keywords*\x06max=10R\vseoKeywords\x123\n" +
+	"\n" +
+	"image_urls\x18\x15 \x03(\tB\x14\x8a\xb5\x18\x10\x1a\x06images*\x06max=20R\timageUrls\x12B\n" +
+	"\x0fattachment_urls\x18\x16 \x03(\tB\x19\x8a\xb5\x18\x15\x1a\vattachments*\x06max=10R\x0eattachmentUrls\x12\x83\x01\n" +
+	"\rcustom_fields\x18\x17 \x03(\v2,.example.CreatePostRequest.CustomFieldsEntryB0\x8a\xb5\x18,\x1a\rcustom_fieldsj\x1bvalidate:post_custom_fieldsR\fcustomFields\x12F\n" +
+	"\vexternal_id\x18\x18 \x01(\tB%\x8a\xb5\x18!\x1a\vexternal_idj\x12external_id_formatR\n" +
+	"externalId\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd6\x01\n" +
+	"\x12CreatePostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.example.PostR\x04post\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bedit_url\x18\x03 \x01(\tR\aeditUrl\x12\x1f\n" +
+	"\vpreview_url\x18\x04 \x01(\tR\n" +
+	"previewUrl\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x12+\n" +
+	"\x11requires_approval\x18\x06 \x01(\bR\x10requiresApproval\"\xaa\t\n" +
+	"\x11UpdateUserRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x12B\n" +
+	"\x11send_notification\x18\x02 \x01(\bB\x15\x92\xb5\x18\x11send_notificationR\x10sendNotification\x12/\n" +
+	"\rupdate_reason\x18\x03 \x01(\tB\n" +
+	"\x92\xb5\x18\x06reasonR\fupdateReason\x12'\n" +
+	"\bif_match\x18\x04 \x01(\tB\f\xa2\xb5\x18\bIf-MatchR\aifMatch\x12C\n" +
+	"\rauthorization\x18\x05 \x01(\tB\x1d\x8a\xb5\x18\x19\"\rAuthorization*\brequiredR\rauthorization\x12A\n" +
+	"\busername\x18\x06 \x01(\tB%\x8a\xb5\x18!\x1a\busername*\x15required,min=3,max=50R\busername\x121\n" +
+	"\x05email\x18\a \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x05email*\x0erequired,emailR\x05email\x12D\n" +
+	"\tfull_name\x18\b \x01(\tB'\x8a\xb5\x18#\x1a\tfull_name*\x16required,min=2,max=100R\bfullName\x121\n" +
+	"\x05phone\x18\t \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x05phone*\x0elen=11,numericR\x05phone\x12+\n" +
+	"\x03age\x18\n" +
+	" \x01(\x05B\x19\x8a\xb5\x18\x15\x1a\x03age*\x0emin=13,max=120R\x03age\x12%\n" +
+	"\x03bio\x18\v \x01(\tB\x13\x8a\xb5\x18\x0f\x1a\x03bio*\bmax=1000R\x03bio\x12U\n" +
+	"\x06status\x18\f \x01(\tB=\x8a\xb5\x189\x1a\x06status*/required,oneof=active inactive suspended bannedR\x06status\x12(\n" +
+	"\x05roles\x18\r \x03(\tB\x12\x8a\xb5\x18\x0e\x1a\x05roles*\x05min=1R\x05roles\x129\n" +
+	"\aaddress\x18\x0e \x01(\v2\x10.example.AddressB\r\x8a\xb5\x18\t\x1a\aaddressR\aaddress\x12b\n" +
+	"\fsocial_links\x18\x0f \x03(\v2+.example.UpdateUserRequest.SocialLinksEntryB\x12\x8a\xb5\x18\x0e\x1a\fsocial_linksR\vsocialLinks\x12A\n" +
+	"\bsettings\x18\x10 \x01(\v2\x15.example.UserSettingsB\x0e\x8a\xb5\x18\n" +
+	"\x1a\bsettingsR\bsettings\x12\\\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\tB=\x8a\xb5\x189\x1a\n" +
+	"updated_at*+required,datetime=2006-01-02T15:04:05Z07:00R\tupdatedAt\x127\n" +
+	"\aversion\x18\x12 \x01(\x05B\x1d\x8a\xb5\x18\x19\x1a\aversion*\x0erequired,min=1R\aversion\x1a>\n" +
+	"\x10SocialLinksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x12UpdateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\x1bemail_verification_required\x18\x03 \x01(\bR\x19emailVerificationRequired\x12)\n" +
+	"\x10verification_url\x18\x04 \x01(\tR\x0fverificationUrl\x12%\n" +
+	"\x0eupdated_fields\x18\x05 \x03(\tR\rupdatedFields\"\xaa\x01\n" +
+	"\x14UpdateProfileRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x124\n" +
+	"\aprofile\x18\x02 \x01(\v2\x14.example.UserProfileB\x04\xe2A\x01\x02R\aprofile\x12%\n" +
+	"\vupdate_time\x18\x03 \x01(\tB\x04\xe2A\x01\x03R\n" +
+	"updateTime\"x\n" +
+	"\x13UploadAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x06avatar\x18\x02 \x01(\fB\x16\x8a\xb5\x18\x12*\brequiredb\x06avatarR\x06avatar\x12\x18\n" +
+	"\acaption\x18\x03 \x01(\tR\acaption\"<\n" +
+	"\x14UploadAvatarResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\x88\x01\n" +
+	"\x15UpdateProfileResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.example.UserProfileR\aprofile\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0eupdated_fields\x18\x03 \x03(\tR\rupdatedFields\"\xc5\f\n" +
+	"\x10PatchUserRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x12'\n" +
+	"\bif_match\x18\x02 \x01(\tB\f\xa2\xb5\x18\bIf-MatchR\aifMatch\x12G\n" +
+	"\x13if_unmodified_since\x18\x03 \x01(\tB\x17\xa2\xb5\x18\x13If-Unmodified-SinceR\x11ifUnmodifiedSince\x12C\n" +
+	"\rauthorization\x18\x04 \x01(\tB\x1d\x8a\xb5\x18\x19\"\rAuthorization*\brequiredR\rauthorization\x125\n" +
+	"\fpatch_source\x18\x05 \x01(\tB\x12\xa2\xb5\x18\x0eX-Patch-SourceR\vpatchSource\x128\n" +
+	"\busername\x18\x06 \x01(\tB\x1c\x8a\xb5\x18\x18\x1a\busername*\fmin=3,max=50R\busername\x12(\n" +
+	"\x05email\x18\a \x01(\tB\x12\x8a\xb5\x18\x0e\x1a\x05email*\x05emailR\x05email\x12;\n" +
+	"\tfull_name\x18\b \x01(\tB\x1e\x8a\xb5\x18\x1a\x1a\tfull_name*\rmin=2,max=100R\bfullName\x121\n" +
+	"\x05phone\x18\t \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\x05phone*\x0elen=11,numericR\x05phone\x12%\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tB\x13\x8a\xb5\x18\x0f\x1a\x03bio*\bmax=1000R\x03bio\x12E\n" +
+	"\x06status\x18\v \x01(\tB-\x8a\xb5\x18)\x1a\x06status*\x1foneof=active inactive suspendedR\x06status\x12m\n" +
+	"\x0fprofile_patches\x18\f \x03(\v2-.example.PatchUserRequest.ProfilePatchesEntryB\x15\x8a\xb5\x18\x11\x1a\x0fprofile_patchesR\x0eprofilePatches\x12q\n" +
+	"\x10settings_patches\x18\r \x03(\v2..example.PatchUserRequest.SettingsPatchesEntryB\x16\x8a\xb5\x18\x12\x1a\x10settings_patchesR\x0fsettingsPatches\x12m\n" +
+	"\x0faddress_patches\x18\x0e \x03(\v2-.example.PatchUserRequest.AddressPatchesEntryB\x15\x8a\xb5\x18\x11\x1a\x0faddress_patchesR\x0eaddressPatches\x12,\n" +
+	"\tadd_roles\x18\x0f \x03(\tB\x0f\x8a\xb5\x18\v\x1a\tadd_rolesR\baddRoles\x125\n" +
+	"\fremove_roles\x18\x10 \x03(\tB\x12\x8a\xb5\x18\x0e\x1a\fremove_rolesR\vremoveRoles\x12)\n" +
+	"\badd_tags\x18\x11 \x03(\tB\x0e\x8a\xb5\x18\n" +
+	"\x1a\badd_tagsR\aaddTags\x122\n" +
+	"\vremove_tags\x18\x12 \x03(\tB\x11\x8a\xb5\x18\r\x1a\vremove_tagsR\n" +
+	"removeTags\x12>\n" +
+	"\fpatch_reason\x18\x13 \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\fpatch_reason*\amax=200R\vpatchReason\x12i\n" +
+	"\x0epatch_metadata\x18\x14 \x03(\v2,.example.PatchUserRequest.PatchMetadataEntryB\x14\x8a\xb5\x18\x10\x1a\x0epatch_metadataR\rpatchMetadata\x1aA\n" +
+	"\x13ProfilePatchesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14SettingsPatchesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13AddressPatchesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12PatchMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc2\x01\n" +
+	"\x11PatchUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user\x12%\n" +
+	"\x0epatched_fields\x18\x02 \x03(\tR\rpatchedFields\x12-\n" +
+	"\x12applied_operations\x18\x03 \x03(\tR\x11appliedOperations\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\"\xfc\x03\n" +
+	"\x11DeleteUserRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x120\n" +
+	"\vhard_delete\x18\x02 \x01(\bB\x0f\x92\xb5\x18\vhard_deleteR\n" +
+	"hardDelete\x12:\n" +
+	"\rdelete_reason\x18\x03 \x01(\tB\x15\x8a\xb5\x18\x11\n" +
+	"\x06reason*\amax=500R\fdeleteReason\x126\n" +
+	"\rtransfer_data\x18\x04 \x01(\bB\x11\x92\xb5\x18\rtransfer_dataR\ftransferData\x12A\n" +
+	"\x10transfer_to_user\x18\x05 \x01(\tB\x17\x8a\xb5\x18\x13\n" +
+	"\vtransfer_to*\x04uuidR\x0etransferToUser\x12N\n" +
+	"\fconfirmation\x18\x06 \x01(\tB*\x8a\xb5\x18&\"\x10X-Confirm-Delete*\x12required,eq=DELETER\fconfirmation\x12C\n" +
+	"\rauthorization\x18\a \x01(\tB\x1d\x8a\xb5\x18\x19\"\rAuthorization*\brequiredR\rauthorization\x122\n" +
+	"\vadmin_token\x18\b \x01(\tB\x11\xa2\xb5\x18\rX-Admin-TokenR\n" +
+	"adminToken\"\xe4\x01\n" +
+	"\x12DeleteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\tR\tdeletedAt\x12%\n" +
+	"\x0eis_recoverable\x18\x04 \x01(\bR\risRecoverable\x12+\n" +
+	"\x11recovery_deadline\x18\x05 \x01(\tR\x10recoveryDeadline\x12'\n" +
+	"\x0fbackup_location\x18\x06 \x01(\tR\x0ebackupLocation\"\x8b\x03\n" +
+	"\x17BatchDeleteUsersRequest\x12A\n" +
+	"\buser_ids\x18\x01 \x03(\tB&\x8a\xb5\x18\"\n" +
+	"\buser_ids*\x16required,min=1,max=100R\auserIds\x120\n" +
+	"\vhard_delete\x18\x02 \x01(\bB\x0f\x92\xb5\x18\vhard_deleteR\n" +
+	"hardDelete\x12/\n" +
+	"\rdelete_reason\x18\x03 \x01(\tB\n" +
+	"\x92\xb5\x18\x06reasonR\fdeleteReason\x12N\n" +
+	"\x12batch_confirmation\x18\x04 \x01(\tB\x1f\x8a\xb5\x18\x1b\"\x0fX-Batch-Confirm*\brequiredR\x11batchConfirmation\x12C\n" +
+	"\rauthorization\x18\x05 \x01(\tB\x1d\x8a\xb5\x18\x19\"\rAuthorization*\brequiredR\rauthorization\x125\n" +
+	"\foperation_id\x18\x06 \x01(\tB\x12\xa2\xb5\x18\x0eX-Operation-IDR\voperationId\"\xb5\x02\n" +
+	"\x18BatchDeleteUsersResponse\x12'\n" +
+	"\x0ftotal_requested\x18\x01 \x01(\x05R\x0etotalRequested\x121\n" +
+	"\x14successfully_deleted\x18\x02 \x01(\x05R\x13successfullyDeleted\x12)\n" +
+	"\x10failed_deletions\x18\x03 \x01(\x05R\x0ffailedDeletions\x12(\n" +
+	"\x10deleted_user_ids\x18\x04 \x03(\tR\x0edeletedUserIds\x12+\n" +
+	"\x06errors\x18\x05 \x03(\v2\x13.example.BatchErrorR\x06errors\x12!\n" +
+	"\foperation_id\x18\x06 \x01(\tR\voperationId\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xad\x06\n" +
+	"\x16GetPostCommentsRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x125\n" +
+	"\apost_id\x18\x02 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\apost_id*\rrequired,uuidR\x06postId\x12%\n" +
+	"\x04page\x18\x03 \x01(\x05B\x11\x8a\xb5\x18\r\n" +
+	"\x04page*\x05min=1R\x04page\x128\n" +
+	"\bper_page\x18\x04 \x01(\x05B\x1d\x8a\xb5\x18\x19\n" +
+	"\bper_page*\rmin=1,max=100R\aperPage\x12I\n" +
+	"\x04sort\x18\x05 \x01(\tB5\x8a\xb5\x181\n" +
+	"\x04sort*)oneof=created_at updated_at likes repliesR\x04sort\x121\n" +
+	"\x05order\x18\x06 \x01(\tB\x1b\x8a\xb5\x18\x17\n" +
+	"\x05order*\x0eoneof=asc descR\x05order\x12H\n" +
+	"\x06status\x18\a \x01(\tB0\x8a\xb5\x18,\n" +
+	"\x06status*\"oneof=all published hidden deletedR\x06status\x12<\n" +
+	"\x0finclude_replies\x18\b \x01(\bB\x13\x92\xb5\x18\x0finclude_repliesR\x0eincludeReplies\x129\n" +
+	"\x0einclude_hidden\x18\t \x01(\bB\x12\x92\xb5\x18\x0einclude_hiddenR\rincludeHidden\x12E\n" +
+	"\x05since\x18\n" +
+	" \x01(\tB/\x8a\xb5\x18+\n" +
+	"\x05since*\"datetime=2006-01-02T15:04:05Z07:00R\x05since\x12E\n" +
+	"\x05until\x18\v \x01(\tB/\x8a\xb5\x18+\n" +
+	"\x05until*\"datetime=2006-01-02T15:04:05Z07:00R\x05until\x125\n" +
+	"\fuser_context\x18\f \x01(\tB\x12\xa2\xb5\x18\x0eX-User-ContextR\vuserContext\x12>\n" +
+	"\x0fclient_timezone\x18\r \x01(\tB\x15\xa2\xb5\x18\x11X-Client-TimezoneR\x0eclientTimezone\"\xdf\x01\n" +
+	"\x17GetPostCommentsResponse\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.example.CommentR\bcomments\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\x12+\n" +
+	"\x05stats\x18\x06 \x01(\v2\x15.example.CommentStatsR\x05stats\"\xea\x03\n" +
+	"\x15GetUserProfileRequest\x125\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1c\x8a\xb5\x18\x18\x12\auser_id*\rrequired,uuidR\x06userId\x12(\n" +
+	"\bsections\x18\x02 \x03(\tB\f\x92\xb5\x18\bsectionsR\bsections\x126\n" +
+	"\rinclude_stats\x18\x03 \x01(\bB\x11\x92\xb5\x18\rinclude_statsR\fincludeStats\x126\n" +
+	"\rinclude_posts\x18\x04 \x01(\bB\x11\x92\xb5\x18\rinclude_postsR\fincludePosts\x12B\n" +
+	"\x11include_followers\x18\x05 \x01(\bB\x15\x92\xb5\x18\x11include_followersR\x10includeFollowers\x12W\n" +
+	"\x0eviewer_context\x18\x06 \x01(\tB0\x8a\xb5\x18,\n" +
+	"\acontext*!oneof=public friend follower selfR\rviewerContext\x12,\n" +
+	"\tviewer_id\x18\a \x01(\tB\x0f\xa2\xb5\x18\vX-Viewer-IDR\bviewerId\x125\n" +
+	"\faccess_token\x18\b \x01(\tB\x12\xa2\xb5\x18\x0eX-Access-TokenR\vaccessToken\"\xe7\x02\n" +
+	"\x16GetUserProfileResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.example.UserR\x04user\x12.\n" +
+	"\aprofile\x18\x02 \x01(\v2\x14.example.UserProfileR\aprofile\x12(\n" +
+	"\x05stats\x18\x03 \x01(\v2\x12.example.UserStatsR\x05stats\x120\n" +
+	"\frecent_posts\x18\x04 \x03(\v2\r.example.PostR\vrecentPosts\x12+\n" +
+	"\tfollowers\x18\x05 \x03(\v2\r.example.UserR\tfollowers\x12!\n" +
+	"\fis_following\x18\x06 \x01(\bR\visFollowing\x12\x1f\n" +
+	"\vcan_message\x18\a \x01(\bR\n" +
+	"canMessage\x12-\n" +
+	"\x12profile_visibility\x18\b \x01(\tR\x11profileVisibility\"\xe2\x05\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x124\n" +
+	"\x05email\x18\x03 \x01(\tB\x1e\x82\xc8\x18\x1a\n" +
+	"\x06public\n" +
+	"\apartner\x12\apartialR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\x1d\n" +
+	"\x05phone\x18\x05 \x01(\tB\a\xea\xc7\x18\x03piiR\x05phone\x12\x10\n" +
+	"\x03age\x18\x06 \x01(\x05R\x03age\x12\x16\n" +
+	"\x06gender\x18\a \x01(\tR\x06gender\x12\x10\n" +
+	"\x03bio\x18\b \x01(\tR\x03bio\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x14\n" +
+	"\x05roles\x18\n" +
+	" \x03(\tR\x05roles\x128\n" +
+	"\aaddress\x18\v \x01(\v2\x10.example.AddressB\f\x82\xc8\x18\b\n" +
+	"\x06publicR\aaddress\x12.\n" +
+	"\aprofile\x18\f \x01(\v2\x14.example.UserProfileR\aprofile\x121\n" +
+	"\bsettings\x18\r \x01(\v2\x15.example.UserSettingsR\bsettings\x12A\n" +
+	"\fsocial_links\x18\x0e \x03(\v2\x1e.example.User.SocialLinksEntryR\vsocialLinks\x12\x18\n" +
+	"\ahobbies\x18\x0f \x03(\tR\ahobbies\x12\x1c\n" +
+	"\tlanguages\x18\x10 \x03(\tR\tlanguages\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\tR\tupdatedAt\x12\"\n" +
+	"\rlast_login_at\x18\x13 \x01(\tR\vlastLoginAt\x12\x18\n" +
+	"\aversion\x18\x14 \x01(\x05R\aversion\x1a>\n" +
+	"\x10SocialLinksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb9\x04\n" +
+	"\vUserProfile\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\x12&\n" +
+	"\x0fcover_image_url\x18\x03 \x01(\tR\rcoverImageUrl\x12\x18\n" +
+	"\awebsite\x18\x04 \x01(\tR\awebsite\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x06 \x01(\tR\tbirthDate\x12\x1e\n" +
+	"\n" +
+	"occupation\x18\a \x01(\tR\n" +
+	"occupation\x12\x18\n" +
+	"\acompany\x18\b \x01(\tR\acompany\x12\x1c\n" +
+	"\teducation\x18\t \x01(\tR\teducation\x12\x1c\n" +
+	"\tinterests\x18\n" +
+	" \x03(\tR\tinterests\x12\x16\n" +
+	"\x06skills\x18\v \x03(\tR\x06skills\x12H\n" +
+	"\fcontact_info\x18\f \x03(\v2%.example.UserProfile.ContactInfoEntryR\vcontactInfo\x12\x1b\n" +
+	"\tis_public\x18\r \x01(\bR\bisPublic\x12\x1a\n" +
+	"\bverified\x18\x0e \x01(\bR\bverified\x12+\n" +
+	"\x11verification_type\x18\x0f \x01(\tR\x10verificationType\x1a>\n" +
+	"\x10ContactInfoEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x05\n" +
+	"\fUserSettings\x12/\n" +
+	"\x13email_notifications\x18\x01 \x01(\bR\x12emailNotifications\x12-\n" +
+	"\x12push_notifications\x18\x02 \x01(\bR\x11pushNotifications\x12+\n" +
+	"\x11sms_notifications\x18\x03 \x01(\bR\x10smsNotifications\x12\x14\n" +
+	"\x05theme\x18\x04 \x01(\tR\x05theme\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x1f\n" +
+	"\vdate_format\x18\a \x01(\tR\n" +
+	"dateFormat\x12\x1f\n" +
+	"\vtime_format\x18\b \x01(\tR\n" +
+	"timeFormat\x12,\n" +
+	"\x12two_factor_enabled\x18\t \x01(\bR\x10twoFactorEnabled\x12#\n" +
+	"\rprivacy_level\x18\n" +
+	" \x01(\tR\fprivacyLevel\x12,\n" +
+	"\x12show_online_status\x18\v \x01(\bR\x10showOnlineStatus\x12%\n" +
+	"\x0eallow_messages\x18\f \x01(\bR\rallowMessages\x12H\n" +
+	"\vpreferences\x18\r \x03(\v2&.example.UserSettings.PreferencesEntryR\vpreferences\x12#\n" +
+	"\rblocked_users\x18\x0e \x03(\tR\fblockedUsers\x12%\n" +
+	"\x0emuted_keywords\x18\x0f \x03(\tR\rmutedKeywords\x1a>\n" +
+	"\x10PreferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x04\n" +
+	"\aAddress\x12.\n" +
+	"\x06street\x18\x01 \x01(\tB\x16\x8a\xb5\x18\x12\x1a\x06street*\brequiredR\x06street\x12'\n" +
+	"\astreet2\x18\x02 \x01(\tB\r\x8a\xb5\x18\t\x1a\astreet2R\astreet2\x12(\n" +
+	"\x04city\x18\x03 \x01(\tB\x14\x8a\xb5\x18\x10\x1a\x04city*\brequiredR\x04city\x12+\n" +
+	"\x05state\x18\x04 \x01(\tB\x15\x8a\xb5\x18\x11\x1a\x05state*\brequiredR\x05state\x121\n" +
+	"\acountry\x18\x05 \x01(\tB\x17\x8a\xb5\x18\x13\x1a\acountry*\brequiredR\acountry\x12<\n" +
+	"\vpostal_code\x18\x06 \x01(\tB\x1b\x8a\xb5\x18\x17\x1a\vpostal_code*\brequiredR\n" +
+	"postalCode\x12:\n" +
+	"\blatitude\x18\a \x01(\x01B\x1e\x8a\xb5\x18\x1a\x1a\blatitude*\x0emin=-90,max=90R\blatitude\x12?\n" +
+	"\tlongitude\x18\b \x01(\x01B!\x8a\xb5\x18\x1d\x1a\tlongitude*\x10min=-180,max=180R\tlongitude\x12/\n" +
+	"\n" +
+	"is_primary\x18\t \x01(\bB\x10\x8a\xb5\x18\f\x1a\n" +
+	"is_primaryR\tisPrimary\x12D\n" +
+	"\faddress_type\x18\n" +
+	" \x01(\tB!\x8a\xb5\x18\x1d\x1a\x04type*\x15oneof=home work otherR\vaddressType\"\xca\x06\n" +
+	"\x04Post\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\aexcerpt\x18\x05 \x01(\tR\aexcerpt\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\t \x01(\tR\n" +
+	"visibility\x12%\n" +
+	"\x0eallow_comments\x18\n" +
+	" \x01(\bR\rallowComments\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\v \x03(\tR\timageUrls\x12'\n" +
+	"\x0fattachment_urls\x18\f \x03(\tR\x0eattachmentUrls\x12\x1d\n" +
+	"\n" +
+	"meta_title\x18\r \x01(\tR\tmetaTitle\x12)\n" +
+	"\x10meta_description\x18\x0e \x01(\tR\x0fmetaDescription\x12!\n" +
+	"\fseo_keywords\x18\x0f \x03(\tR\vseoKeywords\x12D\n" +
+	"\rcustom_fields\x18\x10 \x03(\v2\x1f.example.Post.CustomFieldsEntryR\fcustomFields\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x11 \x01(\x05R\tviewCount\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x12 \x01(\x05R\tlikeCount\x12#\n" +
+	"\rcomment_count\x18\x13 \x01(\x05R\fcommentCount\x12\x1f\n" +
+	"\vshare_count\x18\x14 \x01(\x05R\n" +
+	"shareCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\tR\tupdatedAt\x12!\n" +
+	"\fpublished_at\x18\x17 \x01(\tR\vpublishedAt\x12\x1f\n" +
+	"\vexternal_id\x18\x18 \x01(\tR\n" +
+	"externalId\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xef\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\a \x01(\x05R\tlikeCount\x12\x1f\n" +
+	"\vreply_count\x18\b \x01(\x05R\n" +
+	"replyCount\x12\x1b\n" +
+	"\tis_pinned\x18\t \x01(\bR\bisPinned\x12\x1b\n" +
+	"\tis_edited\x18\n" +
+	" \x01(\bR\bisEdited\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tedited_at\x18\r \x01(\tR\beditedAt\"\xb1\x02\n" +
+	"\tUserStats\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x01 \x01(\x05R\tpostCount\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\x12'\n" +
+	"\x0ffollowing_count\x18\x03 \x01(\x05R\x0efollowingCount\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x04 \x01(\x05R\tlikeCount\x12#\n" +
+	"\rcomment_count\x18\x05 \x01(\x05R\fcommentCount\x12'\n" +
+	"\x0fengagement_rate\x18\x06 \x01(\x01R\x0eengagementRate\x12#\n" +
+	"\rlast_activity\x18\a \x01(\tR\flastActivity\x12#\n" +
+	"\rprofile_views\x18\b \x01(\x05R\fprofileViews\"\xfe\x01\n" +
+	"\fCommentStats\x12%\n" +
+	"\x0etotal_comments\x18\x01 \x01(\x05R\rtotalComments\x12-\n" +
+	"\x12published_comments\x18\x02 \x01(\x05R\x11publishedComments\x12'\n" +
+	"\x0fhidden_comments\x18\x03 \x01(\x05R\x0ehiddenComments\x12#\n" +
+	"\rtotal_replies\x18\x04 \x01(\x05R\ftotalReplies\x12%\n" +
+	"\x0eaverage_rating\x18\x05 \x01(\x01R\raverageRating\x12#\n" +
+	"\rflagged_count\x18\x06 \x01(\x05R\fflaggedCount\"\xd8\x01\n" +
+	"\n" +
+	"BatchError\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12:\n" +
+	"\adetails\x18\x04 \x03(\v2 .example.BatchError.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xe1\x01\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12y\n" +
+	"\x0eUSER_NOT_FOUND\x10\x01\x1ae\xaa\xd4\x18a\b\x94\x03\x12\x11user %s not found\x1a.\n" +
+	"\x02ja\x12(ユーザー %s が見つかりません\x1a\x19\n" +
+	"\x02zh\x12\x13用户 %s 不存在\x122\n" +
+	"\vEMAIL_TAKEN\x10\x02\x1a!\xaa\xd4\x18\x1d\b\x99\x03\x12\x18email already registered\x1a\x05\x88\xce\x18\xf4\x032\xe9\x11\n" +
+	"\x16CompleteExampleService\x12\xb8\x01\n" +
+	"\tListUsers\x12\x19.example.ListUsersRequest\x1a\x1a.example.ListUsersResponse\"t»\x18\x05200msʻ\x18\x13\n" +
+	"\rX-Api-Version\x12\x02v1ʻ\x18\x1c\n" +
+	"\rX-Total-Count\x1a\vtotal_count\x82\xbc\x18\x18分页查询用户列表\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x90\x02\x01\x12u\n" +
+	"\vExportUsers\x12\x19.example.ListUsersRequest\x1a\x1a.example.ListUsersResponse\"/\xba\xbb\x18\x0f\n" +
+	"\x05users\x12\x06ndjson\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/users/export\x12[\n" +
+	"\n" +
+	"WatchUsers\x12\x1a.example.WatchUsersRequest\x1a\x12.example.UserEvent\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/users/watch0\x01\x12[\n" +
+	"\rChatWithUsers\x12\x14.example.ChatMessage\x1a\x14.example.ChatMessage\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/chat(\x010\x01\x12\xa1\x01\n" +
+	"\aGetUser\x12\x17.example.GetUserRequest\x1a\x18.example.GetUserResponse\"c»\x18\x0450msڻ\x18\"\t+\x87\x16\xd9\xce\xf7\xef?\x12\x05100ms\x19\xaeG\xe1z\x14\xae\xef?!\xcd\xcc\xcc\xcc\xcc\xcc,@\x9a\xbc\x18\x022s\xa2\xbc\x18\t\n" +
+	"\x0330s\x10\x01 \x01\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/users/{user_id}\x90\x02\x01\x12f\n" +
+	"\vSearchUsers\x12\x1b.example.SearchUsersRequest\x1a\x1c.example.SearchUsersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/users/search\x12\x80\x01\n" +
+	"\n" +
+	"CreateUser\x12\x1a.example.CreateUserRequest\x1a\x1b.example.CreateUserResponse\"9\xb2\xbb\x18\vusers.write\xf0\xbb\x18\xc9\x01\xfa\xbb\x18\tListUsers\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/users\x12n\n" +
+	"\fRegisterUser\x12\x1c.example.RegisterUserRequest\x1a\x1d.example.RegisterUserResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/users/register\x12t\n" +
+	"\n" +
+	"CreatePost\x12\x1a.example.CreatePostRequest\x1a\x1b.example.CreatePostResponse\"-\xf0\xbb\x18\xc9\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/users/{user_id}/posts\x12\x94\x01\n" +
+	"\n" +
+	"UpdateUser\x12\x1a.example.UpdateUserRequest\x1a\x1b.example.UpdateUserResponse\"M\xb2\xbb\x18\vusers.write\xe8\xbb\x18\x01\xfa\xbb\x18\tListUsers\xfa\xbb\x18\aGetUser\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/users/{user_id}\x12\x80\x01\n" +
+	"\rUpdateProfile\x12\x1d.example.UpdateProfileRequest\x1a\x1e.example.UpdateProfileResponse\"0\x82\xd3\xe4\x93\x02*:\aprofile\x1a\x1f/api/v1/users/{user_id}/profile\x12\x8d\x01\n" +
+	"\fUploadAvatar\x12\x1c.example.UploadAvatarRequest\x1a\x1d.example.UploadAvatarResponse\"@\x92\xbc\x18\x13multipart/form-data\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/users/{user_id}/avatar\x12f\n" +
+	"\tPatchUser\x12\x19.example.PatchUserRequest\x1a\x1a.example.PatchUserResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/api/v1/users/{user_id}\x12\x96\x01\n" +
+	"\n" +
+	"DeleteUser\x12\x1a.example.DeleteUserRequest\x1a\x1b.example.DeleteUserResponse\"O\xaa\xbb\x18\x05admin\xb2\xbb\x18\vusers.admin\xfa\xbb\x18\tListUsers\xfa\xbb\x18\aGetUser\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/users/{user_id}\x12\x92\x01\n" +
+	"\x10BatchDeleteUsers\x12 .example.BatchDeleteUsersRequest\x1a!.example.BatchDeleteUsersResponse\"9\xaa\xbb\x18\x05admin\xb2\xbb\x18\vusers.adminһ\x18\binternal\x82\xd3\xe4\x93\x02\x0f*\r/api/v1/users\x12\x8e\x01\n" +
+	"\x0fGetPostComments\x12\x1f.example.GetPostCommentsRequest\x1a .example.GetPostCommentsResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/users/{user_id}/posts/{post_id}/comments\x12\x9c\x01\n" +
+	"\x0eGetUserProfile\x12\x1e.example.GetUserProfileRequest\x1a\x1f.example.GetUserProfileResponse\"I\xe0\xbb\x18\x01\x82\xd3\xe4\x93\x02?Z\x1c\x12\x1a/api/v1/profiles/{user_id}\x12\x1f/api/v1/users/{user_id}/profileB+Z)github.com/go-kenka/ginpb/example/api;apib\x06proto3"
 
 var (
 	file_complete_example_proto_rawDescOnce sync.Once
-	file_complete_example_proto_rawDescData = file_complete_example_proto_rawDesc
+	file_complete_example_proto_rawDescData []byte
 )
 
 func file_complete_example_proto_rawDescGZIP() []byte {
 	file_complete_example_proto_rawDescOnce.Do(func() {
-		file_complete_example_proto_rawDescData = protoimpl.X.CompressGZIP(file_complete_example_proto_rawDescData)
+		file_complete_example_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_complete_example_proto_rawDesc), len(file_complete_example_proto_rawDesc)))
 	})
 	return file_complete_example_proto_rawDescData
 }
 
-var file_complete_example_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_complete_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_complete_example_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_complete_example_proto_goTypes = []any{
-	(*ListUsersRequest)(nil),         // 0: example.ListUsersRequest
-	(*ListUsersResponse)(nil),        // 1: example.ListUsersResponse
-	(*GetUserRequest)(nil),           // 2: example.GetUserRequest
-	(*GetUserResponse)(nil),          // 3: example.GetUserResponse
-	(*SearchUsersRequest)(nil),       // 4: example.SearchUsersRequest
-	(*SearchUsersResponse)(nil),      // 5: example.SearchUsersResponse
-	(*CreateUserRequest)(nil),        // 6: example.CreateUserRequest
-	(*CreateUserResponse)(nil),       // 7: example.CreateUserResponse
-	(*RegisterUserRequest)(nil),      // 8: example.RegisterUserRequest
-	(*RegisterUserResponse)(nil),     // 9: example.RegisterUserResponse
-	(*CreatePostRequest)(nil),        // 10: example.CreatePostRequest
-	(*CreatePostResponse)(nil),       // 11: example.CreatePostResponse
-	(*UpdateUserRequest)(nil),        // 12: example.UpdateUserRequest
-	(*UpdateUserResponse)(nil),       // 13: example.UpdateUserResponse
-	(*UpdateProfileRequest)(nil),     // 14: example.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),    // 15: example.UpdateProfileResponse
-	(*PatchUserRequest)(nil),         // 16: example.PatchUserRequest
-	(*PatchUserResponse)(nil),        // 17: example.PatchUserResponse
-	(*DeleteUserRequest)(nil),        // 18: example.DeleteUserRequest
-	(*DeleteUserResponse)(nil),       // 19: example.DeleteUserResponse
-	(*BatchDeleteUsersRequest)(nil),  // 20: example.BatchDeleteUsersRequest
-	(*BatchDeleteUsersResponse)(nil), // 21: example.BatchDeleteUsersResponse
-	(*GetPostCommentsRequest)(nil),   // 22: example.GetPostCommentsRequest
-	(*GetPostCommentsResponse)(nil),  // 23: example.GetPostCommentsResponse
-	(*GetUserProfileRequest)(nil),    // 24: example.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),   // 25: example.GetUserProfileResponse
-	(*User)(nil),                     // 26: example.User
-	(*UserProfile)(nil),              // 27: example.UserProfile
-	(*UserSettings)(nil),             // 28: example.UserSettings
-	(*Address)(nil),                  // 29: example.Address
-	(*Post)(nil),                     // 30: example.Post
-	(*Comment)(nil),                  // 31: example.Comment
-	(*UserStats)(nil),                // 32: example.UserStats
-	(*CommentStats)(nil),             // 33: example.CommentStats
-	(*BatchError)(nil),               // 34: example.BatchError
-	nil,                              // 35: example.CreateUserRequest.SocialLinksEntry
-	nil,                              // 36: example.CreateUserRequest.PreferencesEntry
-	nil,                              // 37: example.CreatePostRequest.CustomFieldsEntry
-	nil,                              // 38: example.UpdateUserRequest.SocialLinksEntry
-	nil,                              // 39: example.PatchUserRequest.ProfilePatchesEntry
-	nil,                              // 40: example.PatchUserRequest.SettingsPatchesEntry
-	nil,                              // 41: example.PatchUserRequest.AddressPatchesEntry
-	nil,                              // 42: example.PatchUserRequest.PatchMetadataEntry
-	nil,                              // 43: example.User.SocialLinksEntry
-	nil,                              // 44: example.UserProfile.ContactInfoEntry
-	nil,                              // 45: example.UserSettings.PreferencesEntry
-	nil,                              // 46: example.Post.CustomFieldsEntry
-	nil,                              // 47: example.BatchError.DetailsEntry
+	(ErrorReason)(0),                 // 0: example.ErrorReason
+	(*ListUsersRequest)(nil),         // 1: example.ListUsersRequest
+	(*ListUsersResponse)(nil),        // 2: example.ListUsersResponse
+	(*WatchUsersRequest)(nil),        // 3: example.WatchUsersRequest
+	(*UserEvent)(nil),                // 4: example.UserEvent
+	(*Tag)(nil),                      // 5: example.Tag
+	(*UserCreatedEvent)(nil),         // 6: example.UserCreatedEvent
+	(*UserDeletedEvent)(nil),         // 7: example.UserDeletedEvent
+	(*ChatMessage)(nil),              // 8: example.ChatMessage
+	(*GetUserRequest)(nil),           // 9: example.GetUserRequest
+	(*GetUserResponse)(nil),          // 10: example.GetUserResponse
+	(*SearchUsersRequest)(nil),       // 11: example.SearchUsersRequest
+	(*SearchUsersResponse)(nil),      // 12: example.SearchUsersResponse
+	(*CreateUserRequest)(nil),        // 13: example.CreateUserRequest
+	(*CreateUserResponse)(nil),       // 14: example.CreateUserResponse
+	(*RegisterUserRequest)(nil),      // 15: example.RegisterUserRequest
+	(*RegisterUserResponse)(nil),     // 16: example.RegisterUserResponse
+	(*CreatePostRequest)(nil),        // 17: example.CreatePostRequest
+	(*CreatePostResponse)(nil),       // 18: example.CreatePostResponse
+	(*UpdateUserRequest)(nil),        // 19: example.UpdateUserRequest
+	(*UpdateUserResponse)(nil),       // 20: example.UpdateUserResponse
+	(*UpdateProfileRequest)(nil),     // 21: example.UpdateProfileRequest
+	(*UploadAvatarRequest)(nil),      // 22: example.UploadAvatarRequest
+	(*UploadAvatarResponse)(nil),     // 23: example.UploadAvatarResponse
+	(*UpdateProfileResponse)(nil),    // 24: example.UpdateProfileResponse
+	(*PatchUserRequest)(nil),         // 25: example.PatchUserRequest
+	(*PatchUserResponse)(nil),        // 26: example.PatchUserResponse
+	(*DeleteUserRequest)(nil),        // 27: example.DeleteUserRequest
+	(*DeleteUserResponse)(nil),       // 28: example.DeleteUserResponse
+	(*BatchDeleteUsersRequest)(nil),  // 29: example.BatchDeleteUsersRequest
+	(*BatchDeleteUsersResponse)(nil), // 30: example.BatchDeleteUsersResponse
+	(*GetPostCommentsRequest)(nil),   // 31: example.GetPostCommentsRequest
+	(*GetPostCommentsResponse)(nil),  // 32: example.GetPostCommentsResponse
+	(*GetUserProfileRequest)(nil),    // 33: example.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),   // 34: example.GetUserProfileResponse
+	(*User)(nil),                     // 35: example.User
+	(*UserProfile)(nil),              // 36: example.UserProfile
+	(*UserSettings)(nil),             // 37: example.UserSettings
+	(*Address)(nil),                  // 38: example.Address
+	(*Post)(nil),                     // 39: example.Post
+	(*Comment)(nil),                  // 40: example.Comment
+	(*UserStats)(nil),                // 41: example.UserStats
+	(*CommentStats)(nil),             // 42: example.CommentStats
+	(*BatchError)(nil),               // 43: example.BatchError
+	nil,                              // 44: example.CreateUserRequest.SocialLinksEntry
+	nil,                              // 45: example.CreateUserRequest.PreferencesEntry
+	nil,                              // 46: example.CreatePostRequest.CustomFieldsEntry
+	nil,                              // 47: example.UpdateUserRequest.SocialLinksEntry
+	nil,                              // 48: example.PatchUserRequest.ProfilePatchesEntry
+	nil,                              // 49: example.PatchUserRequest.SettingsPatchesEntry
+	nil,                              // 50: example.PatchUserRequest.AddressPatchesEntry
+	nil,                              // 51: example.PatchUserRequest.PatchMetadataEntry
+	nil,                              // 52: example.User.SocialLinksEntry
+	nil,                              // 53: example.UserProfile.ContactInfoEntry
+	nil,                              // 54: example.UserSettings.PreferencesEntry
+	nil,                              // 55: example.Post.CustomFieldsEntry
+	nil,                              // 56: example.BatchError.DetailsEntry
+	(*fieldmaskpb.FieldMask)(nil),    // 57: google.protobuf.FieldMask
 }
 var file_complete_example_proto_depIdxs = []int32{
-	26, // 0: example.ListUsersResponse.users:type_name -> example.User
-	26, // 1: example.GetUserResponse.user:type_name -> example.User
-	27, // 2: example.GetUserResponse.profile:type_name -> example.UserProfile
-	30, // 3: example.GetUserResponse.posts:type_name -> example.Post
-	32, // 4: example.GetUserResponse.stats:type_name -> example.UserStats
-	26, // 5: example.SearchUsersResponse.users:type_name -> example.User
-	29, // 6: example.CreateUserRequest.address:type_name -> example.Address
-	35, // 7: example.CreateUserRequest.social_links:type_name -> example.CreateUserRequest.SocialLinksEntry
-	36, // 8: example.CreateUserRequest.preferences:type_name -> example.CreateUserRequest.PreferencesEntry
-	28, // 9: example.CreateUserRequest.settings:type_name -> example.UserSettings
-	26, // 10: example.CreateUserResponse.user:type_name -> example.User
-	37, // 11: example.CreatePostRequest.custom_fields:type_name -> example.CreatePostRequest.CustomFieldsEntry
-	30, // 12: example.CreatePostResponse.post:type_name -> example.Post
-	29, // 13: example.UpdateUserRequest.address:type_name -> example.Address
-	38, // 14: example.UpdateUserRequest.social_links:type_name -> example.UpdateUserRequest.SocialLinksEntry
-	28, // 15: example.UpdateUserRequest.settings:type_name -> example.UserSettings
-	26, // 16: example.UpdateUserResponse.user:type_name -> example.User
-	27, // 17: example.UpdateProfileRequest.profile:type_name -> example.UserProfile
-	27, // 18: example.UpdateProfileResponse.profile:type_name -> example.UserProfile
-	39, // 19: example.PatchUserRequest.profile_patches:type_name -> example.PatchUserRequest.ProfilePatchesEntry
-	40, // 20: example.PatchUserRequest.settings_patches:type_name -> example.PatchUserRequest.SettingsPatchesEntry
-	41, // 21: example.PatchUserRequest.address_patches:type_name -> example.PatchUserRequest.AddressPatchesEntry
-	42, // 22: example.PatchUserRequest.patch_metadata:type_name -> example.PatchUserRequest.PatchMetadataEntry
-	26, // 23: example.PatchUserResponse.user:type_name -> example.User
-	34, // 24: example.BatchDeleteUsersResponse.errors:type_name -> example.BatchError
-	31, // 25: example.GetPostCommentsResponse.comments:type_name -> example.Comment
-	33, // 26: example.GetPostCommentsResponse.stats:type_name -> example.CommentStats
-	26, // 27: example.GetUserProfileResponse.user:type_name -> example.User
-	27, // 28: example.GetUserProfileResponse.profile:type_name -> example.UserProfile
-	32, // 29: example.GetUserProfileResponse.stats:type_name -> example.UserStats
-	30, // 30: example.GetUserProfileResponse.recent_posts:type_name -> example.Post
-	26, // 31: example.GetUserProfileResponse.followers:type_name -> example.User
-	29, // 32: example.User.address:type_name -> example.Address
-	27, // 33: example.User.profile:type_name -> example.UserProfile
-	28, // 34: example.User.settings:type_name -> example.UserSettings
-	43, // 35: example.User.social_links:type_name -> example.User.SocialLinksEntry
-	44, // 36: example.UserProfile.contact_info:type_name -> example.UserProfile.ContactInfoEntry
-	45, // 37: example.UserSettings.preferences:type_name -> example.UserSettings.PreferencesEntry
-	46, // 38: example.Post.custom_fields:type_name -> example.Post.CustomFieldsEntry
-	47, // 39: example.BatchError.details:type_name -> example.BatchError.DetailsEntry
-	0,  // 40: example.CompleteExampleService.ListUsers:input_type -> example.ListUsersRequest
-	2,  // 41: example.CompleteExampleService.GetUser:input_type -> example.GetUserRequest
-	4,  // 42: example.CompleteExampleService.SearchUsers:input_type -> example.SearchUsersRequest
-	6,  // 43: example.CompleteExampleService.CreateUser:input_type -> example.CreateUserRequest
-	8,  // 44: example.CompleteExampleService.RegisterUser:input_type -> example.RegisterUserRequest
-	10, // 45: example.CompleteExampleService.CreatePost:input_type -> example.CreatePostRequest
-	12, // 46: example.CompleteExampleService.UpdateUser:input_type -> example.UpdateUserRequest
-	14, // 47: example.CompleteExampleService.UpdateProfile:input_type -> example.UpdateProfileRequest
-	16, // 48: example.CompleteExampleService.PatchUser:input_type -> example.PatchUserRequest
-	18, // 49: example.CompleteExampleService.DeleteUser:input_type -> example.DeleteUserRequest
-	20, // 50: example.CompleteExampleService.BatchDeleteUsers:input_type -> example.BatchDeleteUsersRequest
-	22, // 51: example.CompleteExampleService.GetPostComments:input_type -> example.GetPostCommentsRequest
-	24, // 52: example.CompleteExampleService.GetUserProfile:input_type -> example.GetUserProfileRequest
-	1,  // 53: example.CompleteExampleService.ListUsers:output_type -> example.ListUsersResponse
-	3,  // 54: example.CompleteExampleService.GetUser:output_type -> example.GetUserResponse
-	5,  // 55: example.CompleteExampleService.SearchUsers:output_type -> example.SearchUsersResponse
-	7,  // 56: example.CompleteExampleService.CreateUser:output_type -> example.CreateUserResponse
-	9,  // 57: example.CompleteExampleService.RegisterUser:output_type -> example.RegisterUserResponse
-	11, // 58: example.CompleteExampleService.CreatePost:output_type -> example.CreatePostResponse
-	13, // 59: example.CompleteExampleService.UpdateUser:output_type -> example.UpdateUserResponse
-	15, // 60: example.CompleteExampleService.UpdateProfile:output_type -> example.UpdateProfileResponse
-	17, // 61: example.CompleteExampleService.PatchUser:output_type -> example.PatchUserResponse
-	19, // 62: example.CompleteExampleService.DeleteUser:output_type -> example.DeleteUserResponse
-	21, // 63: example.CompleteExampleService.BatchDeleteUsers:output_type -> example.BatchDeleteUsersResponse
-	23, // 64: example.CompleteExampleService.GetPostComments:output_type -> example.GetPostCommentsResponse
-	25, // 65: example.CompleteExampleService.GetUserProfile:output_type -> example.GetUserProfileResponse
-	53, // [53:66] is the sub-list for method output_type
-	40, // [40:53] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	35, // 0: example.ListUsersResponse.users:type_name -> example.User
+	35, // 1: example.UserEvent.user:type_name -> example.User
+	35, // 2: example.UserCreatedEvent.user:type_name -> example.User
+	57, // 3: example.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	35, // 4: example.GetUserResponse.user:type_name -> example.User
+	36, // 5: example.GetUserResponse.profile:type_name -> example.UserProfile
+	39, // 6: example.GetUserResponse.posts:type_name -> example.Post
+	41, // 7: example.GetUserResponse.stats:type_name -> example.UserStats
+	35, // 8: example.SearchUsersResponse.users:type_name -> example.User
+	38, // 9: example.CreateUserRequest.address:type_name -> example.Address
+	44, // 10: example.CreateUserRequest.social_links:type_name -> example.CreateUserRequest.SocialLinksEntry
+	45, // 11: example.CreateUserRequest.preferences:type_name -> example.CreateUserRequest.PreferencesEntry
+	37, // 12: example.CreateUserRequest.settings:type_name -> example.UserSettings
+	35, // 13: example.CreateUserResponse.user:type_name -> example.User
+	46, // 14: example.CreatePostRequest.custom_fields:type_name -> example.CreatePostRequest.CustomFieldsEntry
+	39, // 15: example.CreatePostResponse.post:type_name -> example.Post
+	38, // 16: example.UpdateUserRequest.address:type_name -> example.Address
+	47, // 17: example.UpdateUserRequest.social_links:type_name -> example.UpdateUserRequest.SocialLinksEntry
+	37, // 18: example.UpdateUserRequest.settings:type_name -> example.UserSettings
+	35, // 19: example.UpdateUserResponse.user:type_name -> example.User
+	36, // 20: example.UpdateProfileRequest.profile:type_name -> example.UserProfile
+	36, // 21: example.UpdateProfileResponse.profile:type_name -> example.UserProfile
+	48, // 22: example.PatchUserRequest.profile_patches:type_name -> example.PatchUserRequest.ProfilePatchesEntry
+	49, // 23: example.PatchUserRequest.settings_patches:type_name -> example.PatchUserRequest.SettingsPatchesEntry
+	50, // 24: example.PatchUserRequest.address_patches:type_name -> example.PatchUserRequest.AddressPatchesEntry
+	51, // 25: example.PatchUserRequest.patch_metadata:type_name -> example.PatchUserRequest.PatchMetadataEntry
+	35, // 26: example.PatchUserResponse.user:type_name -> example.User
+	43, // 27: example.BatchDeleteUsersResponse.errors:type_name -> example.BatchError
+	40, // 28: example.GetPostCommentsResponse.comments:type_name -> example.Comment
+	42, // 29: example.GetPostCommentsResponse.stats:type_name -> example.CommentStats
+	35, // 30: example.GetUserProfileResponse.user:type_name -> example.User
+	36, // 31: example.GetUserProfileResponse.profile:type_name -> example.UserProfile
+	41, // 32: example.GetUserProfileResponse.stats:type_name -> example.UserStats
+	39, // 33: example.GetUserProfileResponse.recent_posts:type_name -> example.Post
+	35, // 34: example.GetUserProfileResponse.followers:type_name -> example.User
+	38, // 35: example.User.address:type_name -> example.Address
+	36, // 36: example.User.profile:type_name -> example.UserProfile
+	37, // 37: example.User.settings:type_name -> example.UserSettings
+	52, // 38: example.User.social_links:type_name -> example.User.SocialLinksEntry
+	53, // 39: example.UserProfile.contact_info:type_name -> example.UserProfile.ContactInfoEntry
+	54, // 40: example.UserSettings.preferences:type_name -> example.UserSettings.PreferencesEntry
+	55, // 41: example.Post.custom_fields:type_name -> example.Post.CustomFieldsEntry
+	56, // 42: example.BatchError.details:type_name -> example.BatchError.DetailsEntry
+	1,  // 43: example.CompleteExampleService.ListUsers:input_type -> example.ListUsersRequest
+	1,  // 44: example.CompleteExampleService.ExportUsers:input_type -> example.ListUsersRequest
+	3,  // 45: example.CompleteExampleService.WatchUsers:input_type -> example.WatchUsersRequest
+	8,  // 46: example.CompleteExampleService.ChatWithUsers:input_type -> example.ChatMessage
+	9,  // 47: example.CompleteExampleService.GetUser:input_type -> example.GetUserRequest
+	11, // 48: example.CompleteExampleService.SearchUsers:input_type -> example.SearchUsersRequest
+	13, // 49: example.CompleteExampleService.CreateUser:input_type -> example.CreateUserRequest
+	15, // 50: example.CompleteExampleService.RegisterUser:input_type -> example.RegisterUserRequest
+	17, // 51: example.CompleteExampleService.CreatePost:input_type -> example.CreatePostRequest
+	19, // 52: example.CompleteExampleService.UpdateUser:input_type -> example.UpdateUserRequest
+	21, // 53: example.CompleteExampleService.UpdateProfile:input_type -> example.UpdateProfileRequest
+	22, // 54: example.CompleteExampleService.UploadAvatar:input_type -> example.UploadAvatarRequest
+	25, // 55: example.CompleteExampleService.PatchUser:input_type -> example.PatchUserRequest
+	27, // 56: example.CompleteExampleService.DeleteUser:input_type -> example.DeleteUserRequest
+	29, // 57: example.CompleteExampleService.BatchDeleteUsers:input_type -> example.BatchDeleteUsersRequest
+	31, // 58: example.CompleteExampleService.GetPostComments:input_type -> example.GetPostCommentsRequest
+	33, // 59: example.CompleteExampleService.GetUserProfile:input_type -> example.GetUserProfileRequest
+	2,  // 60: example.CompleteExampleService.ListUsers:output_type -> example.ListUsersResponse
+	2,  // 61: example.CompleteExampleService.ExportUsers:output_type -> example.ListUsersResponse
+	4,  // 62: example.CompleteExampleService.WatchUsers:output_type -> example.UserEvent
+	8,  // 63: example.CompleteExampleService.ChatWithUsers:output_type -> example.ChatMessage
+	10, // 64: example.CompleteExampleService.GetUser:output_type -> example.GetUserResponse
+	12, // 65: example.CompleteExampleService.SearchUsers:output_type -> example.SearchUsersResponse
+	14, // 66: example.CompleteExampleService.CreateUser:output_type -> example.CreateUserResponse
+	16, // 67: example.CompleteExampleService.RegisterUser:output_type -> example.RegisterUserResponse
+	18, // 68: example.CompleteExampleService.CreatePost:output_type -> example.CreatePostResponse
+	20, // 69: example.CompleteExampleService.UpdateUser:output_type -> example.UpdateUserResponse
+	24, // 70: example.CompleteExampleService.UpdateProfile:output_type -> example.UpdateProfileResponse
+	23, // 71: example.CompleteExampleService.UploadAvatar:output_type -> example.UploadAvatarResponse
+	26, // 72: example.CompleteExampleService.PatchUser:output_type -> example.PatchUserResponse
+	28, // 73: example.CompleteExampleService.DeleteUser:output_type -> example.DeleteUserResponse
+	30, // 74: example.CompleteExampleService.BatchDeleteUsers:output_type -> example.BatchDeleteUsersResponse
+	32, // 75: example.CompleteExampleService.GetPostComments:output_type -> example.GetPostCommentsResponse
+	34, // 76: example.CompleteExampleService.GetUserProfile:output_type -> example.GetUserProfileResponse
+	60, // [60:77] is the sub-list for method output_type
+	43, // [43:60] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_complete_example_proto_init() }
@@ -5556,18 +5480,18 @@ func file_complete_example_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_complete_example_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   48,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_complete_example_proto_rawDesc), len(file_complete_example_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_complete_example_proto_goTypes,
 		DependencyIndexes: file_complete_example_proto_depIdxs,
+		EnumInfos:         file_complete_example_proto_enumTypes,
 		MessageInfos:      file_complete_example_proto_msgTypes,
 	}.Build()
 	File_complete_example_proto = out.File
-	file_complete_example_proto_rawDesc = nil
 	file_complete_example_proto_goTypes = nil
 	file_complete_example_proto_depIdxs = nil
 }
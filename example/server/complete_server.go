@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
 	"github.com/go-kenka/ginpb/example/api"
 	"github.com/go-kenka/ginpb/middleware"
 )
@@ -63,6 +64,24 @@ func (s *CompleteExampleServiceImpl) ListUsers(ctx context.Context, req *api.Lis
 	}, nil
 }
 
+func (s *CompleteExampleServiceImpl) ExportUsers(ctx context.Context, req *api.ListUsersRequest, send func(*api.User) error) error {
+	fmt.Printf("ExportUsers called with: page_size=%d\n", req.PageSize)
+
+	// Stream users one by one instead of building the whole list in memory
+	for i := 1; i <= 1000; i++ {
+		user := &api.User{
+			Id:       fmt.Sprintf("user-%04d", i),
+			Username: fmt.Sprintf("user%d", i),
+			Email:    fmt.Sprintf("user%d@example.com", i),
+			Status:   "active",
+		}
+		if err := send(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *CompleteExampleServiceImpl) GetUser(ctx context.Context, req *api.GetUserRequest) (*api.GetUserResponse, error) {
 	fmt.Printf("GetUser called with user_id=%s, fields=%v\n", req.UserId, req.Fields)
 
@@ -165,6 +184,7 @@ func (s *CompleteExampleServiceImpl) CreateUser(ctx context.Context, req *api.Cr
 		Message:         "User created successfully",
 		ActivationToken: "activation-token-12345",
 		Warnings:        []string{},
+		Location:        "/api/v1/users/" + userID,
 	}, nil
 }
 
@@ -504,25 +524,34 @@ func main() {
 
 	// Create operation-specific middleware map
 	operationMiddleware := map[string][]gin.HandlerFunc{
-		"CreateUser": {
+		api.OperationCompleteExampleServiceCreateUser: {
 			middleware.BearerAuth("demo-secret-key"),
 		},
-		"UpdateUser": {
+		api.OperationCompleteExampleServiceUpdateUser: {
 			middleware.BearerAuth("demo-secret-key"),
 		},
-		"DeleteUser": {
+		api.OperationCompleteExampleServiceDeleteUser: {
 			middleware.BearerAuth("admin-secret-key"),
 		},
-		"BatchDeleteUsers": {
+		api.OperationCompleteExampleServiceBatchDeleteUsers: {
 			middleware.BearerAuth("admin-secret-key"),
 		},
 	}
 
+	// Check the scopes of the operations once the caller is authenticated
+	authorize := middleware.Authorize(api.CompleteExampleServiceOperationScopes)
+	for operation := range api.CompleteExampleServiceOperationScopes {
+		operationMiddleware[operation] = append(operationMiddleware[operation], authorize)
+	}
+
 	// Register service with operation-specific middleware using function options
 	api.RegisterCompleteExampleServiceHTTPServer(r, service,
 		api.WithCompleteExampleServiceOperationMiddlewares(operationMiddleware),
 	)
 
+	// Log registered routes with their operations
+	ginpb.DumpRoutes(r)
+
 	// Add health check endpoint
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -0,0 +1,180 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// CodeInvalidFieldMask is the error of read_mask paths that are not fields of the reply, generated handlers
+// return it before calling the service
+var CodeInvalidFieldMask = &ErrorCode{Status: http.StatusBadRequest, Reason: "INVALID_ARGUMENT", Message: "invalid read_mask: %s"}
+
+// FieldMask is the type of google.protobuf.FieldMask fields in generated gin structs, it binds the protojson
+// form "name,address.city" from JSON, queries and paths, as well as {"paths": [...]} objects
+type FieldMask struct {
+	paths []string
+}
+
+// Paths returns the bound paths
+func (f *FieldMask) Paths() []string {
+	return f.paths
+}
+
+// UnmarshalJSON accepts comma separated strings and objects with paths
+func (f *FieldMask) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		return f.UnmarshalParam(s)
+	}
+	var v struct {
+		Paths []string `json:"paths"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("invalid field mask %s: expected a string like \"name,address.city\"", b)
+	}
+	f.paths = v.Paths
+	return nil
+}
+
+// UnmarshalParam binds query and path parameters, see gin binding.BindUnmarshaler
+func (f *FieldMask) UnmarshalParam(param string) error {
+	f.paths = nil
+	for _, path := range strings.Split(param, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			f.paths = append(f.paths, path)
+		}
+	}
+	return nil
+}
+
+// FieldMaskProto converts a bound field mask, nil stays nil
+func FieldMaskProto(f *FieldMask) *fieldmaskpb.FieldMask {
+	if f == nil {
+		return nil
+	}
+	return &fieldmaskpb.FieldMask{Paths: f.paths}
+}
+
+// FieldMaskFromProto converts a field mask to its bound field mask, nil stays nil
+func FieldMaskFromProto(m *fieldmaskpb.FieldMask) *FieldMask {
+	if m == nil {
+		return nil
+	}
+	return &FieldMask{paths: m.GetPaths()}
+}
+
+// maskTree holds the selected fields of a message, a nil subtree selects the whole field
+type maskTree map[protoreflect.Name]maskTree
+
+// CheckFieldMask validates the paths of mask against the fields of m, which may be a typed nil pointer.
+// Path segments are proto or JSON field names and may descend into messages, lists and maps of messages.
+func CheckFieldMask(m proto.Message, mask *fieldmaskpb.FieldMask) error {
+	_, err := buildMaskTree(m.ProtoReflect().Descriptor(), mask.GetPaths(), true)
+	return err
+}
+
+// PruneFields clears the fields of m not selected by mask in place, so replies only carry the requested
+// paths. An empty mask or the path "*" selects everything. Paths that are not fields of m are ignored,
+// generated handlers reject them with CheckFieldMask before calling the service.
+func PruneFields(m proto.Message, mask *fieldmaskpb.FieldMask) {
+	if m == nil {
+		return
+	}
+	tree, _ := buildMaskTree(m.ProtoReflect().Descriptor(), mask.GetPaths(), false)
+	if tree != nil {
+		pruneMessage(m.ProtoReflect(), tree)
+	}
+}
+
+// PruneEach prunes every item with PruneFields, e.g. the resources of a list reply
+func PruneEach[T proto.Message](items []T, mask *fieldmaskpb.FieldMask) {
+	for _, item := range items {
+		PruneFields(item, mask)
+	}
+}
+
+// buildMaskTree returns the tree of paths, nil when every field is selected. Unknown paths are an error
+// when strict, skipped otherwise.
+func buildMaskTree(md protoreflect.MessageDescriptor, paths []string, strict bool) (maskTree, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	tree := make(maskTree)
+	for _, path := range paths {
+		if path == "*" {
+			return nil, nil
+		}
+		if err := tree.add(md, path); err != nil && strict {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// add selects path, resolving all its segments before the tree is changed
+func (t maskTree) add(md protoreflect.MessageDescriptor, path string) error {
+	var names []protoreflect.Name
+	msg := md
+	for _, segment := range strings.Split(path, ".") {
+		if msg == nil {
+			return CodeInvalidFieldMask.New(fmt.Sprintf("%q descends into %s, which is not a message", path, names[len(names)-1]))
+		}
+		fd := msg.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			fd = msg.Fields().ByJSONName(segment)
+		}
+		if fd == nil {
+			return CodeInvalidFieldMask.New(fmt.Sprintf("%q is not a field of %s", path, msg.FullName()))
+		}
+		names = append(names, fd.Name())
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		msg = fd.Message()
+	}
+
+	node := t
+	for _, name := range names[:len(names)-1] {
+		sub, seen := node[name]
+		if seen && sub == nil {
+			// The whole field is already selected by a shorter path
+			return nil
+		}
+		if sub == nil {
+			sub = make(maskTree)
+			node[name] = sub
+		}
+		node = sub
+	}
+	node[names[len(names)-1]] = nil
+	return nil
+}
+
+func pruneMessage(m protoreflect.Message, tree maskTree) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree[fd.Name()]
+		switch {
+		case !ok:
+			m.Clear(fd)
+		case sub == nil:
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+				pruneMessage(item.Message(), sub)
+				return true
+			})
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				pruneMessage(v.List().Get(i).Message(), sub)
+			}
+		default:
+			pruneMessage(v.Message(), sub)
+		}
+		return true
+	})
+}
@@ -0,0 +1,78 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+)
+
+func TestFieldMaskBinding(t *testing.T) {
+	var v struct {
+		ReadMask *FieldMask `json:"read_mask" form:"read_mask"`
+	}
+	require.NoError(t, binding.MapFormWithTag(&v, url.Values{"read_mask": {"name, methods.name"}}, "form"))
+	assert.Equal(t, []string{"name", "methods.name"}, FieldMaskProto(v.ReadMask).GetPaths())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"read_mask":"version"}`), &v))
+	assert.Equal(t, []string{"version"}, v.ReadMask.Paths())
+	require.NoError(t, json.Unmarshal([]byte(`{"read_mask":{"paths":["syntax"]}}`), &v))
+	assert.Equal(t, []string{"syntax"}, v.ReadMask.Paths())
+	assert.Error(t, json.Unmarshal([]byte(`{"read_mask":1}`), &v))
+
+	assert.Nil(t, FieldMaskProto(nil))
+	assert.Nil(t, FieldMaskFromProto(nil))
+}
+
+func TestPruneFields(t *testing.T) {
+	newAPI := func() *apipb.Api {
+		return &apipb.Api{
+			Name:          "users",
+			Version:       "v1",
+			Methods:       []*apipb.Method{{Name: "Get", RequestTypeUrl: "type.googleapis.com/GetUser"}},
+			SourceContext: &sourcecontextpb.SourceContext{FileName: "users.proto"},
+		}
+	}
+
+	// Paths select fields by proto or JSON name and descend into messages and lists of messages
+	api := newAPI()
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"name", "methods.requestTypeUrl", "source_context"}}
+	require.NoError(t, CheckFieldMask((*apipb.Api)(nil), mask))
+	PruneFields(api, mask)
+	assert.Equal(t, "users", api.Name)
+	assert.Empty(t, api.Version)
+	require.Len(t, api.Methods, 1)
+	assert.True(t, proto.Equal(&apipb.Method{RequestTypeUrl: "type.googleapis.com/GetUser"}, api.Methods[0]))
+	assert.Equal(t, "users.proto", api.SourceContext.FileName)
+
+	// A shorter path selects the whole field
+	api = newAPI()
+	PruneFields(api, &fieldmaskpb.FieldMask{Paths: []string{"methods.name", "methods"}})
+	assert.Equal(t, "type.googleapis.com/GetUser", api.Methods[0].RequestTypeUrl)
+
+	// Empty masks and * select everything
+	for _, mask := range []*fieldmaskpb.FieldMask{nil, {Paths: []string{"*"}}} {
+		api = newAPI()
+		PruneFields(api, mask)
+		assert.True(t, proto.Equal(newAPI(), api))
+	}
+
+	items := []*apipb.Api{newAPI(), newAPI()}
+	PruneEach(items, &fieldmaskpb.FieldMask{Paths: []string{"version"}})
+	for _, item := range items {
+		assert.True(t, proto.Equal(&apipb.Api{Version: "v1"}, item))
+	}
+
+	err := CheckFieldMask((*apipb.Api)(nil), &fieldmaskpb.FieldMask{Paths: []string{"methods.unknown"}})
+	assert.True(t, IsErrorReason(err, "INVALID_ARGUMENT"))
+	assert.EqualError(t, err, `invalid read_mask: "methods.unknown" is not a field of google.protobuf.Method`)
+	err = CheckFieldMask((*apipb.Api)(nil), &fieldmaskpb.FieldMask{Paths: []string{"name.first"}})
+	assert.EqualError(t, err, `invalid read_mask: "name.first" descends into name, which is not a message`)
+}
@@ -0,0 +1,252 @@
+// Package ginpbtest runs declarative HTTP fixtures against services in-process.
+package ginpbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Fixture is a request with the expected response, read from YAML:
+//
+//	name: get user
+//	request:
+//	  method: GET
+//	  path: /v1/users/1
+//	  headers: {Authorization: Bearer token}
+//	expect:
+//	  status: 200
+//	  body: {id: "1"}
+//
+// A body given as string is sent as is, other values are sent as JSON.
+// The expected body is a subset: objects may have more fields, arrays must have the same length.
+type Fixture struct {
+	Name    string  `yaml:"name"`
+	Request Request `yaml:"request"`
+	Expect  Expect  `yaml:"expect"`
+
+	// File is the fixture file, set by LoadFixtures
+	File string `yaml:"-"`
+}
+
+// Request is the request of a fixture
+type Request struct {
+	Method  string            `yaml:"method"`
+	Path    string            `yaml:"path"`
+	Headers map[string]string `yaml:"headers"`
+	Body    any               `yaml:"body"`
+}
+
+// Expect is the expected response of a fixture, zero fields are not checked
+type Expect struct {
+	Status  int               `yaml:"status"`
+	Headers map[string]string `yaml:"headers"`
+	Body    any               `yaml:"body"`
+}
+
+// LoadFixtures reads the fixtures of the files matching pattern, e.g. "testdata/*.yaml".
+// A file holds one fixture per YAML document or a list of fixtures.
+func LoadFixtures(pattern string) ([]*Fixture, error) {
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no fixture files match %q", pattern)
+	}
+	sort.Strings(files)
+
+	var fixtures []*Fixture
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		for {
+			var node yaml.Node
+			if err := dec.Decode(&node); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			var docs []*Fixture
+			if len(node.Content) > 0 && node.Content[0].Kind == yaml.SequenceNode {
+				err = node.Decode(&docs)
+			} else {
+				f := new(Fixture)
+				err = node.Decode(f)
+				docs = append(docs, f)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			for i, f := range docs {
+				f.File = file
+				if f.Name == "" {
+					f.Name = fmt.Sprintf("%s#%d", filepath.Base(file), len(fixtures)+i+1)
+				}
+				if f.Request.Method == "" || f.Request.Path == "" {
+					return nil, fmt.Errorf("%s: fixture %q needs request.method and request.path", file, f.Name)
+				}
+			}
+			fixtures = append(fixtures, docs...)
+		}
+	}
+	return fixtures, nil
+}
+
+// Run loads the fixtures matching pattern and runs each of them as subtest against h,
+// e.g. a gin engine with the generated services registered
+func Run(t *testing.T, h http.Handler, pattern string) {
+	t.Helper()
+	fixtures, err := LoadFixtures(pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			if err := f.Check(h); err != nil {
+				t.Errorf("%s: %v", f.File, err)
+			}
+		})
+	}
+}
+
+// Check sends the request of the fixture to h and compares the response with the expectation
+func (f *Fixture) Check(h http.Handler) error {
+	req, err := f.Request.build()
+	if err != nil {
+		return err
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	var problems []string
+	if f.Expect.Status != 0 && w.Code != f.Expect.Status {
+		problems = append(problems, fmt.Sprintf("status is %d, want %d", w.Code, f.Expect.Status))
+	}
+	for k, want := range f.Expect.Headers {
+		if got := w.Header().Get(k); got != want {
+			problems = append(problems, fmt.Sprintf("header %s is %q, want %q", k, got, want))
+		}
+	}
+	if f.Expect.Body != nil {
+		if err := matchBody(f.Expect.Body, w.Body.Bytes()); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s %s: %s\nresponse body: %s", f.Request.Method, f.Request.Path, strings.Join(problems, "; "), w.Body.String())
+	}
+	return nil
+}
+
+func (r Request) build() (*http.Request, error) {
+	var body io.Reader
+	contentType := ""
+	switch b := r.Body.(type) {
+	case nil:
+	case string:
+		body = strings.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("encode request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+	req := httptest.NewRequest(strings.ToUpper(r.Method), r.Path, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range r.Headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
+
+// matchBody compares a string expectation with the raw body, other expectations with the JSON body
+func matchBody(want any, body []byte) error {
+	if s, ok := want.(string); ok {
+		if got := strings.TrimSpace(string(body)); got != strings.TrimSpace(s) {
+			return fmt.Errorf("body is %q, want %q", got, s)
+		}
+		return nil
+	}
+	var got any
+	if err := json.Unmarshal(body, &got); err != nil {
+		return fmt.Errorf("body is not JSON: %w", err)
+	}
+	// Normalize the YAML values to their JSON form, e.g. ints to float64
+	data, err := json.Marshal(want)
+	if err != nil {
+		return fmt.Errorf("encode expected body: %w", err)
+	}
+	if err := json.Unmarshal(data, &want); err != nil {
+		return err
+	}
+	return subset(want, got, "body")
+}
+
+// subset reports the first difference where got does not contain want
+func subset(want, got any, path string) error {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is %s, want an object", path, describe(got))
+		}
+		keys := make([]string, 0, len(w))
+		for k := range w {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v, ok := g[k]
+			if !ok {
+				return fmt.Errorf("%s.%s is missing", path, k)
+			}
+			if err := subset(w[k], v, path+"."+k); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			return fmt.Errorf("%s is %s, want an array", path, describe(got))
+		}
+		if len(g) != len(w) {
+			return fmt.Errorf("%s has %d items, want %d", path, len(g), len(w))
+		}
+		for i := range w {
+			if err := subset(w[i], g[i], fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Errorf("%s is %s, want %s", path, describe(got), describe(want))
+	}
+	return nil
+}
+
+func describe(v any) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,62 @@
+package ginpbtest
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/v1/users/:id", func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Header("X-Tenant", "acme")
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id"), "name": "alice", "tags": []gin.H{{"name": "admin", "level": 1}}})
+	})
+	e.POST("/v1/users", func(c *gin.Context) {
+		var body map[string]any
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		body["id"] = "8"
+		c.JSON(http.StatusCreated, body)
+	})
+	return e
+}
+
+func TestRun(t *testing.T) {
+	Run(t, testEngine(), "testdata/*.yaml")
+}
+
+func TestFixtureCheck(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "fail.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+request: {method: GET, path: /v1/users/7, headers: {Authorization: x}}
+expect:
+  status: 200
+  body: {name: bob, tags: [{name: admin}, {name: ops}]}
+`), 0o644))
+
+	fixtures, err := LoadFixtures(file)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 1)
+	assert.Equal(t, "fail.yaml#1", fixtures[0].Name)
+	assert.ErrorContains(t, fixtures[0].Check(testEngine()), `body.name is "alice", want "bob"`)
+
+	fixtures[0].Expect.Body = map[string]any{"tags": []any{map[string]any{"name": "admin"}, map[string]any{"name": "ops"}}}
+	assert.ErrorContains(t, fixtures[0].Check(testEngine()), "body.tags has 1 items, want 2")
+
+	_, err = LoadFixtures(filepath.Join(dir, "*.yml"))
+	assert.ErrorContains(t, err, "no fixture files match")
+}
@@ -0,0 +1,119 @@
+package ginpb_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/binding"
+	"github.com/go-kenka/ginpb/example/api"
+)
+
+// createUserBody is a CreateUser request satisfying its binding rules
+const createUserBody = `{"username":"alice","email":"alice@example.com","password":"s3cret-password","full_name":"Alice Liddell",` +
+	`"phone":"13800138000","age":30,"gender":"female","hobbies":["go"],"agree_terms":true}`
+
+// newExampleServer serves srv with the generated handler and the key provider its encrypted fields need
+func newExampleServer(t *testing.T, srv api.CompleteExampleServiceHTTPServer, opts ...api.CompleteExampleServiceRegisterOption) *httptest.Server {
+	keys, err := ginpb.NewAESKeyProvider(map[string][]byte{"pii": []byte("0123456789abcdef0123456789abcdef")})
+	require.NoError(t, err)
+	ts := httptest.NewServer(api.NewCompleteExampleServiceHandler(srv, append(opts, api.WithCompleteExampleServiceKeyProvider(keys))...))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// postUser posts body to the CreateUser route of ts
+func postUser(t *testing.T, ts *httptest.Server, contentType, body string) *http.Response {
+	resp, err := http.Post(ts.URL+"/api/v1/users", contentType, strings.NewReader(body))
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestNewHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	srv := &api.CompleteExampleServiceHTTPServerMock{
+		CreateUserFunc: func(ctx context.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
+			if req.Username == "panic" {
+				panic("storage unavailable")
+			}
+			return &api.CreateUserResponse{User: &api.User{Username: req.Username}}, nil
+		},
+	}
+
+	ts := newExampleServer(t, srv)
+	assert.Equal(t, http.StatusCreated, postUser(t, ts, "application/json", createUserBody).StatusCode)
+
+	// Panics are recovered by the engine instead of reaching net/http
+	body := strings.Replace(createUserBody, `"alice"`, `"panic"`, 1)
+	assert.Equal(t, http.StatusInternalServerError, postUser(t, ts, "application/json", body).StatusCode)
+
+	// The engine option replaces the default middleware and runs before the routes are registered
+	ts = newExampleServer(t, srv, api.WithCompleteExampleServiceEngine(func(e *gin.Engine) {
+		e.Use(func(c *gin.Context) {
+			defer func() {
+				if recover() != nil {
+					c.AbortWithStatus(http.StatusServiceUnavailable)
+				}
+			}()
+			c.Next()
+		})
+	}))
+	assert.Equal(t, http.StatusServiceUnavailable, postUser(t, ts, "application/json", body).StatusCode)
+}
+
+func TestHandlerEncoders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	srv := &api.CompleteExampleServiceHTTPServerMock{
+		CreateUserFunc: func(ctx context.Context, req *api.CreateUserRequest) (*api.CreateUserResponse, error) {
+			if req.Username == "taken" {
+				return nil, api.ErrorEmailTaken()
+			}
+			return &api.CreateUserResponse{Message: "created"}, nil
+		},
+	}
+	var errs []error
+	ts := newExampleServer(t, srv,
+		api.WithCompleteExampleServiceErrorEncoder(func(c *gin.Context, err error) {
+			errs = append(errs, err)
+			c.JSON(http.StatusTeapot, gin.H{"error": err.Error()})
+		}),
+		api.WithCompleteExampleServiceResponseEncoder(func(c *gin.Context, status int, reply any) {
+			c.JSON(status, gin.H{"data": reply})
+		}),
+	)
+
+	// Requests failing to bind, content types the method rejects and service errors all reach the error encoder
+	resp := postUser(t, ts, "application/json", `{"username":`)
+	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+	var bindErr *binding.Error
+	require.ErrorAs(t, errs[0], &bindErr)
+	assert.Equal(t, http.StatusBadRequest, bindErr.Status)
+
+	resp, err := http.Post(ts.URL+"/api/v1/users/u1/avatar", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+	require.Len(t, errs, 2)
+	assert.True(t, ginpb.IsErrorReason(errs[1], "UNSUPPORTED_MEDIA_TYPE"), errs[1].Error())
+
+	body := strings.Replace(createUserBody, `"alice"`, `"taken"`, 1)
+	assert.Equal(t, http.StatusTeapot, postUser(t, ts, "application/json", body).StatusCode)
+	require.Len(t, errs, 3)
+	assert.True(t, api.IsEmailTaken(errs[2]))
+
+	// Replies are written by the response encoder with the status of the method
+	resp = postUser(t, ts, "application/json", createUserBody)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"message":"created"}}`, string(b))
+}
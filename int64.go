@@ -0,0 +1,76 @@
+package ginpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// Int64 is the type of int64 fields in generated gin structs, it binds JSON numbers as well as
+// strings, the protojson encoding JavaScript clients use for IDs above 2^53
+type Int64 int64
+
+// UnmarshalJSON accepts 123 and "123"
+func (v *Int64) UnmarshalJSON(b []byte) error {
+	s, ok := jsonInteger(b)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid int64 %s: %w", b, err)
+	}
+	*v = Int64(n)
+	return nil
+}
+
+// Uint64 is the type of uint64 fields in generated gin structs, it binds JSON numbers and strings like Int64
+type Uint64 uint64
+
+// UnmarshalJSON accepts 123 and "123"
+func (v *Uint64) UnmarshalJSON(b []byte) error {
+	s, ok := jsonInteger(b)
+	if !ok {
+		return nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid uint64 %s: %w", b, err)
+	}
+	*v = Uint64(n)
+	return nil
+}
+
+// jsonInteger returns the digits of a JSON number or string, ok is false for null
+func jsonInteger(b []byte) (string, bool) {
+	b = bytes.TrimSpace(b)
+	if string(b) == "null" {
+		return "", false
+	}
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		b = b[1 : len(b)-1]
+	}
+	return string(b), true
+}
+
+// convertInt64 rewrites the 64-bit integer fields of b, the JSON encoding of a md message, to strings or numbers
+func convertInt64(b []byte, md protoreflect.MessageDescriptor, toString bool) ([]byte, error) {
+	return jsonfield.Walk(b, md, func(fd protoreflect.FieldDescriptor, v any) (any, error) {
+		switch fd.Kind() {
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+			protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			if n, ok := v.(json.Number); ok && toString {
+				return n.String(), nil
+			}
+			if s, ok := v.(string); ok && !toString {
+				return json.Number(s), nil
+			}
+		}
+		return v, nil
+	})
+}
@@ -0,0 +1,52 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestRenderJSONInt64(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reply := &descriptorpb.FieldOptions{UninterpretedOption: []*descriptorpb.UninterpretedOption{{
+		PositiveIntValue: proto.Uint64(1<<53 + 1),
+		NegativeIntValue: proto.Int64(-5),
+	}}}
+
+	for naming, want := range map[JSONNaming]string{
+		JSONDefault:                        `{"uninterpreted_option":[{"positive_int_value":9007199254740993,"negative_int_value":-5}]}`,
+		JSONDefault | JSONInt64AsString:    `{"uninterpreted_option":[{"positive_int_value":"9007199254740993","negative_int_value":"-5"}]}`,
+		JSONCamelCase:                      `{"uninterpretedOption":[{"positiveIntValue":"9007199254740993","negativeIntValue":"-5"}]}`,
+		JSONCamelCase | JSONInt64AsNumber:  `{"uninterpretedOption":[{"positiveIntValue":9007199254740993,"negativeIntValue":-5}]}`,
+		JSONProtoNames | JSONInt64AsNumber: `{"uninterpreted_option":[{"positive_int_value":9007199254740993,"negative_int_value":-5}]}`,
+	} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		RenderJSON(c, 200, naming, reply)
+		assert.JSONEq(t, want, w.Body.String(), naming.String())
+	}
+}
+
+func TestInt64UnmarshalJSON(t *testing.T) {
+	var v struct {
+		ID    Int64   `json:"id"`
+		Count *Uint64 `json:"count"`
+		IDs   []Int64 `json:"ids"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"9007199254740993","count":7,"ids":[1,"-2",null]}`), &v))
+	assert.Equal(t, Int64(1<<53+1), v.ID)
+	assert.Equal(t, Uint64(7), *v.Count)
+	assert.Equal(t, []Int64{1, -2, 0}, v.IDs)
+	assert.Equal(t, []int64{1, -2, 0}, ConvertInts[int64](v.IDs))
+
+	err := json.Unmarshal([]byte(`{"id":"1x"}`), &v)
+	assert.ErrorContains(t, err, `invalid int64 "1x"`)
+	err = json.Unmarshal([]byte(`{"count":-1}`), &v)
+	assert.ErrorContains(t, err, "invalid uint64 -1")
+}
@@ -0,0 +1,81 @@
+// Package digest computes and parses the body checksum headers Content-MD5 (RFC 1864) and Digest (RFC 3230)
+package digest
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"net/http"
+	"strings"
+)
+
+// Checksum headers
+const (
+	ContentMD5Header = "Content-MD5"
+	Header           = "Digest"
+)
+
+// Supported algorithms, MD5 is sent as Content-MD5 and the others in Digest
+const (
+	MD5    = "MD5"
+	SHA256 = "SHA-256"
+	SHA512 = "SHA-512"
+)
+
+// New returns the hash of algorithm, matched case-insensitively, or nil if it is not supported
+func New(algorithm string) hash.Hash {
+	switch strings.ToUpper(algorithm) {
+	case MD5:
+		return md5.New()
+	case SHA256:
+		return sha256.New()
+	case SHA512:
+		return sha512.New()
+	}
+	return nil
+}
+
+// Sum returns the base64 encoded checksum of body, algorithm must be supported
+func Sum(algorithm string, body []byte) string {
+	h := New(algorithm)
+	h.Write(body)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Expected returns the supported checksums declared in h by upper-cased algorithm.
+// Algorithms of Digest that are not supported are ignored, as RFC 3230 requires.
+func Expected(h http.Header) map[string]string {
+	sums := make(map[string]string)
+	for _, value := range h.Values(Header) {
+		for _, part := range strings.Split(value, ",") {
+			algorithm, sum, ok := strings.Cut(strings.TrimSpace(part), "=")
+			if ok && New(algorithm) != nil {
+				sums[strings.ToUpper(algorithm)] = strings.TrimSpace(sum)
+			}
+		}
+	}
+	if sum := h.Get(ContentMD5Header); sum != "" {
+		sums[MD5] = strings.TrimSpace(sum)
+	}
+	return sums
+}
+
+// Set declares the checksums of body for algorithms in h, keeping checksums already set
+func Set(h http.Header, body []byte, algorithms []string) {
+	var digests []string
+	for _, algorithm := range algorithms {
+		algorithm = strings.ToUpper(algorithm)
+		if algorithm == MD5 {
+			if h.Get(ContentMD5Header) == "" {
+				h.Set(ContentMD5Header, Sum(MD5, body))
+			}
+			continue
+		}
+		digests = append(digests, algorithm+"="+Sum(algorithm, body))
+	}
+	if len(digests) > 0 && h.Get(Header) == "" {
+		h.Set(Header, strings.Join(digests, ","))
+	}
+}
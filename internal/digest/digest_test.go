@@ -0,0 +1,23 @@
+package digest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetExpected(t *testing.T) {
+	body := []byte(`{"amount":100}`)
+	h := http.Header{}
+	Set(h, body, []string{"md5", SHA256})
+	assert.Equal(t, Sum(MD5, body), h.Get(ContentMD5Header))
+	assert.Equal(t, "SHA-256="+Sum(SHA256, body), h.Get(Header))
+
+	h.Add(Header, "UNIXsum=30637, sha-512="+Sum(SHA512, body))
+	assert.Equal(t, map[string]string{
+		MD5:    Sum(MD5, body),
+		SHA256: Sum(SHA256, body),
+		SHA512: Sum(SHA512, body),
+	}, Expected(h))
+}
@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// setBytesEncoding types a bytes field with a ginpb.bytes_encoding with the gin type decoding it
+func setBytesEncoding(field *protogen.Field, f *fieldInfo) error {
+	enc := jsonfield.BytesEncoding(field.Desc)
+	if enc == "" {
+		return nil
+	}
+	if !jsonfield.ValidBytesEncoding(enc) {
+		return fmt.Errorf("ginpb.bytes_encoding of %s must be base64, base64url, hex or raw, not %q", field.Desc.FullName(), enc)
+	}
+	if field.Desc.Kind() != protoreflect.BytesKind || field.Desc.IsList() || field.Desc.IsMap() {
+		return fmt.Errorf("ginpb.bytes_encoding is set on %s, only singular bytes fields can set an encoding", field.Desc.FullName())
+	}
+	f.bytesEncoding = enc
+	switch enc {
+	case jsonfield.Hex:
+		f.BindType = "ginpb.HexBytes"
+	case jsonfield.Base64URL:
+		f.BindType = "ginpb.Base64URLBytes"
+	case jsonfield.Raw:
+		f.BindType = "string"
+	}
+	f.Convert = fmt.Sprintf("[]byte(r.%s)", field.GoName)
+	f.ConvertFrom = fmt.Sprintf("%s(in.%s)", f.BindType, field.GoName)
+	return nil
+}
@@ -0,0 +1,57 @@
+package gen
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// setCache builds the ginpb.CachePolicy literal of ginpb.cache, which only applies to unary GET methods
+func setCache(g *protogen.GeneratedFile, md *methodDesc, m *protogen.Method, cache *ginext.CacheOptions) error {
+	if md.Method != http.MethodGet || md.WebSocket || md.ServerStream || md.StreamItem != "" {
+		return fmt.Errorf("cache of %s: only replies of unary GET methods are cacheable", m.Desc.FullName())
+	}
+	var maxAge time.Duration
+	if cache.GetMaxAge() != "" {
+		d, err := time.ParseDuration(cache.GetMaxAge())
+		if err != nil || d < 0 || d%time.Second != 0 {
+			return fmt.Errorf("cache of %s: max_age %q is not a Go duration of whole seconds such as \"60s\"", m.Desc.FullName(), cache.GetMaxAge())
+		}
+		maxAge = d
+	}
+
+	var fields []string
+	if maxAge > 0 {
+		fields = append(fields, "MaxAge: "+durationLiteral(g, maxAge))
+	}
+	if cache.GetPrivate() {
+		fields = append(fields, "Private: true")
+	}
+	if cache.GetNoCache() {
+		fields = append(fields, "NoCache: true")
+	}
+	if cache.GetEtag() {
+		fields = append(fields, "ETag: true")
+	}
+	md.Cache = "ginpb.CachePolicy{" + strings.Join(fields, ", ") + "}"
+	md.CacheETag = cache.GetEtag()
+	md.CacheControl = cacheControl(maxAge, cache)
+	return nil
+}
+
+// cacheControl returns the Cache-Control value written by ginpb.CachePolicy.CacheControl, for docs and metadata
+func cacheControl(maxAge time.Duration, cache *ginext.CacheOptions) string {
+	directives := []string{"public"}
+	if cache.GetPrivate() {
+		directives[0] = "private"
+	}
+	if cache.GetNoCache() {
+		directives = append(directives, "no-cache")
+	}
+	return strings.Join(append(directives, fmt.Sprintf("max-age=%d", maxAge/time.Second)), ", ")
+}
@@ -0,0 +1,274 @@
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigFiles are looked up in the working directory (usually the proto root) when no config param is given
+var DefaultConfigFiles = []string{"ginpb.yaml", "ginpb.yml", "ginpb.toml"}
+
+// Options are the generator settings that can be set globally and overridden per file or service.
+// Unset fields inherit from the enclosing level.
+type Options struct {
+	// Omitempty skips services and methods without google.api.http rules
+	Omitempty *bool `yaml:"omitempty" toml:"omitempty"`
+	// Server enables the server interface, handlers and registration
+	Server *bool `yaml:"server" toml:"server"`
+	// Client enables the resty based client
+	Client *bool `yaml:"client" toml:"client"`
+	// FileSuffix replaces the default ".pb.gin.go" suffix, only honored globally and per file
+	FileSuffix string `yaml:"file_suffix" toml:"file_suffix"`
+	// BuildTags writes server and client code to separate files guarded by build constraints,
+	// only honored globally and per file
+	BuildTags *bool `yaml:"build_tags" toml:"build_tags"`
+	// SplitFiles writes server code, client code and the shared operation constants and binding types to
+	// xxx_gin_server.pb.go, xxx_gin_client.pb.go and xxx_gin_types.pb.go, only honored globally and per file
+	SplitFiles *bool `yaml:"split_files" toml:"split_files"`
+	// ServerBuildTag is the constraint of the server file, "!ginpb_no_server" by default
+	ServerBuildTag string `yaml:"server_build_tag" toml:"server_build_tag"`
+	// ClientBuildTag is the constraint of the client file, "!ginpb_no_client" by default
+	ClientBuildTag string `yaml:"client_build_tag" toml:"client_build_tag"`
+	// K6 writes a k6 load test script per service
+	K6 *bool `yaml:"k6" toml:"k6"`
+	// OpenAPI writes an OpenAPI 3 document per proto file describing its HTTP rules and binding rules
+	OpenAPI *bool `yaml:"openapi" toml:"openapi"`
+	// WebSocket serves client and bidirectional streaming methods over WebSocket instead of skipping them
+	WebSocket *bool `yaml:"websocket" toml:"websocket"`
+	// Validate validates bound requests with the registered ginpb.Validator before calling the service method
+	Validate *bool `yaml:"validate" toml:"validate"`
+	// PathPrefix is prepended to every HTTP path, prefixes of nested levels are joined
+	PathPrefix string `yaml:"path_prefix" toml:"path_prefix"`
+	// JSONNames is the JSON field naming: "default" (encoding/json with proto names), "proto" (protojson with
+	// proto names) or "camel" (protojson with lowerCamel names, like grpc-gateway). It sets the json tags of the
+	// gin structs, the encoding of replies and the OpenAPI schemas, only honored globally and per file.
+	JSONNames string `yaml:"json_names" toml:"json_names"`
+	// EmitUnpopulated writes zero values of replies encoded with protojson
+	EmitUnpopulated *bool `yaml:"emit_unpopulated" toml:"emit_unpopulated"`
+	// Templates is a directory of operations.tmpl, server.tmpl, client.tmpl and tags.tmpl files replacing
+	// the built-in templates, missing files fall back to the built-in ones
+	Templates string `yaml:"templates" toml:"templates"`
+}
+
+// Override is a set of options applied to files or services matching Match.
+// Match is a path.Match pattern against the proto file path or the fully-qualified service name.
+type Override struct {
+	Match   string `yaml:"match" toml:"match"`
+	Options `yaml:",inline"`
+}
+
+// Config is the ginpb.yaml / ginpb.toml configuration file
+type Config struct {
+	Options  `yaml:",inline"`
+	Files    []Override `yaml:"files" toml:"files"`
+	Services []Override `yaml:"services" toml:"services"`
+	// MetadataOut is the path of a JSON file describing the routes of all generated services, relative to the output directory
+	MetadataOut string `yaml:"metadata_out" toml:"metadata_out"`
+
+	// services generated so far, described in MetadataOut
+	services []*serviceDesc
+}
+
+// ResolvedOptions are the effective settings for a file or service
+type ResolvedOptions struct {
+	Omitempty      bool
+	Server         bool
+	Client         bool
+	FileSuffix     string
+	BuildTags      bool
+	SplitFiles     bool
+	ServerBuildTag string
+	ClientBuildTag string
+	K6             bool
+	OpenAPI        bool
+	WebSocket      bool
+	Validate       bool
+	PathPrefix     string
+	Templates      string
+	// JSONNames and EmitUnpopulated choose the json tags and the default ginpb.JSONNaming of the registration
+	JSONNames       string
+	EmitUnpopulated bool
+}
+
+// LoadConfig reads the config file at name, the format is chosen by extension (.yaml, .yml or .toml).
+// An empty name looks for DefaultConfigFiles and returns an empty config if none exists.
+func LoadConfig(name string) (*Config, error) {
+	if name == "" {
+		for _, candidate := range DefaultConfigFiles {
+			if _, err := os.Stat(candidate); err == nil {
+				name = candidate
+				break
+			}
+		}
+		if name == "" {
+			return &Config{}, nil
+		}
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	config := &Config{}
+	switch ext := filepath.Ext(name); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		// An empty document is an empty config
+		if err := dec.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parse config %s: %w", name, err)
+		}
+	case ".toml":
+		dec := toml.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(config); err != nil {
+			return nil, fmt.Errorf("parse config %s: %w", name, err)
+		}
+	default:
+		return nil, fmt.Errorf("config %s: unsupported format %q, use .yaml, .yml or .toml", name, ext)
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %w", name, err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	for _, o := range append(append([]Override(nil), c.Files...), c.Services...) {
+		if o.Match == "" {
+			return errors.New("override without match pattern")
+		}
+		if _, err := path.Match(o.Match, ""); err != nil {
+			return fmt.Errorf("invalid match pattern %q: %w", o.Match, err)
+		}
+	}
+	for _, o := range c.Services {
+		if o.FileSuffix != "" || o.BuildTags != nil || o.SplitFiles != nil || o.ServerBuildTag != "" || o.ClientBuildTag != "" || o.JSONNames != "" {
+			return fmt.Errorf("service override %q: file_suffix, split_files, json_names and build tags can only be set globally or per file", o.Match)
+		}
+	}
+	return nil
+}
+
+// ForFile returns the effective options for the proto file at filename
+func (c *Config) ForFile(filename string) ResolvedOptions {
+	res := ResolvedOptions{
+		Omitempty:      true,
+		Server:         true,
+		Client:         true,
+		FileSuffix:     ".pb.gin.go",
+		ServerBuildTag: "!ginpb_no_server",
+		ClientBuildTag: "!ginpb_no_client",
+	}
+	res.apply(c.Options)
+	for _, o := range c.Files {
+		if ok, _ := path.Match(o.Match, filename); ok {
+			res.apply(o.Options)
+		}
+	}
+	return res
+}
+
+// ForService returns the effective options for the service with the given fully-qualified name in filename
+func (c *Config) ForService(filename, service string) ResolvedOptions {
+	res := c.ForFile(filename)
+	for _, o := range c.Services {
+		if ok, _ := path.Match(o.Match, service); ok {
+			res.apply(o.Options)
+		}
+	}
+	return res
+}
+
+func (r *ResolvedOptions) apply(o Options) {
+	if o.Omitempty != nil {
+		r.Omitempty = *o.Omitempty
+	}
+	if o.Server != nil {
+		r.Server = *o.Server
+	}
+	if o.Client != nil {
+		r.Client = *o.Client
+	}
+	if o.FileSuffix != "" {
+		r.FileSuffix = o.FileSuffix
+	}
+	if o.BuildTags != nil {
+		r.BuildTags = *o.BuildTags
+	}
+	if o.SplitFiles != nil {
+		r.SplitFiles = *o.SplitFiles
+	}
+	if o.ServerBuildTag != "" {
+		r.ServerBuildTag = o.ServerBuildTag
+	}
+	if o.ClientBuildTag != "" {
+		r.ClientBuildTag = o.ClientBuildTag
+	}
+	if o.K6 != nil {
+		r.K6 = *o.K6
+	}
+	if o.OpenAPI != nil {
+		r.OpenAPI = *o.OpenAPI
+	}
+	if o.WebSocket != nil {
+		r.WebSocket = *o.WebSocket
+	}
+	if o.Validate != nil {
+		r.Validate = *o.Validate
+	}
+	if o.Templates != "" {
+		r.Templates = o.Templates
+	}
+	if o.JSONNames != "" {
+		r.JSONNames = o.JSONNames
+	}
+	if o.EmitUnpopulated != nil {
+		r.EmitUnpopulated = *o.EmitUnpopulated
+	}
+	if o.PathPrefix != "" {
+		r.PathPrefix = strings.TrimSuffix(r.PathPrefix, "/") + "/" + strings.Trim(o.PathPrefix, "/")
+	}
+}
+
+// jsonNamings maps the json_names option to the ginpb.JSONNaming constants
+var jsonNamings = map[string]string{
+	"":        "",
+	"default": "",
+	"proto":   "JSONProtoNames",
+	"camel":   "JSONCamelCase",
+}
+
+// jsonNaming returns the ginpb.JSONNaming constants combined into the default encoding, none for encoding/json
+func (r ResolvedOptions) jsonNaming() ([]string, error) {
+	naming, ok := jsonNamings[r.JSONNames]
+	if !ok {
+		return nil, fmt.Errorf("json_names %q: want default, proto or camel", r.JSONNames)
+	}
+	if naming == "" {
+		if r.EmitUnpopulated {
+			return nil, errors.New("emit_unpopulated needs json_names=proto or json_names=camel, encoding/json always writes zero values")
+		}
+		return nil, nil
+	}
+	if r.EmitUnpopulated {
+		return []string{naming, "JSONEmitUnpopulated"}, nil
+	}
+	return []string{naming}, nil
+}
+
+// camelJSON reports whether fields are named with their lowerCamel JSON names
+func (r ResolvedOptions) camelJSON() bool {
+	return r.JSONNames == "camel"
+}
@@ -0,0 +1,59 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigOverrides(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "ginpb.yaml")
+	require.NoError(t, os.WriteFile(name, []byte(`
+path_prefix: /gw
+files:
+  - match: "internal/*.proto"
+    client: false
+    file_suffix: .gin.go
+    split_files: true
+services:
+  - match: "example.Admin*"
+    server: false
+    path_prefix: /admin/
+`), 0o644))
+
+	config, err := LoadConfig(name)
+	require.NoError(t, err)
+
+	assert.Equal(t, ResolvedOptions{Omitempty: true, Server: true, Client: true, FileSuffix: ".pb.gin.go",
+		ServerBuildTag: "!ginpb_no_server", ClientBuildTag: "!ginpb_no_client", PathPrefix: "/gw"},
+		config.ForFile("api/user.proto"))
+	assert.Equal(t, ResolvedOptions{Omitempty: true, Server: false, Client: false, FileSuffix: ".gin.go", SplitFiles: true,
+		ServerBuildTag: "!ginpb_no_server", ClientBuildTag: "!ginpb_no_client", PathPrefix: "/gw/admin"},
+		config.ForService("internal/admin.proto", "example.AdminService"))
+}
+
+func TestConfigRejectsUnknownFields(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ginpb.toml")
+	require.NoError(t, os.WriteFile(name, []byte("omit_empty = true\n"), 0o644))
+
+	_, err := LoadConfig(name)
+	assert.Error(t, err)
+}
+
+func TestResolvedJSONNaming(t *testing.T) {
+	namings, err := ResolvedOptions{JSONNames: "camel", EmitUnpopulated: true}.jsonNaming()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"JSONCamelCase", "JSONEmitUnpopulated"}, namings)
+	namings, err = ResolvedOptions{JSONNames: "default"}.jsonNaming()
+	require.NoError(t, err)
+	assert.Empty(t, namings)
+
+	_, err = ResolvedOptions{JSONNames: "snake"}.jsonNaming()
+	assert.ErrorContains(t, err, `json_names "snake": want default, proto or camel`)
+	_, err = ResolvedOptions{EmitUnpopulated: true}.jsonNaming()
+	assert.ErrorContains(t, err, "needs json_names=proto or json_names=camel")
+}
@@ -0,0 +1,38 @@
+package gen
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// buildContentTypes checks the media types of ginpb.content_types, which only apply to methods binding a body
+func buildContentTypes(md *methodDesc, m *protogen.Method, types []string) ([]string, error) {
+	if !md.HasBody || m.Desc.IsStreamingClient() {
+		return nil, fmt.Errorf("content_types of %s: the method has no request body, declare body in its http rule", m.Desc.FullName())
+	}
+	res := make([]string, 0, len(types))
+	for _, t := range types {
+		mediaType, params, err := mime.ParseMediaType(t)
+		if err != nil || len(params) > 0 || !strings.Contains(mediaType, "/") {
+			return nil, fmt.Errorf("content_types of %s: %q is not a media type such as \"application/json\" or \"image/*\"", m.Desc.FullName(), t)
+		}
+		res = append(res, mediaType)
+	}
+	return res, nil
+}
+
+// accepts reports whether m accepts request bodies of mediaType
+func (m *methodDesc) accepts(mediaType string) bool {
+	if len(m.ContentTypes) == 0 {
+		return true
+	}
+	for _, t := range m.ContentTypes {
+		if t == mediaType || t == "*/*" || (strings.HasSuffix(t, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(t, "*"))) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,309 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+var crudPackage = protogen.GoImportPath("github.com/go-kenka/ginpb/crud")
+
+// crudMethods are the standard methods generated by default
+var crudMethods = []string{"create", "get", "list", "update", "delete"}
+
+var (
+	// patternVariable matches a {variable} segment of a resource pattern
+	patternVariable = regexp.MustCompile(`^\{[a-z][a-z0-9_]*\}$`)
+	// patternCollection matches a collection segment of a resource pattern
+	patternCollection = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+)
+
+// crudResource is a resource declared with google.api.resource and ginpb.crud
+type crudResource struct {
+	Message    string // Book
+	Type       string // example.com/Book
+	Pattern    string // publishers/{publisher}/books/{book}
+	Plural     string // books
+	PluralName string // Books
+	NameField  string // name
+	Collection string // /v1/publishers/:publisher/books
+	Item       string // /v1/publishers/:publisher/books/:book
+	IDParam    string // book_id
+	Create     bool
+	Get        bool
+	List       bool
+	Update     bool
+	Delete     bool
+}
+
+var crudTemplate = `
+{{- range .}}
+// {{.Message}}Resource describes the {{.Type}} resource named {{.Pattern}}
+var {{.Message}}Resource = &crud.Resource{Type: {{printf "%q" .Type}}, Pattern: {{printf "%q" .Pattern}}, Plural: {{printf "%q" .Plural}}, NameField: {{printf "%q" .NameField}}}
+
+// {{.Message}}CRUDServer serves the standard methods of the {{.Type}} resource
+type {{.Message}}CRUDServer interface {
+{{- if .Create}}
+	// Create{{.Message}} creates the resource under parent, id is the {{.IDParam}} chosen by the client or empty
+	Create{{.Message}}(ctx context.Context, parent, id string, in *{{.Message}}) (*{{.Message}}, error)
+{{- end}}
+{{- if .Get}}
+	Get{{.Message}}(ctx context.Context, name string) (*{{.Message}}, error)
+{{- end}}
+{{- if .List}}
+	List{{.PluralName}}(ctx context.Context, parent string, pageSize int, pageToken string) (*crud.Page[*{{.Message}}], error)
+{{- end}}
+{{- if .Update}}
+	// Update{{.Message}} applies the fields of in selected by updateMask, every populated field when empty
+	Update{{.Message}}(ctx context.Context, name string, in *{{.Message}}, updateMask []string) (*{{.Message}}, error)
+{{- end}}
+{{- if .Delete}}
+	Delete{{.Message}}(ctx context.Context, name string) error
+{{- end}}
+}
+
+// Default{{.Message}}CRUDServer implements {{.Message}}CRUDServer on a crud.Store,
+// embed it to override single methods
+type Default{{.Message}}CRUDServer struct {
+	Service *crud.Service[*{{.Message}}]
+}
+
+// NewDefault{{.Message}}CRUDServer creates the default server of {{.Type}} on store,
+// e.g. crud.NewMemoryStore[*{{.Message}}]()
+func NewDefault{{.Message}}CRUDServer(store crud.Store[*{{.Message}}]) *Default{{.Message}}CRUDServer {
+	return &Default{{.Message}}CRUDServer{Service: &crud.Service[*{{.Message}}]{Resource: {{.Message}}Resource, Store: store}}
+}
+{{- if .Create}}
+
+func (s *Default{{.Message}}CRUDServer) Create{{.Message}}(ctx context.Context, parent, id string, in *{{.Message}}) (*{{.Message}}, error) {
+	return s.Service.Create(ctx, parent, id, in)
+}
+{{- end}}
+{{- if .Get}}
+
+func (s *Default{{.Message}}CRUDServer) Get{{.Message}}(ctx context.Context, name string) (*{{.Message}}, error) {
+	return s.Service.Get(ctx, name)
+}
+{{- end}}
+{{- if .List}}
+
+func (s *Default{{.Message}}CRUDServer) List{{.PluralName}}(ctx context.Context, parent string, pageSize int, pageToken string) (*crud.Page[*{{.Message}}], error) {
+	return s.Service.List(ctx, parent, pageSize, pageToken)
+}
+{{- end}}
+{{- if .Update}}
+
+func (s *Default{{.Message}}CRUDServer) Update{{.Message}}(ctx context.Context, name string, in *{{.Message}}, updateMask []string) (*{{.Message}}, error) {
+	return s.Service.Update(ctx, name, in, updateMask)
+}
+{{- end}}
+{{- if .Delete}}
+
+func (s *Default{{.Message}}CRUDServer) Delete{{.Message}}(ctx context.Context, name string) error {
+	return s.Service.Delete(ctx, name)
+}
+{{- end}}
+
+// Register{{.Message}}CRUDHTTPServer registers the standard methods of the {{.Type}} resource:
+{{- if .Create}}
+//   - POST   {{.Collection}}
+{{- end}}
+{{- if .Get}}
+//   - GET    {{.Item}}
+{{- end}}
+{{- if .List}}
+//   - GET    {{.Collection}}
+{{- end}}
+{{- if .Update}}
+//   - PATCH  {{.Item}}
+{{- end}}
+{{- if .Delete}}
+//   - DELETE {{.Item}}
+{{- end}}
+func Register{{.Message}}CRUDHTTPServer(r gin.IRouter, srv {{.Message}}CRUDServer) {
+{{- if .Create}}
+	r.POST({{printf "%q" .Collection}}, func(c *gin.Context) {
+		in := new({{.Message}})
+		if err := crud.DecodeBody(c, in); err != nil {
+			ginpb.RenderError(c, err)
+			return
+		}
+		reply, err := srv.Create{{.Message}}(c.Request.Context(), {{.Message}}Resource.Parent(c), c.Query({{printf "%q" .IDParam}}), in)
+		crud.Render(c, reply, err)
+	})
+{{- end}}
+{{- if .Get}}
+	r.GET({{printf "%q" .Item}}, func(c *gin.Context) {
+		reply, err := srv.Get{{.Message}}(c.Request.Context(), {{.Message}}Resource.Name(c))
+		crud.Render(c, reply, err)
+	})
+{{- end}}
+{{- if .List}}
+	r.GET({{printf "%q" .Collection}}, func(c *gin.Context) {
+		pageSize, pageToken, err := crud.ListParams(c)
+		if err != nil {
+			ginpb.RenderError(c, err)
+			return
+		}
+		page, err := srv.List{{.PluralName}}(c.Request.Context(), {{.Message}}Resource.Parent(c), pageSize, pageToken)
+		crud.RenderList(c, {{.Message}}Resource.Plural, page, err)
+	})
+{{- end}}
+{{- if .Update}}
+	r.PATCH({{printf "%q" .Item}}, func(c *gin.Context) {
+		in := new({{.Message}})
+		if err := crud.DecodeBody(c, in); err != nil {
+			ginpb.RenderError(c, err)
+			return
+		}
+		reply, err := srv.Update{{.Message}}(c.Request.Context(), {{.Message}}Resource.Name(c), in, crud.UpdateMask(c))
+		crud.Render(c, reply, err)
+	})
+{{- end}}
+{{- if .Delete}}
+	r.DELETE({{printf "%q" .Item}}, func(c *gin.Context) {
+		if err := srv.Delete{{.Message}}(c.Request.Context(), {{.Message}}Resource.Name(c)); err != nil {
+			ginpb.RenderError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+{{- end}}
+}
+{{end}}`
+
+// genCRUD writes the standard methods of the resources of file declared with ginpb.crud
+func genCRUD(gen *protogen.Plugin, file *protogen.File, opts ResolvedOptions) error {
+	resources, err := collectResources(file.Messages)
+	if err != nil || len(resources) == 0 {
+		return err
+	}
+
+	tmpl, err := template.New("crud").Parse(crudTemplate)
+	if err != nil {
+		return fmt.Errorf("parse crud template: %w", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, resources); err != nil {
+		return fmt.Errorf("execute crud template: %w", err)
+	}
+	g := newGeneratedFile(gen, file, file.GeneratedFilenamePrefix+"_crud"+opts.FileSuffix, "")
+	// The template refers to these packages by name, ginpb and net/http only in some handlers
+	g.QualifiedGoIdent(contextPackage.Ident("Context"))
+	g.QualifiedGoIdent(ginPackage.Ident("IRouter"))
+	g.QualifiedGoIdent(crudPackage.Ident("Resource"))
+	for _, r := range resources {
+		if r.Create || r.List || r.Update {
+			g.QualifiedGoIdent(ginpbPackage.Ident("RenderError"))
+		}
+		if r.Delete {
+			g.QualifiedGoIdent(httpPackage.Ident("StatusNoContent"))
+		}
+	}
+	g.P(buf.String())
+	return nil
+}
+
+// collectResources returns the resources declared with ginpb.crud in messages and their nested messages
+func collectResources(messages []*protogen.Message) ([]*crudResource, error) {
+	var res []*crudResource
+	for _, m := range messages {
+		if opt, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Crud).(*ginext.CRUD); opt != nil {
+			r, err := buildResource(m, opt)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, r)
+		}
+		nested, err := collectResources(m.Messages)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, nested...)
+	}
+	return res, nil
+}
+
+// buildResource describes the standard methods of message m from its google.api.resource and ginpb.crud
+func buildResource(m *protogen.Message, opt *ginext.CRUD) (*crudResource, error) {
+	desc, _ := proto.GetExtension(m.Desc.Options(), annotations.E_Resource).(*annotations.ResourceDescriptor)
+	if len(desc.GetPattern()) == 0 {
+		return nil, fmt.Errorf("ginpb.crud of %s requires a google.api.resource annotation with a pattern", m.Desc.FullName())
+	}
+	pattern := desc.GetPattern()[0]
+	segs := strings.Split(pattern, "/")
+	if len(segs)%2 != 0 {
+		return nil, fmt.Errorf("resource pattern %q of %s must alternate collections and {variables}", pattern, m.Desc.FullName())
+	}
+	routes := make([]string, len(segs))
+	for i, seg := range segs {
+		switch {
+		case i%2 == 0 && patternCollection.MatchString(seg):
+			routes[i] = seg
+		case i%2 == 1 && patternVariable.MatchString(seg):
+			routes[i] = ":" + seg[1:len(seg)-1]
+		default:
+			return nil, fmt.Errorf("resource pattern %q of %s must alternate collections and {variables}, not %q", pattern, m.Desc.FullName(), seg)
+		}
+	}
+
+	nameField := desc.GetNameField()
+	if nameField == "" {
+		nameField = "name"
+	}
+	fd := m.Desc.Fields().ByName(protoreflect.Name(nameField))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return nil, fmt.Errorf("resource %s needs the string field %q holding its name", m.Desc.FullName(), nameField)
+	}
+
+	prefix := strings.TrimSuffix(opt.GetPrefix(), "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		return nil, fmt.Errorf("ginpb.crud prefix %q of %s must start with /", opt.GetPrefix(), m.Desc.FullName())
+	}
+	item := prefix + "/" + strings.Join(routes, "/")
+	plural := desc.GetPlural()
+	if plural == "" {
+		plural = segs[len(segs)-2]
+	}
+	r := &crudResource{
+		Message:    m.GoIdent.GoName,
+		Type:       desc.GetType(),
+		Pattern:    pattern,
+		Plural:     plural,
+		PluralName: camelCase(plural),
+		NameField:  nameField,
+		Collection: item[:strings.LastIndex(item, "/")],
+		Item:       item,
+		IDParam:    strings.Trim(segs[len(segs)-1], "{}") + "_id",
+	}
+	methods := opt.GetMethods()
+	if len(methods) == 0 {
+		methods = crudMethods
+	}
+	for _, method := range methods {
+		switch method {
+		case "create":
+			r.Create = true
+		case "get":
+			r.Get = true
+		case "list":
+			r.List = true
+		case "update":
+			r.Update = true
+		case "delete":
+			r.Delete = true
+		default:
+			return nil, fmt.Errorf("ginpb.crud method %q of %s must be one of %s", method, m.Desc.FullName(), strings.Join(crudMethods, ", "))
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,50 @@
+package gen
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// methodDoc returns the ginpb.summary and the description of m, ginpb.description or its leading comment
+func methodDoc(m *protogen.Method) (summary, description string) {
+	summary, _ = proto.GetExtension(m.Desc.Options(), ginext.E_Summary).(string)
+	description, _ = proto.GetExtension(m.Desc.Options(), ginext.E_Description).(string)
+	if description == "" {
+		description = commentText(m.Comments.Leading)
+	}
+	return strings.TrimSpace(summary), strings.TrimSpace(description)
+}
+
+// commentText returns the text of a proto comment without the space following the comment markers
+func commentText(c protogen.Comments) string {
+	lines := strings.Split(string(c), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(strings.TrimPrefix(line, " "), " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Doc returns the summary and description of the method as Go line comments indented by indent, each line
+// preceded by a newline, so templates place it right before the documented declaration
+func (m *methodDesc) Doc(indent string) string {
+	var b strings.Builder
+	write := func(text string) {
+		for _, line := range strings.Split(text, "\n") {
+			b.WriteString("\n" + indent + strings.TrimRight("// "+line, " "))
+		}
+	}
+	if m.Summary != "" {
+		write(m.Summary)
+	}
+	if m.Summary != "" && m.Description != "" {
+		write("")
+	}
+	if m.Description != "" {
+		write(m.Description)
+	}
+	return b.String()
+}
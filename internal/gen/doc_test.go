@@ -0,0 +1,13 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodDescDoc(t *testing.T) {
+	m := &methodDesc{Summary: "Lists users", Description: "Results are ordered by name.\n\n  Pages hold 50 users."}
+	assert.Equal(t, "\n\t// Lists users\n\t//\n\t// Results are ordered by name.\n\t//\n\t//   Pages hold 50 users.", m.Doc("\t"))
+	assert.Empty(t, (&methodDesc{}).Doc(""))
+}
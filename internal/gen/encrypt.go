@@ -0,0 +1,42 @@
+package gen
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// hasEncryptedFields reports whether md or a message reachable from it has fields annotated with
+// (ginpb.encrypt), and rejects the option on fields other than string and bytes
+func hasEncryptedFields(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) (bool, error) {
+	if visited[md.FullName()] {
+		return false, nil
+	}
+	visited[md.FullName()] = true
+
+	found := false
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		value := fd
+		if fd.IsMap() {
+			value = fd.MapValue()
+		}
+		if alias, _ := proto.GetExtension(fd.Options(), ginext.E_Encrypt).(string); alias != "" {
+			if value.Kind() != protoreflect.StringKind && value.Kind() != protoreflect.BytesKind {
+				return false, fmt.Errorf("(ginpb.encrypt) of %s: only string and bytes fields can be encrypted, not %s", fd.FullName(), value.Kind())
+			}
+			found = true
+		} else if value.Message() != nil {
+			ok, err := hasEncryptedFields(value.Message(), visited)
+			if err != nil {
+				return false, err
+			}
+			found = found || ok
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,140 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// errorEnum is an enum declared as error codes with ginpb.default_status
+type errorEnum struct {
+	FullName string // example.ErrorReason
+	Codes    []*errorCode
+}
+
+type errorCode struct {
+	Var      string // _ErrorReason_USER_NOT_FOUND
+	GoName   string // UserNotFound
+	Reason   string // USER_NOT_FOUND
+	Status   int
+	Message  string
+	Messages map[string]string
+}
+
+var errorsTemplate = `
+{{- range .}}
+// Error codes of {{.FullName}}
+var (
+{{- range .Codes}}
+	{{.Var}} = &ginpb.ErrorCode{Status: {{.Status}}, Reason: {{printf "%q" .Reason}}, Message: {{printf "%q" .Message}}
+		{{- if .Messages}}, Messages: map[string]string{ {{- range $lang, $message := .Messages}}{{printf "%q" $lang}}: {{printf "%q" $message}}, {{end}}}{{end}}}
+{{- end}}
+)
+{{range .Codes}}
+// Error{{.GoName}} returns the {{.Reason}} error, HTTP {{.Status}}: {{.Message}}
+func Error{{.GoName}}(args ...any) *ginpb.Error {
+	return {{.Var}}.New(args...)
+}
+
+// Is{{.GoName}} reports whether err is the {{.Reason}} error, returned by a service or received by a client
+func Is{{.GoName}}(err error) bool {
+	return ginpb.IsErrorReason(err, {{.Var}}.Reason)
+}
+{{end}}
+{{- end}}`
+
+// genErrors writes the constructors and helpers of the error code enums of file
+func genErrors(gen *protogen.Plugin, file *protogen.File, opts ResolvedOptions) error {
+	enums := collectEnums(file.Enums, file.Messages)
+	var data []*errorEnum
+	for _, enum := range enums {
+		e, err := buildErrorEnum(enum)
+		if err != nil {
+			return err
+		}
+		if e != nil {
+			data = append(data, e)
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	tmpl, err := template.New("errors").Parse(errorsTemplate)
+	if err != nil {
+		return fmt.Errorf("parse errors template: %w", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return fmt.Errorf("execute errors template: %w", err)
+	}
+	g := newGeneratedFile(gen, file, file.GeneratedFilenamePrefix+"_errors"+opts.FileSuffix, "")
+	// The template refers to the runtime as ginpb
+	g.QualifiedGoIdent(ginpbPackage.Ident("ErrorCode"))
+	g.P(buf.String())
+	return nil
+}
+
+// collectEnums returns the enums of a file including the enums nested in messages
+func collectEnums(enums []*protogen.Enum, messages []*protogen.Message) []*protogen.Enum {
+	res := append([]*protogen.Enum(nil), enums...)
+	for _, m := range messages {
+		res = append(res, collectEnums(m.Enums, m.Messages)...)
+	}
+	return res
+}
+
+// buildErrorEnum describes the codes of enum, nil unless it sets ginpb.default_status
+func buildErrorEnum(enum *protogen.Enum) (*errorEnum, error) {
+	if !proto.HasExtension(enum.Desc.Options(), ginext.E_DefaultStatus) {
+		return nil, nil
+	}
+	defaultStatus := int(proto.GetExtension(enum.Desc.Options(), ginext.E_DefaultStatus).(int32))
+	if !isErrorStatus(defaultStatus) {
+		return nil, fmt.Errorf("ginpb.default_status of %s must be a 4xx or 5xx status, not %d", enum.Desc.FullName(), defaultStatus)
+	}
+	e := &errorEnum{FullName: string(enum.Desc.FullName())}
+	for _, v := range enum.Values {
+		reason := string(v.Desc.Name())
+		code := &errorCode{
+			Var:     "_" + v.GoIdent.GoName,
+			GoName:  camelCase(strings.ToLower(reason)),
+			Reason:  reason,
+			Status:  defaultStatus,
+			Message: strings.ToLower(strings.ReplaceAll(reason, "_", " ")),
+		}
+		if opt, _ := proto.GetExtension(v.Desc.Options(), ginext.E_Error).(*ginext.ErrorCode); opt != nil {
+			if opt.GetStatus() != 0 {
+				code.Status = int(opt.GetStatus())
+			}
+			if !isErrorStatus(code.Status) {
+				return nil, fmt.Errorf("ginpb.error of %s must use a 4xx or 5xx status, not %d", v.Desc.FullName(), code.Status)
+			}
+			if opt.GetMessage() != "" {
+				code.Message = opt.GetMessage()
+			}
+			for _, lang := range slices.Sorted(maps.Keys(opt.GetMessages())) {
+				if _, err := language.Parse(lang); err != nil {
+					return nil, fmt.Errorf("ginpb.error of %s: message language %q is not a BCP 47 tag: %w", v.Desc.FullName(), lang, err)
+				}
+			}
+			code.Messages = opt.GetMessages()
+		}
+		e.Codes = append(e.Codes, code)
+	}
+	return e, nil
+}
+
+func isErrorStatus(status int) bool {
+	return status >= http.StatusBadRequest && status <= 599
+}
@@ -0,0 +1,299 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// example is a sample request of a method built from the binding rules of its fields,
+// used by the k6 scripts and the self-test examples of the generated routes
+type example struct {
+	Path    string            // client path with sample path parameters
+	Query   string            // encoded query
+	Headers map[string]string // header fields, plus Content-Type for bodies
+	Body    string            // JSON or multipart body, empty for none
+}
+
+// sampleBoundary separates the parts of sample multipart bodies
+const sampleBoundary = "ginpb-sample-boundary"
+
+func buildExample(m *methodDesc) *example {
+	params := make(map[string]bool)
+	for _, p := range m.PathParams {
+		params[strings.SplitN(p, "=", 2)[0]] = true
+	}
+
+	ex := &example{Path: m.ClientPath, Headers: make(map[string]string)}
+	var body interface{}
+	fields := make(map[string]interface{})
+	query := url.Values{}
+	var files []*fieldInfo
+	for _, f := range m.Fields {
+		value := sampleValue(f)
+		switch {
+		case params[f.Name]:
+			ex.Path = replacePathParam(ex.Path, f.Name, m.pathSample(f.Name, fmt.Sprint(value)))
+		case hasTag(f, "header"):
+			ex.Headers[getTag(f, "header")] = fmt.Sprint(value)
+		case m.HasBody && (m.Body == "" || m.Body == "."+f.GoName):
+			if m.Body == "" && hasTag(f, "multipart") {
+				files = append(files, f)
+			} else if m.Body == "" {
+				fields[tagName(f, "json")] = value
+			} else {
+				body = value
+			}
+		case hasTag(f, "form"):
+			for _, v := range sampleQueryValues(value) {
+				query.Add(tagName(f, "form"), v)
+			}
+		}
+	}
+	if m.HasBody && m.Body == "" {
+		body = fields
+	}
+	switch {
+	case len(files) > 0 || (m.Body == "" && body != nil && !m.accepts("application/json") && m.accepts("multipart/form-data")):
+		// Files are only uploaded as multipart forms, as are bodies of methods not accepting JSON
+		ex.Headers["Content-Type"] = "multipart/form-data; boundary=" + sampleBoundary
+		ex.Body = multipartBody(m.Fields, fields, files)
+	case m.Body == "" && body != nil && !m.accepts("application/json") && m.accepts("application/x-www-form-urlencoded"):
+		form := url.Values{}
+		for _, f := range m.Fields {
+			if value, ok := fields[tagName(f, "json")]; ok {
+				form[tagName(f, "form")] = sampleQueryValues(value)
+			}
+		}
+		ex.Headers["Content-Type"] = "application/x-www-form-urlencoded"
+		ex.Body = form.Encode()
+	case body != nil:
+		ex.Headers["Content-Type"] = "application/json"
+		ex.Body = jsonString(body)
+	}
+	ex.Query = query.Encode()
+	return ex
+}
+
+// multipartBody encodes the sample values of the body fields as form values and files as sample files
+func multipartBody(all []*fieldInfo, values map[string]interface{}, files []*fieldInfo) string {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	_ = w.SetBoundary(sampleBoundary)
+	for _, f := range all {
+		value, ok := values[tagName(f, "json")]
+		if !ok {
+			continue
+		}
+		for _, v := range sampleQueryValues(value) {
+			_ = w.WriteField(tagName(f, "form"), v)
+		}
+	}
+	for _, f := range files {
+		part, _ := w.CreateFormFile(tagName(f, "form"), "sample.txt")
+		_, _ = part.Write([]byte("sample"))
+	}
+	_ = w.Close()
+	return buf.String()
+}
+
+// replacePathParam substitutes a {name} or {name=pattern} path variable with the escaped value
+func replacePathParam(path, name, value string) string {
+	start := strings.Index(path, "{"+name)
+	if start < 0 {
+		return path
+	}
+	end := strings.Index(path[start:], "}")
+	if end < 0 {
+		return path
+	}
+	return path[:start] + value + path[start+end+1:]
+}
+
+// tagName returns the name part of a struct tag, falling back to the proto field name
+func tagName(f *fieldInfo, tag string) string {
+	if name := strings.Split(getTag(f, tag), ",")[0]; name != "" && name != "-" {
+		return name
+	}
+	return f.Name
+}
+
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func sampleQueryValues(v interface{}) []string {
+	if list, ok := v.([]interface{}); ok {
+		res := make([]string, 0, len(list))
+		for _, item := range list {
+			res = append(res, fmt.Sprint(item))
+		}
+		return res
+	}
+	if _, ok := v.(map[string]interface{}); ok {
+		return nil
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+// sampleValue returns a realistic value for the field honoring its binding / validate rules
+func sampleValue(f *fieldInfo) interface{} {
+	rules := parseRules(getTag(f, "binding") + "," + getTag(f, "validate"))
+	goType := strings.TrimPrefix(f.GoType, "*")
+	switch {
+	case strings.HasPrefix(goType, "map["):
+		return map[string]interface{}{}
+	case goType == "[]byte":
+		switch f.bytesEncoding {
+		case jsonfield.Hex:
+			return "73616d706c65"
+		case jsonfield.Raw:
+			return "sample"
+		}
+		return "c2FtcGxl"
+	case strings.HasPrefix(goType, "[]"):
+		elem := &fieldInfo{Name: f.Name, GoType: goType[2:]}
+		n := 1
+		if min, err := strconv.Atoi(rules["min"]); err == nil && min > n {
+			n = min
+		}
+		res := make([]interface{}, n)
+		for i := range res {
+			res[i] = sampleValue(elem)
+		}
+		return res
+	}
+
+	switch goType {
+	case "bool":
+		return true
+	case "int32", "int64", "uint32", "uint64":
+		if v, err := strconv.ParseInt(rules["min"], 10, 64); err == nil {
+			return v
+		}
+		if v, err := strconv.ParseInt(rules["gte"], 10, 64); err == nil {
+			return v
+		}
+		return 1
+	case "float32", "float64":
+		if v, err := strconv.ParseFloat(rules["min"], 64); err == nil {
+			return v
+		}
+		return 1.5
+	case "string":
+		return sampleString(f.Name, rules)
+	default:
+		// Message fields
+		return map[string]interface{}{}
+	}
+}
+
+func sampleString(name string, rules map[string]string) string {
+	switch {
+	case rules["oneof"] != "":
+		return strings.Fields(rules["oneof"])[0]
+	case hasRule(rules, "email") || strings.Contains(name, "email"):
+		return "user@example.com"
+	case hasRule(rules, "uuid") || hasRule(rules, "uuid4"):
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case hasRule(rules, "url") || hasRule(rules, "uri") || strings.HasSuffix(name, "_url"):
+		return "https://example.com/resource"
+	case rules["datetime"] != "":
+		return time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC).Format(rules["datetime"])
+	case rules["startswith"] != "":
+		return rules["startswith"] + "sample"
+	}
+
+	s := "sample" + strings.ReplaceAll(camelCase(name), "_", "")
+	if hasRule(rules, "numeric") {
+		s = "1234567890"
+	}
+	if n, err := strconv.Atoi(rules["len"]); err == nil {
+		return fitLength(s, n, n)
+	}
+	min, _ := strconv.Atoi(rules["min"])
+	max, err := strconv.Atoi(rules["max"])
+	if err != nil {
+		max = len(s)
+		if min > max {
+			max = min
+		}
+	}
+	return fitLength(s, min, max)
+}
+
+// fitLength pads or truncates s to a length between min and max
+func fitLength(s string, min, max int) string {
+	for len(s) < min {
+		s += s
+	}
+	if max > 0 && len(s) > max {
+		s = s[:max]
+	}
+	return s
+}
+
+// parseRules parses validator rules such as "required,min=3,oneof=a b" into a map
+func parseRules(tag string) map[string]string {
+	rules := make(map[string]string)
+	for _, rule := range strings.Split(tag, ",") {
+		kv := strings.SplitN(rule, "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if key == "" {
+			continue
+		}
+		if len(kv) == 2 {
+			rules[key] = kv[1]
+		} else {
+			rules[key] = ""
+		}
+	}
+	return rules
+}
+
+func hasRule(rules map[string]string, name string) bool {
+	_, ok := rules[name]
+	return ok
+}
+
+// goLiteral returns the example as a *ginpb.RouteExample literal
+func (e *example) goLiteral() string {
+	var b strings.Builder
+	b.WriteString("&ginpb.RouteExample{Path: " + strconv.Quote(e.Path))
+	if e.Query != "" {
+		b.WriteString(", Query: " + strconv.Quote(e.Query))
+	}
+	if len(e.Headers) > 0 {
+		keys := make([]string, 0, len(e.Headers))
+		for k := range e.Headers {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString(", Header: map[string]string{")
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(strconv.Quote(k) + ": " + strconv.Quote(e.Headers[k]))
+		}
+		b.WriteString("}")
+	}
+	if e.Body != "" {
+		if strings.ContainsAny(e.Body, "`\r") {
+			b.WriteString(", Body: " + strconv.Quote(e.Body))
+		} else {
+			b.WriteString(", Body: `" + e.Body + "`")
+		}
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -3,11 +3,15 @@ package gen
 import (
 	"bytes"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -29,21 +33,189 @@ const (
 	clientPackage      = protogen.GoImportPath("github.com/go-kenka/ginpb/client")
 	fmtPackage         = protogen.GoImportPath("fmt")
 	stringsPackage     = protogen.GoImportPath("strings")
+	ginpbPackage       = protogen.GoImportPath("github.com/go-kenka/ginpb")
+	httpPackage        = protogen.GoImportPath("net/http")
+	iterPackage        = protogen.GoImportPath("iter")
+	urlPackage         = protogen.GoImportPath("net/url")
+	sloPackage         = protogen.GoImportPath("github.com/go-kenka/ginpb/slo")
+	timePackage        = protogen.GoImportPath("time")
 )
 
-var serverTemplate = `{{$svrType := .ServiceType}}
+var operationsTemplate = `{{$svrType := .ServiceType}}
 {{$svrName := .ServiceName}}
 
 {{- range .MethodSets}}
 const Operation{{$svrType}}{{.OriginalName}} = "/{{$svrName}}/{{.OriginalName}}"
 {{- end}}
 
+// {{.ServiceType}}Operations lists all operations of {{.ServiceName}}
+var {{.ServiceType}}Operations = []string{
+{{- range .MethodSets}}
+	Operation{{$svrType}}{{.OriginalName}},
+{{- end}}
+}
+
+// {{.ServiceType}}OperationScopes maps operations of {{.ServiceName}} to the auth scopes they require
+var {{.ServiceType}}OperationScopes = map[string][]string{
+{{- range .MethodSets}}
+{{- if .Scopes}}
+	Operation{{$svrType}}{{.OriginalName}}: { {{- range $i, $s := .Scopes}}{{if $i}}, {{end}}"{{$s}}"{{end -}} },
+{{- end}}
+{{- end}}
+}
+
+// {{.ServiceType}}IdempotentOperations lists operations of {{.ServiceName}} that clients may retry, marked with
+// ginpb.idempotent or an idempotency_level
+var {{.ServiceType}}IdempotentOperations = []string{
+{{- range .MethodSets}}
+{{- if .Idempotent}}
+	Operation{{$svrType}}{{.OriginalName}},
+{{- end}}
+{{- end}}
+}
+{{- if .SLOType}}
+
+// {{.ServiceType}}OperationSLOs maps operations of {{.ServiceName}} to the service level objectives declared with ginpb.slo
+var {{.ServiceType}}OperationSLOs = map[string]{{.SLOType}}{
+{{- range .MethodSets}}
+{{- if .SLO}}
+	Operation{{$svrType}}{{.OriginalName}}: {{.SLO}},
+{{- end}}
+{{- end}}
+}
+{{- end}}
+{{- if .Invalidations}}
+
+// {{.ServiceType}}CacheInvalidations maps operations of {{.ServiceName}} to the operations whose cached responses
+// they make stale, declared with ginpb.invalidates. Pass it to middleware.CacheConfig.
+var {{.ServiceType}}CacheInvalidations = map[string][]string{
+{{- range .MethodSets}}
+{{- if .Invalidates}}
+	Operation{{$svrType}}{{.OriginalName}}: { {{- range $i, $op := .Invalidates}}{{if $i}}, {{end}}{{$op}}{{end -}} },
+{{- end}}
+{{- end}}
+}
+{{- end}}
+{{- if .MaskTable}}
+
+// {{.ServiceType}}MaskedFields lists the reply fields of {{.ServiceName}} hidden per masking profile, declared with
+// ginpb.mask. Handlers apply it with the profile selected by ginpb.SetMaskProfile, e.g. through middleware.MaskProfile.
+var {{.ServiceType}}MaskedFields = {{.MaskTable}}{
+{{- range .MaskedMessages}}
+	"{{.Name}}": {
+	{{- range .Fields}}
+		{Name: "{{.Name}}", Profiles: []string{ {{- range $i, $p := .Profiles}}{{if $i}}, {{end}}{{printf "%q" $p}}{{end -}} }, Style: {{.Style}}},
+	{{- end}}
+	},
+{{- end}}
+}
+{{- end}}
+{{- if .RegionPinned}}
+
+// {{.ServiceType}}RegionPinnedOperations lists operations of {{.ServiceName}} marked with ginpb.region_pinned
+var {{.ServiceType}}RegionPinnedOperations = []string{
+{{- range .MethodSets}}
+{{- if .RegionPinned}}
+	Operation{{$svrType}}{{.OriginalName}},
+{{- end}}
+{{- end}}
+}
+{{- end}}
+`
+
+var serverTemplate = `{{$svrType := .ServiceType}}
+{{$svrName := .ServiceName}}
+
 type {{.ServiceType}}HTTPServer interface {
 {{- range .MethodSets}}
+{{- .Doc "\t"}}
+{{- if .WebSocket}}
+	{{.Name}}(context.Context, *ginpb.WebSocketStream[{{.Request}}, {{.Reply}}]) error
+{{- else if .ServerStream}}
+	{{.Name}}(context.Context, *{{.Request}}, *ginpb.EventStream[*{{.Reply}}]) error
+{{- else if .StreamItem}}
+	{{.Name}}(context.Context, *{{.Request}}, func(*{{.StreamItem}}) error) error
+{{- else}}
 	{{.Name}}(context.Context, *{{.Request}}) (*{{.Reply}}, error)
 {{- end}}
+{{- end}}
 }
 
+// Unimplemented{{.ServiceType}}HTTPServer can be embedded to have forward compatible implementations,
+// methods it provides answer 501 Not Implemented
+type Unimplemented{{.ServiceType}}HTTPServer struct{}
+{{range .MethodSets}}
+{{- if .WebSocket}}
+func (Unimplemented{{$svrType}}HTTPServer) {{.Name}}(context.Context, *ginpb.WebSocketStream[{{.Request}}, {{.Reply}}]) error {
+	return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+}
+{{- else if .ServerStream}}
+func (Unimplemented{{$svrType}}HTTPServer) {{.Name}}(context.Context, *{{.Request}}, *ginpb.EventStream[*{{.Reply}}]) error {
+	return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+}
+{{- else if .StreamItem}}
+func (Unimplemented{{$svrType}}HTTPServer) {{.Name}}(context.Context, *{{.Request}}, func(*{{.StreamItem}}) error) error {
+	return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+}
+{{- else}}
+func (Unimplemented{{$svrType}}HTTPServer) {{.Name}}(context.Context, *{{.Request}}) (*{{.Reply}}, error) {
+	return nil, ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+}
+{{- end}}
+{{end}}
+// {{.ServiceType}}HTTPServerMock implements {{.ServiceType}}HTTPServer with a function field per method to unit test
+// the wiring and middlewares of the service, methods without function answer 501 Not Implemented
+type {{.ServiceType}}HTTPServerMock struct {
+	ginpb.MockCalls
+{{- range .MethodSets}}
+{{- if .WebSocket}}
+	{{.Name}}Func func(context.Context, *ginpb.WebSocketStream[{{.Request}}, {{.Reply}}]) error
+{{- else if .ServerStream}}
+	{{.Name}}Func func(context.Context, *{{.Request}}, *ginpb.EventStream[*{{.Reply}}]) error
+{{- else if .StreamItem}}
+	{{.Name}}Func func(context.Context, *{{.Request}}, func(*{{.StreamItem}}) error) error
+{{- else}}
+	{{.Name}}Func func(context.Context, *{{.Request}}) (*{{.Reply}}, error)
+{{- end}}
+{{- end}}
+}
+
+var _ {{.ServiceType}}HTTPServer = (*{{.ServiceType}}HTTPServerMock)(nil)
+{{range .MethodSets}}
+{{- if .WebSocket}}
+func (m *{{$svrType}}HTTPServerMock) {{.Name}}(ctx context.Context, stream *ginpb.WebSocketStream[{{.Request}}, {{.Reply}}]) error {
+	m.MockCalls.Record(Operation{{$svrType}}{{.OriginalName}})
+	if m.{{.Name}}Func == nil {
+		return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+	}
+	return m.{{.Name}}Func(ctx, stream)
+}
+{{- else if .ServerStream}}
+func (m *{{$svrType}}HTTPServerMock) {{.Name}}(ctx context.Context, req *{{.Request}}, stream *ginpb.EventStream[*{{.Reply}}]) error {
+	m.MockCalls.Record(Operation{{$svrType}}{{.OriginalName}})
+	if m.{{.Name}}Func == nil {
+		return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+	}
+	return m.{{.Name}}Func(ctx, req, stream)
+}
+{{- else if .StreamItem}}
+func (m *{{$svrType}}HTTPServerMock) {{.Name}}(ctx context.Context, req *{{.Request}}, send func(*{{.StreamItem}}) error) error {
+	m.MockCalls.Record(Operation{{$svrType}}{{.OriginalName}})
+	if m.{{.Name}}Func == nil {
+		return ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+	}
+	return m.{{.Name}}Func(ctx, req, send)
+}
+{{- else}}
+func (m *{{$svrType}}HTTPServerMock) {{.Name}}(ctx context.Context, req *{{.Request}}) (*{{.Reply}}, error) {
+	m.MockCalls.Record(Operation{{$svrType}}{{.OriginalName}})
+	if m.{{.Name}}Func == nil {
+		return nil, ginpb.CodeUnimplemented.New(Operation{{$svrType}}{{.OriginalName}})
+	}
+	return m.{{.Name}}Func(ctx, req)
+}
+{{- end}}
+{{end}}
 // RegisterOption defines registration options
 type {{.ServiceType}}RegisterOption func(*{{.ServiceType}}RegisterOptions)
 
@@ -51,6 +223,25 @@ type {{.ServiceType}}RegisterOption func(*{{.ServiceType}}RegisterOptions)
 type {{.ServiceType}}RegisterOptions struct {
 	globalMiddlewares    []gin.HandlerFunc
 	operationMiddlewares map[string][]gin.HandlerFunc
+	responseRewriters    map[string]*ginpb.ResponseRewriter
+	bindConfig           binding1.Config
+	exposures            []string
+	jsonNaming           ginpb.JSONNaming
+	jsonEngine           ginpb.JSONEngine
+	routeTable           *ginpb.RouteTable
+	keyProvider          ginpb.KeyProvider
+	errorEncoder         ginpb.ErrorEncoder
+	responseEncoder      ginpb.ResponseEncoder
+	engine               func(*gin.Engine)
+	{{- if .WebSocket}}
+	webSocketUpgrader    *ginpb.WebSocketUpgrader
+	{{- end}}
+	{{- if .Validate}}
+	validator            ginpb.Validator
+	{{- end}}
+	{{- if .Dedup}}
+	deduplicator         *ginpb.Deduplicator
+	{{- end}}
 }
 
 // WithGlobalMiddleware adds global middleware
@@ -82,18 +273,200 @@ func With{{.ServiceType}}OperationMiddlewares(middlewares map[string][]gin.Handl
 	}
 }
 
+// With{{.ServiceType}}ResponseRewriter rewrites the replies of operation, e.g. to serve legacy field names
+// to old clients during a migration. Streamed replies are not rewritten.
+func With{{.ServiceType}}ResponseRewriter(operation string, rw *ginpb.ResponseRewriter) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if o.responseRewriters == nil {
+			o.responseRewriters = make(map[string]*ginpb.ResponseRewriter)
+		}
+		o.responseRewriters[operation] = rw
+	}
+}
+
+// With{{.ServiceType}}ResponseRewriters sets the response rewriters of multiple operations
+func With{{.ServiceType}}ResponseRewriters(rewriters map[string]*ginpb.ResponseRewriter) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		for operation, rw := range rewriters {
+			With{{.ServiceType}}ResponseRewriter(operation, rw)(o)
+		}
+	}
+}
+
+// With{{.ServiceType}}BindConfig sets request body binding limits such as the body size and multipart memory
+func With{{.ServiceType}}BindConfig(config binding1.Config) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.bindConfig = config
+	}
+}
+
+// With{{.ServiceType}}Exposure sets the exposures of the deployment, methods annotated with
+// another (ginpb.expose) are not registered, e.g. internal-only methods on a public gateway
+func With{{.ServiceType}}Exposure(exposures ...string) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.exposures = append(o.exposures, exposures...)
+	}
+}
+
+// With{{.ServiceType}}JSONNaming encodes replies with protojson using proto field names or lowerCamel JSON names,
+// configure clients with client.WithProtoJSON to decode them. Combine it with ginpb.JSONInt64AsString or
+// ginpb.JSONInt64AsNumber to choose how 64-bit integers are written.
+func With{{.ServiceType}}JSONNaming(naming ginpb.JSONNaming) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.jsonNaming = naming
+	}
+}
+
+// With{{.ServiceType}}JSONEngine marshals replies and streamed list items with e where encoding/json would be used,
+// e.g. jsonengine.Sonic for large list replies. Replies encoded with protojson are not affected.
+func With{{.ServiceType}}JSONEngine(e ginpb.JSONEngine) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if e != nil {
+			o.jsonEngine = e
+		}
+	}
+}
+
+// With{{.ServiceType}}RouteTable mounts the routes through t, so registering the service again replaces
+// its handlers and t.Unregister({{.ServiceType}}Operations...) removes them at runtime
+func With{{.ServiceType}}RouteTable(t *ginpb.RouteTable) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.routeTable = t
+	}
+}
+
+// With{{.ServiceType}}KeyProvider sets the provider encrypting and decrypting fields annotated with ginpb.encrypt,
+// requests and replies of methods with such fields fail without it
+func With{{.ServiceType}}KeyProvider(p ginpb.KeyProvider) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.keyProvider = p
+	}
+}
+{{- if .WebSocket}}
+
+// With{{.ServiceType}}WebSocketUpgrader sets the upgrader of methods served over WebSocket, e.g. to check the
+// origin of cross-origin requests. Without it only same-origin requests are accepted.
+func With{{.ServiceType}}WebSocketUpgrader(u *ginpb.WebSocketUpgrader) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.webSocketUpgrader = u
+	}
+}
+{{- end}}
+{{- if .Validate}}
+
+// With{{.ServiceType}}Validator sets the validator of bound requests, e.g. protovalidate wrapped in a ginpb.ValidatorFunc.
+// Without it requests are validated with their generated Validate methods (ginpb.DefaultValidator).
+func With{{.ServiceType}}Validator(v ginpb.Validator) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if v != nil {
+			o.validator = v
+		}
+	}
+}
+{{- end}}
+{{- if .Dedup}}
+
+// With{{.ServiceType}}Deduplicator sets the store and window deduplicating deliveries by ginpb.message_id.
+// Without it duplicates are dropped for ginpb.DefaultDedupTTL with an in-memory store.
+func With{{.ServiceType}}Deduplicator(d *ginpb.Deduplicator) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if d != nil {
+			o.deduplicator = d
+		}
+	}
+}
+{{- end}}
+
+// With{{.ServiceType}}ErrorEncoder sets how errors are written, e.g. to map domain errors to statuses: requests
+// that could not be bound as *binding.Error with their 400 or 413 status, content types and read masks the
+// method rejects, and errors returned by unary methods. Without it they are written with ginpb.RenderError. Path parameters violating their rules, requests failing (ginpb.validate) with their
+// field violations and failed WebSocket handshakes are answered before and without it.
+func With{{.ServiceType}}ErrorEncoder(e ginpb.ErrorEncoder) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if e != nil {
+			o.errorEncoder = e
+		}
+	}
+}
+
+// With{{.ServiceType}}ResponseEncoder sets how replies of unary methods are written, e.g. in an envelope.
+// Without it they are written as JSON or protobuf with the JSON naming and the response rewriters.
+func With{{.ServiceType}}ResponseEncoder(e ginpb.ResponseEncoder) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		if e != nil {
+			o.responseEncoder = e
+		}
+	}
+}
+
+// With{{.ServiceType}}Engine configures the engine built by New{{.ServiceType}}Handler before the routes are
+// registered, e.g. to add recovery and logging middleware or set trusted proxies. Without it the engine
+// recovers panics with gin.Recovery. Register{{.ServiceType}}HTTPServer ignores it.
+func With{{.ServiceType}}Engine(configure func(*gin.Engine)) {{.ServiceType}}RegisterOption {
+	return func(o *{{.ServiceType}}RegisterOptions) {
+		o.engine = configure
+	}
+}
+
+// {{.ServiceType}}HTTPRoutes lists the routes of {{.ServiceName}}, e.g. to label metrics or configure API gateways
+var {{.ServiceType}}HTTPRoutes = []ginpb.RouteInfo{
+{{- range .Methods}}
+	{Operation: Operation{{$svrType}}{{.OriginalName}}, Method: "{{.Method}}", Path: "{{.RoutePath}}", RequestType: "{{.RequestType}}", ReplyType: "{{.ReplyType}}"{{if .ContentTypes}}, ContentTypes: {{template "contentTypes" .ContentTypes}}{{end}}},
+{{- end}}
+}
+
 // Register{{.ServiceType}}HTTPServer registers HTTP server with function options pattern
 func Register{{.ServiceType}}HTTPServer(r gin.IRouter, srv {{.ServiceType}}HTTPServer, opts ...{{.ServiceType}}RegisterOption) {
-	options := &{{.ServiceType}}RegisterOptions{}
+	options := &{{.ServiceType}}RegisterOptions{
+		bindConfig:   binding1.DefaultConfig(),
+		errorEncoder: ginpb.RenderError,
+		{{- if .JSONNaming}}
+		jsonNaming:   {{.JSONNaming}},
+		{{- end}}
+	}
 	for _, opt := range opts {
 		opt(options)
 	}
+
+	// Fail fast on middleware and rewriters bound to operations this service does not define
+	referenced := make([]string, 0, len(options.operationMiddlewares)+len(options.responseRewriters))
+	for operation := range options.operationMiddlewares {
+		referenced = append(referenced, operation)
+	}
+	for operation := range options.responseRewriters {
+		referenced = append(referenced, operation)
+	}
+	if err := ginpb.ValidateOperations({{.ServiceType}}Operations, referenced...); err != nil {
+		panic(err)
+	}
 	
 	// Helper function to register route with middleware support
-	registerRoute := func(method, path, operation string, handler gin.HandlerFunc) {
+	var verbs *ginpb.VerbRoutes
+	registerRoute := func(method, path, verb, operation, expose string, wildcards []ginpb.PathWildcard, params []ginpb.PathParam, example *ginpb.RouteExample, contentTypes []string, handler gin.HandlerFunc) {
+		// Skip methods not exposed in this deployment
+		if !ginpb.Exposed(expose, options.exposures) {
+			return
+		}
 		var finalHandlers []gin.HandlerFunc
 		
-		// Add global middlewares first
+		// Set the interned operation before any middleware runs
+		op := ginpb.Intern(operation)
+		finalHandlers = append(finalHandlers, func(ctx *gin.Context) {
+			ctx.Set(ginpb.OperationKey, op)
+		})
+		
+		// Join multi-segment path variables before they are validated and bound
+		if len(wildcards) > 0 {
+			finalHandlers = append(finalHandlers, ginpb.JoinPathWildcards(wildcards...))
+		}
+		
+		// Reject path parameters violating their binding rules before anything else runs
+		if len(params) > 0 {
+			finalHandlers = append(finalHandlers, ginpb.ValidatePathParams(params...))
+		}
+		middlewares := len(options.globalMiddlewares) + len(options.operationMiddlewares[operation])
+		
+		// Add global middlewares
 		finalHandlers = append(finalHandlers, options.globalMiddlewares...)
 		
 		// Add operation-specific middlewares
@@ -104,54 +477,208 @@ func Register{{.ServiceType}}HTTPServer(r gin.IRouter, srv {{.ServiceType}}HTTPS
 		// Add the handler at the end
 		finalHandlers = append(finalHandlers, handler)
 		
-		// Register the route
-		r.Handle(method, path, finalHandlers...)
+		// Custom verbs share the route of their path and are dispatched by verb
+		if verb != "" {
+			if verbs == nil {
+				verbs = ginpb.NewVerbRoutes()
+			}
+			finalHandlers = verbs.Handle(r, method, path, verb, finalHandlers...)
+		}
+		
+		// Register the route, a verb route only once
+		if options.routeTable != nil && len(finalHandlers) > 0 {
+			options.routeTable.Handle(r, method, path, operation, finalHandlers...)
+		} else if len(finalHandlers) > 0 {
+			r.Handle(method, path, finalHandlers...)
+		}
+		ginpb.AddRoute(r, ginpb.RouteInfo{Operation: operation, Method: method, Path: ginpb.VerbPath(path, verb), Middlewares: middlewares, Example: example, ContentTypes: contentTypes})
 	}
 	
 	{{- range .Methods}}
-	registerRoute("{{.Method}}", "{{.Path}}", Operation{{$svrType}}{{.OriginalName}}, _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv))
+	registerRoute("{{.Method}}", "{{.Path}}", "{{.Verb}}", Operation{{$svrType}}{{.OriginalName}}, "{{.Expose}}", {{template "pathWildcards" .Wildcards}}, {{template "pathParams" .PathRules}}, {{.Example}}, {{template "contentTypes" .ContentTypes}}, _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv, options))
 	{{- end}}
 }
 
+// New{{.ServiceType}}Handler returns a self-contained http.Handler serving {{.ServiceName}} on its own gin engine
+func New{{.ServiceType}}Handler(srv {{.ServiceType}}HTTPServer, opts ...{{.ServiceType}}RegisterOption) http.Handler {
+	var options {{.ServiceType}}RegisterOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	e := gin.New()
+	if options.engine != nil {
+		options.engine(e)
+	} else {
+		e.Use(gin.Recovery())
+	}
+	Register{{.ServiceType}}HTTPServer(e, srv, opts...)
+	return e
+}
+
+// {{.ServiceType}}Registration returns a registration of {{.ServiceName}} for ginpb.RegisterAll
+func {{.ServiceType}}Registration(srv {{.ServiceType}}HTTPServer, opts ...{{.ServiceType}}RegisterOption) ginpb.Registration {
+	return ginpb.Registration{
+		Operations: {{.ServiceType}}Operations,
+		Register: func(r gin.IRouter, config ginpb.RegisterConfig) {
+			defaults := []{{.ServiceType}}RegisterOption{
+				With{{.ServiceType}}GlobalMiddleware(config.Middlewares...),
+				With{{.ServiceType}}OperationMiddlewares(config.OperationMiddlewaresFor({{.ServiceType}}Operations)),
+				With{{.ServiceType}}ResponseRewriters(config.ResponseRewritersFor({{.ServiceType}}Operations)),
+				With{{.ServiceType}}Exposure(config.Exposures...),
+				{{- if not .JSONNaming}}
+				With{{.ServiceType}}JSONNaming(config.JSONNaming),
+				{{- end}}
+				With{{.ServiceType}}JSONEngine(config.JSONEngine),
+				With{{.ServiceType}}RouteTable(config.RouteTable),
+				With{{.ServiceType}}KeyProvider(config.KeyProvider),
+				With{{.ServiceType}}ErrorEncoder(config.ErrorEncoder),
+				With{{.ServiceType}}ResponseEncoder(config.ResponseEncoder),
+				{{- if .Validate}}
+				With{{.ServiceType}}Validator(config.Validator),
+				{{- end}}
+				{{- if .Dedup}}
+				With{{.ServiceType}}Deduplicator(config.Deduplicator),
+				{{- end}}
+			}
+			{{- if .JSONNaming}}
+			// Keep the encoding chosen at generation unless the config sets one
+			if config.JSONNaming != ginpb.JSONDefault {
+				defaults = append(defaults, With{{.ServiceType}}JSONNaming(config.JSONNaming))
+			}
+			{{- end}}
+			Register{{.ServiceType}}HTTPServer(r, srv, append(defaults, opts...)...)
+		},
+	}
+}
+{{- if .Dependencies}}
+
+// {{.ServiceType}}Dependencies holds the clients of the upstream services {{.ServiceName}} depends on
+type {{.ServiceType}}Dependencies struct {
+	{{- range .Dependencies}}
+	{{.Field}} {{.Client}} // {{.Name}}
+	{{- end}}
+}
+
+// New{{.ServiceType}}Dependencies constructs the upstream clients of {{.ServiceName}} from their config
+func New{{.ServiceType}}Dependencies(upstreams ginpb.Upstreams) (*{{.ServiceType}}Dependencies, error) {
+	var err error
+	deps := &{{.ServiceType}}Dependencies{}
+	{{- range .Dependencies}}
+	if deps.{{.Field}}, err = ginpb.NewUpstreamClient(upstreams, "{{.Name}}", {{.NewClient}}); err != nil {
+		return nil, err
+	}
+	{{- end}}
+	return deps, nil
+}
+
+// Register{{.ServiceType}}HTTPServerWithDependencies constructs the upstream clients, injects them into newServer and registers the server
+func Register{{.ServiceType}}HTTPServerWithDependencies(r gin.IRouter, upstreams ginpb.Upstreams, newServer func(*{{.ServiceType}}Dependencies) {{.ServiceType}}HTTPServer, opts ...{{.ServiceType}}RegisterOption) error {
+	deps, err := New{{.ServiceType}}Dependencies(upstreams)
+	if err != nil {
+		return err
+	}
+	Register{{.ServiceType}}HTTPServer(r, newServer(deps), opts...)
+	return nil
+}
+{{- end}}
+
 {{range .Methods}}
-func _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv {{$svrType}}HTTPServer) func(ctx *gin.Context) {
+{{- .Doc ""}}
+func _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv {{$svrType}}HTTPServer, options *{{$svrType}}RegisterOptions) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		// Set operation for middleware
-		ctx.Set("operation", Operation{{$svrType}}{{.OriginalName}})
-		
+		{{- if .WebSocket}}
+		// Self-test requests end before the connection is upgraded
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
+		newCtx := metadata.NewContext(ctx)
+		// Requests and replies are exchanged as WebSocket messages
+		stream, err := ginpb.UpgradeWebSocket[{{.Request}}, {{.Reply}}](ctx, options.jsonNaming, options.webSocketUpgrader)
+		if err != nil {
+			// The upgrader has answered the handshake already
+			ctx.Error(err)
+			return
+		}
+		{{- if .DecryptRequest}}
+		stream.PrepareRecv(func(in *{{.Request}}) error {
+			return ginpb.DecryptFields(newCtx, options.keyProvider, in)
+		})
+		{{- end}}
+		{{- if or .MaskReply .EncryptReply}}
+		stream.Prepare(func(reply *{{.Reply}}) error {
+			{{- if .MaskReply}}
+			ginpb.MaskFields(newCtx, {{$svrType}}MaskedFields, reply)
+			{{- end}}
+			{{- if .EncryptReply}}
+			return ginpb.EncryptFields(newCtx, options.keyProvider, reply)
+			{{- else}}
+			return nil
+			{{- end}}
+		})
+		{{- end}}
+		stream.Close(srv.{{.Name}}(newCtx, stream))
+		{{- else}}
 		{{if .Fields}}var ginReq _{{.Name}}GinRequest{{else}}var in {{.Request}}{{end}}
+		{{- if .HasHeaders}}
+		// headers, bound first so the validation of the following steps sees them
+		if err := binding1.BindHeader(ctx, &ginReq); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		{{- end}}
+		{{- if .ContentTypes}}
+		// Reject content types the method does not accept before binding the body
+		if err := ginpb.CheckContentType(ctx{{range .ContentTypes}}, {{printf "%q" .}}{{end}}); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		{{- end}}
 		{{- if .HasBody}}
 		// body binding with automatic Content-Type detection
-		{{if .Fields}}if err := binding1.BindByContentType(ctx, &ginReq); err != nil {
-		{{- else}}if err := binding1.BindByContentType(ctx, &in); err != nil {
+		{{if .Fields}}{{if .ProtoBody}}if binding1.IsProtobuf(ctx) {
+			// Protobuf bodies are decoded into the message and copied into the gin struct so its binding tags apply
+			var body {{.ProtoBody}}
+			if err := binding1.BindProtobufWithConfig(ctx, &body, options.bindConfig); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			{{.ProtoBodyCopy}}
+			{{- if and (eq .Body "") (not .HasParams)}}
+			if err := binding1.Validate(ctx, &ginReq); err != nil {
+				options.errorEncoder(ctx, err)
+				return
+			}
+			{{- end}}
+		} else {{end}}if err := binding1.BindByContentTypeWithConfig(ctx, &ginReq, options.bindConfig); err != nil {
+		{{- else}}if err := binding1.BindByContentTypeWithConfig(ctx, &in, options.bindConfig); err != nil {
 		{{- end}}
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
 		{{- if not (eq .Body "")}}
 		// query
-		{{if .Fields}}if err := ctx.BindQuery(&ginReq); err != nil {
-		{{- else}}if err := ctx.BindQuery(&in); err != nil {
+		{{if .Fields}}if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+		{{- else}}if err := binding1.BindQuery(ctx, &in); err != nil {
 		{{- end}}
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
 		{{end}}
 		{{else}}
 		// query
-		{{if .Fields}}if err := ctx.BindQuery(&ginReq); err != nil {
-		{{- else}}if err := ctx.BindQuery(&in); err != nil {
+		{{if .Fields}}if err := binding1.BindQuery(ctx, &ginReq); err != nil {
+		{{- else}}if err := binding1.BindQuery(ctx, &in); err != nil {
 		{{- end}}
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
 		{{end}}
 		{{- if .HasParams}}
 		// params
-		{{if .Fields}}if err := ctx.BindUri(&ginReq); err != nil {
-		{{- else}}if err := ctx.BindUri(&in); err != nil {
+		{{if .Fields}}if err := binding1.BindUri(ctx, &ginReq); err != nil {
+		{{- else}}if err := binding1.BindUri(ctx, &in); err != nil {
 		{{- end}}
-			ctx.Error(err)
+			options.errorEncoder(ctx, err)
 			return
 		}
 		{{end}}
@@ -159,23 +686,183 @@ func _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv {{$svrType}}HTTPServer) fu
 		// Convert gin request to protobuf request
 		in := ginReq.to{{.Name}}Request()
 		{{end}}
+		// Self-test requests end once binding succeeded, without calling the service
+		if ginpb.EndSelfTest(ctx) {
+			return
+		}
 		// Use new context for metadata passing, including request, writer and route params
 		newCtx := metadata.NewContext(ctx)
+		{{- if .DecryptRequest}}
+		// Decrypt fields annotated with ginpb.encrypt
+		if err := ginpb.DecryptFields(newCtx, options.keyProvider, {{if .Fields}}in{{else}}&in{{end}}); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		{{- end}}
+		{{- if .Validate}}
+		// Reject requests violating their validation rules with 400 and the field violations
+		if !ginpb.ValidateRequest(ctx, options.validator, {{if .Fields}}in{{else}}&in{{end}}) {
+			return
+		}
+		{{- end}}
+		{{- if .ReadMask}}
+		// Reject read_mask paths that are not fields of the reply before calling the service
+		if err := ginpb.CheckFieldMask((*{{.ReadMaskType}})(nil), in.Get{{.ReadMask}}()); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		{{- end}}
+		{{- if .ServerStream}}
+		{{- template "responseHeaders" .ResponseHeaders}}
+		// Send replies as Server-Sent Events, the request context is cancelled when the client disconnects
+		stream := ginpb.NewEventStream[*{{.Reply}}](ctx, options.jsonNaming)
+		{{- if or .MaskReply .EncryptReply .ReadMask}}
+		stream.Prepare(func(reply *{{.Reply}}) error {
+			{{- if .ReadMask}}
+			ginpb.PruneFields(reply, in.Get{{.ReadMask}}())
+			{{- end}}
+			{{- if .MaskReply}}
+			ginpb.MaskFields(newCtx, {{$svrType}}MaskedFields, reply)
+			{{- end}}
+			{{- if .EncryptReply}}
+			return ginpb.EncryptFields(newCtx, options.keyProvider, reply)
+			{{- else}}
+			return nil
+			{{- end}}
+		})
+		{{- end}}
+		stream.Close(srv.{{.Name}}(newCtx, {{if .Fields}}in{{else}}&in{{end}}, stream))
+		{{- else if .StreamItem}}
+		{{- template "responseHeaders" .ResponseHeaders}}
+		// Stream {{.StreamField}} items as they are produced
+		w := ginpb.NewListWriterWithNaming(ctx, "{{.StreamFormat}}", options.jsonNaming).WithEngine(options.jsonEngine)
+		err := srv.{{.Name}}(newCtx, {{if .Fields}}in{{else}}&in{{end}}, func(item *{{.StreamItem}}) error {
+			{{- if .ReadMask}}
+			ginpb.PruneFields(item, in.Get{{.ReadMask}}())
+			{{- end}}
+			{{- if .MaskReply}}
+			ginpb.MaskFields(newCtx, {{$svrType}}MaskedFields, item)
+			{{- end}}
+			{{- if .EncryptReply}}
+			if err := ginpb.EncryptFields(newCtx, options.keyProvider, item); err != nil {
+				return err
+			}
+			{{- end}}
+			return w.Send(item)
+		})
+		w.Close(err)
+		{{- else}}
+		{{- if .MessageID}}
+		// Replay the original response to duplicate deliveries of the same message
+		replayed, done := ginpb.Deduplicate(ctx, options.deduplicator, Operation{{$svrType}}{{.OriginalName}}, in.Get{{.MessageID}}())
+		if replayed {
+			return
+		}
+		defer done()
+		{{- end}}
+		{{- if .Timeout}}
+		// Bound the service call by the method timeout
+		newCtx, cancel := context.WithTimeout(newCtx, {{.TimeoutExpr}})
+		defer cancel()
+		{{- end}}
 		{{if .Fields}}reply, err := srv.{{.Name}}(newCtx, in){{else}}reply, err := srv.{{.Name}}(newCtx, &in){{end}}
 		if err != nil {
-			ctx.Error(err)
+			{{- if .Timeout}}
+			options.errorEncoder(ctx, ginpb.TimeoutError(newCtx, err, Operation{{$svrType}}{{.OriginalName}}, {{.TimeoutExpr}}))
+			{{- else}}
+			options.errorEncoder(ctx, err)
+			{{- end}}
+			return
+		}
+		{{- if .ReadMask}}
+		// Prune the reply to the paths of read_mask
+		{{if .PageItems}}ginpb.PruneEach(reply.Get{{.PageItems}}(), in.Get{{.ReadMask}}()){{else}}ginpb.PruneFields(reply, in.Get{{.ReadMask}}()){{end}}
+		{{- end}}
+		{{- if .MaskReply}}
+		// Hide fields annotated with ginpb.mask from the masking profile of the request
+		ginpb.MaskFields(newCtx, {{$svrType}}MaskedFields, reply)
+		{{- end}}
+		{{- if .EncryptReply}}
+		// Encrypt fields annotated with ginpb.encrypt before anything of the reply is written
+		if err := ginpb.EncryptFields(newCtx, options.keyProvider, reply); err != nil {
+			options.errorEncoder(ctx, err)
+			return
+		}
+		{{- end}}
+		{{- template "responseHeaders" .ResponseHeaders}}
+		{{- if .Cache}}
+		// Write Cache-Control{{if .CacheETag}}, the ETag of the encoded reply and 304 for matching If-None-Match{{end}}
+		defer ginpb.CacheReply(ctx, {{.Cache}})()
+		{{- end}}
+		if options.responseEncoder != nil {
+			options.responseEncoder(ctx, {{.Status}}, reply{{.ResponseBody}})
 			return
 		}
-		ctx.JSON(200, reply{{.ResponseBody}})
+		ginpb.RenderReply(ctx, {{.Status}}, options.jsonNaming, options.jsonEngine, options.responseRewriters[Operation{{$svrType}}{{.OriginalName}}], reply{{.ResponseBody}})
+		{{- end}}
+		{{- end}}
 	}
 }
-{{end}}`
+{{end}}
+
+{{define "responseHeaders"}}
+{{- range .}}
+		{{- if .Field}}
+		if v := reply.Get{{.Field}}(); {{.Cond}} {
+			ctx.Header({{printf "%q" .Name}}, {{.Expr}})
+		}
+		{{- else}}
+		ctx.Header({{printf "%q" .Name}}, {{printf "%q" .Value}})
+		{{- end}}
+{{- end}}
+{{- end}}
+
+{{define "pathWildcards"}}
+{{- if .}}[]ginpb.PathWildcard{
+{{- range $i, $w := .}}{{if $i}}, {{end}}{Name: "{{$w.Name}}", Segments: []string{ {{- range $j, $s := $w.Segments}}{{if $j}}, {{end}}{{printf "%q" $s}}{{end -}} }}{{end -}}
+}{{else}}nil{{end}}
+{{- end}}
+
+{{define "contentTypes"}}
+{{- if .}}[]string{ {{- range $i, $t := .}}{{if $i}}, {{end}}{{printf "%q" $t}}{{end -}} }{{else}}nil{{end}}
+{{- end}}
+
+{{define "pathParams"}}
+{{- if .}}[]ginpb.PathParam{
+{{- range $i, $p := .}}{{if $i}}, {{end}}{Name: "{{$p.Name}}", Kind: ginpb.{{$p.Kind}}, Rule: {{printf "%q" $p.Rule}}}{{end -}}
+}{{else}}nil{{end}}
+{{- end}}`
+
+var clientTemplate = `{{define "clientMethod" -}}
+{{.Name}}(ctx context.Context, req *{{.Request}}, {{if .StreamItem}}fn func(*{{.StreamItem}}) error, {{end}}opts ...client.CallOption) {{if .StreamItem}}error{{else}}(rsp *{{.Reply}}, err error){{end}}
+{{- if .PageItem}}
+	{{.Name}}Iter(ctx context.Context, req *{{.Request}}, opts ...client.CallOption) iter.Seq2[*{{.PageItem}}, error]
+{{- end}}
+{{- end}}
+{{$svrType := .ServiceType}}
+{{$svrName := .ServiceName}}
 
-var clientTemplate = `{{$svrType := .ServiceType}}
+{{- range .ClientGroups}}
+// {{$svrType}}{{.GoName}}HTTPClient is the "{{.Name}}" client group of {{$svrName}}
+type {{$svrType}}{{.GoName}}HTTPClient interface {
+{{- range .Methods}}
+{{- if not .WebSocket}}
+{{- .Doc "\t"}}
+	{{template "clientMethod" .}}
+{{- end}}
+{{- end}}
+}
+{{end}}
 
 type {{.ServiceType}}HTTPClient interface {
+{{- range .ClientGroups}}
+	{{$svrType}}{{.GoName}}HTTPClient
+{{- end}}
 {{- range .MethodSets}}
-	{{.Name}}(ctx context.Context, req *{{.Request}}, opts ...client.CallOption) (rsp *{{.Reply}}, err error) 
+{{- if not (or .Group .WebSocket)}}
+{{- .Doc "\t"}}
+	{{template "clientMethod" .}}
+{{- end}}
 {{- end}}
 }
 	
@@ -184,23 +871,70 @@ type {{.ServiceType}}HTTPClientImpl struct{
 }
 	
 func New{{.ServiceType}}HTTPClient(opts ...client.ClientOption) {{.ServiceType}}HTTPClient {
-	c := client.NewClient(opts...)
+	c := client.NewClient(append([]client.ClientOption{
+		client.WithOperationScopes({{.ServiceType}}OperationScopes),
+		client.WithIdempotentOperations({{.ServiceType}}IdempotentOperations...),
+		{{- if eq .JSONNames "camel"}}
+		client.WithCamelCaseJSON(),
+		{{- else if eq .JSONNames "proto"}}
+		client.WithProtoJSON(),
+		{{- end}}
+		{{- if .RegionPinned}}
+		client.WithRegionPinnedOperations({{.ServiceType}}RegionPinnedOperations...),
+		{{- end}}
+	}, opts...)...)
 	return &{{.ServiceType}}HTTPClientImpl{client: c}
 }
 
 {{range .MethodSets}}
+{{- if not .WebSocket}}
+{{- .Doc ""}}
+{{- if .StreamItem}}
+func (c *{{$svrType}}HTTPClientImpl) {{.Name}}(ctx context.Context, in *{{.Request}}, fn func(*{{.StreamItem}}) error, opts ...client.CallOption) error {
+{{- else}}
 func (c *{{$svrType}}HTTPClientImpl) {{.Name}}(ctx context.Context, in *{{.Request}}, opts ...client.CallOption) (*{{.Reply}}, error) {
 	var out {{.Reply}}
+{{- end}}
+	opts = append([]client.CallOption{client.Operation(Operation{{$svrType}}{{.OriginalName}})}, opts...)
 	
 	// Build request path
 	path := "{{.ClientPath}}"
 	{{- if .HasParams}}
 	// Replace path parameters
+	{{- $method := .}}
 	{{- range .PathParams}}
-	path = strings.ReplaceAll(path, "{{print "{" . "}" }}", fmt.Sprintf("%v", in.{{camelCase .}}))
+	path = strings.ReplaceAll(path, "{{print "{" . "}" }}", {{$method.PathValue .}})
+	{{- end}}
+	{{- end}}
+	{{- if .QueryParams}}
+	// Send the filters with the page token, every page of the iterator is queried alike
+	path = client.AppendMessageQuery(path, in,
+		{{- range .QueryParams}}
+		client.QueryParam{Field: "{{.Field}}", Name: "{{.Name}}"},
+		{{- end}}
+	)
 	{{- end}}
+	{{- if .ReadMaskQuery}}
+	path = client.AppendQuery(path, "{{.ReadMaskQuery}}", in.Get{{.ReadMask}}().GetPaths()...)
 	{{- end}}
 	
+	{{- if .ServerStream}}
+	// Receive replies sent as Server-Sent Events
+	err := client.StreamEvents(ctx, c.client, "{{.Method}}", path, {{if and (ne .Method "GET") .HasBody}}in{{.Body}}{{else}}nil{{end}}, fn, opts...)
+	if err != nil {
+		return fmt.Errorf("{{.Method}} {{.ClientPath}} failed: %w", err)
+	}
+	return nil
+}
+	{{- else if .StreamItem}}
+	// Stream {{.StreamField}} items
+	err := client.StreamList(ctx, c.client, "{{.Method}}", path, {{if and (ne .Method "GET") .HasBody}}in{{.Body}}{{else}}nil{{end}}, fn, opts...)
+	if err != nil {
+		return fmt.Errorf("{{.Method}} {{.ClientPath}} failed: %w", err)
+	}
+	return nil
+}
+	{{- else}}
 	{{- if eq .Method "GET"}}
 	// GET request
 	err := c.client.Invoke(ctx, "{{.Method}}", path, nil, &out{{.ResponseBody}}, opts...)
@@ -218,6 +952,20 @@ func (c *{{$svrType}}HTTPClientImpl) {{.Name}}(ctx context.Context, in *{{.Reque
 	}
 	return &out, nil
 }
+	{{- end}}
+{{- if .PageItem}}
+
+// {{.Name}}Iter calls {{.Name}} page by page following next_page_token and yields the {{.PageItems}} of all pages
+func (c *{{$svrType}}HTTPClientImpl) {{.Name}}Iter(ctx context.Context, in *{{.Request}}, opts ...client.CallOption) iter.Seq2[*{{.PageItem}}, error] {
+	return client.Paginate(ctx, in, func(ctx context.Context, req *{{.Request}}) ([]*{{.PageItem}}, string, error) {
+		rsp, err := c.{{.Name}}(ctx, req, opts...)
+		return rsp.Get{{.PageItems}}(), rsp.GetNextPageToken(), err
+	}, func(req *{{.Request}}, token string) {
+		req.PageToken = token
+	})
+}
+{{- end}}
+{{- end}}
 {{end}}`
 
 var tagsStructTemplate = `// Internal structs with gin binding tags for protobuf messages
@@ -226,101 +974,447 @@ var tagsStructTemplate = `// Internal structs with gin binding tags for protobuf
 {{if .Fields}}
 // _{{.Name}}GinRequest provides gin binding tags for {{.Request}}
 type _{{.Name}}GinRequest struct {
-{{range .Fields}}	{{.GoName}} {{.GoType}} {{formatTags .Tags}}
+{{range .Fields}}	{{.GoName}} {{or .BindType .GoType}} {{formatTags .Tags}}
 {{end}}}
 
 // convert{{.Name}}GinRequest converts from gin request struct to protobuf struct
 func (r *_{{.Name}}GinRequest) to{{.Name}}Request() *{{.Request}} {
 	return &{{.Request}}{
-{{range .Fields}}		{{.GoName}}: r.{{.GoName}},
-{{end}}	}
+{{range .Fields}}{{if .Convert}}		{{.GoName}}: {{.Convert}},
+{{end}}{{end}}	}
+}
+
+// from{{.Name}}Request copies a protobuf request decoded from the body into the gin struct
+func (r *_{{.Name}}GinRequest) from{{.Name}}Request(in *{{.Request}}) {
+{{range .Fields}}{{if .ConvertFrom}}	r.{{.GoName}} = {{.ConvertFrom}}
+{{end}}{{end}}}
+{{range .Messages}}
+// {{.Name}} provides gin binding tags for {{.Type}}
+type {{.Name}} struct {
+{{range .Fields}}	{{.GoName}} {{or .BindType .GoType}} {{formatTags .Tags}}
+{{end}}}
+
+// toProto converts from gin struct to protobuf struct
+func (r *{{.Name}}) toProto() *{{.Type}} {
+	if r == nil {
+		return nil
+	}
+	return &{{.Type}}{
+{{range .Fields}}{{if .Convert}}		{{.GoName}}: {{.Convert}},
+{{end}}{{end}}	}
+}
+
+// {{.Name}}FromProto converts from protobuf struct to gin struct
+func {{.Name}}FromProto(in *{{.Type}}) *{{.Name}} {
+	if in == nil {
+		return nil
+	}
+	return &{{.Name}}{
+{{range .Fields}}{{if .ConvertFrom}}		{{.GoName}}: {{.ConvertFrom}},
+{{end}}{{end}}	}
+}
+{{end}}
+{{- range .Enums}}
+// {{.Name}} binds {{.Type}} by the name or the number of its values
+type {{.Name}} int32
+
+func (e *{{.Name}}) UnmarshalJSON(b []byte) error {
+	return ginpb.UnmarshalEnumJSON(b, (*int32)(e), {{.Type}}(0).Descriptor())
+}
+
+func (e *{{.Name}}) UnmarshalParam(param string) error {
+	return ginpb.UnmarshalEnumParam(param, (*int32)(e), {{.Type}}(0).Descriptor())
 }
 {{end}}
+{{- end}}
 {{end}}`
 
 const Release = "v1.0.0" // Plugin version
 
-var methodSets = make(map[string]int)
-
 // GenerateFile generates a .pb.gin.go file using resty-based client
-func GenerateFile(gen *protogen.Plugin, file *protogen.File, omitempty bool) *protogen.GeneratedFile {
-	if len(file.Services) == 0 || (omitempty && !hasHTTPRule(file.Services)) {
+func GenerateFile(gen *protogen.Plugin, file *protogen.File, config *Config) (*protogen.GeneratedFile, error) {
+	opts := config.ForFile(file.Desc.Path())
+	if err := genErrors(gen, file, opts); err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+	}
+	if err := genWebhooks(gen, file, opts); err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+	}
+	if err := genCRUD(gen, file, opts); err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+	}
+	if len(file.Services) == 0 || (opts.Omitempty && !hasHTTPRule(file.Services, opts.WebSocket)) {
+		return nil, nil
+	}
+	out := newOutputFiles(gen, file, opts)
+	g := out.Operations
+	if err := generateFileContent(gen, file, out, config); err != nil {
+		return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+	}
+	for _, sd := range out.K6 {
+		if err := genK6(gen, file, sd); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+	}
+	if len(out.OpenAPI) > 0 {
+		if err := genOpenAPI(gen, file, out.OpenAPI, opts.camelJSON()); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+	}
+	config.services = append(config.services, out.Services...)
+	return g, nil
+}
+
+// GenerateMetadata writes config.MetadataOut describing the services of the files generated before, if set
+func GenerateMetadata(gen *protogen.Plugin, config *Config) error {
+	if config.MetadataOut == "" {
 		return nil
 	}
-	filename := file.GeneratedFilenamePrefix + ".pb.gin.go"
-	g := gen.NewGeneratedFile(filename, file.GoImportPath)
-	g.P("// Code generated by protoc-gen-gin with resty client. DO NOT EDIT.")
-	g.P("// versions:")
-	g.P(fmt.Sprintf("// - protoc-gen-gin %s", Release))
-	g.P("// - protoc             ", protocVersion(gen))
-	if file.Proto.GetOptions().GetDeprecated() {
-		g.P("// ", file.Desc.Path(), " is a deprecated file.")
-	} else {
-		g.P("// source: ", file.Desc.Path())
+	if err := genMetadata(gen, config.MetadataOut, config.services); err != nil {
+		return fmt.Errorf("metadata_out %s: %w", config.MetadataOut, err)
+	}
+	return nil
+}
+
+// outputFiles are the files the parts of a service are written to, all the same file unless build tags or
+// split files are enabled
+type outputFiles struct {
+	Operations *protogen.GeneratedFile
+	Server     *protogen.GeneratedFile
+	Client     *protogen.GeneratedFile
+	// Types holds the gin binding structs, which only the server uses
+	Types *protogen.GeneratedFile
+	// services to write k6 load tests for
+	K6 []*serviceDesc
+	// services to describe in the OpenAPI document
+	OpenAPI []*serviceDesc
+	// all generated services
+	Services []*serviceDesc
+}
+
+// newOutputFiles creates the files the services of file are written to
+func newOutputFiles(gen *protogen.Plugin, file *protogen.File, opts ResolvedOptions) *outputFiles {
+	serverTag, clientTag := "", ""
+	if opts.BuildTags {
+		serverTag, clientTag = opts.ServerBuildTag, opts.ClientBuildTag
+	}
+	prefix := file.GeneratedFilenamePrefix
+	if opts.SplitFiles {
+		out := &outputFiles{
+			Operations: newGeneratedFile(gen, file, prefix+"_gin_types.pb.go", ""),
+			Server:     newGeneratedFile(gen, file, prefix+"_gin_server.pb.go", serverTag),
+			Client:     newGeneratedFile(gen, file, prefix+"_gin_client.pb.go", clientTag),
+		}
+		// Binding structs depend on ginpb, they stay with the server when it can be compiled out
+		out.Types = out.Operations
+		if opts.BuildTags {
+			out.Types = out.Server
+		}
+		return out
+	}
+	g := newGeneratedFile(gen, file, prefix+opts.FileSuffix, "")
+	out := &outputFiles{Operations: g, Server: g, Client: g, Types: g}
+	if opts.BuildTags {
+		out.Server = newGeneratedFile(gen, file, prefix+"_server"+opts.FileSuffix, serverTag)
+		out.Client = newGeneratedFile(gen, file, prefix+"_client"+opts.FileSuffix, clientTag)
+		out.Types = out.Server
+	}
+	return out
+}
+
+// newGeneratedFile creates a generated file with the standard header, guarded by buildTag if set
+func newGeneratedFile(gen *protogen.Plugin, file *protogen.File, filename, buildTag string) *protogen.GeneratedFile {
+	g := gen.NewGeneratedFile(filename, file.GoImportPath)
+	g.P("// Code generated by protoc-gen-gin with resty client. DO NOT EDIT.")
+	g.P("// versions:")
+	g.P(fmt.Sprintf("// - protoc-gen-gin %s", Release))
+	g.P("// - protoc             ", protocVersion(gen))
+	if file.Proto.GetOptions().GetDeprecated() {
+		g.P("// ", file.Desc.Path(), " is a deprecated file.")
+	} else {
+		g.P("// source: ", file.Desc.Path())
+	}
+	g.P()
+	if buildTag != "" {
+		g.P("//go:build ", buildTag)
+		g.P()
+	}
+	g.P("package ", file.GoPackageName)
+	g.P()
+	return g
+}
+
+// generateFileContent generates the resty-based client implementation
+func generateFileContent(gen *protogen.Plugin, file *protogen.File, out *outputFiles, config *Config) error {
+	if len(file.Services) == 0 {
+		return nil
+	}
+	genAssertions(out.Server)
+	if out.Client != out.Server {
+		genAssertions(out.Client)
+	}
+
+	for _, service := range file.Services {
+		opts := config.ForService(file.Desc.Path(), string(service.Desc.FullName()))
+		if err := genService(gen, file, out, service, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// genAssertions references the packages used by the templates so they are imported
+func genAssertions(g *protogen.GeneratedFile) {
+	g.P("// This is a compile-time assertion to ensure that this generated file")
+	g.P("// is compatible with the resty client it is being compiled against.")
+	g.P("var _ = new(", contextPackage.Ident("Context"), ")")
+	g.P("var _ = new(", metadataPackage.Ident("GinData"), ")")
+	g.P("var _ = new(", ginPackage.Ident("H"), ")")
+	g.P("var _ = new(", clientPackage.Ident("Client"), ")")
+	g.P("var _ = ", bindingPackage.Ident("JSON"))
+	g.P("var _ = ", bindingutilPackage.Ident("BindByContentType"))
+	g.P("var _ = ", middlewarePackage.Ident("Chain"))
+	g.P("var _ = ", fmtPackage.Ident("Sprintf"))
+	g.P("var _ = ", stringsPackage.Ident("ReplaceAll"))
+	g.P("var _ = ", ginpbPackage.Ident("AddRoute"))
+	g.P("var _ = new(", httpPackage.Ident("Handler"), ")")
+	g.P()
+}
+
+func genService(gen *protogen.Plugin, file *protogen.File, out *outputFiles, service *protogen.Service, opts ResolvedOptions) error {
+	if !opts.Server && !opts.Client {
+		return nil
+	}
+	if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
+		out.Operations.P("//")
+		out.Operations.P(deprecationComment)
+	}
+
+	// Message types are imported into the file of the first enabled half, see importMethodTypes for the other
+	g := out.Server
+	if !opts.Server {
+		g = out.Client
+	}
+
+	// HTTP Server.
+	sd := &serviceDesc{
+		ServiceType: service.GoName,
+		ServiceName: string(service.Desc.FullName()),
+		Metadata:    file.Desc.Path(),
+		Server:      opts.Server,
+		Client:      opts.Client,
+		JSONNames:   opts.JSONNames,
+		service:     service,
+		templates:   opts.Templates,
+	}
+	namings, err := opts.jsonNaming()
+	if err != nil {
+		return fmt.Errorf("%s: %w", service.Desc.FullName(), err)
+	}
+	for _, method := range service.Methods {
+		// Server-streaming methods are served as Server-Sent Events, client and bidirectional streaming over WebSocket when enabled
+		if method.Desc.IsStreamingClient() && !opts.WebSocket {
+			continue
+		}
+		rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule != nil && ok {
+			rules := append([]*annotations.HttpRule{}, rule.AdditionalBindings...)
+			for _, r := range append(rules, rule) {
+				md, err := buildHTTPRule(g, method, r, opts.camelJSON())
+				if err != nil {
+					return err
+				}
+				sd.Methods = append(sd.Methods, md)
+			}
+		} else if !opts.Omitempty {
+			path := fmt.Sprintf("/%s/%s", service.Desc.FullName(), method.Desc.Name())
+			md, err := buildMethodDesc(g, method, http.MethodPost, path, opts.camelJSON())
+			if err != nil {
+				return err
+			}
+			sd.Methods = append(sd.Methods, md)
+		} else {
+			continue
+		}
+		if opts.Server && opts.Client && out.Client != out.Server {
+			importMethodTypes(out.Client, method)
+		}
+	}
+	// Number the HTTP rules of each method in declaration order, scoped to the service
+	rules := make(map[string]int)
+	for _, m := range sd.Methods {
+		m.Num = rules[m.Name]
+		rules[m.Name]++
+	}
+	if opts.Server {
+		deps, err := buildDependencies(gen, out.Server, service)
+		if err != nil {
+			return err
+		}
+		sd.Dependencies = deps
+		for i, n := range namings {
+			namings[i] = out.Server.QualifiedGoIdent(ginpbPackage.Ident(n))
+		}
+		sd.JSONNaming = strings.Join(namings, " | ")
+	}
+	if opts.PathPrefix != "" {
+		for _, m := range sd.Methods {
+			m.Path = opts.PathPrefix + m.Path
+			m.ClientPath = opts.PathPrefix + m.ClientPath
+		}
+	}
+	if err := checkVerbRoutes(sd.Methods); err != nil {
+		return err
+	}
+	if err := checkDuplicateRoutes(sd.ServiceName, sd.Methods); err != nil {
+		return err
+	}
+	routed := make(map[string]bool)
+	for _, m := range sd.Methods {
+		routed[m.OriginalName] = true
+	}
+	for _, method := range service.Methods {
+		var descs []*methodDesc
+		for _, m := range sd.Methods {
+			if m.Name == method.GoName {
+				descs = append(descs, m)
+			}
+		}
+		if len(descs) == 0 {
+			continue
+		}
+		decrypt, err := hasEncryptedFields(method.Input.Desc, make(map[protoreflect.FullName]bool))
+		if err != nil {
+			return err
+		}
+		encrypt, err := hasEncryptedFields(method.Output.Desc, make(map[protoreflect.FullName]bool))
+		if err != nil {
+			return err
+		}
+		objective, err := buildSLO(out.Operations, method)
+		if err != nil {
+			return err
+		}
+		invalidates, err := buildInvalidations(method, routed)
+		if err != nil {
+			return err
+		}
+		sd.Invalidations = sd.Invalidations || len(invalidates) > 0
+		if objective != "" {
+			sd.SLOType = out.Operations.QualifiedGoIdent(sloPackage.Ident("Objective"))
+		}
+		masked, err := collectMaskedFields(out.Operations, sd, method.Output.Desc, make(map[protoreflect.FullName]bool))
+		if err != nil {
+			return err
+		}
+		if masked {
+			sd.MaskTable = out.Operations.QualifiedGoIdent(ginpbPackage.Ident("MaskTable"))
+		}
+		for _, m := range descs {
+			m.MaskReply = masked
+			m.SLO = objective
+			m.Invalidates = invalidates
+			m.DecryptRequest = decrypt
+			m.EncryptReply = encrypt
+		}
+	}
+	for _, m := range sd.Methods {
+		m.Example = buildExample(m).goLiteral()
+		sd.RegionPinned = sd.RegionPinned || m.RegionPinned
+		sd.WebSocket = sd.WebSocket || m.WebSocket
+		// Requests of WebSocket methods arrive as messages and are not validated
+		m.Validate = opts.Validate && !m.WebSocket
+		sd.Validate = sd.Validate || m.Validate
+		sd.Dedup = sd.Dedup || m.MessageID != ""
+		if m.PageItem != "" && opts.Client {
+			out.Client.QualifiedGoIdent(iterPackage.Ident("Seq2"))
+		}
+		if m.escapesPathSegment() && opts.Client {
+			out.Client.QualifiedGoIdent(urlPackage.Ident("PathEscape"))
+		}
+	}
+	if len(sd.Methods) != 0 {
+		code, err := sd.execute()
+		if err != nil {
+			return err
+		}
+		if opts.K6 {
+			out.K6 = append(out.K6, sd)
+		}
+		if opts.OpenAPI {
+			out.OpenAPI = append(out.OpenAPI, sd)
+		}
+		out.Services = append(out.Services, sd)
+		out.Operations.P(code.Operations)
+		if code.Server != "" {
+			out.Server.P(code.Server)
+		}
+		if code.Client != "" {
+			out.Client.P(code.Client)
+		}
+		if code.Tags != "" {
+			out.Types.P(code.Tags)
+		}
 	}
-	g.P()
-	g.P("package ", file.GoPackageName)
-	g.P()
-	generateFileContent(gen, file, g, omitempty)
-	return g
+	return nil
 }
 
-// generateFileContent generates the resty-based client implementation
-func generateFileContent(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile, omitempty bool) {
-	if len(file.Services) == 0 {
-		return
+// buildDependencies resolves the upstream services listed in (ginpb.depends_on) to their generated clients
+func buildDependencies(gen *protogen.Plugin, g *protogen.GeneratedFile, service *protogen.Service) ([]*dependency, error) {
+	names, _ := proto.GetExtension(service.Desc.Options(), ginext.E_DependsOn).([]string)
+	if len(names) == 0 {
+		return nil, nil
 	}
-	g.P("// This is a compile-time assertion to ensure that this generated file")
-	g.P("// is compatible with the resty client it is being compiled against.")
-	g.P("var _ = new(", contextPackage.Ident("Context"), ")")
-	g.P("var _ = new(", metadataPackage.Ident("GinData"), ")")
-	g.P("var _ = new(", ginPackage.Ident("H"), ")")
-	g.P("var _ = new(", clientPackage.Ident("Client"), ")")
-	g.P("var _ = ", bindingPackage.Ident("JSON"))
-	g.P("var _ = ", bindingutilPackage.Ident("BindByContentType"))
-	g.P("var _ = ", middlewarePackage.Ident("Chain"))
-	g.P("var _ = ", fmtPackage.Ident("Sprintf"))
-	g.P("var _ = ", stringsPackage.Ident("ReplaceAll"))
-	g.P()
 
-	for _, service := range file.Services {
-		genService(gen, file, g, service, omitempty)
+	services := make(map[string]*dependency)
+	for _, f := range gen.Files {
+		for _, s := range f.Services {
+			services[string(s.Desc.FullName())] = &dependency{
+				Name:      string(s.Desc.FullName()),
+				Field:     s.GoName,
+				Client:    g.QualifiedGoIdent(f.GoImportPath.Ident(s.GoName + "HTTPClient")),
+				NewClient: g.QualifiedGoIdent(f.GoImportPath.Ident("New" + s.GoName + "HTTPClient")),
+			}
+		}
 	}
-}
 
-func genService(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile, service *protogen.Service, omitempty bool) {
-	if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
-		g.P("//")
-		g.P(deprecationComment)
+	var res []*dependency
+	fields := make(map[string]bool)
+	for _, name := range names {
+		dep, ok := services[strings.TrimPrefix(name, ".")]
+		if !ok {
+			return nil, fmt.Errorf("depends_on %q of %s: service not found, use its full name and import its .proto file", name, service.Desc.FullName())
+		}
+		if dep.Name == string(service.Desc.FullName()) {
+			return nil, fmt.Errorf("depends_on of %s lists the service itself", service.Desc.FullName())
+		}
+		if fields[dep.Field] {
+			// Same service name in different packages
+			dep.Field = camelCase(strings.ReplaceAll(dep.Name, ".", "_"))
+		}
+		fields[dep.Field] = true
+		res = append(res, dep)
 	}
+	return res, nil
+}
 
-	// HTTP Server.
-	sd := &serviceDesc{
-		ServiceType: service.GoName,
-		ServiceName: string(service.Desc.FullName()),
-		Metadata:    file.Desc.Path(),
-	}
-	for _, method := range service.Methods {
-		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
-			continue
-		}
-		rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
-		if rule != nil && ok {
-			for _, bind := range rule.AdditionalBindings {
-				sd.Methods = append(sd.Methods, buildHTTPRule(g, method, bind))
+// importMethodTypes imports the message types of m into g
+func importMethodTypes(g *protogen.GeneratedFile, m *protogen.Method) {
+	g.QualifiedGoIdent(m.Input.GoIdent)
+	g.QualifiedGoIdent(m.Output.GoIdent)
+	if so, ok := proto.GetExtension(m.Desc.Options(), ginext.E_Stream).(*ginext.StreamOptions); ok && so != nil {
+		for _, f := range m.Output.Fields {
+			if string(f.Desc.Name()) == so.GetField() && f.Message != nil {
+				g.QualifiedGoIdent(f.Message.GoIdent)
 			}
-			sd.Methods = append(sd.Methods, buildHTTPRule(g, method, rule))
-		} else if !omitempty {
-			path := fmt.Sprintf("/%s/%s", service.Desc.FullName(), method.Desc.Name())
-			sd.Methods = append(sd.Methods, buildMethodDesc(g, method, http.MethodPost, path))
 		}
 	}
-	if len(sd.Methods) != 0 {
-		g.P(sd.execute())
+	if items := pageItemsField(m); items != nil {
+		g.QualifiedGoIdent(items.Message.GoIdent)
 	}
 }
 
-func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotations.HttpRule) *methodDesc {
+func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotations.HttpRule, camel bool) (*methodDesc, error) {
 	var (
 		path         string
 		method       string
@@ -350,10 +1444,13 @@ func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotati
 	}
 	body = rule.Body
 	responseBody = rule.ResponseBody
-	md := buildMethodDesc(g, m, method, path)
+	md, err := buildMethodDesc(g, m, method, path, camel)
+	if err != nil {
+		return nil, err
+	}
 
 	// Parse path parameters
-	md.PathParams = extractPathParams(path)
+	md.PathParams = extractPathParams(md.ClientPath)
 
 	if method == http.MethodGet || method == http.MethodDelete {
 		if body != "" {
@@ -373,25 +1470,102 @@ func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotati
 	} else {
 		md.HasBody = false
 	}
+	setProtoBody(g, md, m, body)
+	// Page tokens and filters outside the body are sent as query parameters
+	if md.PageItem != "" && body != "*" {
+		md.QueryParams = buildQueryParams(md, body)
+	}
 	if responseBody == "*" {
 		md.ResponseBody = ""
 	} else if responseBody != "" {
 		md.ResponseBody = "." + camelCaseVars(responseBody)
+		// The client returns the response body field only, pages cannot be followed
+		md.PageItems, md.PageItem, md.QueryParams = "", "", nil
+	}
+	if types, _ := proto.GetExtension(m.Desc.Options(), ginext.E_ContentTypes).([]string); len(types) > 0 {
+		if md.ContentTypes, err = buildContentTypes(md, m, types); err != nil {
+			return nil, err
+		}
+	}
+	if timeout, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Timeout).(string); timeout != "" {
+		if err := setTimeout(g, md, m, timeout); err != nil {
+			return nil, err
+		}
+	}
+	if cache, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Cache).(*ginext.CacheOptions); cache != nil {
+		if err := setCache(g, md, m, cache); err != nil {
+			return nil, err
+		}
+	}
+	if f := readMaskField(m); f != nil && !md.WebSocket {
+		setReadMask(md, f, body)
+	}
+	return md, nil
+}
+
+// readMaskField returns the google.protobuf.FieldMask field read_mask of the request, see AIP-157
+func readMaskField(m *protogen.Method) *protogen.Field {
+	for _, f := range m.Input.Fields {
+		if f.Desc.Name() == "read_mask" && !f.Desc.IsList() && f.Message != nil && f.Message.Desc.FullName() == "google.protobuf.FieldMask" {
+			return f
+		}
+	}
+	return nil
+}
+
+// queryParam is a field of the request sent as query parameter by the client
+type queryParam struct {
+	Field string // proto name, e.g. page_token
+	Name  string // query parameter from the form tag, e.g. pageToken
+}
+
+// buildQueryParams returns the request fields the server binds from the query, all but path parameters,
+// headers, the body field and read masks, which are sent with their own query parameter
+func buildQueryParams(md *methodDesc, body string) []*queryParam {
+	path := make(map[string]bool)
+	for _, p := range md.PathParams {
+		path[strings.SplitN(p, "=", 2)[0]] = true
+	}
+	var res []*queryParam
+	for _, f := range md.Fields {
+		form := strings.Split(getTag(f, "form"), ",")[0]
+		if form == "" || form == "-" || getTag(f, "header") != "" || path[f.Name] || f.Name == body || strings.HasSuffix(f.protoType, "google.protobuf.FieldMask") {
+			continue
+		}
+		res = append(res, &queryParam{Field: f.Name, Name: form})
 	}
-	return md
+	return res
 }
 
-func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path string) *methodDesc {
-	defer func() { methodSets[m.GoName]++ }()
+// setReadMask prunes replies to the paths of the read_mask field f, the paths select fields of the streamed
+// items, of the page items or of the reply
+func setReadMask(md *methodDesc, f *protogen.Field, body string) {
+	md.ReadMask = f.GoName
+	switch {
+	case md.StreamItem != "":
+		md.ReadMaskType = md.StreamItem
+	case md.PageItem != "":
+		md.ReadMaskType = md.PageItem
+	default:
+		md.ReadMaskType = md.Reply
+	}
+	// Masks outside the body are sent as query parameter
+	if body != "*" && body != string(f.Desc.Name()) {
+		md.ReadMaskQuery = string(f.Desc.Name())
+		for _, info := range md.Fields {
+			if info.Name == md.ReadMaskQuery && getTag(info, "form") != "" {
+				md.ReadMaskQuery = getTag(info, "form")
+			}
+		}
+	}
+}
 
+func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path string, camel bool) (*methodDesc, error) {
 	params := buildPathParams(path)
 
-	for v, s := range params {
+	for _, v := range slices.Sorted(maps.Keys(params)) {
 		fields := m.Input.Desc.Fields()
 
-		if s != nil {
-			path = replacePath(v, *s, path)
-		}
 		for _, field := range strings.Split(v, ".") {
 			if strings.TrimSpace(field) == "" {
 				continue
@@ -401,8 +1575,7 @@ func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path
 			}
 			fd := fields.ByName(protoreflect.Name(field))
 			if fd == nil {
-				fmt.Fprintf(os.Stderr, "\u001B[31mERROR\u001B[m: The corresponding field '%s' declaration in message could not be found in '%s'\n", v, path)
-				os.Exit(2)
+				return nil, fmt.Errorf("path variable '%s' of %s in %s is not a field of %s", v, path, m.Desc.FullName(), m.Input.Desc.FullName())
 			}
 			if fd.IsMap() {
 				fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: The field in path:'%s' shouldn't be a map.\n", v)
@@ -413,18 +1586,289 @@ func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path
 			}
 		}
 	}
-	return &methodDesc{
-		Name:         m.GoName,
-		OriginalName: string(m.Desc.Name()),
-		Num:          methodSets[m.GoName],
-		Request:      g.QualifiedGoIdent(m.Input.GoIdent),
-		Reply:        g.QualifiedGoIdent(m.Output.GoIdent),
-		Path:         transformPath(path),
-		ClientPath:   path,
-		Method:       method,
-		HasParams:    len(params) > 0,
-		Fields:       parseMessageFields(m.Input),
+	route, clientPath, verb, wildcards, err := routePath(m, path)
+	if err != nil {
+		return nil, err
+	}
+	group, _ := proto.GetExtension(m.Desc.Options(), ginext.E_ClientGroup).(string)
+	scopes, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Scopes).([]string)
+	budget, _ := proto.GetExtension(m.Desc.Options(), ginext.E_LatencyBudget).(string)
+	expose, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Expose).(string)
+	pinned, _ := proto.GetExtension(m.Desc.Options(), ginext.E_RegionPinned).(bool)
+	idempotent, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Idempotent).(bool)
+	switch m.Desc.Options().(*descriptorpb.MethodOptions).GetIdempotencyLevel() {
+	case descriptorpb.MethodOptions_NO_SIDE_EFFECTS, descriptorpb.MethodOptions_IDEMPOTENT:
+		idempotent = true
+	}
+	md := &methodDesc{
+		Group:         group,
+		Scopes:        scopes,
+		LatencyBudget: budget,
+		Expose:        expose,
+		RegionPinned:  pinned,
+		Idempotent:    idempotent,
+		Name:          m.GoName,
+		OriginalName:  string(m.Desc.Name()),
+		Request:       g.QualifiedGoIdent(m.Input.GoIdent),
+		Reply:         g.QualifiedGoIdent(m.Output.GoIdent),
+		RequestType:   string(m.Input.Desc.FullName()),
+		ReplyType:     string(m.Output.Desc.FullName()),
+		Path:          route,
+		ClientPath:    clientPath,
+		Verb:          verb,
+		Wildcards:     wildcards,
+		Method:        method,
+		HasParams:     len(params) > 0,
+		desc:          m,
+	}
+	nested := &ginStructs{prefix: "_" + m.GoName + "Gin", names: make(map[protoreflect.FullName]string), camel: camel}
+	if md.Fields, err = parseMessageFields(g, m.Input, nested); err != nil {
+		return nil, err
+	}
+	md.Messages = nested.messages
+	md.Enums = nested.enums
+	// Bind path variables by name unless tagged explicitly
+	for _, f := range md.Fields {
+		if _, ok := params[f.Name]; ok && !hasTag(f, "uri") {
+			f.Tags["uri"] = f.Name
+		}
+	}
+	md.PathRules = buildPathRules(md.Fields, params)
+	md.Summary, md.Description = methodDoc(m)
+	md.Status = http.StatusOK
+	if status, _ := proto.GetExtension(m.Desc.Options(), ginext.E_HttpStatus).(int32); status != 0 {
+		if status < 200 || status > 299 {
+			return nil, fmt.Errorf("ginpb.http_status of %s must be a 2xx success code, not %d", m.Desc.FullName(), status)
+		}
+		if m.Desc.IsStreamingServer() || m.Desc.IsStreamingClient() || proto.HasExtension(m.Desc.Options(), ginext.E_Stream) {
+			return nil, fmt.Errorf("%s streams its reply with status 200, it cannot use ginpb.http_status", m.Desc.FullName())
+		}
+		md.Status = int(status)
+	}
+	f, err := messageIDField(m)
+	if err != nil {
+		return nil, err
+	}
+	if f != nil {
+		if m.Desc.IsStreamingServer() || m.Desc.IsStreamingClient() || proto.HasExtension(m.Desc.Options(), ginext.E_Stream) {
+			return nil, fmt.Errorf("%s streams its reply, ginpb.message_id on %s can only deduplicate unary methods", m.Desc.FullName(), f.Desc.FullName())
+		}
+		md.MessageID = f.GoName
+	}
+	if so, ok := proto.GetExtension(m.Desc.Options(), ginext.E_Stream).(*ginext.StreamOptions); ok && so != nil {
+		if m.Desc.IsStreamingServer() || m.Desc.IsStreamingClient() {
+			return nil, fmt.Errorf("%s is a streaming method, its messages are sent as Server-Sent Events or over WebSocket and cannot use ginpb.stream", m.Desc.FullName())
+		}
+		if err := setStreamOptions(g, md, m, so); err != nil {
+			return nil, err
+		}
+	}
+	if m.Desc.IsStreamingClient() {
+		if method != http.MethodGet {
+			return nil, fmt.Errorf("%s is served over WebSocket, its http rule must use get instead of %s", m.Desc.FullName(), strings.ToLower(method))
+		}
+		// Requests arrive as WebSocket messages instead of being bound from the HTTP request
+		md.WebSocket = true
+		md.Fields = nil
+		md.Messages = nil
+		md.Enums = nil
+		md.PathRules = nil
+		return md, nil
+	}
+	if m.Desc.IsStreamingServer() {
+		md.ServerStream = true
+		md.StreamItem = md.Reply
+		md.StreamFormat = "sse"
+	}
+	headers, _ := proto.GetExtension(m.Desc.Options(), ginext.E_ResponseHeaders).([]*ginext.ResponseHeader)
+	if md.ResponseHeaders, err = buildResponseHeaders(md, m, headers); err != nil {
+		return nil, err
+	}
+	if items := pageItemsField(m); items != nil && md.StreamItem == "" {
+		md.PageItems = items.GoName
+		md.PageItem = g.QualifiedGoIdent(items.Message.GoIdent)
+	}
+	return md, nil
+}
+
+// messageIDField returns the field of the request annotated with (ginpb.message_id)
+func messageIDField(m *protogen.Method) (*protogen.Field, error) {
+	var res *protogen.Field
+	for _, f := range m.Input.Fields {
+		if ok, _ := proto.GetExtension(f.Desc.Options(), ginext.E_MessageId).(bool); !ok {
+			continue
+		}
+		if f.Desc.Kind() != protoreflect.StringKind || f.Desc.IsList() {
+			return nil, fmt.Errorf("ginpb.message_id of %s: only singular string fields identify messages", f.Desc.FullName())
+		}
+		if res != nil {
+			return nil, fmt.Errorf("%s has more than one ginpb.message_id field: %s and %s", m.Input.Desc.FullName(), res.Desc.Name(), f.Desc.Name())
+		}
+		res = f
+	}
+	return res, nil
+}
+
+// pageItemsField returns the items of a paginated list method following AIP-158: the request has a string
+// page_token field, the reply a string next_page_token field and exactly one repeated message field
+func pageItemsField(m *protogen.Method) *protogen.Field {
+	isString := func(fd protoreflect.FieldDescriptor) bool {
+		return fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList()
+	}
+	if !isString(m.Input.Desc.Fields().ByName("page_token")) || !isString(m.Output.Desc.Fields().ByName("next_page_token")) {
+		return nil
+	}
+	var items *protogen.Field
+	for _, f := range m.Output.Fields {
+		if f.Desc.IsList() && f.Message != nil {
+			if items != nil {
+				return nil
+			}
+			items = f
+		}
+	}
+	return items
+}
+
+// buildResponseHeaders resolves the headers declared by ginpb.response_headers and the ginpb.response_header
+// options of reply fields
+func buildResponseHeaders(md *methodDesc, m *protogen.Method, headers []*ginext.ResponseHeader) ([]*responseHeader, error) {
+	fail := func(format string, args ...interface{}) error {
+		return fmt.Errorf("response header of %s: %s", m.Desc.FullName(), fmt.Sprintf(format, args...))
+	}
+
+	var res []*responseHeader
+	seen := make(map[string]bool)
+	add := func(rh *responseHeader, err error) error {
+		if err != nil {
+			return fmt.Errorf("response header of %s: %w", m.Desc.FullName(), err)
+		}
+		key := http.CanonicalHeaderKey(rh.Name)
+		if seen[key] {
+			return fail("header %s is declared twice", rh.Name)
+		}
+		seen[key] = true
+		res = append(res, rh)
+		return nil
+	}
+	for _, h := range headers {
+		if h.GetName() == "" {
+			return nil, fail("name is required")
+		}
+		if (h.GetValue() == "") == (h.GetField() == "") {
+			return nil, fail("header %s must set exactly one of value or field", h.GetName())
+		}
+		if h.GetValue() != "" {
+			if err := add(&responseHeader{Name: h.GetName(), Value: h.GetValue()}, nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var field *protogen.Field
+		for _, f := range m.Output.Fields {
+			if string(f.Desc.Name()) == h.GetField() {
+				field = f
+			}
+		}
+		if field == nil {
+			return nil, fail("header %s: field '%s' must be a scalar field of %s", h.GetName(), h.GetField(), m.Output.Desc.FullName())
+		}
+		if err := add(fieldResponseHeader(md, h.GetName(), field)); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range m.Output.Fields {
+		if name, _ := proto.GetExtension(f.Desc.Options(), ginext.E_ResponseHeader).(string); name != "" {
+			if err := add(fieldResponseHeader(md, name, f)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// fieldResponseHeader writes the scalar reply field as header name, skipping zero values
+func fieldResponseHeader(md *methodDesc, name string, field *protogen.Field) (*responseHeader, error) {
+	if md.StreamItem != "" {
+		return nil, fmt.Errorf("header %s of a streamed method cannot use a reply field, only static values", name)
+	}
+	if field.Desc.IsList() || field.Desc.IsMap() || field.Message != nil {
+		return nil, fmt.Errorf("header %s: field '%s' must be a scalar field of %s", name, field.Desc.Name(), field.Parent.Desc.FullName())
+	}
+	rh := &responseHeader{Name: name, Field: field.GoName}
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		rh.Cond, rh.Expr = `v != ""`, "v"
+	case protoreflect.BoolKind:
+		rh.Cond, rh.Expr = "v", `"true"`
+	case protoreflect.BytesKind:
+		rh.Cond, rh.Expr = "len(v) > 0", "string(v)"
+	default:
+		rh.Cond, rh.Expr = "v != 0", "fmt.Sprint(v)"
+	}
+	return rh, nil
+}
+
+// buildPathRules collects the binding rules of path parameters that can be checked on the raw value
+func buildPathRules(fields []*fieldInfo, params map[string]*string) []*pathRule {
+	var res []*pathRule
+	for _, f := range fields {
+		// Enums accept names, which the number rules would reject
+		if _, ok := params[f.Name]; !ok || f.enum {
+			continue
+		}
+		var kind string
+		switch strings.TrimPrefix(f.GoType, "*") {
+		case "string":
+			kind = "ParamString"
+		case "int32", "int64":
+			kind = "ParamInt"
+		case "uint32", "uint64":
+			kind = "ParamUint"
+		case "float32", "float64":
+			kind = "ParamFloat"
+		default:
+			continue
+		}
+
+		// Cross-field and nested rules need the whole struct, leave them to binding
+		var rules []string
+		for _, rule := range strings.Split(getTag(f, "binding"), ",") {
+			name := strings.SplitN(rule, "=", 2)[0]
+			if name == "" || strings.Contains(name, "field") || strings.HasPrefix(name, "required_") ||
+				strings.HasPrefix(name, "excluded_") || name == "dive" {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		res = append(res, &pathRule{Name: f.Name, Kind: kind, Rule: strings.Join(rules, ",")})
+	}
+	return res
+}
+
+// setStreamOptions resolves the streamed reply field configured by ginpb.stream
+func setStreamOptions(g *protogen.GeneratedFile, md *methodDesc, m *protogen.Method, so *ginext.StreamOptions) error {
+	var field *protogen.Field
+	for _, f := range m.Output.Fields {
+		if string(f.Desc.Name()) == so.GetField() {
+			field = f
+		}
+	}
+	if field == nil || !field.Desc.IsList() || field.Message == nil {
+		return fmt.Errorf("stream field '%s' of %s must be a repeated message field of %s", so.GetField(), m.Desc.FullName(), m.Output.Desc.FullName())
+	}
+	switch so.GetFormat() {
+	case "", "json", "ndjson":
+	default:
+		return fmt.Errorf("stream format '%s' of %s must be \"json\" or \"ndjson\"", so.GetFormat(), m.Desc.FullName())
 	}
+	md.StreamField = field.GoName
+	md.StreamItem = g.QualifiedGoIdent(field.Message.GoIdent)
+	md.StreamFormat = so.GetFormat()
+	return nil
 }
 
 // Helper functions
@@ -440,8 +1884,9 @@ func extractPathParams(path string) []string {
 	return params
 }
 
-// parseFieldTags parses custom gin tags from field options
-func parseFieldTags(field *protogen.Field) map[string]string {
+// parseFieldTags parses custom gin tags from field options, fields without json tag are named with their
+// lowerCamel JSON name when camel is set and their proto name otherwise
+func parseFieldTags(field *protogen.Field, camel bool) map[string]string {
 	tags := make(map[string]string)
 
 	opts := field.Desc.Options().(*descriptorpb.FieldOptions)
@@ -527,9 +1972,43 @@ func parseFieldTags(field *protogen.Field) map[string]string {
 		tags["multipart"] = multipartTag
 	}
 
+	// Uploaded files are bound by gin from the form field of the multipart tag and never read from JSON
+	if multipart := tags["multipart"]; multipart != "" {
+		tags["form"] = multipart
+		tags["json"] = "-"
+	}
+
+	// AIP-203 required fields, and proto2 or editions LEGACY_REQUIRED ones, are required on binding unless
+	// their rules already say so
+	if hasFieldBehavior(field, annotations.FieldBehavior_REQUIRED) || field.Desc.Cardinality() == protoreflect.Required {
+		if rules := tags["binding"]; rules == "" {
+			tags["binding"] = "required"
+		} else if !hasRule(parseRules(rules), "required") {
+			tags["binding"] = "required," + rules
+		}
+	}
+
+	// Bind query and form values by proto field name unless the field declares its own binding source,
+	// field masks are bound from their comma separated form, e.g. read_mask=title,author.name
+	_, hasForm := tags["form"]
+	_, hasURI := tags["uri"]
+	_, hasHeader := tags["header"]
+	scalar := field.Message == nil || field.Message.Desc.FullName() == "google.protobuf.FieldMask"
+	if !hasForm && !hasURI && !hasHeader && scalar && !field.Desc.IsMap() {
+		tags["form"] = string(field.Desc.Name())
+	}
+
+	// Carry explicit defaults (proto2 / editions) over to form binding
+	if form, ok := tags["form"]; ok && field.Desc.HasDefault() && !strings.Contains(form, ",default=") {
+		tags["form"] = fmt.Sprintf("%s,default=%v", form, field.Desc.Default().Interface())
+	}
+
 	// Auto-generate json tag if not explicitly set
 	if _, hasJson := tags["json"]; !hasJson {
 		tags["json"] = string(field.Desc.Name())
+		if camel {
+			tags["json"] = field.Desc.JSONName()
+		}
 	}
 
 	return tags
@@ -550,7 +2029,12 @@ func getGoType(field *protogen.Field) string {
 		return fmt.Sprintf("map[%s]%s", keyType, valueType)
 	}
 
-	return getScalarGoType(field)
+	goType := getScalarGoType(field)
+	// Scalars with explicit presence (proto2, proto3 optional, editions default) are pointers, bytes use nil instead
+	if field.Desc.HasPresence() && field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind {
+		return "*" + goType
+	}
+	return goType
 }
 
 // getScalarGoType gets the Go type for scalar protobuf types
@@ -576,8 +2060,8 @@ func getScalarGoType(field *protogen.Field) string {
 		return "[]byte"
 	case protoreflect.EnumKind:
 		return "int32" // Enums are typically int32 in Go
-	case protoreflect.MessageKind:
-		// For message types, we'll use the full Go type name
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		// For message types, we'll use the full Go type name, delimited (editions) messages are groups on the wire
 		return "*" + field.Message.GoIdent.GoName
 	default:
 		return "interface{}" // fallback
@@ -633,24 +2117,57 @@ func getMapValueType(valueField protoreflect.FieldDescriptor) string {
 	}
 }
 
-// parseMessageFields recursively parses message fields and extracts tag information
-func parseMessageFields(message *protogen.Message) []*fieldInfo {
+// parseMessageFields recursively parses message fields and extracts tag information, messages nested in
+// the request are bound into their own gin structs appended to nested
+func parseMessageFields(g *protogen.GeneratedFile, message *protogen.Message, nested *ginStructs) ([]*fieldInfo, error) {
 	var fields []*fieldInfo
 
 	for _, field := range message.Fields {
+		// Oneof members live in a wrapper interface rather than a struct field
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			continue
+		}
+		// Output only fields are set by the server, values sent by clients are ignored
+		if hasFieldBehavior(field, annotations.FieldBehavior_OUTPUT_ONLY) {
+			continue
+		}
+
 		fieldInfo := &fieldInfo{
-			Name:     string(field.Desc.Name()),
-			GoName:   field.GoName,
-			GoType:   getGoType(field),
-			JsonName: field.Desc.JSONName(),
-			Tags:     parseFieldTags(field),
+			Name:        string(field.Desc.Name()),
+			GoName:      field.GoName,
+			GoType:      getGoType(field),
+			JsonName:    field.Desc.JSONName(),
+			Tags:        parseFieldTags(field, nested.camel),
+			Convert:     "r." + field.GoName,
+			ConvertFrom: "in." + field.GoName,
+			protoType:   protoTypeName(field.Desc),
+		}
+		if err := nested.setMessageType(g, field, fieldInfo); err != nil {
+			return nil, err
+		}
+		setInt64Type(field, fieldInfo)
+		if err := setBytesEncoding(field, fieldInfo); err != nil {
+			return nil, err
+		}
+		nested.setEnumType(g, field, fieldInfo)
+		if err := setFileType(g, field, fieldInfo); err != nil {
+			return nil, err
 		}
 		fields = append(fields, fieldInfo)
-
-		// TODO: Handle nested messages if needed
 	}
 
-	return fields
+	return fields, nil
+}
+
+// hasFieldBehavior reports whether field is annotated with the given (google.api.field_behavior)
+func hasFieldBehavior(field *protogen.Field, behavior annotations.FieldBehavior) bool {
+	behaviors, _ := proto.GetExtension(field.Desc.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, b := range behaviors {
+		if b == behavior {
+			return true
+		}
+	}
+	return false
 }
 
 // formatStructTags formats tag map into Go struct tag string
@@ -669,19 +2186,17 @@ func formatStructTags(tags map[string]string) string {
 		}
 	}
 
-	// Add any remaining custom tags
-	for key, value := range tags {
-		found := false
-		for _, orderKey := range tagOrder {
-			if key == orderKey {
-				found = true
-				break
-			}
-		}
-		if !found {
-			parts = append(parts, fmt.Sprintf(`%s:"%s"`, key, value))
+	// Add any remaining custom tags, sorted by key so the output is stable
+	var custom []string
+	for key := range tags {
+		if !slices.Contains(tagOrder, key) {
+			custom = append(custom, key)
 		}
 	}
+	sort.Strings(custom)
+	for _, key := range custom {
+		parts = append(parts, fmt.Sprintf(`%s:"%s"`, key, tags[key]))
+	}
 
 	if len(parts) > 0 {
 		return "`" + strings.Join(parts, " ") + "`"
@@ -700,10 +2215,24 @@ func getTag(field *fieldInfo, tagName string) string {
 	return field.Tags[tagName]
 }
 
-func hasHTTPRule(services []*protogen.Service) bool {
+// HasHeaders reports whether a field of the request or its nested messages is bound from a header
+func (m *methodDesc) HasHeaders() bool {
+	fields := m.Fields
+	for _, msg := range m.Messages {
+		fields = append(fields[:len(fields):len(fields)], msg.Fields...)
+	}
+	for _, f := range fields {
+		if getTag(f, "header") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHTTPRule(services []*protogen.Service, websocket bool) bool {
 	for _, service := range services {
 		for _, method := range service.Methods {
-			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			if method.Desc.IsStreamingClient() && !websocket {
 				continue
 			}
 			rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
@@ -715,17 +2244,6 @@ func hasHTTPRule(services []*protogen.Service) bool {
 	return false
 }
 
-// transformPath converts parameter routes {xx} --> :xx
-func transformPath(path string) string {
-	paths := strings.Split(path, "/")
-	for i, p := range paths {
-		if len(p) > 0 && (p[0] == '{' && p[len(p)-1] == '}' || p[0] == ':') {
-			paths[i] = ":" + p[1:len(p)-1]
-		}
-	}
-	return strings.Join(paths, "/")
-}
-
 func buildPathParams(path string) (res map[string]*string) {
 	if strings.HasSuffix(path, "/") {
 		fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: Path %s should not end with \"/\" \n", path)
@@ -744,20 +2262,6 @@ func buildPathParams(path string) (res map[string]*string) {
 	return
 }
 
-func replacePath(name string, value string, path string) string {
-	pattern := regexp.MustCompile(fmt.Sprintf(`(?i){([\s]*%s[\s]*)=?([^{}]*)}`, name))
-	idx := pattern.FindStringIndex(path)
-	if len(idx) > 0 {
-		path = fmt.Sprintf("%s%s:%s%s",
-			path[:idx[0]], // The start of the match
-			name,
-			strings.ReplaceAll(value, "*", ".*"),
-			path[idx[1]:],
-		)
-	}
-	return path
-}
-
 func camelCaseVars(s string) string {
 	subs := strings.Split(s, ".")
 	vars := make([]string, 0, len(subs))
@@ -767,14 +2271,6 @@ func camelCaseVars(s string) string {
 	return strings.Join(vars, ".")
 }
 
-// camelCase returns the CamelCased name.
-// If there is an interior underscore followed by a lower case letter,
-// drop the underscore and convert the letter to upper case.
-// There is a remote possibility of this rewrite causing a name collision,
-// but it's so remote we're prepared to pretend it's nonexistent - since the
-// C++ generator lowercase names, it's extremely unlikely to have two fields
-// with different capitalization.
-// In short, _my_field_name_2 becomes XMyFieldName_2.
 func camelCase(s string) string {
 	if s == "" {
 		return ""
@@ -840,25 +2336,128 @@ type serviceDesc struct {
 	ServiceType string // Greeter
 	ServiceName string // helloworld.Greeter
 	Metadata    string // api/helloworld/helloworld.proto
+	Server      bool   // generate server interface and registration
+	Client      bool   // generate resty client
 	Methods     []*methodDesc
 	MethodSets  map[string]*methodDesc
+	// client interface segregation
+	ClientGroups []*clientGroup
+	// upstream clients from ginpb.depends_on
+	Dependencies []*dependency
+	// qualified slo.Objective, empty when no method declares ginpb.slo
+	SLOType string
+	// any method declares ginpb.region_pinned
+	RegionPinned bool
+	// any method is a client or bidirectional streaming method served over WebSocket
+	WebSocket bool
+	// any method validates its bound request
+	Validate bool
+	// any method deduplicates deliveries by ginpb.message_id
+	Dedup bool
+	// any method declares ginpb.invalidates
+	Invalidations bool
+	// json_names option, the generated client encodes and decodes messages with protojson unless default
+	JSONNames string
+	// default ginpb.JSONNaming expression from the json_names option, empty for encoding/json
+	JSONNaming string
+	// qualified ginpb.MaskTable, empty when no reply has fields annotated with ginpb.mask
+	MaskTable string
+	// messages with fields annotated with ginpb.mask, reachable from replies
+	MaskedMessages []*maskedMessage
+	masked         map[protoreflect.FullName]bool
+
+	service *protogen.Service
+	// directory of user templates overriding the built-in ones, see Options.Templates
+	templates string
+}
+
+type dependency struct {
+	Name      string // billing.v1.BillingService
+	Field     string // BillingService
+	Client    string // billingv1.BillingServiceHTTPClient
+	NewClient string // billingv1.NewBillingServiceHTTPClient
+}
+
+type responseHeader struct {
+	Name  string // X-Next-Page
+	Value string // static value
+	Field string // reply field Go name, NextPageToken
+	Cond  string // non-zero check of v
+	Expr  string // header value of v
+}
+
+type pathRule struct {
+	Name string // user_id
+	Kind string // ParamString, ParamInt, ParamUint or ParamFloat
+	Rule string // required,uuid
+}
+
+type clientGroup struct {
+	Name    string // admin
+	GoName  string // Admin
+	Methods []*methodDesc
 }
 
 type fieldInfo struct {
-	Name     string
-	GoName   string
-	GoType   string
+	Name   string
+	GoName string
+	GoType string
+	// type of the field in the gin struct when it differs from GoType, i.e. ginpb.Int64 for int64 fields
+	BindType string
 	JsonName string
 	Tags     map[string]string // tag name -> tag value
+	Convert  string            // expression converting the field of r to its protobuf value
+	// expression converting the field of the protobuf message in to its gin value
+	ConvertFrom string
+	// function converting a message of the field to its gin struct, empty for other fields
+	fromProto string
+	// protobuf type, e.g. repeated string or map<string, example.Address>
+	protoType string
+	// enum fields bind the names of their values as well as numbers
+	enum bool
+	// ginpb.bytes_encoding of bytes fields, empty for the default base64
+	bytesEncoding string
 }
 
 type methodDesc struct {
 	// method
-	Name         string
-	OriginalName string // The parsed original name
-	Num          int
-	Request      string
-	Reply        string
+	Group         string          // client group from ginpb.client_group
+	Scopes        []string        // required auth scopes from ginpb.scopes
+	LatencyBudget string          // expected p95 latency from ginpb.latency_budget
+	Expose        string          // exposure from ginpb.expose, empty for every deployment
+	RegionPinned  bool            // routed to regional endpoints from ginpb.region_pinned
+	Idempotent    bool            // retried by clients, from ginpb.idempotent or idempotency_level
+	WebSocket     bool            // client or bidirectional streaming method served over WebSocket
+	Validate      bool            // bound request validated with ginpb.ValidateRequest
+	MessageID     string          // request field Go name from ginpb.message_id, duplicates are deduplicated
+	Status        int             // success status code from ginpb.http_status, 200 by default
+	Invalidates   []string        // operation expressions from ginpb.invalidates
+	Summary       string          // one-line summary from ginpb.summary
+	Description   string          // from ginpb.description or the leading comment of the method
+	SLO           string          // slo.Objective literal from ginpb.slo
+	PathRules     []*pathRule     // validation rules of path parameters
+	Wildcards     []*pathWildcard // multi-segment path variables, joined by ginpb.JoinPathWildcards
+	Verb          string          // custom verb dispatched by ginpb.VerbRoutes, e.g. :cancel or things:batchGet
+	ContentTypes  []string        // accepted request content types from ginpb.content_types, empty for any
+	Timeout       time.Duration   // deadline of the service call from ginpb.timeout, zero for none
+	TimeoutExpr   string          // Timeout as Go expression, e.g. 5 * time.Second
+	Cache         string          // ginpb.CachePolicy literal from ginpb.cache, empty for none
+	CacheETag     bool            // replies get an ETag and If-None-Match is answered with 304
+	CacheControl  string          // Cache-Control value of Cache, e.g. private, max-age=60
+	Example       string          // *ginpb.RouteExample literal used by ginpb.SelfTest
+	// field encryption from ginpb.encrypt
+	DecryptRequest bool // request has encrypted fields
+	EncryptReply   bool // reply has encrypted fields
+	MaskReply      bool // reply has fields annotated with ginpb.mask
+	// declarative response headers from ginpb.response_headers
+	ResponseHeaders []*responseHeader
+	Name            string
+	OriginalName    string // The parsed original name
+	Num             int
+	Request         string
+	Reply           string
+	RequestType     string // full protobuf name of the request, helloworld.HelloRequest
+	ReplyType       string // full protobuf name of the reply
 	// http_rule
 	Path         string
 	Method       string
@@ -871,59 +2470,130 @@ type methodDesc struct {
 	PathParams []string
 	// field information for tag generation
 	Fields []*fieldInfo
+	// protobuf binary bodies
+	ProtoBody     string // message type protobuf bodies are decoded into
+	ProtoBodyCopy string // statement copying the decoded body into ginReq
+	// gin structs of the messages nested in the request
+	Messages []*ginMessage
+	// gin types of the enums of the request and its nested messages
+	Enums []*ginEnum
+	// list streaming from ginpb.stream
+	StreamField  string // Users
+	StreamItem   string // User
+	StreamFormat string // json, ndjson or sse
+	ServerStream bool   // server-streaming method sent as Server-Sent Events
+	// page iteration of list methods with page_token and next_page_token fields
+	PageItems string // Users
+	PageItem  string // User
+	// query parameters of the request sent by the client, page_token and the filters of list methods
+	QueryParams []*queryParam
+	// replies pruned to the paths of the read_mask field
+	ReadMask      string // ReadMask
+	ReadMaskType  string // message the paths select fields of, the reply, page item or stream item
+	ReadMaskQuery string // query parameter of read_mask, empty when it is sent in the body
+
+	desc *protogen.Method
+}
+
+// serviceCode is the generated code of a service split by the file it is written to
+type serviceCode struct {
+	Operations string
+	Server     string
+	Client     string
+	Tags       string
 }
 
-func (s *serviceDesc) execute() string {
+func (s *serviceDesc) execute() (*serviceCode, error) {
 	s.MethodSets = make(map[string]*methodDesc)
 	for _, m := range s.Methods {
 		s.MethodSets[m.Name] = m
 	}
+	s.ClientGroups = buildClientGroups(s.MethodSets)
 
-	buf := new(bytes.Buffer)
+	code := &serviceCode{}
+	var err error
 
-	// Generate server code
-	serverTmpl, err := template.New("server").Funcs(template.FuncMap{
-		"camelCase":  camelCase,
-		"formatTags": formatStructTags,
-		"hasTag":     hasTag,
-		"getTag":     getTag,
-		"lower":      strings.ToLower,
-	}).Parse(strings.TrimSpace(serverTemplate))
-	if err != nil {
-		panic(err)
-	}
-	if err := serverTmpl.Execute(buf, s); err != nil {
-		panic(err)
+	// Generate operation tables shared by server and client
+	if code.Operations, err = s.executeTemplate("operations", operationsTemplate, nil); err != nil {
+		return nil, err
 	}
 
-	buf.WriteString("\n\n")
+	if s.Server {
+		// Generate server code
+		if code.Server, err = s.executeTemplate("server", serverTemplate, template.FuncMap{
+			"camelCase":  camelCase,
+			"formatTags": formatStructTags,
+			"hasTag":     hasTag,
+			"getTag":     getTag,
+			"lower":      strings.ToLower,
+		}); err != nil {
+			return nil, err
+		}
 
-	// Generate client code
-	clientTmpl, err := template.New("client").Funcs(template.FuncMap{
-		"camelCase": camelCase,
-	}).Parse(strings.TrimSpace(clientTemplate))
-	if err != nil {
-		panic(err)
+		// Generate tagged structs at the end
+		if code.Tags, err = s.executeTemplate("tags", tagsStructTemplate, template.FuncMap{
+			"formatTags": formatStructTags,
+			"lower":      strings.ToLower,
+		}); err != nil {
+			return nil, err
+		}
 	}
-	if err := clientTmpl.Execute(buf, s); err != nil {
-		panic(err)
+
+	// Generate client code
+	if s.Client {
+		if code.Client, err = s.executeTemplate("client", clientTemplate, template.FuncMap{
+			"camelCase": camelCase,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
-	buf.WriteString("\n\n")
+	if err := verifySource(s.ServiceName, strings.Join([]string{code.Operations, code.Server, code.Client, code.Tags}, "\n\n")); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
 
-	// Generate tagged structs at the end
-	tagsTmpl, err := template.New("tags").Funcs(template.FuncMap{
-		"formatTags": formatStructTags,
-		"lower":      strings.ToLower,
-	}).Parse(strings.TrimSpace(tagsStructTemplate))
+// executeTemplate parses and executes a template for the service, text unless the templates directory
+// overrides it
+func (s *serviceDesc) executeTemplate(name, text string, funcs template.FuncMap) (string, error) {
+	text, err := loadTemplate(s.templates, name, text)
 	if err != nil {
-		panic(err)
+		return "", err
 	}
-	if err := tagsTmpl.Execute(buf, s); err != nil {
-		panic(err)
+	tmpl, err := template.New(name).Funcs(funcs).Parse(strings.TrimSpace(text))
+	if err != nil {
+		return "", fmt.Errorf("parse %s template: %w", name, err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, s); err != nil {
+		return "", fmt.Errorf("execute %s template for %s: %w", name, s.ServiceName, err)
+	}
+	return strings.Trim(buf.String(), "\r\n"), nil
+}
+
+// buildClientGroups groups methods by client group, both ordered by name
+func buildClientGroups(methods map[string]*methodDesc) []*clientGroup {
+	groups := make(map[string]*clientGroup)
+	for _, m := range methods {
+		if m.Group == "" {
+			continue
+		}
+		cg, ok := groups[m.Group]
+		if !ok {
+			cg = &clientGroup{Name: m.Group, GoName: camelCase(m.Group)}
+			groups[m.Group] = cg
+		}
+		cg.Methods = append(cg.Methods, m)
 	}
 
-	return strings.Trim(buf.String(), "\r\n")
+	res := make([]*clientGroup, 0, len(groups))
+	for _, cg := range groups {
+		sort.Slice(cg.Methods, func(i, j int) bool { return cg.Methods[i].Name < cg.Methods[j].Name })
+		res = append(res, cg)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
 }
 
 const deprecationComment = "// Deprecated: Do not use."
@@ -0,0 +1,207 @@
+package gen
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestGolden generates every testdata/*.proto fixture and compares the output with the golden files
+// next to it. Run `go test ./internal/gen -run Golden -update` after changing templates and review the diff.
+func TestGolden(t *testing.T) {
+	fixtures, err := filepath.Glob("testdata/*.proto")
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		name := filepath.Base(fixture)
+		t.Run(strings.TrimSuffix(name, ".proto"), func(t *testing.T) {
+			for file, content := range generateFixture(t, name) {
+				golden := filepath.Join("testdata", filepath.Base(file)+".golden")
+				if *update {
+					require.NoError(t, os.WriteFile(golden, []byte(content), 0o644))
+					continue
+				}
+				want, err := os.ReadFile(golden)
+				require.NoError(t, err, "missing golden file, run with -update to create it")
+				assert.Equal(t, string(want), content, "%s differs from %s, run with -update to accept the change", file, golden)
+			}
+		})
+	}
+}
+
+// generateFixture runs the plugin on testdata/name and returns the generated Go files by name
+func generateFixture(t *testing.T, name string) map[string]string {
+	t.Helper()
+	plugin, config := fixturePlugin(t, name, nil)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			_, err := GenerateFile(plugin, f, config)
+			require.NoError(t, err)
+		}
+	}
+	resp := plugin.Response()
+	require.Nil(t, resp.Error)
+
+	out := make(map[string]string)
+	for _, f := range resp.File {
+		if strings.HasSuffix(f.GetName(), ".go") {
+			out[f.GetName()] = f.GetContent()
+		}
+	}
+	require.NotEmpty(t, out)
+	return out
+}
+
+// fixturePlugin compiles testdata/name, or the source of name in sources, into the plugin request protoc
+// would send and returns it with the options of testdata/<fixture>.ginpb.yaml
+func fixturePlugin(t *testing.T, name string, sources map[string]string) (*protogen.Plugin, *Config) {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{"testdata", "../../third_party"},
+			Accessor: func(path string) (io.ReadCloser, error) {
+				if src, ok := sources[filepath.Base(path)]; ok {
+					return io.NopCloser(strings.NewReader(src)), nil
+				}
+				// The well-known types come from the compiler, the vendored descriptor.proto predates editions
+				if strings.HasPrefix(path, "../../third_party/google/protobuf/") {
+					return nil, os.ErrNotExist
+				}
+				return os.Open(path)
+			},
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	res, err := compiler.Compile(context.Background(), name)
+	require.NoError(t, err)
+
+	// The request lists dependencies before the files importing them, as protoc does
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range res {
+		add(fd)
+	}
+
+	// Encode the request as protoc sends it, so options are decoded with the registered extension types
+	b, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate:  []string{name},
+		Parameter:       proto.String("paths=source_relative"),
+		ProtoFile:       files,
+		CompilerVersion: &pluginpb.Version{Major: proto.Int32(5), Minor: proto.Int32(29), Patch: proto.Int32(0)},
+	})
+	require.NoError(t, err)
+	req := &pluginpb.CodeGeneratorRequest{}
+	require.NoError(t, proto.Unmarshal(b, req))
+	plugin, err := protogen.Options{}.New(req)
+	require.NoError(t, err)
+
+	// Fixtures are generated with the options of testdata/<fixture>.ginpb.yaml when it exists
+	config := &Config{}
+	if options := filepath.Join("testdata", strings.TrimSuffix(name, ".proto")+".ginpb.yaml"); fileExists(options) {
+		config, err = LoadConfig(options)
+		require.NoError(t, err)
+	}
+	return plugin, config
+}
+
+// TestGenerateErrors checks invalid annotations are returned as errors naming the file and method
+func TestGenerateErrors(t *testing.T) {
+	const header = `syntax = "proto3";
+package invalid;
+import "google/api/annotations.proto";
+import "tag/options.proto";
+import "tag/tags.proto";
+option go_package = "github.com/go-kenka/ginpb/internal/gen/testdata/invalid;invalid";
+message Request { string name = 1; }
+message UploadRequest { int32 size = 1 [(tag.multipart_tag) = "size"]; }
+message Item { string name = 1; }
+`
+	tests := []struct {
+		name    string
+		service string
+		err     string
+	}{
+		{
+			"timeout of a stream",
+			`service Svc { rpc Watch(Request) returns (stream Item) { option (google.api.http) = { get: "/v1/items" }; option (ginpb.timeout) = "5s"; } }`,
+			"invalid.proto: timeout of invalid.Svc.Watch: streaming methods run until the client disconnects, bound them with middleware.Timeout instead",
+		},
+		{
+			"invalid timeout",
+			`service Svc { rpc Get(Request) returns (Item) { option (google.api.http) = { get: "/v1/items" }; option (ginpb.timeout) = "soon"; } }`,
+			`invalid.proto: timeout of invalid.Svc.Get: "soon" is not a positive Go duration such as "5s"`,
+		},
+		{
+			"cache of a post",
+			`service Svc { rpc Create(Request) returns (Item) { option (google.api.http) = { post: "/v1/items" body: "*" }; option (ginpb.cache) = { max_age: "60s" }; } }`,
+			"invalid.proto: cache of invalid.Svc.Create: only replies of unary GET methods are cacheable",
+		},
+		{
+			"content types without body",
+			`service Svc { rpc Get(Request) returns (Item) { option (google.api.http) = { get: "/v1/items" }; option (ginpb.content_types) = "application/json"; } }`,
+			"invalid.proto: content_types of invalid.Svc.Get: the method has no request body, declare body in its http rule",
+		},
+		{
+			"multipart tag on a number",
+			`service Svc { rpc Upload(UploadRequest) returns (Item) { option (google.api.http) = { post: "/v1/items" body: "*" }; } }`,
+			"invalid.proto: multipart tag of invalid.UploadRequest.size: uploaded files bind to string or bytes fields, not int32",
+		},
+		{
+			"unknown path variable",
+			`service Svc { rpc Get(Request) returns (Item) { option (google.api.http) = { get: "/v1/items/{id}" }; } }`,
+			"invalid.proto: path variable 'id' of /v1/items/{id} in invalid.Svc.Get is not a field of invalid.Request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin, config := fixturePlugin(t, "invalid.proto", map[string]string{"invalid.proto": header + tt.service})
+			for _, f := range plugin.Files {
+				if f.Generate {
+					_, err := GenerateFile(plugin, f, config)
+					assert.EqualError(t, err, tt.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateStable(t *testing.T) {
+	// Numbering of additional bindings must not carry over from an earlier run in the same process
+	assert.Equal(t, generateFixture(t, "bindings.proto"), generateFixture(t, "bindings.proto"))
+
+	tags := map[string]string{"json": "name", "mapstructure": "name", "db": "name", "binding": "required", "bson": "name"}
+	assert.Equal(t, "`json:\"name\" binding:\"required\" bson:\"name\" db:\"name\" mapstructure:\"name\"`", formatStructTags(tags))
+}
+
+func fileExists(name string) bool {
+	_, err := os.Stat(name)
+	return err == nil
+}
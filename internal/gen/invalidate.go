@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// buildInvalidations returns the operations invalidated by m from its (ginpb.invalidates) option as Go expressions:
+// the operation constant for methods of the same service and a quoted full operation for others.
+// routed holds the names of the methods of the service that have routes.
+func buildInvalidations(m *protogen.Method, routed map[string]bool) ([]string, error) {
+	targets, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Invalidates).([]string)
+	var res []string
+	for _, target := range targets {
+		if strings.HasPrefix(target, "/") {
+			if parts := strings.Split(target, "/"); len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+				return nil, fmt.Errorf("ginpb.invalidates %q of %s is not an operation such as \"/pkg.Service/Method\"", target, m.Desc.FullName())
+			}
+			res = append(res, strconv.Quote(target))
+			continue
+		}
+		if !routed[target] {
+			return nil, fmt.Errorf("ginpb.invalidates %q of %s: %s has no method %s with an http rule, name methods of other services by full operation",
+				target, m.Desc.FullName(), m.Parent.Desc.FullName(), target)
+		}
+		res = append(res, "Operation"+m.Parent.GoName+target)
+	}
+	return res, nil
+}
@@ -0,0 +1,130 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var k6Template = `// Code generated by protoc-gen-gin. DO NOT EDIT.
+// k6 load test for {{.ServiceName}}
+// source: {{.Metadata}}
+//
+// Run: k6 run -e BASE_URL=http://localhost:8000 -e VUS=10 -e DURATION=30s {{.File}}
+import http from 'k6/http';
+import { check } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8000';
+const VUS = parseInt(__ENV.VUS || '10', 10);
+const DURATION = __ENV.DURATION || '30s';
+
+export const options = {
+  scenarios: {
+{{- range .Operations}}
+    {{.Func}}: { executor: 'constant-vus', vus: VUS, duration: DURATION, exec: '{{.Func}}', tags: { operation: '{{.Func}}' } },
+{{- end}}
+  },
+  thresholds: {
+    http_req_failed: ['rate<0.01'],
+{{- range .Operations}}
+{{- if .Budget}}
+    'http_req_duration{operation:{{.Func}}}': ['p(95)<{{.Budget}}'],
+{{- end}}
+{{- end}}
+  },
+};
+{{range .Operations}}
+// {{.Func}} calls {{.Method}} {{.Path}}
+export function {{.Func}}() {
+  const res = http.request('{{.Method}}', ` + "`${BASE_URL}{{.URL}}`" + `, {{.Body}}, {
+    headers: {{.Headers}},
+  });
+  check(res, { '{{.Func}} status is 2xx': (r) => r.status >= 200 && r.status < 300 });
+}
+{{end}}`
+
+// k6Operation is a scenario of the generated k6 script
+type k6Operation struct {
+	Func    string // ListUsers, or ListUsers1 for additional bindings
+	Method  string
+	Path    string
+	URL     string // path with sample params and query
+	Body    string // JSON.stringify expression or null
+	Headers string // JSON object
+	Budget  int64  // p95 threshold in milliseconds, 0 for none
+}
+
+// genK6 writes a k6 load test script for the service next to the generated Go code
+func genK6(gen *protogen.Plugin, file *protogen.File, sd *serviceDesc) error {
+	filename := file.GeneratedFilenamePrefix + "." + sd.ServiceType + ".k6.js"
+	data := struct {
+		*serviceDesc
+		File       string
+		Operations []*k6Operation
+	}{serviceDesc: sd, File: filename[strings.LastIndex(filename, "/")+1:]}
+
+	seen := make(map[string]int)
+	for _, m := range sd.Methods {
+		// WebSocket streams are not plain HTTP requests
+		if m.WebSocket {
+			continue
+		}
+		op, err := buildK6Operation(m)
+		if err != nil {
+			return fmt.Errorf("k6 script for %s: %w", sd.ServiceName, err)
+		}
+		if n := seen[m.Name]; n > 0 {
+			op.Func = m.Name + strconv.Itoa(n)
+		}
+		seen[m.Name]++
+		data.Operations = append(data.Operations, op)
+	}
+
+	tmpl, err := template.New("k6").Parse(k6Template)
+	if err != nil {
+		return fmt.Errorf("parse k6 template: %w", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return fmt.Errorf("execute k6 template for %s: %w", sd.ServiceName, err)
+	}
+	g := gen.NewGeneratedFile(filename, "")
+	_, err = g.Write(buf.Bytes())
+	return err
+}
+
+func buildK6Operation(m *methodDesc) (*k6Operation, error) {
+	op := &k6Operation{
+		Func:    m.Name,
+		Method:  m.Method,
+		Path:    m.ClientPath,
+		Body:    "null",
+		Headers: "{}",
+	}
+	if m.LatencyBudget != "" {
+		d, err := time.ParseDuration(m.LatencyBudget)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency_budget %q of %s, use a Go duration such as \"200ms\": %w", m.LatencyBudget, m.Name, err)
+		}
+		op.Budget = d.Milliseconds()
+	}
+
+	ex := buildExample(m)
+	if strings.HasPrefix(ex.Headers["Content-Type"], "multipart/") {
+		// Multipart bodies are sent as encoded
+		op.Body = jsonString(ex.Body)
+	} else if ex.Body != "" {
+		op.Body = "JSON.stringify(" + ex.Body + ")"
+	}
+	op.Headers = jsonString(ex.Headers)
+	op.URL = strings.ReplaceAll(ex.Path, "`", "")
+	if ex.Query != "" {
+		op.URL += "?" + ex.Query
+	}
+	return op, nil
+}
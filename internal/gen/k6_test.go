@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildK6Operation(t *testing.T) {
+	m := &methodDesc{
+		Name:          "CreatePost",
+		Method:        "POST",
+		ClientPath:    "/users/{user_id}/posts",
+		PathParams:    []string{"user_id"},
+		HasBody:       true,
+		LatencyBudget: "250ms",
+		Fields: []*fieldInfo{
+			{Name: "user_id", GoType: "string", Tags: map[string]string{"uri": "user_id", "binding": "required,uuid"}},
+			{Name: "authorization", GoType: "string", Tags: map[string]string{"header": "Authorization", "binding": "startswith=Bearer "}},
+			{Name: "title", GoType: "string", Tags: map[string]string{"json": "title", "binding": "min=5,max=8"}},
+			{Name: "tags", GoType: "[]string", Tags: map[string]string{"json": "tags", "binding": "min=2"}},
+			{Name: "age", GoType: "*int32", Tags: map[string]string{"json": "age", "binding": "min=13"}},
+		},
+	}
+
+	op, err := buildK6Operation(m)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(250), op.Budget)
+	assert.Equal(t, "/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts", op.URL)
+	assert.Equal(t, `JSON.stringify({"age":13,"tags":["sampleTags","sampleTags"],"title":"sampleTi"})`, op.Body)
+	assert.Equal(t, `{"Authorization":"Bearer sample","Content-Type":"application/json"}`, op.Headers)
+
+	m.LatencyBudget = "fast"
+	_, err = buildK6Operation(m)
+	assert.Error(t, err)
+}
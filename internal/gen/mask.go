@@ -0,0 +1,98 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// maskedMessage is an entry of the generated XMaskedFields table
+type maskedMessage struct {
+	Name   string         // example.User
+	Fields []*maskedField // fields annotated with ginpb.mask
+}
+
+type maskedField struct {
+	Name     string   // email
+	Profiles []string // public, partner
+	Style    string   // qualified ginpb.MaskStyle constant
+}
+
+// maskStyles maps the styles of ginpb.mask to their ginpb constants
+var maskStyles = map[string]string{
+	"":        "MaskClear",
+	"clear":   "MaskClear",
+	"partial": "MaskPartial",
+	"full":    "MaskFull",
+}
+
+// collectMaskedFields appends md and the messages reachable from it having fields annotated with (ginpb.mask)
+// to sd.MaskedMessages, and reports whether any was found. Messages already in the table count as found.
+func collectMaskedFields(g *protogen.GeneratedFile, sd *serviceDesc, md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) (bool, error) {
+	if visited[md.FullName()] {
+		return sd.masked[md.FullName()], nil
+	}
+	visited[md.FullName()] = true
+
+	entry := &maskedMessage{Name: string(md.FullName())}
+	found := false
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if mask, _ := proto.GetExtension(fd.Options(), ginext.E_Mask).(*ginext.Mask); mask != nil {
+			f, err := buildMaskedField(g, fd, mask)
+			if err != nil {
+				return false, err
+			}
+			entry.Fields = append(entry.Fields, f)
+		}
+		value := fd
+		if fd.IsMap() {
+			value = fd.MapValue()
+		}
+		if value.Message() != nil {
+			ok, err := collectMaskedFields(g, sd, value.Message(), visited)
+			if err != nil {
+				return false, err
+			}
+			found = found || ok
+		}
+	}
+	if len(entry.Fields) > 0 && !sd.masked[md.FullName()] {
+		if sd.masked == nil {
+			sd.masked = make(map[protoreflect.FullName]bool)
+		}
+		sd.masked[md.FullName()] = true
+		sd.MaskedMessages = append(sd.MaskedMessages, entry)
+	}
+	return found || len(entry.Fields) > 0, nil
+}
+
+// buildMaskedField validates the (ginpb.mask) option of fd
+func buildMaskedField(g *protogen.GeneratedFile, fd protoreflect.FieldDescriptor, mask *ginext.Mask) (*maskedField, error) {
+	style, ok := maskStyles[mask.GetStyle()]
+	if !ok {
+		return nil, fmt.Errorf("(ginpb.mask) of %s: unknown style %q, want clear, partial or full", fd.FullName(), mask.GetStyle())
+	}
+	if style != "MaskClear" && (fd.Kind() != protoreflect.StringKind || fd.IsMap()) {
+		return nil, fmt.Errorf("(ginpb.mask) of %s: style %q only applies to string fields, use clear for %s fields", fd.FullName(), mask.GetStyle(), fd.Kind())
+	}
+	if len(mask.GetProfiles()) == 0 {
+		return nil, fmt.Errorf("(ginpb.mask) of %s: no profiles, list the audiences the field is hidden from", fd.FullName())
+	}
+	for _, p := range mask.GetProfiles() {
+		if strings.TrimSpace(p) == "" {
+			return nil, fmt.Errorf("(ginpb.mask) of %s: empty profile", fd.FullName())
+		}
+	}
+	return &maskedField{
+		Name:     string(fd.Name()),
+		Profiles: mask.GetProfiles(),
+		Style:    g.QualifiedGoIdent(ginpbPackage.Ident(style)),
+	}, nil
+}
@@ -0,0 +1,207 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// routeModel is the metadata_out document, the route model the generator used for all generated services
+type routeModel struct {
+	Generator string          `json:"generator"`
+	Services  []*serviceModel `json:"services"`
+}
+
+type serviceModel struct {
+	Name      string         `json:"name"` // user.v1.UserService
+	GoType    string         `json:"go_type"`
+	File      string         `json:"file"`
+	Server    bool           `json:"server"`
+	Client    bool           `json:"client"`
+	DependsOn []string       `json:"depends_on,omitempty"`
+	Methods   []*methodModel `json:"methods"`
+}
+
+type methodModel struct {
+	Name          string   `json:"name"`
+	Summary       string   `json:"summary,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Operation     string   `json:"operation"` // /user.v1.UserService/GetUser
+	HTTPMethod    string   `json:"http_method"`
+	Path          string   `json:"path"`          // gin route, /users/:user_id
+	PathTemplate  string   `json:"path_template"` // /users/{user_id}
+	Body          string   `json:"body,omitempty"`
+	ResponseBody  string   `json:"response_body,omitempty"`
+	Request       string   `json:"request"`
+	Reply         string   `json:"reply"`
+	Status        int      `json:"status"`
+	Stream        string   `json:"stream,omitempty"` // sse, websocket, json or ndjson
+	Group         string   `json:"client_group,omitempty"`
+	Scopes        []string `json:"scopes,omitempty"`
+	LatencyBudget string   `json:"latency_budget,omitempty"`
+	Expose        string   `json:"expose,omitempty"`
+	RegionPinned  bool     `json:"region_pinned,omitempty"`
+	Idempotent    bool     `json:"idempotent,omitempty"`
+	Validate      bool     `json:"validate,omitempty"`
+	MessageID     string   `json:"message_id,omitempty"`
+	Invalidates   []string `json:"invalidates,omitempty"`
+	Encrypted     bool     `json:"encrypted,omitempty"`
+	ContentTypes  []string `json:"content_types,omitempty"`
+	Timeout       string   `json:"timeout,omitempty"`
+	CacheControl  string   `json:"cache_control,omitempty"`
+	ETag          bool     `json:"etag,omitempty"`
+	// ResponseHeaders are the names of the headers declared with ginpb.response_headers
+	ResponseHeaders []string      `json:"response_headers,omitempty"`
+	Fields          []*fieldModel `json:"fields,omitempty"`
+}
+
+type fieldModel struct {
+	Name string            `json:"name"`
+	In   string            `json:"in"`   // path, query, header or body
+	Type string            `json:"type"` // protobuf type, e.g. repeated string
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// genMetadata writes the route model of services to name
+func genMetadata(gen *protogen.Plugin, name string, services []*serviceDesc) error {
+	doc := &routeModel{Generator: "protoc-gen-gin " + Release, Services: make([]*serviceModel, 0, len(services))}
+	for _, sd := range services {
+		s := &serviceModel{
+			Name:    sd.ServiceName,
+			GoType:  sd.ServiceType,
+			File:    sd.Metadata,
+			Server:  sd.Server,
+			Client:  sd.Client,
+			Methods: make([]*methodModel, 0, len(sd.Methods)),
+		}
+		for _, d := range sd.Dependencies {
+			s.DependsOn = append(s.DependsOn, d.Name)
+		}
+		for _, m := range sd.Methods {
+			s.Methods = append(s.Methods, buildMethodModel(sd, m))
+		}
+		doc.Services = append(doc.Services, s)
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	g := gen.NewGeneratedFile(name, "")
+	_, err := g.Write(buf.Bytes())
+	return err
+}
+
+// buildMethodModel describes a route of m
+func buildMethodModel(sd *serviceDesc, m *methodDesc) *methodModel {
+	mm := &methodModel{
+		Name:          m.OriginalName,
+		Summary:       m.Summary,
+		Description:   m.Description,
+		Operation:     "/" + sd.ServiceName + "/" + m.OriginalName,
+		HTTPMethod:    m.Method,
+		Path:          m.Path,
+		PathTemplate:  m.ClientPath,
+		Request:       m.Request,
+		Reply:         m.Reply,
+		Status:        m.Status,
+		Group:         m.Group,
+		Scopes:        m.Scopes,
+		LatencyBudget: m.LatencyBudget,
+		Expose:        m.Expose,
+		RegionPinned:  m.RegionPinned,
+		Idempotent:    m.Idempotent,
+		Validate:      m.Validate,
+		Encrypted:     m.DecryptRequest || m.EncryptReply,
+		ContentTypes:  m.ContentTypes,
+		CacheControl:  m.CacheControl,
+		ETag:          m.CacheETag,
+	}
+	if m.Timeout > 0 {
+		mm.Timeout = m.Timeout.String()
+	}
+	if m.desc != nil {
+		mm.Request = string(m.desc.Input.Desc.FullName())
+		mm.Reply = string(m.desc.Output.Desc.FullName())
+		mm.Invalidates, _ = proto.GetExtension(m.desc.Desc.Options(), ginext.E_Invalidates).([]string)
+	}
+	switch {
+	case m.WebSocket:
+		mm.Stream = "websocket"
+	case m.StreamItem != "":
+		mm.Stream = m.StreamFormat
+	}
+	if m.HasBody {
+		mm.Body = "*"
+		if m.Body != "" {
+			mm.Body = bodyFieldName(m)
+		}
+	}
+	if m.ResponseBody != "" {
+		mm.ResponseBody = strings.TrimPrefix(m.ResponseBody, ".")
+	}
+	for _, h := range m.ResponseHeaders {
+		mm.ResponseHeaders = append(mm.ResponseHeaders, h.Name)
+	}
+
+	params := make(map[string]bool)
+	for _, p := range m.PathParams {
+		params[strings.SplitN(p, "=", 2)[0]] = true
+	}
+	for _, f := range m.Fields {
+		if f.GoName == m.MessageID {
+			mm.MessageID = f.Name
+		}
+		fm := &fieldModel{Name: f.Name, Type: f.protoType, Tags: f.Tags}
+		switch {
+		case params[f.Name]:
+			fm.In = "path"
+		case hasTag(f, "header"):
+			fm.In = "header"
+		case m.HasBody && (m.Body == "" || m.Body == "."+f.GoName):
+			fm.In = "body"
+		default:
+			fm.In = "query"
+		}
+		mm.Fields = append(mm.Fields, fm)
+	}
+	return mm
+}
+
+// bodyFieldName returns the proto name of the body field of m
+func bodyFieldName(m *methodDesc) string {
+	for _, f := range m.Fields {
+		if "."+f.GoName == m.Body {
+			return f.Name
+		}
+	}
+	return strings.TrimPrefix(m.Body, ".")
+}
+
+// protoTypeName returns the protobuf type of fd as written in proto files
+func protoTypeName(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", protoTypeName(fd.MapKey()), protoTypeName(fd.MapValue()))
+	}
+	name := fd.Kind().String()
+	switch {
+	case fd.Message() != nil:
+		name = string(fd.Message().FullName())
+	case fd.Enum() != nil:
+		name = string(fd.Enum().FullName())
+	}
+	if fd.IsList() {
+		return "repeated " + name
+	}
+	return name
+}
@@ -0,0 +1,39 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMethodModel(t *testing.T) {
+	sd := &serviceDesc{ServiceName: "blog.v1.BlogService"}
+	m := &methodDesc{
+		Name:         "UpdatePost",
+		OriginalName: "UpdatePost",
+		Method:       "PATCH",
+		Path:         "/posts/:post_id",
+		ClientPath:   "/posts/{post_id}",
+		PathParams:   []string{"post_id"},
+		HasBody:      true,
+		Body:         ".Post",
+		Status:       200,
+		MessageID:    "RequestId",
+		Fields: []*fieldInfo{
+			{Name: "post_id", GoName: "PostId", protoType: "string", Tags: map[string]string{"uri": "post_id"}},
+			{Name: "post", GoName: "Post", protoType: "blog.v1.Post", Tags: map[string]string{"json": "post"}},
+			{Name: "request_id", GoName: "RequestId", protoType: "string", Tags: map[string]string{"header": "X-Request-ID"}},
+			{Name: "fields", GoName: "Fields", protoType: "repeated string", Tags: map[string]string{"form": "fields"}},
+		},
+	}
+
+	mm := buildMethodModel(sd, m)
+	assert.Equal(t, "/blog.v1.BlogService/UpdatePost", mm.Operation)
+	assert.Equal(t, "post", mm.Body)
+	assert.Equal(t, "request_id", mm.MessageID)
+	var in []string
+	for _, f := range mm.Fields {
+		in = append(in, f.In)
+	}
+	assert.Equal(t, []string{"path", "body", "header", "query"}, in)
+}
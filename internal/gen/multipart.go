@@ -0,0 +1,29 @@
+package gen
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fileHeaderIdent is the type of uploaded files in gin structs
+var fileHeaderIdent = protogen.GoIdent{GoName: "FileHeader", GoImportPath: "mime/multipart"}
+
+// setFileType binds string and bytes fields tagged with multipart to the uploaded files of the form field,
+// *multipart.FileHeader or []*multipart.FileHeader for repeated fields. Files are not copied into the
+// protobuf message, services read them with metadata.FormFile.
+func setFileType(g *protogen.GeneratedFile, field *protogen.Field, f *fieldInfo) error {
+	if f.Tags["multipart"] == "" {
+		return nil
+	}
+	if field.Desc.IsMap() || (field.Desc.Kind() != protoreflect.StringKind && field.Desc.Kind() != protoreflect.BytesKind) {
+		return fmt.Errorf("multipart tag of %s: uploaded files bind to string or bytes fields, not %s", field.Desc.FullName(), protoTypeName(field.Desc))
+	}
+	f.BindType = "*" + g.QualifiedGoIdent(fileHeaderIdent)
+	if field.Desc.IsList() {
+		f.BindType = "[]" + f.BindType
+	}
+	f.Convert, f.ConvertFrom = "", ""
+	return nil
+}
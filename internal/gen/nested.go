@@ -0,0 +1,308 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ginMessage is the gin binding struct of a message nested in a request
+type ginMessage struct {
+	Name   string // _CreateUserGinAddress
+	Type   string // qualified protobuf type, Address
+	Fields []*fieldInfo
+}
+
+// ginEnum is the gin type of an enum of a request, binding the names of its values as well as numbers
+type ginEnum struct {
+	Name string // _CreateUserGinStatus
+	Type string // qualified protobuf type, Status
+}
+
+// ginStructs collects the gin structs of the messages nested in a request, each message is bound once
+// per method so recursive messages reference their own struct
+type ginStructs struct {
+	prefix   string // _CreateUserGin
+	messages []*ginMessage
+	enums    []*ginEnum
+	names    map[protoreflect.FullName]string
+	taken    map[string]bool
+	camel    bool // json tags use lowerCamel JSON names, from the json_names option
+}
+
+// setMessageType types message fields, list elements and map values with the gin struct of their message,
+// so the binding tags of nested fields apply. Well-known types keep their protobuf type.
+func (s *ginStructs) setMessageType(g *protogen.GeneratedFile, field *protogen.Field, f *fieldInfo) error {
+	message := field.Message
+	if field.Desc.IsMap() {
+		message = field.Message.Fields[1].Message
+	}
+	if message == nil {
+		return nil
+	}
+	if isWellKnownType(message) && setWellKnownType(g, field, message, f) {
+		return nil
+	}
+	elem := "*" + g.QualifiedGoIdent(message.GoIdent)
+	convert := ""
+	if !isWellKnownType(message) {
+		name, err := s.message(g, message)
+		if err != nil {
+			return err
+		}
+		elem = "*" + name
+		convert = "(*" + name + ").toProto"
+		f.fromProto = name + "FromProto"
+	}
+	switch {
+	case field.Desc.IsMap():
+		f.GoType = fmt.Sprintf("map[%s]%s", getMapKeyType(field.Desc.MapKey()), elem)
+		if convert != "" {
+			f.Convert = fmt.Sprintf("ginpb.ConvertMap(r.%s, %s)", field.GoName, convert)
+			f.ConvertFrom = fmt.Sprintf("ginpb.ConvertMap(in.%s, %s)", field.GoName, f.fromProto)
+		}
+	case field.Desc.IsList():
+		f.GoType = "[]" + elem
+		if convert != "" {
+			f.Convert = fmt.Sprintf("ginpb.ConvertList(r.%s, %s)", field.GoName, convert)
+			f.ConvertFrom = fmt.Sprintf("ginpb.ConvertList(in.%s, %s)", field.GoName, f.fromProto)
+		}
+	default:
+		f.GoType = elem
+		if convert != "" {
+			f.Convert = fmt.Sprintf("r.%s.toProto()", field.GoName)
+			f.ConvertFrom = fmt.Sprintf("%s(in.%s)", f.fromProto, field.GoName)
+		}
+	}
+	return nil
+}
+
+// setWellKnownType binds Timestamp, Duration, Struct and FieldMask fields, list elements and map values, and
+// singular wrapper fields with Go types gin can bind, e.g. RFC 3339 strings to *time.Time. It reports whether
+// message is one of them, other well-known types keep their protobuf type.
+func setWellKnownType(g *protogen.GeneratedFile, field *protogen.Field, message *protogen.Message, f *fieldInfo) bool {
+	var elem, to, from string
+	switch message.Desc.FullName() {
+	case "google.protobuf.Timestamp":
+		elem = "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "Time", GoImportPath: "time"})
+		to, from = "ginpb.TimestampProto", "ginpb.TimestampFromProto"
+	case "google.protobuf.Duration":
+		elem, to, from = "*ginpb.Duration", "ginpb.DurationProto", "ginpb.DurationFromProto"
+	case "google.protobuf.Struct":
+		elem, to, from = "map[string]interface{}", "ginpb.StructProto", "ginpb.StructFromProto"
+	case "google.protobuf.FieldMask":
+		elem, to, from = "*ginpb.FieldMask", "ginpb.FieldMaskProto", "ginpb.FieldMaskFromProto"
+	default:
+		if field.Desc.IsList() || field.Desc.IsMap() {
+			return false
+		}
+		return setWrapperType(g, field, message, f)
+	}
+	switch {
+	case field.Desc.IsMap():
+		f.BindType = fmt.Sprintf("map[%s]%s", getMapKeyType(field.Desc.MapKey()), elem)
+		f.Convert = fmt.Sprintf("ginpb.ConvertMap(r.%s, %s)", field.GoName, to)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertMap(in.%s, %s)", field.GoName, from)
+	case field.Desc.IsList():
+		f.BindType = "[]" + elem
+		f.Convert = fmt.Sprintf("ginpb.ConvertList(r.%s, %s)", field.GoName, to)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertList(in.%s, %s)", field.GoName, from)
+	default:
+		f.BindType = elem
+		f.Convert = fmt.Sprintf("%s(r.%s)", to, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("%s(in.%s)", from, field.GoName)
+		f.fromProto = from
+	}
+	return true
+}
+
+// setWrapperType binds a wrapper field, e.g. google.protobuf.StringValue, with a pointer to its value type
+func setWrapperType(g *protogen.GeneratedFile, field *protogen.Field, message *protogen.Message, f *fieldInfo) bool {
+	if message.Desc.Fields().Len() != 1 || !strings.HasSuffix(string(message.Desc.Name()), "Value") {
+		return false
+	}
+	value := message.Desc.Fields().Get(0)
+	var goType, bindType string
+	switch value.Kind() {
+	case protoreflect.StringKind:
+		goType = "string"
+	case protoreflect.BoolKind:
+		goType = "bool"
+	case protoreflect.BytesKind:
+		goType = "[]byte"
+	case protoreflect.Int32Kind:
+		goType = "int32"
+	case protoreflect.Uint32Kind:
+		goType = "uint32"
+	case protoreflect.Int64Kind:
+		goType, bindType = "int64", "ginpb.Int64"
+	case protoreflect.Uint64Kind:
+		goType, bindType = "uint64", "ginpb.Uint64"
+	case protoreflect.FloatKind:
+		goType = "float32"
+	case protoreflect.DoubleKind:
+		goType = "float64"
+	default:
+		return false
+	}
+	// wrapperspb.String creates a StringValue, wrapperspb.UInt64 an UInt64Value
+	wrap := g.QualifiedGoIdent(protogen.GoIdent{
+		GoName:       strings.TrimSuffix(message.GoIdent.GoName, "Value"),
+		GoImportPath: message.GoIdent.GoImportPath,
+	})
+	f.BindType = "*" + goType
+	f.Convert = fmt.Sprintf("ginpb.Wrap(r.%s, %s)", field.GoName, wrap)
+	f.ConvertFrom = fmt.Sprintf("ginpb.Unwrap[%s](in.%s)", goType, field.GoName)
+	f.fromProto = "ginpb.Unwrap[" + goType + "]"
+	if bindType != "" {
+		f.BindType = "*" + bindType
+		f.Convert = fmt.Sprintf("ginpb.Wrap((*%s)(r.%s), %s)", goType, field.GoName, wrap)
+		f.ConvertFrom = fmt.Sprintf("(*%s)(ginpb.Unwrap[%s](in.%s))", bindType, goType, field.GoName)
+		f.fromProto = ""
+	}
+	return true
+}
+
+// setInt64Type types 64-bit integer fields, list elements and map values with ginpb.Int64 or ginpb.Uint64,
+// so JSON bodies may carry them as numbers or as the strings protojson writes
+func setInt64Type(field *protogen.Field, f *fieldInfo) {
+	kind := field.Desc.Kind()
+	if field.Desc.IsMap() {
+		kind = field.Desc.MapValue().Kind()
+	}
+	var bind, goType string
+	switch kind {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		bind, goType = "ginpb.Int64", "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		bind, goType = "ginpb.Uint64", "uint64"
+	default:
+		return
+	}
+	switch {
+	case field.Desc.IsMap():
+		f.BindType = fmt.Sprintf("map[%s]%s", getMapKeyType(field.Desc.MapKey()), bind)
+		f.Convert = fmt.Sprintf("ginpb.ConvertIntMap[%s](r.%s)", goType, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertIntMap[%s](in.%s)", bind, field.GoName)
+	case field.Desc.IsList():
+		f.BindType = "[]" + bind
+		f.Convert = fmt.Sprintf("ginpb.ConvertInts[%s](r.%s)", goType, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertInts[%s](in.%s)", bind, field.GoName)
+	case strings.HasPrefix(f.GoType, "*"):
+		f.BindType = "*" + bind
+		f.Convert = fmt.Sprintf("(*%s)(r.%s)", goType, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("(*%s)(in.%s)", bind, field.GoName)
+	default:
+		f.BindType = bind
+		f.Convert = fmt.Sprintf("%s(r.%s)", goType, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("%s(in.%s)", bind, field.GoName)
+	}
+}
+
+// setEnumType types enum fields, list elements and map values with the gin type of their enum
+func (s *ginStructs) setEnumType(g *protogen.GeneratedFile, field *protogen.Field, f *fieldInfo) {
+	enum := field.Enum
+	if field.Desc.IsMap() {
+		enum = field.Message.Fields[1].Enum
+	}
+	if enum == nil {
+		return
+	}
+	name, typ := s.enum(g, enum), g.QualifiedGoIdent(enum.GoIdent)
+	f.enum = true
+	switch {
+	case field.Desc.IsMap():
+		f.BindType = fmt.Sprintf("map[%s]%s", getMapKeyType(field.Desc.MapKey()), name)
+		f.Convert = fmt.Sprintf("ginpb.ConvertIntMap[%s](r.%s)", typ, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertIntMap[%s](in.%s)", name, field.GoName)
+	case field.Desc.IsList():
+		f.BindType = "[]" + name
+		f.Convert = fmt.Sprintf("ginpb.ConvertInts[%s](r.%s)", typ, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("ginpb.ConvertInts[%s](in.%s)", name, field.GoName)
+	case strings.HasPrefix(f.GoType, "*"):
+		f.BindType = "*" + name
+		f.Convert = fmt.Sprintf("(*%s)(r.%s)", typ, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("(*%s)(in.%s)", name, field.GoName)
+	default:
+		f.BindType = name
+		f.Convert = fmt.Sprintf("%s(r.%s)", typ, field.GoName)
+		f.ConvertFrom = fmt.Sprintf("%s(in.%s)", name, field.GoName)
+	}
+}
+
+// enum returns the name of the gin type of enum
+func (s *ginStructs) enum(g *protogen.GeneratedFile, enum *protogen.Enum) string {
+	if name, ok := s.names[enum.Desc.FullName()]; ok {
+		return name
+	}
+	name := s.name(enum.GoIdent.GoName)
+	s.names[enum.Desc.FullName()] = name
+	s.enums = append(s.enums, &ginEnum{Name: name, Type: g.QualifiedGoIdent(enum.GoIdent)})
+	return name
+}
+
+// setProtoBody lets md decode application/x-protobuf bodies, i.e. the request for body "*" or the
+// message of a singular message body field. Other bodies are only bound from JSON and the like.
+func setProtoBody(g *protogen.GeneratedFile, md *methodDesc, m *protogen.Method, body string) {
+	if !md.HasBody || len(md.Fields) == 0 {
+		return
+	}
+	if body == "*" {
+		md.ProtoBody = md.Request
+		md.ProtoBodyCopy = fmt.Sprintf("ginReq.from%sRequest(&body)", md.Name)
+		return
+	}
+	for _, f := range md.Fields {
+		if f.Name != body {
+			continue
+		}
+		for _, field := range m.Input.Fields {
+			if field.Desc.Name() != protoreflect.Name(body) || field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() {
+				continue
+			}
+			if f.BindType != "" && f.fromProto == "" {
+				continue
+			}
+			md.ProtoBody = g.QualifiedGoIdent(field.Message.GoIdent)
+			md.ProtoBodyCopy = fmt.Sprintf("ginReq.%s = &body", f.GoName)
+			if f.fromProto != "" {
+				md.ProtoBodyCopy = fmt.Sprintf("ginReq.%s = %s(&body)", f.GoName, f.fromProto)
+			}
+		}
+	}
+}
+
+// message returns the name of the gin struct of message, parsing its fields on first use
+func (s *ginStructs) message(g *protogen.GeneratedFile, message *protogen.Message) (string, error) {
+	if name, ok := s.names[message.Desc.FullName()]; ok {
+		return name, nil
+	}
+	name := s.name(message.GoIdent.GoName)
+	s.names[message.Desc.FullName()] = name
+
+	m := &ginMessage{Name: name, Type: g.QualifiedGoIdent(message.GoIdent)}
+	s.messages = append(s.messages, m)
+	var err error
+	m.Fields, err = parseMessageFields(g, message, s)
+	return name, err
+}
+
+// name returns an unused gin type name for goName, types of different packages may share their Go name
+func (s *ginStructs) name(goName string) string {
+	if s.taken == nil {
+		s.taken = make(map[string]bool)
+	}
+	name := s.prefix + goName
+	for i := 2; s.taken[name]; i++ {
+		name = fmt.Sprintf("%s%s%d", s.prefix, goName, i)
+	}
+	s.taken[name] = true
+	return name
+}
+
+// isWellKnownType reports whether message is a google.protobuf type, e.g. Timestamp, bound as is
+func isWellKnownType(message *protogen.Message) bool {
+	return message.Desc.ParentFile().Package() == "google.protobuf"
+}
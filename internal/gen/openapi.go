@@ -0,0 +1,582 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// openAPIDocument is the OpenAPI 3 document written for a proto file with the openapi option
+type openAPIDocument struct {
+	OpenAPI    string                                  `yaml:"openapi"`
+	Info       openAPIInfo                             `yaml:"info"`
+	Tags       []*openAPITag                           `yaml:"tags,omitempty"`
+	Paths      map[string]map[string]*openAPIOperation `yaml:"paths"`
+	Components struct {
+		Schemas map[string]*openAPISchema `yaml:"schemas,omitempty"`
+	} `yaml:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version"`
+}
+
+type openAPITag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type openAPIOperation struct {
+	Tags        []string                    `yaml:"tags,omitempty"`
+	Summary     string                      `yaml:"summary,omitempty"`
+	Description string                      `yaml:"description,omitempty"`
+	OperationID string                      `yaml:"operationId"`
+	Deprecated  bool                        `yaml:"deprecated,omitempty"`
+	Parameters  []*openAPIParameter         `yaml:"parameters,omitempty"`
+	RequestBody *openAPIBody                `yaml:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `yaml:"name"`
+	In          string         `yaml:"in"`
+	Description string         `yaml:"description,omitempty"`
+	Required    bool           `yaml:"required,omitempty"`
+	Schema      *openAPISchema `yaml:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                     `yaml:"required,omitempty"`
+	Content  map[string]*openAPIMedia `yaml:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                    `yaml:"description"`
+	Headers     map[string]*openAPIHeader `yaml:"headers,omitempty"`
+	Content     map[string]*openAPIMedia  `yaml:"content,omitempty"`
+}
+
+type openAPIHeader struct {
+	Schema *openAPISchema `yaml:"schema"`
+}
+
+type openAPIMedia struct {
+	Schema *openAPISchema `yaml:"schema"`
+}
+
+// openAPISchema is the subset of the schema object emitted for messages, fields and binding rules
+type openAPISchema struct {
+	Ref                  string                    `yaml:"$ref,omitempty"`
+	Type                 string                    `yaml:"type,omitempty"`
+	Format               string                    `yaml:"format,omitempty"`
+	Description          string                    `yaml:"description,omitempty"`
+	Deprecated           bool                      `yaml:"deprecated,omitempty"`
+	ReadOnly             bool                      `yaml:"readOnly,omitempty"`
+	Enum                 []any                     `yaml:"enum,omitempty"`
+	Default              any                       `yaml:"default,omitempty"`
+	Required             []string                  `yaml:"required,omitempty"`
+	Properties           map[string]*openAPISchema `yaml:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `yaml:"additionalProperties,omitempty"`
+	Items                *openAPISchema            `yaml:"items,omitempty"`
+	Minimum              *float64                  `yaml:"minimum,omitempty"`
+	Maximum              *float64                  `yaml:"maximum,omitempty"`
+	ExclusiveMinimum     bool                      `yaml:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool                      `yaml:"exclusiveMaximum,omitempty"`
+	MinLength            *int                      `yaml:"minLength,omitempty"`
+	MaxLength            *int                      `yaml:"maxLength,omitempty"`
+	MinItems             *int                      `yaml:"minItems,omitempty"`
+	MaxItems             *int                      `yaml:"maxItems,omitempty"`
+	Pattern              string                    `yaml:"pattern,omitempty"`
+}
+
+// openAPIBuilder collects the operations and message schemas of the services of a proto file
+type openAPIBuilder struct {
+	file  *protogen.File
+	doc   *openAPIDocument
+	camel bool // properties use lowerCamel JSON names, from the json_names option
+}
+
+// genOpenAPI writes the OpenAPI 3 document of the services next to the generated Go code
+func genOpenAPI(gen *protogen.Plugin, file *protogen.File, services []*serviceDesc, camel bool) error {
+	b := &openAPIBuilder{file: file, camel: camel, doc: &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info:    openAPIInfo{Title: string(file.Desc.Package()) + " API", Version: "0.0.1"},
+		Paths:   make(map[string]map[string]*openAPIOperation),
+	}}
+	b.doc.Components.Schemas = make(map[string]*openAPISchema)
+	if len(services) == 1 {
+		b.doc.Info.Title = services[0].ServiceType + " API"
+		b.doc.Info.Description = comment(services[0].service.Comments.Leading)
+	}
+	for _, sd := range services {
+		b.doc.Tags = append(b.doc.Tags, &openAPITag{Name: sd.ServiceType, Description: comment(sd.service.Comments.Leading)})
+		for _, m := range sd.Methods {
+			// WebSocket streams cannot be described as HTTP operations
+			if m.WebSocket {
+				continue
+			}
+			path := openAPIPath(m.ClientPath)
+			if b.doc.Paths[path] == nil {
+				b.doc.Paths[path] = make(map[string]*openAPIOperation)
+			}
+			method := strings.ToLower(m.Method)
+			if _, ok := b.doc.Paths[path][method]; ok {
+				return fmt.Errorf("openapi: %s %s is bound by more than one method", m.Method, m.ClientPath)
+			}
+			b.doc.Paths[path][method] = b.operation(sd, m)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "# Code generated by protoc-gen-gin. DO NOT EDIT.\n# source: %s\n\n", file.Desc.Path())
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(b.doc); err != nil {
+		return fmt.Errorf("encode openapi document: %w", err)
+	}
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+".openapi.yaml", "")
+	_, err := g.Write(buf.Bytes())
+	return err
+}
+
+// operation describes a method bound to one HTTP rule
+func (b *openAPIBuilder) operation(sd *serviceDesc, m *methodDesc) *openAPIOperation {
+	op := &openAPIOperation{
+		Tags:        []string{sd.ServiceType},
+		Summary:     m.Summary,
+		Description: m.Description,
+		OperationID: sd.ServiceType + "_" + m.Name,
+		Deprecated:  m.desc.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated(),
+		Responses:   make(map[string]*openAPIResponse),
+	}
+	if m.Num > 0 {
+		op.OperationID += strconv.Itoa(m.Num)
+	}
+
+	params := make(map[string]bool)
+	for _, p := range m.PathParams {
+		params[strings.SplitN(p, "=", 2)[0]] = true
+	}
+	var bodyField *protogen.Field
+	for _, f := range m.desc.Input.Fields {
+		if f.Oneof != nil && !f.Oneof.Desc.IsSynthetic() {
+			continue
+		}
+		if m.HasBody && m.Body == "."+f.GoName {
+			bodyField = f
+			continue
+		}
+		if hasFieldBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			continue
+		}
+		tags := parseFieldTags(f, b.camel)
+		rules := bindingRules(tags)
+		required := hasRule(parseRules(rules), "required")
+		param := &openAPIParameter{Description: comment(f.Comments.Leading)}
+		var defaultValue string
+		switch name := string(f.Desc.Name()); {
+		case params[name]:
+			param.Name, param.In, param.Required = name, "path", true
+			delete(params, name)
+		case tags["header"] != "":
+			param.Name, param.In, param.Required = tags["header"], "header", required
+		case tags["form"] != "" && !(m.HasBody && m.Body == ""):
+			form := strings.Split(tags["form"], ",")
+			param.Name, param.In, param.Required = form[0], "query", required
+			for _, opt := range form[1:] {
+				if v, ok := strings.CutPrefix(opt, "default="); ok {
+					defaultValue = v
+				}
+			}
+		default:
+			continue
+		}
+		param.Schema = b.fieldSchema(f, rules)
+		if defaultValue != "" {
+			param.Schema.Default = defaultValue
+			if v, err := strconv.ParseFloat(defaultValue, 64); err == nil && (param.Schema.Type == "integer" || param.Schema.Type == "number") {
+				param.Schema.Default = v
+			} else if v, err := strconv.ParseBool(defaultValue); err == nil && param.Schema.Type == "boolean" {
+				param.Schema.Default = v
+			}
+		}
+		op.Parameters = append(op.Parameters, param)
+	}
+	// Path variables of nested fields, e.g. {user.id}
+	for _, p := range m.PathParams {
+		if name := strings.SplitN(p, "=", 2)[0]; params[name] {
+			op.Parameters = append(op.Parameters, &openAPIParameter{Name: name, In: "path", Required: true, Schema: &openAPISchema{Type: "string"}})
+		}
+	}
+
+	if m.HasBody {
+		var schema *openAPISchema
+		media := "application/json"
+		if bodyField != nil {
+			schema = b.fieldSchema(bodyField, bindingRules(parseFieldTags(bodyField, b.camel)))
+		} else if hasFileFields(m.desc.Input) {
+			// Files are only uploaded as multipart forms
+			media = "multipart/form-data"
+			schema = b.requestBodySchema(m, true)
+		} else {
+			schema = b.requestBodySchema(m, false)
+		}
+		op.RequestBody = &openAPIBody{Required: true, Content: map[string]*openAPIMedia{media: {Schema: schema}}}
+		if len(m.ContentTypes) > 0 {
+			// Only the declared content types are accepted, forms are bound by their form names
+			op.RequestBody.Content = make(map[string]*openAPIMedia, len(m.ContentTypes))
+			for _, t := range m.ContentTypes {
+				media := &openAPIMedia{Schema: schema}
+				if bodyField == nil && (t == "multipart/form-data" || t == "application/x-www-form-urlencoded") {
+					media.Schema = b.requestBodySchema(m, true)
+				}
+				op.RequestBody.Content[t] = media
+			}
+		}
+	}
+
+	resp := &openAPIResponse{Description: http.StatusText(m.Status)}
+	reply := b.messageSchema(m.desc.Output)
+	if m.ResponseBody != "" {
+		for _, f := range m.desc.Output.Fields {
+			if "."+f.GoName == m.ResponseBody {
+				reply = b.fieldSchema(f, "")
+			}
+		}
+	}
+	switch {
+	case m.Status == http.StatusNoContent:
+		// 204 responses have no body
+	case m.ServerStream:
+		resp.Content = map[string]*openAPIMedia{"text/event-stream": {Schema: reply}}
+	case m.StreamItem != "":
+		var item *openAPISchema
+		for _, f := range m.desc.Output.Fields {
+			if f.GoName == m.StreamField {
+				item = b.messageSchema(f.Message)
+			}
+		}
+		if m.StreamFormat == "ndjson" {
+			resp.Content = map[string]*openAPIMedia{"application/x-ndjson": {Schema: item}}
+		} else {
+			resp.Content = map[string]*openAPIMedia{"application/json": {Schema: &openAPISchema{Type: "array", Items: item}}}
+		}
+	default:
+		resp.Content = map[string]*openAPIMedia{"application/json": {Schema: reply}}
+	}
+	for _, h := range m.ResponseHeaders {
+		if resp.Headers == nil {
+			resp.Headers = make(map[string]*openAPIHeader)
+		}
+		resp.Headers[h.Name] = &openAPIHeader{Schema: &openAPISchema{Type: "string"}}
+	}
+	if m.Cache != "" {
+		if resp.Headers == nil {
+			resp.Headers = make(map[string]*openAPIHeader)
+		}
+		resp.Headers["Cache-Control"] = &openAPIHeader{Schema: &openAPISchema{Type: "string", Enum: []any{m.CacheControl}}}
+	}
+	if m.CacheETag {
+		resp.Headers["ETag"] = &openAPIHeader{Schema: &openAPISchema{Type: "string"}}
+		op.Parameters = append(op.Parameters, &openAPIParameter{Name: "If-None-Match", In: "header", Schema: &openAPISchema{Type: "string"}})
+		op.Responses[strconv.Itoa(http.StatusNotModified)] = &openAPIResponse{Description: http.StatusText(http.StatusNotModified)}
+	}
+	op.Responses[strconv.Itoa(m.Status)] = resp
+	return op
+}
+
+// requestBodySchema describes the body of a body: "*" rule, i.e. the request without path and header fields,
+// as JSON object or as multipart form with fields named by their form tag
+func (b *openAPIBuilder) requestBodySchema(m *methodDesc, multipart bool) *openAPISchema {
+	params := make(map[string]bool)
+	for _, p := range m.PathParams {
+		params[strings.SplitN(p, "=", 2)[0]] = true
+	}
+	schema := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+	for _, f := range m.desc.Input.Fields {
+		tags := parseFieldTags(f, b.camel)
+		if (f.Oneof != nil && !f.Oneof.Desc.IsSynthetic()) || params[string(f.Desc.Name())] || tags["header"] != "" ||
+			hasFieldBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY) {
+			continue
+		}
+		if !multipart {
+			b.addProperty(schema, f, strings.Split(tags["json"], ",")[0], tags)
+			continue
+		}
+		name := strings.Split(tags["form"], ",")[0]
+		if name == "" {
+			continue
+		}
+		b.addProperty(schema, f, name, tags)
+		if tags["multipart"] != "" {
+			file := &openAPISchema{Type: "string", Format: "binary", Description: schema.Properties[name].Description}
+			if f.Desc.IsList() {
+				file = &openAPISchema{Type: "array", Items: &openAPISchema{Type: "string", Format: "binary"}, Description: file.Description}
+			}
+			schema.Properties[name] = file
+		}
+	}
+	return schema
+}
+
+// hasFileFields reports whether msg has fields tagged with multipart, bound to uploaded files
+func hasFileFields(msg *protogen.Message) bool {
+	for _, f := range msg.Fields {
+		if parseFieldTags(f, false)["multipart"] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// messageSchema registers the schema of msg as component and returns a reference to it
+func (b *openAPIBuilder) messageSchema(msg *protogen.Message) *openAPISchema {
+	name := b.schemaName(msg.Desc)
+	ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+	if _, ok := b.doc.Components.Schemas[name]; ok {
+		return ref
+	}
+	schema := &openAPISchema{Type: "object", Description: comment(msg.Comments.Leading), Properties: make(map[string]*openAPISchema)}
+	// Registered before the fields so recursive messages terminate
+	b.doc.Components.Schemas[name] = schema
+	for _, f := range msg.Fields {
+		// Oneof members are not encoded as plain fields
+		if f.Oneof != nil && !f.Oneof.Desc.IsSynthetic() {
+			continue
+		}
+		name := f.Desc.TextName()
+		if b.camel {
+			name = f.Desc.JSONName()
+		}
+		b.addProperty(schema, f, name, parseFieldTags(f, b.camel))
+	}
+	return ref
+}
+
+// addProperty adds the field f named name to the object schema
+func (b *openAPIBuilder) addProperty(schema *openAPISchema, f *protogen.Field, name string, tags map[string]string) {
+	if name == "-" {
+		return
+	}
+	rules := bindingRules(tags)
+	prop := b.fieldSchema(f, rules)
+	if prop.Ref == "" {
+		prop.Description = strings.TrimSpace(comment(f.Comments.Leading) + "\n" + comment(f.Comments.Trailing))
+		prop.Deprecated = f.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated()
+		prop.ReadOnly = hasFieldBehavior(f, annotations.FieldBehavior_OUTPUT_ONLY)
+	}
+	schema.Properties[name] = prop
+	if hasRule(parseRules(rules), "required") {
+		schema.Required = append(schema.Required, name)
+	}
+}
+
+// fieldSchema describes a field as encoded by encoding/json with its validator rules applied,
+// rules after "dive" apply to list items
+func (b *openAPIBuilder) fieldSchema(f *protogen.Field, rules string) *openAPISchema {
+	if f.Desc.IsMap() {
+		return &openAPISchema{Type: "object", AdditionalProperties: b.kindSchema(f.Message.Fields[1])}
+	}
+	if f.Desc.IsList() {
+		items := b.kindSchema(f)
+		schema := &openAPISchema{Type: "array", Items: items}
+		container, elem := splitDive(rules)
+		applyRules(schema, parseRules(container))
+		applyRules(items, parseRules(elem))
+		return schema
+	}
+	schema := b.kindSchema(f)
+	applyRules(schema, parseRules(rules))
+	return schema
+}
+
+// kindSchema describes a single value of f
+func (b *openAPIBuilder) kindSchema(f *protogen.Field) *openAPISchema {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return &openAPISchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &openAPISchema{Type: "integer", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		switch jsonfield.BytesEncoding(f.Desc) {
+		case jsonfield.Base64URL:
+			return &openAPISchema{Type: "string", Format: "base64url"}
+		case jsonfield.Hex:
+			return &openAPISchema{Type: "string", Pattern: "^([0-9a-fA-F]{2})*$"}
+		case jsonfield.Raw:
+			return &openAPISchema{Type: "string"}
+		}
+		return &openAPISchema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		// encoding/json writes enums as numbers
+		schema := &openAPISchema{Type: "integer", Format: "int32"}
+		var names []string
+		for _, v := range f.Enum.Values {
+			schema.Enum = append(schema.Enum, int(v.Desc.Number()))
+			names = append(names, fmt.Sprintf("%d = %s", v.Desc.Number(), v.Desc.Name()))
+		}
+		schema.Description = strings.Join(names, ", ")
+		return schema
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if f.Message.Desc.FullName() == "google.protobuf.FieldMask" {
+			// Field masks are bound from comma separated paths, e.g. title,author.name
+			return &openAPISchema{Type: "string", Format: "field-mask"}
+		}
+		return b.messageSchema(f.Message)
+	}
+	return &openAPISchema{Type: "string"}
+}
+
+// schemaName names the component of a message, messages of other packages keep their package
+func (b *openAPIBuilder) schemaName(desc protoreflect.MessageDescriptor) string {
+	name := string(desc.FullName())
+	if pkg := string(b.file.Desc.Package()); pkg != "" && desc.ParentFile().Package() == b.file.Desc.Package() {
+		name = strings.TrimPrefix(name, pkg+".")
+	}
+	return name
+}
+
+// bindingRules returns the binding and validate rules of a field
+func bindingRules(tags map[string]string) string {
+	return tags["binding"] + "," + tags["validate"]
+}
+
+// splitDive splits validator rules into those of a list and those of its items
+func splitDive(rules string) (container, items string) {
+	parts := strings.Split(rules, ",")
+	for i, rule := range parts {
+		if strings.TrimSpace(rule) == "dive" {
+			return strings.Join(parts[:i], ","), strings.Join(parts[i+1:], ",")
+		}
+	}
+	return rules, ""
+}
+
+var numericPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// applyRules maps validator rules onto schema keywords, unknown rules are ignored
+func applyRules(schema *openAPISchema, rules map[string]string) {
+	if schema.Ref != "" {
+		return
+	}
+	number := func(v string) *float64 {
+		if !numericPattern.MatchString(v) {
+			return nil
+		}
+		f, _ := strconv.ParseFloat(v, 64)
+		return &f
+	}
+	length := func(v string, delta int) *int {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil
+		}
+		n += delta
+		return &n
+	}
+	// Conflicting rules like len and min resolve the same way on every run
+	for _, rule := range slices.Sorted(maps.Keys(rules)) {
+		v := rules[rule]
+		switch schema.Type {
+		case "integer", "number":
+			switch rule {
+			case "min", "gte":
+				schema.Minimum = number(v)
+			case "max", "lte":
+				schema.Maximum = number(v)
+			case "gt":
+				schema.Minimum, schema.ExclusiveMinimum = number(v), true
+			case "lt":
+				schema.Maximum, schema.ExclusiveMaximum = number(v), true
+			case "oneof":
+				schema.Enum = nil
+				for _, s := range strings.Fields(v) {
+					if n := number(s); n != nil {
+						schema.Enum = append(schema.Enum, *n)
+					}
+				}
+			}
+		case "string":
+			switch rule {
+			case "len":
+				schema.MinLength, schema.MaxLength = length(v, 0), length(v, 0)
+			case "min", "gte":
+				schema.MinLength = length(v, 0)
+			case "max", "lte":
+				schema.MaxLength = length(v, 0)
+			case "gt":
+				schema.MinLength = length(v, 1)
+			case "lt":
+				schema.MaxLength = length(v, -1)
+			case "oneof":
+				for _, s := range strings.Fields(v) {
+					schema.Enum = append(schema.Enum, s)
+				}
+			case "email":
+				schema.Format = "email"
+			case "uuid", "uuid4":
+				schema.Format = "uuid"
+			case "url", "uri":
+				schema.Format = "uri"
+			case "ipv4", "ipv6", "hostname":
+				schema.Format = rule
+			case "alpha":
+				schema.Pattern = "^[a-zA-Z]+$"
+			case "alphanum":
+				schema.Pattern = "^[a-zA-Z0-9]+$"
+			case "numeric":
+				schema.Pattern = "^[-+]?[0-9]+(?:\\.[0-9]+)?$"
+			case "e164":
+				schema.Pattern = "^\\+[1-9]?[0-9]{7,14}$"
+			case "startswith":
+				schema.Pattern = "^" + regexp.QuoteMeta(v)
+			case "endswith":
+				schema.Pattern = regexp.QuoteMeta(v) + "$"
+			}
+		case "array":
+			switch rule {
+			case "len":
+				schema.MinItems, schema.MaxItems = length(v, 0), length(v, 0)
+			case "min", "gte":
+				schema.MinItems = length(v, 0)
+			case "max", "lte":
+				schema.MaxItems = length(v, 0)
+			}
+		}
+	}
+}
+
+// openAPIPath converts a path template to OpenAPI, e.g. /users/{name=users/*} to /users/{name}
+func openAPIPath(path string) string {
+	return regexp.MustCompile(`{([^}=]+)=[^}]*}`).ReplaceAllString(path, "{$1}")
+}
+
+// comment returns a proto comment without surrounding whitespace
+func comment(c protogen.Comments) string {
+	return strings.TrimSpace(string(c))
+}
@@ -0,0 +1,31 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRules(t *testing.T) {
+	s := &openAPISchema{Type: "string"}
+	applyRules(s, parseRules("required,min=3,max=20,startswith=Bearer "))
+	assert.Equal(t, 3, *s.MinLength)
+	assert.Equal(t, 20, *s.MaxLength)
+	assert.Equal(t, "^Bearer ", s.Pattern)
+
+	n := &openAPISchema{Type: "integer"}
+	applyRules(n, parseRules("gt=0,lte=100,oneof=1 10 100"))
+	assert.Equal(t, 0.0, *n.Minimum)
+	assert.True(t, n.ExclusiveMinimum)
+	assert.Equal(t, 100.0, *n.Maximum)
+	assert.Equal(t, []any{1.0, 10.0, 100.0}, n.Enum)
+
+	container, items := splitDive("required,min=1,dive,uuid")
+	assert.Equal(t, "required,min=1", container)
+	assert.Equal(t, "uuid", items)
+	container, items = splitDive("max=10")
+	assert.Equal(t, "max=10", container)
+	assert.Empty(t, items)
+
+	assert.Equal(t, "/v1/{name}/items/{id}", openAPIPath("/v1/{name=shelves/*}/items/{id}"))
+}
@@ -0,0 +1,197 @@
+package gen
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// pathVariable matches a path variable {name} or {name=pattern}
+var pathVariable = regexp.MustCompile(`{\s*([a-zA-Z0-9_.]+)\s*(?:=([^{}]*))?}`)
+
+// pathWildcard is a path variable matching several segments, e.g. {name=shelves/*} or {path=**}
+type pathWildcard struct {
+	Name     string   // name
+	Pattern  string   // shelves/*
+	Segments []string // gin segments of the pattern, e.g. shelves and :name.2
+}
+
+// routePath converts the path of a http rule to the gin route and the client path template.
+// {id} and {id=*} become :id. Variables of several segments are routed segment by segment with
+// parameters numbered by position, e.g. {name=shelves/*} becomes shelves/:name.2, and a trailing **
+// becomes the catch-all *name.N. The client path keeps {name} only.
+func routePath(m *protogen.Method, path string) (route, clientPath, verb string, wildcards []*pathWildcard, err error) {
+	path, verb = splitVerb(path)
+	var r, c strings.Builder
+	last := 0
+	for _, loc := range pathVariable.FindAllStringSubmatchIndex(path, -1) {
+		r.WriteString(path[last:loc[0]])
+		c.WriteString(path[last:loc[0]])
+		last = loc[1]
+
+		name := path[loc[2]:loc[3]]
+		c.WriteString("{" + name + "}")
+		pattern := "*"
+		if loc[4] >= 0 && strings.TrimSpace(path[loc[4]:loc[5]]) != "" {
+			pattern = strings.TrimSpace(path[loc[4]:loc[5]])
+		}
+		if pattern == "*" {
+			r.WriteString(":" + name)
+			continue
+		}
+
+		w := &pathWildcard{Name: name, Pattern: pattern}
+		parts := strings.Split(pattern, "/")
+		for i, part := range parts {
+			switch {
+			case part == "*":
+				w.Segments = append(w.Segments, fmt.Sprintf(":%s.%d", name, i+1))
+			case part == "**":
+				if i != len(parts)-1 || last != len(path) {
+					return "", "", "", nil, fmt.Errorf("{%s=%s} in %s of %s: ** must be the last segment of the path, gin routes the rest of the path as a catch-all", name, pattern, path, m.Desc.FullName())
+				}
+				w.Segments = append(w.Segments, fmt.Sprintf("*%s.%d", name, i+1))
+			case part == "" || strings.ContainsAny(part, "*:"):
+				return "", "", "", nil, fmt.Errorf("{%s=%s} in %s of %s: segments of a path pattern must be literals, * or a trailing **", name, pattern, path, m.Desc.FullName())
+			default:
+				w.Segments = append(w.Segments, part)
+			}
+		}
+		r.WriteString(strings.Join(w.Segments, "/"))
+		wildcards = append(wildcards, w)
+	}
+	r.WriteString(path[last:])
+	c.WriteString(path[last:])
+	route, clientPath = r.String(), c.String()
+	if verb == "" {
+		return route, clientPath, "", wildcards, nil
+	}
+
+	// gin cannot match a literal after a parameter, the route matches the whole last segment
+	// and ginpb.VerbRoutes dispatches by verb
+	clientPath += ":" + verb
+	i := strings.LastIndex(route, "/")
+	if segment := route[i+1:]; strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+		return route, clientPath, ":" + verb, wildcards, nil
+	}
+	return route[:i+1] + ":" + verbParam, clientPath, route[i+1:] + ":" + verb, wildcards, nil
+}
+
+// verbParam is the gin parameter of a literal last segment with a custom verb, e.g. things:batchGet
+const verbParam = "ginpb.verb"
+
+// splitVerb splits the custom verb off the last segment of path, e.g. cancel of /v1/{name}:cancel
+func splitVerb(path string) (string, string) {
+	start := strings.LastIndex(path, "}") + 1
+	if i := strings.LastIndex(path[start:], "/"); i >= 0 {
+		start += i
+	}
+	if i := strings.LastIndex(path[start:], ":"); i >= 0 {
+		return path[:start+i], path[start+i+1:]
+	}
+	return path, ""
+}
+
+// checkVerbRoutes rejects methods without custom verb sharing the gin route of a method with one,
+// ginpb.VerbRoutes only dispatches requests ending with a verb
+func checkVerbRoutes(methods []*methodDesc) error {
+	verbs := make(map[string]*methodDesc)
+	for _, m := range methods {
+		if m.Verb != "" {
+			verbs[m.Method+" "+m.Path] = m
+		}
+	}
+	for _, m := range methods {
+		if v := verbs[m.Method+" "+m.Path]; v != nil && m.Verb == "" {
+			return fmt.Errorf("%s %s of %s shares the route of %s %s of %s, a route with custom verbs cannot also be used without one",
+				m.Method, m.ClientPath, m.desc.Desc.FullName(), v.Method, v.ClientPath, v.desc.Desc.FullName())
+		}
+	}
+	return nil
+}
+
+// checkDuplicateRoutes rejects methods and additional bindings of service mapped to the same gin route,
+// which gin panics on when the server is registered. Routes differing only in parameter names collide too.
+func checkDuplicateRoutes(service string, methods []*methodDesc) error {
+	seen := make(map[string]*methodDesc)
+	for _, m := range methods {
+		segs := strings.Split(m.Path, "/")
+		for i, seg := range segs {
+			if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+				segs[i] = seg[:1]
+			}
+		}
+		key := m.Method + " " + strings.Join(segs, "/") + " " + m.Verb
+		if other, ok := seen[key]; ok {
+			return fmt.Errorf("%s %s of %s.%s and %s %s of %s.%s map to the same route %s %s, gin would panic registering it",
+				other.Method, other.ClientPath, service, other.OriginalName, m.Method, m.ClientPath, service, m.OriginalName, m.Method, m.RoutePath())
+		}
+		seen[key] = m
+	}
+	return nil
+}
+
+// RoutePath returns the gin path of the route including its custom verb, as recorded by ginpb.VerbPath
+func (m *methodDesc) RoutePath() string {
+	switch {
+	case m.Verb == "":
+		return m.Path
+	case strings.HasPrefix(m.Verb, ":"):
+		return m.Path + m.Verb
+	default:
+		return m.Path[:strings.LastIndex(m.Path, "/")+1] + m.Verb
+	}
+}
+
+// wildcard returns the multi-segment variable param, nil for single segments
+func (m *methodDesc) wildcard(param string) *pathWildcard {
+	for _, w := range m.Wildcards {
+		if w.Name == param {
+			return w
+		}
+	}
+	return nil
+}
+
+// PathValue returns the expression the client fills the path parameter param with
+func (m *methodDesc) PathValue(param string) string {
+	value := fmt.Sprintf(`fmt.Sprintf("%%v", in.%s)`, camelCase(param))
+	for _, f := range m.Fields {
+		if f.Name == param && f.bytesEncoding != "" {
+			value = fmt.Sprintf("ginpb.EncodeBytes(in.%s, %q)", f.GoName, f.bytesEncoding)
+		}
+	}
+	// Slashes of multi-segment values separate segments, single segments escape them
+	if m.wildcard(param) != nil {
+		return "client.EscapePath(" + value + ")"
+	}
+	return "url.PathEscape(" + value + ")"
+}
+
+// escapesPathSegment reports whether the client escapes a single-segment path parameter with url.PathEscape
+func (m *methodDesc) escapesPathSegment() bool {
+	for _, p := range m.PathParams {
+		if m.wildcard(p) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// pathSample returns the escaped sample value of the path parameter param, matching its pattern
+func (m *methodDesc) pathSample(param, value string) string {
+	w := m.wildcard(param)
+	if w == nil {
+		return url.PathEscape(value)
+	}
+	parts := strings.Split(w.Pattern, "/")
+	for i, part := range parts {
+		if part == "*" || part == "**" {
+			parts[i] = url.PathEscape(value)
+		}
+	}
+	return strings.Join(parts, "/")
+}
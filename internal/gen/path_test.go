@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutePath(t *testing.T) {
+	route, clientPath, _, wildcards, err := routePath(nil, "/v1/{name=shelves/*}/books/{book_id}")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/shelves/:name.2/books/:book_id", route)
+	assert.Equal(t, "/v1/{name}/books/{book_id}", clientPath)
+	assert.Equal(t, []*pathWildcard{{Name: "name", Pattern: "shelves/*", Segments: []string{"shelves", ":name.2"}}}, wildcards)
+
+	route, clientPath, _, wildcards, err = routePath(nil, "/v1/files/{path=**}")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/files/*path.1", route)
+	assert.Equal(t, "/v1/files/{path}", clientPath)
+	assert.Equal(t, []string{"*path.1"}, wildcards[0].Segments)
+
+	route, _, _, wildcards, err = routePath(nil, "/v1/users/{id=*}")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/users/:id", route)
+	assert.Empty(t, wildcards)
+
+	md := &methodDesc{PathParams: []string{"name"}, Wildcards: []*pathWildcard{{Name: "name", Pattern: "shelves/*/books/*"}}}
+	assert.Equal(t, "shelves/a%20b/books/a%20b", md.pathSample("name", "a b"))
+	assert.Equal(t, "client.EscapePath(fmt.Sprintf(\"%v\", in.Name))", md.PathValue("name"))
+	assert.False(t, md.escapesPathSegment())
+}
+
+func TestRoutePathVerb(t *testing.T) {
+	route, clientPath, verb, _, err := routePath(nil, "/v1/{name=operations/**}:cancel")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/operations/*name.2", route)
+	assert.Equal(t, "/v1/{name}:cancel", clientPath)
+	assert.Equal(t, ":cancel", verb)
+
+	route, clientPath, verb, _, err = routePath(nil, "/v1/things:batchGet")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/:ginpb.verb", route)
+	assert.Equal(t, "/v1/things:batchGet", clientPath)
+	assert.Equal(t, "things:batchGet", verb)
+
+	route, _, verb, _, err = routePath(nil, "/v1/things/{id}")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/things/:id", route)
+	assert.Empty(t, verb)
+}
+
+func TestCheckDuplicateRoutes(t *testing.T) {
+	get := &methodDesc{OriginalName: "GetUser", Method: "GET", Path: "/v1/users/:id", ClientPath: "/v1/users/{id}"}
+	cancel := &methodDesc{OriginalName: "CancelJob", Method: "POST", Path: "/v1/jobs/:id", ClientPath: "/v1/jobs/{id}:cancel", Verb: ":cancel"}
+	retry := &methodDesc{OriginalName: "RetryJob", Method: "POST", Path: "/v1/jobs/:id", ClientPath: "/v1/jobs/{id}:retry", Verb: ":retry"}
+	update := &methodDesc{OriginalName: "UpdateUser", Method: "PUT", Path: "/v1/users/:id", ClientPath: "/v1/users/{id}"}
+	assert.NoError(t, checkDuplicateRoutes("v1.UserService", []*methodDesc{get, cancel, retry, update}))
+
+	fetch := &methodDesc{OriginalName: "FetchUser", Method: "GET", Path: "/v1/users/:user_id", ClientPath: "/v1/users/{user_id}"}
+	err := checkDuplicateRoutes("v1.UserService", []*methodDesc{get, cancel, fetch})
+	assert.EqualError(t, err, "GET /v1/users/{id} of v1.UserService.GetUser and GET /v1/users/{user_id} of v1.UserService.FetchUser "+
+		"map to the same route GET /v1/users/:user_id, gin would panic registering it")
+}
@@ -0,0 +1,76 @@
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// buildSLO returns the slo.Objective literal of the (ginpb.slo) option of m, empty without option
+func buildSLO(g *protogen.GeneratedFile, m *protogen.Method) (string, error) {
+	o, ok := proto.GetExtension(m.Desc.Options(), ginext.E_Slo).(*ginext.SLO)
+	if !ok || o == nil {
+		return "", nil
+	}
+	name := m.Desc.FullName()
+	ratio := func(field string, v float64) error {
+		if v < 0 || v >= 1 {
+			return fmt.Errorf("slo.%s of %s is %v, use a ratio between 0 and 1 such as 0.999", field, name, v)
+		}
+		return nil
+	}
+	if err := ratio("availability", o.GetAvailability()); err != nil {
+		return "", err
+	}
+	if err := ratio("latency_target", o.GetLatencyTarget()); err != nil {
+		return "", err
+	}
+	if o.GetBurnRate() < 0 {
+		return "", fmt.Errorf("slo.burn_rate of %s is negative", name)
+	}
+
+	var fields []string
+	if v := o.GetAvailability(); v > 0 {
+		fields = append(fields, "Availability: "+formatFloat(v))
+	}
+	if o.GetLatency() != "" {
+		d, err := time.ParseDuration(o.GetLatency())
+		if err != nil || d <= 0 {
+			return "", fmt.Errorf("slo.latency %q of %s is invalid, use a Go duration such as \"300ms\"", o.GetLatency(), name)
+		}
+		fields = append(fields, "Latency: "+durationLiteral(g, d))
+	} else if o.GetLatencyTarget() > 0 {
+		return "", fmt.Errorf("slo.latency_target of %s needs slo.latency", name)
+	}
+	if v := o.GetLatencyTarget(); v > 0 {
+		fields = append(fields, "LatencyTarget: "+formatFloat(v))
+	}
+	if v := o.GetBurnRate(); v > 0 {
+		fields = append(fields, "BurnRate: "+formatFloat(v))
+	}
+	return "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+// durationLiteral returns d as Go expression in the largest exact unit, e.g. 300 * time.Millisecond
+func durationLiteral(g *protogen.GeneratedFile, d time.Duration) string {
+	units := []struct {
+		d    time.Duration
+		name string
+	}{{time.Hour, "Hour"}, {time.Minute, "Minute"}, {time.Second, "Second"}, {time.Millisecond, "Millisecond"}, {time.Microsecond, "Microsecond"}}
+	for _, u := range units {
+		if d%u.d == 0 {
+			return fmt.Sprintf("%d * %s", d/u.d, g.QualifiedGoIdent(timePackage.Ident(u.name)))
+		}
+	}
+	return fmt.Sprintf("%d", int64(d))
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,39 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// userTemplates caches the templates read from template directories by path, empty for missing files
+var userTemplates sync.Map
+
+// loadTemplate returns the template name.tmpl in dir, or fallback when dir is empty or has no such file
+func loadTemplate(dir, name, fallback string) (string, error) {
+	if dir == "" {
+		return fallback, nil
+	}
+	path := filepath.Join(dir, name+".tmpl")
+	if text, ok := userTemplates.Load(path); ok {
+		if text == "" {
+			return fallback, nil
+		}
+		return text.(string), nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		data, err = nil, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read %s template: %w", name, err)
+	}
+	userTemplates.Store(path, string(data))
+	if len(data) == 0 {
+		return fallback, nil
+	}
+	return string(data), nil
+}
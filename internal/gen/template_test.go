@@ -0,0 +1,29 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.tmpl"), []byte("// {{.ServiceType}} client"), 0o644))
+
+	text, err := loadTemplate(dir, "client", clientTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, "// {{.ServiceType}} client", text)
+
+	// Missing files fall back to the built-in template
+	text, err = loadTemplate(dir, "server", serverTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, serverTemplate, text)
+
+	sd := &serviceDesc{ServiceType: "UserService", templates: dir}
+	code, err := sd.executeTemplate("client", clientTemplate, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "// UserService client", code)
+}
@@ -0,0 +1,22 @@
+package gen
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// setTimeout parses ginpb.timeout, which only applies to unary methods, streams run until the client disconnects
+func setTimeout(g *protogen.GeneratedFile, md *methodDesc, m *protogen.Method, timeout string) error {
+	if md.WebSocket || md.ServerStream || md.StreamItem != "" {
+		return fmt.Errorf("timeout of %s: streaming methods run until the client disconnects, bound them with middleware.Timeout instead", m.Desc.FullName())
+	}
+	d, err := time.ParseDuration(timeout)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("timeout of %s: %q is not a positive Go duration such as \"5s\"", m.Desc.FullName(), timeout)
+	}
+	md.Timeout = d
+	md.TimeoutExpr = durationLiteral(g, d)
+	return nil
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"go/scanner"
+	"strings"
+)
+
+// verifyContext is the number of lines shown around each syntax error
+const verifyContext = 3
+
+// verifySource checks that the code emitted for a service is valid Go.
+// Errors point at the offending template output with surrounding lines so
+// template bugs are caught at generation time instead of at build time.
+func verifySource(service, code string) error {
+	const header = "package verify\n"
+	_, err := format.Source([]byte(header + code))
+	if err == nil {
+		return nil
+	}
+
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return fmt.Errorf("generated code for %s is invalid Go: %w", service, err)
+	}
+
+	lines := strings.Split(code, "\n")
+	var b strings.Builder
+	fmt.Fprintf(&b, "generated code for %s is invalid Go, this is a protoc-gen-gin template bug; please report it with the proto definition:\n", service)
+	for _, e := range list {
+		line := e.Pos.Line - 1 // skip the package header
+		fmt.Fprintf(&b, "\n%d:%d: %s\n", line, e.Pos.Column, e.Msg)
+		from, to := max(line-verifyContext, 1), min(line+verifyContext, len(lines))
+		for i := from; i <= to; i++ {
+			marker := " "
+			if i == line {
+				marker = ">"
+			}
+			fmt.Fprintf(&b, "%s %5d | %s\n", marker, i, lines[i-1])
+		}
+	}
+	return errors.New(b.String())
+}
@@ -0,0 +1,47 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySource(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		err  string
+	}{
+		{
+			name: "gofmt clean",
+			code: "func ping() string {\n\treturn \"pong\"\n}\n",
+		},
+		{
+			name: "unformatted",
+			code: "func ping( ) string{\nreturn   \"pong\" }\n",
+		},
+		{
+			name: "unparsable",
+			code: "func ping() string {\n\treturn \"pong\"\n}\n\nfunc sum() int {\n\treturn 1 +\n}\n",
+			err: `generated code for example.Svc is invalid Go, this is a protoc-gen-gin template bug; please report it with the proto definition:
+
+7:1: expected operand, found '}'
+      4 | 
+      5 | func sum() int {
+      6 | 	return 1 +
+>     7 | }
+      8 | 
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifySource("example.Svc", tt.code)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+var webhookPackage = protogen.GoImportPath("github.com/go-kenka/ginpb/webhook")
+
+// eventTypePattern matches dotted lower-case event types such as user.created
+var eventTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+
+// webhookFile describes the events declared with ginpb.event in a file
+type webhookFile struct {
+	Name       string // Events, from events.proto
+	Source     string // api/events.proto
+	Context    string // qualified context.Context
+	Dispatcher string // qualified webhook.Dispatcher
+	Config     string // qualified webhook.Config
+	New        string // qualified webhook.NewDispatcher
+	Events     []*webhookEvent
+}
+
+type webhookEvent struct {
+	Type    string // user.created
+	Message string // UserCreatedEvent
+	Name    string // UserCreated, the message name without Event suffix
+}
+
+var webhooksTemplate = `
+// Webhook event types of {{.Source}}
+const (
+{{- range .Events}}
+	{{.Name}}EventType = {{printf "%q" .Type}}
+{{- end}}
+)
+
+// {{.Name}}WebhookEvents lists the webhook event types of {{.Source}}
+var {{.Name}}WebhookEvents = []string{
+{{- range .Events}}
+	{{.Name}}EventType,
+{{- end}}
+}
+
+// {{.Name}}Webhooks delivers the events of {{.Source}} to their subscriptions and serves the
+// subscription management endpoints with Register
+type {{.Name}}Webhooks struct {
+	*{{.Dispatcher}}
+}
+
+// New{{.Name}}Webhooks creates the webhooks of {{.Source}} and starts their delivery workers,
+// stop them with Close. Subscriptions may select the event types of {{.Name}}WebhookEvents.
+func New{{.Name}}Webhooks(config {{.Config}}) *{{.Name}}Webhooks {
+	config.Events = {{.Name}}WebhookEvents
+	return &{{.Name}}Webhooks{Dispatcher: {{.New}}(config)}
+}
+{{range .Events}}
+// Publish{{.Name}} delivers event to the subscriptions of {{.Type}}
+func (w *{{$.Name}}Webhooks) Publish{{.Name}}(ctx {{$.Context}}, event *{{.Message}}) error {
+	return w.Publish(ctx, {{.Name}}EventType, event)
+}
+{{end}}`
+
+// genWebhooks writes the webhook dispatcher of the messages of file declared with ginpb.event
+func genWebhooks(gen *protogen.Plugin, file *protogen.File, opts ResolvedOptions) error {
+	events, err := collectEvents(file.Messages, make(map[string]string))
+	if err != nil || len(events) == 0 {
+		return err
+	}
+
+	g := newGeneratedFile(gen, file, file.GeneratedFilenamePrefix+"_webhooks"+opts.FileSuffix, "")
+	data := &webhookFile{
+		Name:       camelCase(strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto")),
+		Source:     file.Desc.Path(),
+		Context:    g.QualifiedGoIdent(contextPackage.Ident("Context")),
+		Dispatcher: g.QualifiedGoIdent(webhookPackage.Ident("Dispatcher")),
+		Config:     g.QualifiedGoIdent(webhookPackage.Ident("Config")),
+		New:        g.QualifiedGoIdent(webhookPackage.Ident("NewDispatcher")),
+		Events:     events,
+	}
+	tmpl, err := template.New("webhooks").Parse(webhooksTemplate)
+	if err != nil {
+		return fmt.Errorf("parse webhooks template: %w", err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return fmt.Errorf("execute webhooks template: %w", err)
+	}
+	g.P(buf.String())
+	return nil
+}
+
+// collectEvents returns the events of messages and their nested messages, seen maps the collected event
+// types to their messages
+func collectEvents(messages []*protogen.Message, seen map[string]string) ([]*webhookEvent, error) {
+	var res []*webhookEvent
+	for _, m := range messages {
+		if event, _ := proto.GetExtension(m.Desc.Options(), ginext.E_Event).(string); event != "" {
+			if !eventTypePattern.MatchString(event) {
+				return nil, fmt.Errorf("ginpb.event of %s must be a dotted lower-case type such as user.created, not %q", m.Desc.FullName(), event)
+			}
+			if other, ok := seen[event]; ok {
+				return nil, fmt.Errorf("ginpb.event %q of %s is already declared by %s", event, m.Desc.FullName(), other)
+			}
+			seen[event] = string(m.Desc.FullName())
+			e := &webhookEvent{Type: event, Message: m.GoIdent.GoName, Name: strings.TrimSuffix(m.GoIdent.GoName, "Event")}
+			if e.Name == "" {
+				e.Name = e.Message
+			}
+			res = append(res, e)
+		}
+		nested, err := collectEvents(m.Messages, seen)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, nested...)
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package jsonfield
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+// Encodings of bytes fields set with ginpb.bytes_encoding
+const (
+	Base64    = "base64"    // standard base64, the encoding/json and protojson default
+	Base64URL = "base64url" // URL-safe base64 without padding
+	Hex       = "hex"       // lowercase hex
+	Raw       = "raw"       // the bytes as a plain string
+)
+
+// BytesEncoding returns the ginpb.bytes_encoding of fd, empty for the default base64
+func BytesEncoding(fd protoreflect.FieldDescriptor) string {
+	enc, _ := proto.GetExtension(fd.Options(), ginext.E_BytesEncoding).(string)
+	if enc == Base64 {
+		return ""
+	}
+	return enc
+}
+
+// ValidBytesEncoding reports whether enc is one of the encodings
+func ValidBytesEncoding(enc string) bool {
+	switch enc {
+	case Base64, Base64URL, Hex, Raw:
+		return true
+	}
+	return false
+}
+
+// EncodeBytes encodes b with enc
+func EncodeBytes(b []byte, enc string) string {
+	switch enc {
+	case Base64URL:
+		return base64.RawURLEncoding.EncodeToString(b)
+	case Hex:
+		return hex.EncodeToString(b)
+	case Raw:
+		return string(b)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// DecodeBytes decodes s encoded with enc, base64 is accepted with or without padding
+func DecodeBytes(s, enc string) ([]byte, error) {
+	var b []byte
+	var err error
+	switch enc {
+	case Base64URL:
+		b, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	case Hex:
+		b, err = hex.DecodeString(s)
+	case Raw:
+		return []byte(s), nil
+	default:
+		b, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s bytes %q: %w", encodingName(enc), s, err)
+	}
+	return b, nil
+}
+
+func encodingName(enc string) string {
+	if enc == "" {
+		return Base64
+	}
+	return enc
+}
+
+var encodedBytes sync.Map // protoreflect.FullName -> bool
+
+// HasEncodedBytes reports whether md or a message it contains has a bytes field with a ginpb.bytes_encoding
+func HasEncodedBytes(md protoreflect.MessageDescriptor) bool {
+	if v, ok := encodedBytes.Load(md.FullName()); ok {
+		return v.(bool)
+	}
+	has := hasEncodedBytes(md, make(map[protoreflect.FullName]bool))
+	encodedBytes.Store(md.FullName(), has)
+	return has
+}
+
+func hasEncodedBytes(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		switch {
+		case fd.Kind() == protoreflect.BytesKind && BytesEncoding(fd) != "":
+			return true
+		case fd.Message() != nil && hasEncodedBytes(fd.Message(), seen):
+			return true
+		}
+	}
+	return false
+}
+
+// RecodeBytes rewrites the bytes fields with a ginpb.bytes_encoding of b, the JSON encoding of an md message,
+// from standard base64 to their encoding, or back when fromField is set
+func RecodeBytes(b []byte, md protoreflect.MessageDescriptor, fromField bool) ([]byte, error) {
+	return Walk(b, md, func(fd protoreflect.FieldDescriptor, v any) (any, error) {
+		s, ok := v.(string)
+		enc := BytesEncoding(fd)
+		if !ok || fd.Kind() != protoreflect.BytesKind || enc == "" {
+			return v, nil
+		}
+		from, to := "", enc
+		if fromField {
+			from, to = enc, ""
+		}
+		raw, err := DecodeBytes(s, from)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fd.FullName(), err)
+		}
+		return EncodeBytes(raw, to), nil
+	})
+}
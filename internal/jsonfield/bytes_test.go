@@ -0,0 +1,78 @@
+package jsonfield
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	ginext "github.com/go-kenka/ginpb/tag"
+)
+
+func TestBytesEncodings(t *testing.T) {
+	b := []byte{0xfb, 0xff, 0x00}
+	for enc, want := range map[string]string{Base64: "+/8A", Base64URL: "-_8A", Hex: "fbff00"} {
+		assert.Equal(t, want, EncodeBytes(b, enc), enc)
+		got, err := DecodeBytes(want, enc)
+		require.NoError(t, err, enc)
+		assert.Equal(t, b, got, enc)
+	}
+	got, err := DecodeBytes("-_8=", Base64URL)
+	require.NoError(t, err, "padding is optional")
+	assert.Equal(t, []byte{0xfb, 0xff}, got)
+
+	_, err = DecodeBytes("xyz", Hex)
+	assert.EqualError(t, err, `invalid hex bytes "xyz": encoding/hex: invalid byte: U+0078 'x'`)
+}
+
+func TestRecodeBytes(t *testing.T) {
+	md := bytesMessage(t)
+	assert.True(t, HasEncodedBytes(md))
+
+	b, err := RecodeBytes([]byte(`{"digest":"+/8=","raw":"+/8=","n":1}`), md, false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"digest":"fbff","raw":"+/8=","n":1}`, string(b))
+
+	b, err = RecodeBytes(b, md, true)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"digest":"+/8=","raw":"+/8=","n":1}`, string(b))
+
+	_, err = RecodeBytes([]byte(`{"digest":"nothex"}`), md, true)
+	assert.ErrorContains(t, err, "field test.Blob.digest: invalid hex bytes")
+}
+
+// bytesMessage builds message Blob { bytes digest = 1 [(ginpb.bytes_encoding) = "hex"]; bytes raw = 2; int64 n = 3; }
+func bytesMessage(t *testing.T) protoreflect.MessageDescriptor {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, ginext.E_BytesEncoding, Hex)
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	digest := field("digest", 1, descriptorpb.FieldDescriptorProto_TYPE_BYTES)
+	digest.Options = opts
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test/blob.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Blob"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				digest,
+				field("raw", 2, descriptorpb.FieldDescriptorProto_TYPE_BYTES),
+				field("n", 3, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+			},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	return fd.Messages().Get(0)
+}
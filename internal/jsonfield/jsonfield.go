@@ -0,0 +1,83 @@
+// Package jsonfield rewrites field values of JSON encoded protobuf messages, e.g. to write int64 fields as
+// strings or bytes fields with their ginpb.bytes_encoding, shared by the server runtime and the client.
+package jsonfield
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Func returns the new value of a scalar field value, numbers are json.Number
+type Func func(fd protoreflect.FieldDescriptor, v any) (any, error)
+
+// Walk rewrites the scalar values of the fields of b, the JSON encoding of an md message, with f.
+// Keys may be JSON or proto names, f is called for every list element and map value.
+func Walk(b []byte, md protoreflect.MessageDescriptor, f Func) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if err := walkMessage(v, md, f); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// walkMessage rewrites the fields of the JSON object v of an md message
+func walkMessage(v any, md protoreflect.MessageDescriptor, f Func) error {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	fields := md.Fields()
+	for key, value := range obj {
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			fd = fields.ByName(protoreflect.Name(key))
+		}
+		var err error
+		switch {
+		case fd == nil:
+		case fd.IsMap():
+			m, _ := value.(map[string]any)
+			for k, e := range m {
+				if m[k], err = walkValue(e, fd.MapValue(), f); err != nil {
+					return err
+				}
+			}
+		case fd.IsList():
+			l, _ := value.([]any)
+			for i, e := range l {
+				if l[i], err = walkValue(e, fd, f); err != nil {
+					return err
+				}
+			}
+		default:
+			if obj[key], err = walkValue(value, fd, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func walkValue(v any, fd protoreflect.FieldDescriptor, f Func) (any, error) {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return v, walkMessage(v, fd.Message(), f)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return f(fd, v)
+}
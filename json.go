@@ -0,0 +1,179 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// JSONNaming selects how response field names are encoded, optionally combined with an int64 encoding,
+// e.g. JSONDefault|JSONInt64AsString
+type JSONNaming int
+
+const (
+	// JSONDefault encodes responses with encoding/json and the json tags of the generated types,
+	// i.e. proto field names, but well-known types and enums are not encoded the protojson way
+	JSONDefault JSONNaming = iota
+	// JSONProtoNames encodes responses with protojson using proto field names, e.g. "user_id"
+	JSONProtoNames
+	// JSONCamelCase encodes responses with protojson using lowerCamel JSON names, e.g. "userId"
+	JSONCamelCase
+)
+
+// Encodings of int64, uint64 and their fixed and zigzag variants, combined with a naming.
+// Without either, encoding/json writes numbers and protojson strings.
+const (
+	// JSONInt64AsString writes 64-bit integers as strings like protojson does, so JavaScript clients
+	// keep the precision of IDs above 2^53
+	JSONInt64AsString JSONNaming = 1 << 4
+	// JSONInt64AsNumber writes 64-bit integers as numbers, also with protojson
+	JSONInt64AsNumber JSONNaming = 1 << 5
+)
+
+// JSONEmitUnpopulated writes fields with zero values, e.g. "count": 0, like grpc-gateway with EmitUnpopulated.
+// It combines with JSONProtoNames or JSONCamelCase, encoding/json always writes them.
+const JSONEmitUnpopulated JSONNaming = 1 << 6
+
+// naming returns n without int64 encoding and flags
+func (n JSONNaming) naming() JSONNaming {
+	return n &^ (JSONInt64AsString | JSONInt64AsNumber | JSONEmitUnpopulated)
+}
+
+// String returns "default", "proto" or "camel", followed by "+int64_string" or "+int64_number"
+// and "+emit_unpopulated"
+func (n JSONNaming) String() string {
+	var s string
+	switch n.naming() {
+	case JSONProtoNames:
+		s = "proto"
+	case JSONCamelCase:
+		s = "camel"
+	default:
+		s = "default"
+	}
+	switch {
+	case n&JSONInt64AsString != 0:
+		s += "+int64_string"
+	case n&JSONInt64AsNumber != 0:
+		s += "+int64_number"
+	}
+	if n&JSONEmitUnpopulated != 0 {
+		s += "+emit_unpopulated"
+	}
+	return s
+}
+
+// MarshalOptions returns the protojson settings of n
+func (n JSONNaming) MarshalOptions() protojson.MarshalOptions {
+	return protojson.MarshalOptions{UseProtoNames: n.naming() != JSONCamelCase, EmitUnpopulated: n&JSONEmitUnpopulated != 0}
+}
+
+// JSONEngine marshals replies where encoding/json would, i.e. messages with JSONDefault and values other than
+// messages, e.g. json-iterator or sonic for large list replies, see package jsonengine. Messages encoded with
+// protojson do not use it.
+type JSONEngine interface {
+	Marshal(v any) ([]byte, error)
+}
+
+// JSONEngineFunc adapts a marshal function to JSONEngine
+type JSONEngineFunc func(v any) ([]byte, error)
+
+// Marshal calls f
+func (f JSONEngineFunc) Marshal(v any) ([]byte, error) {
+	return f(v)
+}
+
+// StdJSON marshals with encoding/json, used when no engine is set
+var StdJSON JSONEngine = JSONEngineFunc(json.Marshal)
+
+// marshal encodes v with protojson unless n is JSONDefault or v is not a message. 64-bit integers of messages
+// are rewritten to the int64 encoding of n and bytes fields to their ginpb.bytes_encoding.
+func (n JSONNaming) marshal(v any) ([]byte, bool, error) {
+	return n.marshalWith(nil, v)
+}
+
+// marshalWith is marshal encoding JSONDefault messages with engine, nil for encoding/json
+func (n JSONNaming) marshalWith(engine JSONEngine, v any) ([]byte, bool, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, false, nil
+	}
+	md := m.ProtoReflect().Descriptor()
+	protoJSON := n.naming() != JSONDefault
+	int64s := protoJSON && n&JSONInt64AsNumber != 0 || !protoJSON && n&JSONInt64AsString != 0
+	recode := jsonfield.HasEncodedBytes(md)
+	if !protoJSON && !int64s && !recode {
+		return nil, false, nil
+	}
+
+	var b []byte
+	var err error
+	if protoJSON {
+		b, err = n.MarshalOptions().Marshal(m)
+	} else if engine != nil {
+		b, err = engine.Marshal(m)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	if err == nil && int64s {
+		b, err = convertInt64(b, md, !protoJSON)
+	}
+	if err == nil && recode {
+		b, err = jsonfield.RecodeBytes(b, md, false)
+	}
+	return b, true, err
+}
+
+// RenderJSON writes v as JSON response with the given naming, generated handlers call it for replies.
+// Values other than messages, e.g. a repeated response_body field, are always written with encoding/json.
+// Messages are written as application/x-protobuf instead when the request accepts it, see AcceptsProtobuf.
+func RenderJSON(c *gin.Context, status int, naming JSONNaming, v any) {
+	renderJSON(c, status, naming, nil, v)
+}
+
+// renderJSON is RenderJSON marshalling with engine where encoding/json would be used, nil for encoding/json
+func renderJSON(c *gin.Context, status int, naming JSONNaming, engine JSONEngine, v any) {
+	if renderProtobuf(c, status, v) {
+		return
+	}
+	b, ok, err := naming.marshalWith(engine, v)
+	if !ok && engine == nil {
+		c.JSON(status, v)
+		return
+	}
+	if !ok {
+		b, err = engine.Marshal(v)
+	}
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(status, "application/json; charset=utf-8", b)
+}
+
+// AcceptsProtobuf reports whether the Accept header of the request prefers application/x-protobuf to JSON
+func AcceptsProtobuf(c *gin.Context) bool {
+	return c.Request != nil && c.NegotiateFormat(binding.MIMEJSON, binding.MIMEPROTOBUF) == binding.MIMEPROTOBUF
+}
+
+// renderProtobuf writes v with proto.Marshal when it is a message and the request accepts protobuf
+func renderProtobuf(c *gin.Context, status int, v any) bool {
+	m, ok := v.(proto.Message)
+	if !ok || !AcceptsProtobuf(c) {
+		return false
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, fmt.Errorf("encode %s reply as protobuf: %w", proto.MessageName(m), err))
+		return true
+	}
+	c.Data(status, binding.MIMEPROTOBUF, b)
+	return true
+}
@@ -0,0 +1,86 @@
+package ginpb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/apipb"
+)
+
+func TestRenderJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reply := &descriptorpb.FieldDescriptorProto{TypeName: proto.String(".example.User")}
+
+	for naming, want := range map[JSONNaming]string{
+		JSONDefault:    `{"type_name":".example.User"}`,
+		JSONProtoNames: `{"type_name":".example.User"}`,
+		JSONCamelCase:  `{"typeName":".example.User"}`,
+	} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		RenderJSON(c, 200, naming, reply)
+		assert.JSONEq(t, want, w.Body.String(), naming.String())
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	}
+
+	// Zero values are written on request
+	w0 := httptest.NewRecorder()
+	c0, _ := gin.CreateTestContext(w0)
+	RenderJSON(c0, 200, JSONCamelCase|JSONEmitUnpopulated, &apipb.Api{Name: "a"})
+	assert.Contains(t, w0.Body.String(), `"version":""`)
+	assert.Contains(t, w0.Body.String(), `"methods":[]`)
+	assert.Equal(t, "camel+emit_unpopulated", (JSONCamelCase | JSONEmitUnpopulated).String())
+
+	// Non-message values fall back to encoding/json
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	RenderJSON(c, 200, JSONCamelCase, []string{"a"})
+	assert.JSONEq(t, `["a"]`, w.Body.String())
+}
+
+func TestRenderProtobuf(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reply := &descriptorpb.FieldDescriptorProto{TypeName: proto.String(".example.User")}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("Accept", "application/x-protobuf, application/json;q=0.5")
+	RenderJSON(c, 201, JSONCamelCase, reply)
+	assert.Equal(t, 201, w.Code)
+	assert.Equal(t, "application/x-protobuf", w.Header().Get("Content-Type"))
+	var got descriptorpb.FieldDescriptorProto
+	assert.NoError(t, proto.Unmarshal(w.Body.Bytes(), &got))
+	assert.True(t, proto.Equal(reply, &got))
+
+	// JSON stays the default
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("Accept", "*/*")
+	assert.False(t, AcceptsProtobuf(c))
+}
+
+// BenchmarkRender compares writing a large reply as JSON and as protobuf
+func BenchmarkRender(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	reply := protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto)
+	for name, accept := range map[string]string{"json": "application/json", "protobuf": "application/x-protobuf"} {
+		b.Run(name, func(b *testing.B) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept", accept)
+			for i := 0; i < b.N; i++ {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = req
+				RenderJSON(c, http.StatusOK, JSONProtoNames, reply)
+				b.SetBytes(int64(w.Body.Len()))
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Package jsonengine provides ginpb.JSONEngine implementations faster than encoding/json, select one with the
+// generated WithXJSONEngine option or ginpb.RegisterConfig.JSONEngine. They are configured to write the same
+// JSON as encoding/json: HTML escaped strings, sorted map keys and json tags. Like gin, sonic is only compiled
+// with the sonic build tag, e.g. go build -tags sonic, as it supports a limited range of Go versions.
+package jsonengine
+
+import (
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/go-kenka/ginpb"
+)
+
+// Iterator marshals with json-iterator, compatible with encoding/json
+var Iterator ginpb.JSONEngine = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// ByName returns the engine named "std", "jsoniter" or "sonic", e.g. from a config file
+func ByName(name string) (ginpb.JSONEngine, bool) {
+	switch name {
+	case "std", "":
+		return ginpb.StdJSON, true
+	case "jsoniter":
+		return Iterator, true
+	case "sonic":
+		return Sonic, true
+	}
+	return nil, false
+}
@@ -0,0 +1,64 @@
+package jsonengine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/go-kenka/ginpb"
+)
+
+// render writes reply with engine the way generated handlers do
+func render(engine ginpb.JSONEngine, naming ginpb.JSONNaming, reply any) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ginpb.RenderReply(c, http.StatusOK, naming, engine, nil, reply)
+	return w
+}
+
+func TestEnginesMatchEncodingJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	replies := map[string]any{
+		"message": protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+		"oneof":   structpb.NewStringValue("<b>&</b>"),
+		"map":     map[string]int{"b": 2, "a": 1},
+	}
+	for name, reply := range replies {
+		want := render(nil, ginpb.JSONDefault, reply).Body.String()
+		for _, engine := range []string{"jsoniter", "sonic"} {
+			e, ok := ByName(engine)
+			require.True(t, ok)
+			w := render(e, ginpb.JSONDefault, reply)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.JSONEq(t, want, w.Body.String(), engine+" "+name)
+		}
+	}
+
+	_, ok := ByName("gob")
+	assert.False(t, ok)
+}
+
+// BenchmarkRenderReply compares the engines writing a large reply with encoding/json semantics
+func BenchmarkRenderReply(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+	reply := protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto)
+	for _, name := range []string{"std", "jsoniter", "sonic"} {
+		engine, _ := ByName(name)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				w := render(engine, ginpb.JSONDefault, reply)
+				b.SetBytes(int64(w.Body.Len()))
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+//go:build sonic
+
+package jsonengine
+
+import (
+	"github.com/bytedance/sonic"
+
+	"github.com/go-kenka/ginpb"
+)
+
+// Sonic marshals with sonic, compatible with encoding/json. sonic compiles encoders just in time on amd64 and
+// arm64 and falls back to encoding/json on other platforms.
+var Sonic ginpb.JSONEngine = sonic.ConfigStd
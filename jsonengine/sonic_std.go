@@ -0,0 +1,8 @@
+//go:build !sonic
+
+package jsonengine
+
+import "github.com/go-kenka/ginpb"
+
+// Sonic marshals with encoding/json unless built with the sonic tag
+var Sonic = ginpb.StdJSON
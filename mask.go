@@ -0,0 +1,133 @@
+package ginpb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaskStyle is how a masked field is hidden, from the style of (ginpb.mask)
+type MaskStyle string
+
+// Masking styles
+const (
+	// MaskClear removes the value, the field is omitted from the reply
+	MaskClear MaskStyle = "clear"
+	// MaskPartial stars out all but the last 4 characters of strings, e.g. ********1234
+	MaskPartial MaskStyle = "partial"
+	// MaskFull stars out every character of strings
+	MaskFull MaskStyle = "full"
+)
+
+// maskVisible is the number of trailing characters MaskPartial keeps
+const maskVisible = 4
+
+// MaskedField is a field annotated with (ginpb.mask)
+type MaskedField struct {
+	// Name is the proto name of the field, e.g. email
+	Name string
+	// Profiles are the masking profiles the field is hidden from
+	Profiles []string
+	// Style is how the field is hidden
+	Style MaskStyle
+}
+
+// MaskTable maps full message names to their masked fields, generated as XMaskedFields per service
+type MaskTable map[string][]MaskedField
+
+// MaskProfileKey is the gin context key holding the masking profile, prefer MaskProfileFromContext
+const MaskProfileKey = "ginpb.mask.profile"
+
+// maskProfileKey is the standard context key holding the masking profile
+type maskProfileKey struct{}
+
+// SetMaskProfile selects the masking profile of the request, e.g. "public", and bridges it into the request
+// context so generated handlers apply it to replies. An empty profile masks nothing.
+func SetMaskProfile(c *gin.Context, profile string) {
+	c.Set(MaskProfileKey, profile)
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), maskProfileKey{}, profile))
+}
+
+// MaskProfileFromContext returns the masking profile of the request, ctx may be a *gin.Context,
+// the context passed to service methods or the request context
+func MaskProfileFromContext(ctx context.Context) string {
+	if profile, ok := ctx.Value(maskProfileKey{}).(string); ok {
+		return profile
+	}
+	profile, _ := ctx.Value(MaskProfileKey).(string)
+	return profile
+}
+
+// MaskFields hides the fields of m listed in table for the masking profile of ctx in place, including those of
+// nested messages. Generated handlers call it with the XMaskedFields table before replies are written.
+func MaskFields(ctx context.Context, table MaskTable, m proto.Message) {
+	profile := MaskProfileFromContext(ctx)
+	if profile == "" || len(table) == 0 || m == nil {
+		return
+	}
+	maskMessage(m.ProtoReflect(), table, profile)
+}
+
+func maskMessage(m protoreflect.Message, table MaskTable, profile string) {
+	if !m.IsValid() {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for _, f := range table[string(m.Descriptor().FullName())] {
+		fd := fields.ByName(protoreflect.Name(f.Name))
+		if fd == nil || !m.Has(fd) || !slices.Contains(f.Profiles, profile) {
+			continue
+		}
+		maskField(m, fd, f.Style)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+					maskMessage(item.Message(), table, profile)
+					return true
+				})
+			}
+		case fd.Message() == nil:
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				maskMessage(v.List().Get(i).Message(), table, profile)
+			}
+		default:
+			maskMessage(v.Message(), table, profile)
+		}
+		return true
+	})
+}
+
+// maskField hides the value of fd, star styles only apply to string fields and clear others
+func maskField(m protoreflect.Message, fd protoreflect.FieldDescriptor, style MaskStyle) {
+	if fd.Kind() != protoreflect.StringKind || fd.IsMap() || (style != MaskPartial && style != MaskFull) {
+		m.Clear(fd)
+		return
+	}
+	if !fd.IsList() {
+		m.Set(fd, protoreflect.ValueOfString(maskString(m.Get(fd).String(), style)))
+		return
+	}
+	list := m.Mutable(fd).List()
+	for i := 0; i < list.Len(); i++ {
+		list.Set(i, protoreflect.ValueOfString(maskString(list.Get(i).String(), style)))
+	}
+}
+
+// maskString stars out the characters of s hidden by style
+func maskString(s string, style MaskStyle) string {
+	n := utf8.RuneCountInString(s)
+	if style == MaskFull || n <= maskVisible {
+		return strings.Repeat("*", n)
+	}
+	runes := []rune(s)
+	return strings.Repeat("*", n-maskVisible) + string(runes[n-maskVisible:])
+}
@@ -0,0 +1,36 @@
+package ginpb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/example/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskFields(t *testing.T) {
+	newReply := func() *api.ListUsersResponse {
+		return &api.ListUsersResponse{Users: []*api.User{
+			{Id: "1", Email: "alice@example.com", Address: &api.Address{City: "Paris"}},
+			{Id: "2", Email: "bob"},
+		}}
+	}
+	table := api.CompleteExampleServiceMaskedFields
+
+	reply := newReply()
+	ginpb.MaskFields(context.WithValue(context.Background(), ginpb.MaskProfileKey, "public"), table, reply)
+	assert.Equal(t, "*************.com", reply.Users[0].Email, "nested repeated fields are masked")
+	assert.Nil(t, reply.Users[0].Address)
+	assert.Equal(t, "***", reply.Users[1].Email, "short values are starred out entirely")
+	assert.Equal(t, "1", reply.Users[0].Id)
+
+	reply = newReply()
+	ginpb.MaskFields(context.WithValue(context.Background(), ginpb.MaskProfileKey, "partner"), table, reply)
+	assert.Equal(t, "*************.com", reply.Users[0].Email)
+	assert.Equal(t, "Paris", reply.Users[0].Address.GetCity(), "fields are only masked for their profiles")
+
+	reply = newReply()
+	ginpb.MaskFields(context.Background(), table, reply)
+	assert.Equal(t, "alice@example.com", reply.Users[0].Email, "requests without profile are not masked")
+}
@@ -0,0 +1,36 @@
+package metadata
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// BudgetHeader carries the remaining time budget of a request in milliseconds from hop to hop
+const BudgetHeader = "X-Request-Budget"
+
+// ParseBudget parses a budget header value, it reports false for empty, malformed or negative values
+func ParseBudget(v string) (time.Duration, bool) {
+	ms, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || ms < 0 {
+		return 0, false
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+// FormatBudget formats d as budget header value, rounded down to milliseconds and never negative
+func FormatBudget(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10)
+}
+
+// Budget returns the remaining budget of ctx, i.e. the time until its deadline
+func Budget(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return time.Until(deadline), true
+}
@@ -15,6 +15,24 @@ type GinData struct {
 	Writer  http.ResponseWriter
 }
 
+// ginDataContext serves values from the gin context and the deadline and cancellation of the request context,
+// so deadlines set by middleware reach the service even without gin's ContextWithFallback
+type ginDataContext struct {
+	context.Context
+	gin  *gin.Context
+	data *GinData
+}
+
+func (c *ginDataContext) Value(key any) any {
+	if key == (ginKey{}) {
+		return c.data
+	}
+	if v := c.gin.Value(key); v != nil {
+		return v
+	}
+	return c.Context.Value(key)
+}
+
 // NewContext put gin data into context
 func NewContext(ctx *gin.Context) context.Context {
 	data := &GinData{
@@ -22,7 +40,7 @@ func NewContext(ctx *gin.Context) context.Context {
 		Params:  ctx.Params,
 		Writer:  ctx.Writer,
 	}
-	return context.WithValue(ctx, ginKey{}, data)
+	return &ginDataContext{Context: ctx.Request.Context(), gin: ctx, data: data}
 }
 
 // FromContext extract gin data from context
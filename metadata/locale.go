@@ -0,0 +1,61 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// LocaleKey is the gin context key holding the language.Tag negotiated for the request
+	LocaleKey = "locale"
+	// LocalizerKey is the gin context key holding the Localizer of the request
+	LocalizerKey = "localizer"
+)
+
+// localeKey carries a locale set with WithLocale outside of gin
+type localeKey struct{}
+
+// Localizer translates messages into a locale, e.g. backed by golang.org/x/text/message catalogs.
+// It formats args like fmt.Sprintf and falls back to the message itself without translation.
+type Localizer interface {
+	Localize(locale language.Tag, message string, args ...any) string
+}
+
+// LocalizerFunc is an adapter to allow the use of ordinary functions as Localizer
+type LocalizerFunc func(locale language.Tag, message string, args ...any) string
+
+// Localize calls f(locale, message, args...)
+func (f LocalizerFunc) Localize(locale language.Tag, message string, args ...any) string {
+	return f(locale, message, args...)
+}
+
+// WithLocale returns a copy of ctx carrying locale, e.g. to call services in tests or background jobs
+func WithLocale(ctx context.Context, locale language.Tag) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// Locale returns the locale of the request, set by the Locale middleware or WithLocale.
+// It works with the gin context as well as the context passed to service methods.
+func Locale(ctx context.Context) (language.Tag, bool) {
+	if tag, ok := ctx.Value(LocaleKey).(language.Tag); ok {
+		return tag, true
+	}
+	tag, ok := ctx.Value(localeKey{}).(language.Tag)
+	return tag, ok
+}
+
+// Localize translates message into the locale of the request with the Localizer registered by the
+// Locale middleware. Without locale or localizer the message is only formatted with args.
+func Localize(ctx context.Context, message string, args ...any) string {
+	l, ok := ctx.Value(LocalizerKey).(Localizer)
+	locale, found := Locale(ctx)
+	if ok && found {
+		return l.Localize(locale, message, args...)
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
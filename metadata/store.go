@@ -0,0 +1,107 @@
+package metadata
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StoreKey is the gin context key holding the Store of the request
+const StoreKey = "metadata.store"
+
+// storeKey carries a store set with WithStore outside of gin
+type storeKey struct{}
+
+// Store accumulates facts about a request, e.g. the number of database queries or cache hits, from
+// middleware, handlers and goroutines they start. All methods are safe for concurrent use and do nothing
+// on a nil Store, so code can record facts whether or not the request has one.
+type Store struct {
+	mu     sync.Mutex
+	values map[string]any
+}
+
+// Set records value under key
+func (s *Store) Set(key string, value any) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]any)
+	}
+	s.values[key] = value
+}
+
+// Get returns the value recorded under key
+func (s *Store) Get(key string) (any, bool) {
+	if s == nil {
+		return nil, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Add adds delta to the counter key and returns the new count. A value of another type under key is replaced.
+func (s *Store) Add(key string, delta int64) int64 {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]any)
+	}
+	n, _ := s.values[key].(int64)
+	n += delta
+	s.values[key] = n
+	return n
+}
+
+// Values returns a copy of all recorded facts
+func (s *Store) Values() map[string]any {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.values)
+}
+
+// EnsureStore returns the Store of the request, creating it on first use. Middleware that reads the facts,
+// like the logging middleware, calls it before the handlers run.
+func EnsureStore(c *gin.Context) *Store {
+	if s, ok := c.Value(StoreKey).(*Store); ok {
+		return s
+	}
+	s := &Store{}
+	c.Set(StoreKey, s)
+	return s
+}
+
+// WithStore returns a copy of ctx carrying a new Store, e.g. for background jobs outside of gin
+func WithStore(ctx context.Context) (context.Context, *Store) {
+	s := &Store{}
+	return context.WithValue(ctx, storeKey{}, s), s
+}
+
+// StoreFromContext returns the Store of the request, nil if none was created. It works with the gin context
+// as well as the context passed to service methods.
+func StoreFromContext(ctx context.Context) *Store {
+	if s, ok := ctx.Value(StoreKey).(*Store); ok {
+		return s
+	}
+	s, _ := ctx.Value(storeKey{}).(*Store)
+	return s
+}
+
+// WithValue returns a copy of ctx in which key is associated with value. Unlike gin.Context.Set it leaves
+// ctx unchanged, so values added by a service method are only seen by the calls it makes with the copy.
+// Values of the gin context and the request metadata remain visible through the copy.
+func WithValue(ctx context.Context, key, value any) context.Context {
+	return context.WithValue(ctx, key, value)
+}
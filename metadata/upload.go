@@ -0,0 +1,44 @@
+package metadata
+
+import (
+	"context"
+	"mime/multipart"
+
+	"github.com/gin-gonic/gin"
+)
+
+// formFilesKey carries files set with WithFormFiles outside of gin
+type formFilesKey struct{}
+
+// WithFormFiles returns a copy of ctx carrying uploaded files by form field, e.g. to call services in tests
+func WithFormFiles(ctx context.Context, files map[string][]*multipart.FileHeader) context.Context {
+	return context.WithValue(ctx, formFilesKey{}, files)
+}
+
+// FormFiles returns the files uploaded as the multipart form field name. Generated handlers bind fields
+// tagged with multipart to the uploaded files, the protobuf request does not carry them. It works with the
+// gin context as well as the context passed to service methods.
+func FormFiles(ctx context.Context, name string) []*multipart.FileHeader {
+	if files, ok := ctx.Value(formFilesKey{}).(map[string][]*multipart.FileHeader); ok {
+		return files[name]
+	}
+	var form *multipart.Form
+	if c, ok := ctx.(*gin.Context); ok {
+		form = c.Request.MultipartForm
+	} else if data, ok := FromContext(ctx); ok {
+		form = data.Request.MultipartForm
+	}
+	if form == nil {
+		return nil
+	}
+	return form.File[name]
+}
+
+// FormFile returns the first file uploaded as the multipart form field name, see FormFiles
+func FormFile(ctx context.Context, name string) (*multipart.FileHeader, bool) {
+	files := FormFiles(ctx, name)
+	if len(files) == 0 {
+		return nil, false
+	}
+	return files[0], true
+}
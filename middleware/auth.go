@@ -1,12 +1,15 @@
 package middleware
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/auth"
 )
 
 // AuthConfig defines the config for authentication middleware
@@ -20,6 +23,17 @@ type AuthConfig struct {
 	// Custom validator function
 	Validator func(*gin.Context, string) bool
 
+	// Verify validates the credential against a backend such as a JWKS endpoint or Redis, a non-nil error
+	// means the backend failed. ctx carries the Backend timeout. It takes precedence over Validator.
+	Verify func(ctx context.Context, credential string) (bool, error)
+
+	// Backend applies circuit breaking and a fail-open / fail-closed policy to Verify
+	Backend *BackendGuard
+
+	// Principal resolves the caller from a validated credential, e.g. the subject and scopes of a JWT.
+	// By default the principal only carries the credential.
+	Principal func(*gin.Context, string) (*auth.Principal, error)
+
 	// Error handler function
 	ErrorHandler func(*gin.Context, error)
 }
@@ -30,12 +44,22 @@ func DefaultAuthConfig() AuthConfig {
 		Skipper:      nil,
 		Realm:        "Restricted",
 		Validator:    nil,
+		Verify:       nil,
+		Backend:      nil,
+		Principal:    nil,
 		ErrorHandler: defaultAuthErrorHandler,
 	}
 }
 
 // defaultAuthErrorHandler is the default error handler for authentication middleware
 func defaultAuthErrorHandler(c *gin.Context, err error) {
+	if errors.Is(err, ErrBackendUnavailable) {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+			"error":   "authentication unavailable",
+			"message": err.Error(),
+		})
+		return
+	}
 	c.JSON(http.StatusUnauthorized, gin.H{
 		"error":   "authentication failed",
 		"message": err.Error(),
@@ -43,9 +67,54 @@ func defaultAuthErrorHandler(c *gin.Context, err error) {
 	c.Abort()
 }
 
-// BasicAuth returns a basic authentication middleware
+// BasicAuth returns a basic authentication middleware, the principal subject is the user name
 func BasicAuth(accounts gin.Accounts) gin.HandlerFunc {
-	return gin.BasicAuth(accounts)
+	basic := gin.BasicAuth(accounts)
+	return func(c *gin.Context) {
+		basic(c)
+		if c.IsAborted() {
+			return
+		}
+		auth.SetPrincipal(c, &auth.Principal{Subject: c.GetString(gin.AuthUserKey), Method: auth.MethodBasic})
+	}
+}
+
+// validate checks the credential with Verify through the backend guard, or with Validator
+func (config AuthConfig) validate(c *gin.Context, credential string) (bool, error) {
+	if config.Verify == nil {
+		return config.Validator == nil || config.Validator(c, credential), nil
+	}
+	if config.Backend == nil {
+		valid, err := config.Verify(c.Request.Context(), credential)
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", ErrBackendUnavailable, err)
+		}
+		return valid, nil
+	}
+
+	var valid bool
+	degraded, err := config.Backend.Do(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		valid, err = config.Verify(ctx, credential)
+		return err
+	})
+	return valid || degraded, err
+}
+
+// setPrincipal stores the principal of a validated credential, it reports false once the error handler ran
+func setPrincipal(c *gin.Context, config AuthConfig, method auth.Method, credential string) bool {
+	p := &auth.Principal{Method: method, Credential: credential}
+	if config.Principal != nil {
+		resolved, err := config.Principal(c, credential)
+		if err != nil {
+			config.ErrorHandler(c, fmt.Errorf("resolve principal: %w", err))
+			return false
+		}
+		p = resolved
+		p.Method, p.Credential = method, credential
+	}
+	auth.SetPrincipal(c, p)
+	return true
 }
 
 // BearerAuth returns a bearer token authentication middleware
@@ -62,32 +131,36 @@ func BearerAuthWithConfig(config AuthConfig) gin.HandlerFunc {
 			return
 		}
 
-		auth := c.GetHeader("Authorization")
-		if auth == "" {
+		header := c.GetHeader("Authorization")
+		if header == "" {
 			config.ErrorHandler(c, fmt.Errorf("authorization header missing"))
 			return
 		}
 
-		if !strings.HasPrefix(auth, "Bearer ") {
+		if !strings.HasPrefix(header, "Bearer ") {
 			config.ErrorHandler(c, fmt.Errorf("invalid authorization header format"))
 			return
 		}
 
-		token := strings.TrimPrefix(auth, "Bearer ")
+		token := strings.TrimPrefix(header, "Bearer ")
 		if token == "" {
 			config.ErrorHandler(c, fmt.Errorf("bearer token missing"))
 			return
 		}
 
 		// Use custom validator if provided
-		if config.Validator != nil {
-			if !config.Validator(c, token) {
-				config.ErrorHandler(c, fmt.Errorf("token validation failed"))
-				return
-			}
+		if valid, err := config.validate(c, token); err != nil {
+			config.ErrorHandler(c, err)
+			return
+		} else if !valid {
+			config.ErrorHandler(c, fmt.Errorf("token validation failed"))
+			return
 		}
 
-		// Store token in context
+		if !setPrincipal(c, config, auth.MethodBearer, token) {
+			return
+		}
+		// Legacy key, use auth.PrincipalFromContext
 		c.Set("token", token)
 		c.Next()
 	})
@@ -118,14 +191,18 @@ func APIKeyAuthWithConfig(config AuthConfig, headerName string) gin.HandlerFunc
 		}
 
 		// Use custom validator if provided
-		if config.Validator != nil {
-			if !config.Validator(c, apiKey) {
-				config.ErrorHandler(c, fmt.Errorf("invalid API key"))
-				return
-			}
+		if valid, err := config.validate(c, apiKey); err != nil {
+			config.ErrorHandler(c, err)
+			return
+		} else if !valid {
+			config.ErrorHandler(c, fmt.Errorf("invalid API key"))
+			return
 		}
 
-		// Store API key in context
+		if !setPrincipal(c, config, auth.MethodAPIKey, apiKey) {
+			return
+		}
+		// Legacy key, use auth.PrincipalFromContext
 		c.Set("api_key", apiKey)
 		c.Next()
 	})
@@ -151,17 +228,17 @@ func JWTAuthWithConfig(config AuthConfig) gin.HandlerFunc {
 			return
 		}
 
-		auth := c.GetHeader("Authorization")
-		if auth == "" {
+		header := c.GetHeader("Authorization")
+		if header == "" {
 			config.ErrorHandler(c, fmt.Errorf("authorization header missing"))
 			return
 		}
 
 		var token string
-		if strings.HasPrefix(auth, "Bearer ") {
-			token = strings.TrimPrefix(auth, "Bearer ")
+		if strings.HasPrefix(header, "Bearer ") {
+			token = strings.TrimPrefix(header, "Bearer ")
 		} else {
-			token = auth
+			token = header
 		}
 
 		if token == "" {
@@ -170,14 +247,18 @@ func JWTAuthWithConfig(config AuthConfig) gin.HandlerFunc {
 		}
 
 		// Use custom validator if provided
-		if config.Validator != nil {
-			if !config.Validator(c, token) {
-				config.ErrorHandler(c, fmt.Errorf("JWT token validation failed"))
-				return
-			}
+		if valid, err := config.validate(c, token); err != nil {
+			config.ErrorHandler(c, err)
+			return
+		} else if !valid {
+			config.ErrorHandler(c, fmt.Errorf("JWT token validation failed"))
+			return
 		}
 
-		// Store token in context
+		if !setPrincipal(c, config, auth.MethodJWT, token) {
+			return
+		}
+		// Legacy key, use auth.PrincipalFromContext
 		c.Set("jwt_token", token)
 		c.Next()
 	})
@@ -195,7 +276,7 @@ func BasicAuthFromConfig(username, password string) gin.HandlerFunc {
 	accounts := gin.Accounts{
 		username: password,
 	}
-	return gin.BasicAuth(accounts)
+	return BasicAuth(accounts)
 }
 
 // extractBasicAuth extracts username and password from Authorization header
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/auth"
+)
+
+// AuthorizeConfig defines the config for Authorize middleware
+type AuthorizeConfig struct {
+	// Skip defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Scopes maps operations to the scopes they require, usually the generated XOperationScopes table
+	Scopes map[string][]string
+
+	// Granted returns the scopes granted to the caller
+	Granted func(*gin.Context) []string
+
+	// Error handler function
+	ErrorHandler func(*gin.Context, error)
+}
+
+// DefaultAuthorizeConfig returns a default authorization configuration
+func DefaultAuthorizeConfig() AuthorizeConfig {
+	return AuthorizeConfig{
+		Skipper:      nil,
+		Granted:      defaultGrantedScopes,
+		ErrorHandler: defaultAuthorizeErrorHandler,
+	}
+}
+
+// defaultGrantedScopes reads the scopes of the authenticated principal, falling back to the legacy "scopes" key
+func defaultGrantedScopes(c *gin.Context) []string {
+	if p, ok := auth.PrincipalFromContext(c); ok && p.Scopes != nil {
+		return p.Scopes
+	}
+	return c.GetStringSlice("scopes")
+}
+
+// defaultAuthorizeErrorHandler is the default error handler for authorization middleware
+func defaultAuthorizeErrorHandler(c *gin.Context, err error) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"error":   "permission denied",
+		"message": err.Error(),
+	})
+	c.Abort()
+}
+
+// Authorize returns a middleware that checks the caller holds the scopes required by the operation. Register it
+// with the generated WithXGlobalMiddleware or WithXOperationMiddleware options, it refuses requests without operation.
+func Authorize(scopes map[string][]string) gin.HandlerFunc {
+	config := DefaultAuthorizeConfig()
+	config.Scopes = scopes
+	return AuthorizeWithConfig(config)
+}
+
+// AuthorizeWithConfig returns an authorization middleware with config
+func AuthorizeWithConfig(config AuthorizeConfig) gin.HandlerFunc {
+	if config.Granted == nil {
+		config.Granted = defaultGrantedScopes
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultAuthorizeErrorHandler
+	}
+
+	return gin.HandlerFunc(func(c *gin.Context) {
+		// Skip middleware if skipper returns true
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+
+		// Without operation the middleware runs before the generated handler, e.g. registered with r.Use, and
+		// would let every request through
+		op := ginpb.OperationFromContext(c)
+		if op == "" {
+			config.ErrorHandler(c, fmt.Errorf("no operation for %s %s, register Authorize with WithXGlobalMiddleware or WithXOperationMiddleware instead of r.Use", c.Request.Method, c.FullPath()))
+			return
+		}
+		required := config.Scopes[op]
+		if len(required) == 0 {
+			c.Next()
+			return
+		}
+
+		granted := config.Granted(c)
+		for _, scope := range required {
+			if !contains(granted, scope) {
+				config.ErrorHandler(c, fmt.Errorf("scope %q required, granted %v", scope, granted))
+				return
+			}
+		}
+		c.Next()
+	})
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	authorize := Authorize(map[string][]string{"/example.Svc/DeleteUser": {"users.admin"}})
+	principal := func(scopes ...string) gin.HandlerFunc {
+		return func(c *gin.Context) { auth.SetPrincipal(c, &auth.Principal{Subject: "ada", Scopes: scopes}) }
+	}
+	operation := func(op string) gin.HandlerFunc {
+		return func(c *gin.Context) { c.Set(ginpb.OperationKey, op) }
+	}
+	ok := func(c *gin.Context) { c.Status(http.StatusNoContent) }
+
+	e := gin.New()
+	e.DELETE("/admin/users/:id", operation("/example.Svc/DeleteUser"), principal("users.admin"), authorize, ok)
+	e.DELETE("/users/:id", operation("/example.Svc/DeleteUser"), principal("users.read"), authorize, ok)
+	e.GET("/users/:id", operation("/example.Svc/GetUser"), authorize, ok)
+	// Registered like r.Use, before the generated handler sets the operation
+	e.POST("/users/:id/delete", principal("users.admin"), authorize, operation("/example.Svc/DeleteUser"), ok)
+
+	for _, tc := range []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodDelete, "/admin/users/1", http.StatusNoContent},
+		{http.MethodDelete, "/users/1", http.StatusForbidden},
+		{http.MethodGet, "/users/1", http.StatusNoContent},
+		{http.MethodPost, "/users/1/delete", http.StatusForbidden},
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(tc.method, tc.path, nil))
+		assert.Equal(t, tc.want, w.Code, tc.method+" "+tc.path)
+	}
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users/1/delete", nil))
+	assert.Contains(t, w.Body.String(), "no operation for POST /users/:id/delete")
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// ErrBackendUnavailable is returned when the backend of a middleware failed or its circuit is open
+// and the middleware fails closed, the default error handlers answer it with 503
+var ErrBackendUnavailable = errors.New("middleware backend unavailable")
+
+// FailurePolicy decides requests while the backend of a middleware (Redis, a JWKS endpoint...) is unavailable
+type FailurePolicy int
+
+const (
+	// FailClosed rejects requests while the backend is unavailable
+	FailClosed FailurePolicy = iota
+	// FailOpen lets requests through while the backend is unavailable
+	FailOpen
+)
+
+// String returns "closed" or "open"
+func (p FailurePolicy) String() string {
+	if p == FailOpen {
+		return "open"
+	}
+	return "closed"
+}
+
+// BackendGuardConfig defines the config of a BackendGuard
+type BackendGuardConfig struct {
+	// Name identifies the backend in OnDegraded, e.g. "jwks" or "redis"
+	Name string
+
+	// Policy decides requests while the backend is unavailable
+	Policy FailurePolicy
+
+	// Timeout bounds every backend call, zero means no timeout
+	Timeout time.Duration
+
+	// FailureThreshold is the number of consecutive failures opening the circuit
+	FailureThreshold int
+
+	// OpenTimeout is how long the circuit stays open before a trial call is let through
+	OpenTimeout time.Duration
+
+	// OnDegraded is called for every decision taken by Policy instead of the backend, e.g. to count them
+	OnDegraded func(name string, policy FailurePolicy, err error)
+
+	// Clock times the open circuit, the system clock by default
+	Clock clock.Clock
+}
+
+// DefaultBackendGuardConfig returns a default backend guard configuration
+func DefaultBackendGuardConfig() BackendGuardConfig {
+	return BackendGuardConfig{
+		Name:             "backend",
+		Policy:           FailClosed,
+		Timeout:          time.Second,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		OnDegraded:       nil,
+		Clock:            clock.System,
+	}
+}
+
+// BackendStats are the counters of a BackendGuard
+type BackendStats struct {
+	Calls    int64 // backend calls made
+	Failures int64 // failed backend calls
+	Allowed  int64 // requests let through by FailOpen
+	Denied   int64 // requests rejected by FailClosed
+	Open     bool  // whether the circuit is open
+}
+
+// BackendGuard wraps the backend calls of a middleware with a circuit breaker and a failure policy
+type BackendGuard struct {
+	config BackendGuardConfig
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+
+	calls, failed, allowed, denied atomic.Int64
+}
+
+// NewBackendGuard creates a backend guard
+func NewBackendGuard(config BackendGuardConfig) *BackendGuard {
+	defaults := DefaultBackendGuardConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = defaults.OpenTimeout
+	}
+	config.Clock = clock.OrSystem(config.Clock)
+	return &BackendGuard{config: config}
+}
+
+// Do calls the backend unless the circuit is open. When the call fails or is skipped, the policy decides:
+// FailOpen returns degraded true and a nil error, FailClosed returns an error wrapping ErrBackendUnavailable.
+func (g *BackendGuard) Do(ctx context.Context, call func(context.Context) error) (degraded bool, err error) {
+	if !g.allow() {
+		return g.degrade(fmt.Errorf("%s circuit open", g.config.Name))
+	}
+
+	if g.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.config.Timeout)
+		defer cancel()
+	}
+	g.calls.Add(1)
+	if err := call(ctx); err != nil {
+		g.failed.Add(1)
+		g.record(false)
+		return g.degrade(err)
+	}
+	g.record(true)
+	return false, nil
+}
+
+// Stats returns the counters of the guard
+func (g *BackendGuard) Stats() BackendStats {
+	g.mu.Lock()
+	open := !g.openedAt.IsZero()
+	g.mu.Unlock()
+	return BackendStats{
+		Calls:    g.calls.Load(),
+		Failures: g.failed.Load(),
+		Allowed:  g.allowed.Load(),
+		Denied:   g.denied.Load(),
+		Open:     open,
+	}
+}
+
+// allow reports whether a call may be made, letting a single trial call through once the open timeout elapsed
+func (g *BackendGuard) allow() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.openedAt.IsZero() {
+		return true
+	}
+	if g.trial || g.config.Clock.Now().Sub(g.openedAt) < g.config.OpenTimeout {
+		return false
+	}
+	g.trial = true
+	return true
+}
+
+func (g *BackendGuard) record(ok bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.trial = false
+	if ok {
+		g.failures = 0
+		g.openedAt = time.Time{}
+		return
+	}
+	g.failures++
+	if g.failures >= g.config.FailureThreshold || !g.openedAt.IsZero() {
+		// A failed trial call keeps the circuit open for another period
+		g.openedAt = g.config.Clock.Now()
+	}
+}
+
+func (g *BackendGuard) degrade(err error) (bool, error) {
+	if g.config.OnDegraded != nil {
+		g.config.OnDegraded(g.config.Name, g.config.Policy, err)
+	}
+	if g.config.Policy == FailOpen {
+		g.allowed.Add(1)
+		return true, nil
+	}
+	g.denied.Add(1)
+	return false, fmt.Errorf("%w: %s: %v", ErrBackendUnavailable, g.config.Name, err)
+}
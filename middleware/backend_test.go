@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackendGuard(t *testing.T) {
+	var degraded int
+	config := DefaultBackendGuardConfig()
+	config.Name = "jwks"
+	config.FailureThreshold = 2
+	config.OpenTimeout = time.Minute
+	fake := clock.NewFake(time.Now())
+	config.Clock = fake
+	config.OnDegraded = func(string, FailurePolicy, error) { degraded++ }
+	guard := NewBackendGuard(config)
+
+	calls := 0
+	down := func(context.Context) error { calls++; return errors.New("connection refused") }
+	for i := 0; i < 4; i++ {
+		_, err := guard.Do(context.Background(), down)
+		assert.ErrorIs(t, err, ErrBackendUnavailable)
+	}
+	// The circuit opened after two failures, later requests do not reach the backend
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 4, degraded)
+	assert.Equal(t, BackendStats{Calls: 2, Failures: 2, Denied: 4, Open: true}, guard.Stats())
+
+	fake.Advance(time.Minute)
+	_, err := guard.Do(context.Background(), func(context.Context) error { return nil })
+	assert.NoError(t, err)
+	assert.False(t, guard.Stats().Open)
+}
+
+func TestBearerAuthFailurePolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for policy, want := range map[FailurePolicy]int{FailOpen: http.StatusOK, FailClosed: http.StatusServiceUnavailable} {
+		guardConfig := DefaultBackendGuardConfig()
+		guardConfig.Policy = policy
+		config := DefaultAuthConfig()
+		config.Backend = NewBackendGuard(guardConfig)
+		config.Verify = func(context.Context, string) (bool, error) { return false, errors.New("jwks down") }
+
+		e := gin.New()
+		e.GET("/", BearerAuthWithConfig(config), func(c *gin.Context) { c.Status(http.StatusOK) })
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer t0k")
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		assert.Equal(t, want, w.Code, policy.String())
+	}
+}
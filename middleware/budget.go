@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/metadata"
+)
+
+// BudgetConfig defines the config for the Budget middleware
+type BudgetConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Header carries the remaining budget in milliseconds, metadata.BudgetHeader by default
+	Header string
+
+	// Margin is kept for writing the response and deducted from the incoming budget
+	Margin time.Duration
+
+	// Default is the budget of requests without header, zero means no deadline
+	Default time.Duration
+
+	// Max caps incoming budgets so callers cannot hold resources longer than the service allows, zero means no cap
+	Max time.Duration
+
+	// ErrorHandler answers requests arriving with an exhausted budget
+	ErrorHandler func(c *gin.Context, err error)
+}
+
+// DefaultBudgetConfig returns a default budget configuration
+func DefaultBudgetConfig() BudgetConfig {
+	return BudgetConfig{
+		Skipper:      nil,
+		Header:       metadata.BudgetHeader,
+		Margin:       10 * time.Millisecond,
+		Default:      0,
+		Max:          0,
+		ErrorHandler: defaultBudgetErrorHandler,
+	}
+}
+
+// Budget returns a request budget middleware with default configuration
+func Budget() gin.HandlerFunc {
+	return BudgetWithConfig(DefaultBudgetConfig())
+}
+
+// BudgetWithConfig returns a middleware turning the remaining budget sent by the caller into a deadline
+// of the request context, minus the local margin. Service methods see it through metadata.Budget and
+// clients created with client.WithBudgetPropagation forward what is left to the next hop.
+func BudgetWithConfig(config BudgetConfig) gin.HandlerFunc {
+	if config.Header == "" {
+		config.Header = metadata.BudgetHeader
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultBudgetErrorHandler
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		budget, ok := metadata.ParseBudget(c.GetHeader(config.Header))
+		if !ok {
+			budget = config.Default
+		}
+		if budget <= 0 && !ok {
+			c.Next()
+			return
+		}
+		if config.Max > 0 && budget > config.Max {
+			budget = config.Max
+		}
+		budget -= config.Margin
+		if budget <= 0 {
+			config.ErrorHandler(c, fmt.Errorf("request budget exhausted: %w", context.DeadlineExceeded))
+			c.Abort()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), budget)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// defaultBudgetErrorHandler is the default error handler for the Budget middleware
+func defaultBudgetErrorHandler(c *gin.Context, err error) {
+	c.JSON(http.StatusGatewayTimeout, gin.H{"error": err.Error()})
+}
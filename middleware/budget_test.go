@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/", BudgetWithConfig(BudgetConfig{Margin: 100 * time.Millisecond, Max: time.Second}), func(c *gin.Context) {
+		// Service methods receive the deadline through the metadata context
+		budget, ok := metadata.Budget(metadata.NewContext(c))
+		if !ok {
+			c.String(http.StatusOK, "none")
+			return
+		}
+		c.String(http.StatusOK, metadata.FormatBudget(budget.Round(100*time.Millisecond)))
+	})
+
+	for header, want := range map[string]string{
+		"":        "none",
+		"invalid": "none",
+		"500":     "400",
+		"60000":   "900",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(metadata.BudgetHeader, header)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, want, w.Body.String(), header)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(metadata.BudgetHeader, "50")
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	assert.Contains(t, w.Body.String(), "request budget exhausted")
+}
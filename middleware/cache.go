@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// CacheStatusHeader reports whether a response was served from the cache, "HIT" or "MISS"
+const CacheStatusHeader = "X-Cache"
+
+// CachedResponse is a response stored by the Cache middleware
+type CachedResponse struct {
+	Status      int
+	ContentType string
+	// Header holds the response headers replayed on hits, e.g. ETag, Cache-Control and declared response headers
+	Header http.Header
+	Body   []byte
+}
+
+// CacheStore stores cached responses grouped by operation, e.g. backed by Redis to share them across instances
+type CacheStore interface {
+	// Get returns the response stored for key, ok is false for unknown or expired keys
+	Get(ctx context.Context, key string) (resp *CachedResponse, ok bool, err error)
+	// Set stores the response of key, a response of operation, for ttl
+	Set(ctx context.Context, operation, key string, resp *CachedResponse, ttl time.Duration) error
+	// Purge removes all responses of operation
+	Purge(ctx context.Context, operation string) error
+}
+
+// CacheConfig defines the config for Cache middleware
+type CacheConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Store keeps the responses, an in-memory store by default
+	Store CacheStore
+
+	// TTL is the time responses are cached
+	TTL time.Duration
+
+	// Operations are the cached operations, answered from the cache for GET requests. Nil caches every GET operation.
+	Operations []string
+
+	// Invalidations maps operations to the operations whose responses they make stale,
+	// e.g. the generated XCacheInvalidations. Successful calls purge the invalidated operations.
+	Invalidations map[string][]string
+
+	// Vary lists the request headers distinguishing cached responses, so responses of one user
+	// are never served to another
+	Vary []string
+
+	// Clock expires the responses of the default in-memory store, the system clock by default
+	Clock clock.Clock
+}
+
+// DefaultCacheConfig returns a default cache configuration
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		Skipper: nil,
+		TTL:     time.Minute,
+		Vary:    []string{"Authorization"},
+	}
+}
+
+// Cache returns a middleware caching GET responses, purged by the given invalidations
+func Cache(invalidations map[string][]string) gin.HandlerFunc {
+	config := DefaultCacheConfig()
+	config.Invalidations = invalidations
+	return CacheWithConfig(config)
+}
+
+// CacheWithConfig returns a middleware caching successful GET responses per operation and request URI.
+// Successful calls of operations listed in Invalidations purge the responses of the operations they
+// invalidate, so handlers stay unaware of the cache. It needs the operation of the request,
+// register it with the generated WithXGlobalMiddleware option instead of gin's Use.
+func CacheWithConfig(config CacheConfig) gin.HandlerFunc {
+	if config.Store == nil {
+		config.Store = NewMemoryCacheStoreWithClock(config.Clock)
+	}
+	if config.TTL <= 0 {
+		config.TTL = time.Minute
+	}
+	var cached map[string]bool
+	if config.Operations != nil {
+		cached = make(map[string]bool, len(config.Operations))
+		for _, op := range config.Operations {
+			cached[op] = true
+		}
+	}
+
+	return func(c *gin.Context) {
+		operation := ginpb.OperationFromContext(c)
+		if operation == "" || (config.Skipper != nil && config.Skipper(c)) {
+			c.Next()
+			return
+		}
+		ctx := c.Request.Context()
+
+		if c.Request.Method == http.MethodGet && (cached == nil || cached[operation]) {
+			key := cacheKey(c, operation, config.Vary)
+			if resp, ok, err := config.Store.Get(ctx, key); err != nil {
+				_ = c.Error(err)
+			} else if ok {
+				for name, values := range resp.Header {
+					c.Writer.Header()[name] = values
+				}
+				c.Header(CacheStatusHeader, "HIT")
+				c.Data(resp.Status, resp.ContentType, resp.Body)
+				c.Abort()
+				return
+			}
+			c.Header(CacheStatusHeader, "MISS")
+			w := &responseBodyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+			c.Writer = w
+			c.Next()
+			c.Writer = w.ResponseWriter
+			if c.Writer.Status() == http.StatusOK && len(c.Errors) == 0 {
+				resp := &CachedResponse{
+					Status:      http.StatusOK,
+					ContentType: c.Writer.Header().Get("Content-Type"),
+					Header:      cachedHeader(c.Writer.Header()),
+					Body:        w.body.Bytes(),
+				}
+				if err := config.Store.Set(ctx, operation, key, resp, config.TTL); err != nil {
+					_ = c.Error(err)
+				}
+			}
+			return
+		}
+
+		c.Next()
+		if status := c.Writer.Status(); status < 200 || status >= 300 || len(c.Errors) > 0 {
+			return
+		}
+		for _, stale := range config.Invalidations[operation] {
+			if err := config.Store.Purge(ctx, stale); err != nil {
+				_ = c.Error(err)
+			}
+		}
+	}
+}
+
+// cachedHeader returns the headers of a response replayed on cache hits, cookies belong to the first request
+func cachedHeader(header http.Header) http.Header {
+	res := header.Clone()
+	res.Del("Set-Cookie")
+	res.Del(CacheStatusHeader)
+	return res
+}
+
+// cacheKey returns the key of the response of the request of c, vary headers are hashed
+func cacheKey(c *gin.Context, operation string, vary []string) string {
+	return varyKey(c, operation+"\x00"+c.Request.URL.RequestURI(), vary)
+}
+
+// varyKey appends the hash of the vary headers of the request of c to key
+func varyKey(c *gin.Context, key string, vary []string) string {
+	if len(vary) == 0 {
+		return key
+	}
+	h := sha256.New()
+	for _, name := range vary {
+		h.Write([]byte(c.GetHeader(name)))
+		h.Write([]byte{0})
+	}
+	return key + "\x00" + hex.EncodeToString(h.Sum(nil))
+}
+
+// memoryCacheStore keeps responses in memory grouped by operation
+type memoryCacheStore struct {
+	mu         sync.Mutex
+	operations map[string]map[string]memoryCacheEntry
+	keys       map[string]string // key -> operation
+	clock      clock.Clock
+}
+
+type memoryCacheEntry struct {
+	resp    *CachedResponse
+	expires time.Time
+}
+
+// NewMemoryCacheStore returns a CacheStore keeping responses in process memory,
+// expired responses are removed when their operation is written
+func NewMemoryCacheStore() CacheStore {
+	return NewMemoryCacheStoreWithClock(nil)
+}
+
+// NewMemoryCacheStoreWithClock returns an in-memory CacheStore expiring responses with c, e.g. a clock.Fake in tests
+func NewMemoryCacheStoreWithClock(c clock.Clock) CacheStore {
+	return &memoryCacheStore{
+		operations: make(map[string]map[string]memoryCacheEntry),
+		keys:       make(map[string]string),
+		clock:      clock.OrSystem(c),
+	}
+}
+
+func (s *memoryCacheStore) Get(_ context.Context, key string) (*CachedResponse, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.operations[s.keys[key]][key]
+	if !ok || s.clock.Now().After(e.expires) {
+		return nil, false, nil
+	}
+	return e.resp, true, nil
+}
+
+func (s *memoryCacheStore) Set(_ context.Context, operation, key string, resp *CachedResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	entries := s.operations[operation]
+	if entries == nil {
+		entries = make(map[string]memoryCacheEntry)
+		s.operations[operation] = entries
+	}
+	for k, e := range entries {
+		if now.After(e.expires) {
+			delete(entries, k)
+			delete(s.keys, k)
+		}
+	}
+	entries[key] = memoryCacheEntry{resp: resp, expires: now.Add(ttl)}
+	s.keys[key] = operation
+	return nil
+}
+
+func (s *memoryCacheStore) Purge(_ context.Context, operation string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k := range s.operations[operation] {
+		delete(s.keys, k)
+	}
+	delete(s.operations, operation)
+	return nil
+}
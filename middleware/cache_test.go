@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheInvalidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	users := []string{"ann"}
+	cache := Cache(map[string][]string{"CreateUser": {"ListUsers"}})
+	operation := func(op string) gin.HandlerFunc {
+		return func(c *gin.Context) { c.Set(ginpb.OperationKey, op) }
+	}
+	e := gin.New()
+	e.GET("/users", operation("ListUsers"), cache, func(c *gin.Context) {
+		c.JSON(http.StatusOK, users)
+	})
+	e.POST("/users/:name", operation("CreateUser"), cache, func(c *gin.Context) {
+		users = append(users, c.Param("name"))
+		c.Status(http.StatusCreated)
+	})
+	get := func(auth string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.Header.Set("Authorization", auth)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, "MISS", get("a").Header().Get(CacheStatusHeader))
+	users = append(users, "unseen")
+	w := get("a")
+	assert.Equal(t, "HIT", w.Header().Get(CacheStatusHeader))
+	assert.JSONEq(t, `["ann"]`, w.Body.String())
+	// Responses are cached per Authorization header
+	assert.Equal(t, "MISS", get("b").Header().Get(CacheStatusHeader))
+
+	// A successful CreateUser purges the cached ListUsers responses
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/users/bob", nil))
+	w = get("a")
+	assert.Equal(t, "MISS", w.Header().Get(CacheStatusHeader))
+	assert.JSONEq(t, `["ann","unseen","bob"]`, w.Body.String())
+}
+
+func TestCacheTTL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	fake := clock.NewFake(time.Now())
+	config := DefaultCacheConfig()
+	config.Clock = fake
+	e := gin.New()
+	e.GET("/", func(c *gin.Context) { c.Set(ginpb.OperationKey, "Get") }, CacheWithConfig(config), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	get := func() string {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		return w.Header().Get(CacheStatusHeader)
+	}
+
+	assert.Equal(t, "MISS", get())
+	fake.Advance(config.TTL - time.Second)
+	assert.Equal(t, "HIT", get())
+	fake.Advance(2 * time.Second)
+	assert.Equal(t, "MISS", get(), "responses expire after the TTL")
+}
+
+func TestCacheReplaysHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+	e := gin.New()
+	e.GET("/users/:id", func(c *gin.Context) { c.Set(ginpb.OperationKey, "GetUser") }, Cache(nil), func(c *gin.Context) {
+		calls++
+		defer ginpb.CacheReply(c, ginpb.CachePolicy{MaxAge: time.Minute, ETag: true})()
+		c.Header("Content-Language", "de")
+		c.Header("X-Total-Count", "1")
+		c.Header("Set-Cookie", "session=first")
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+	})
+	get := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+		return w
+	}
+
+	miss := get()
+	assert.Equal(t, "MISS", miss.Header().Get(CacheStatusHeader))
+	hit := get()
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "HIT", hit.Header().Get(CacheStatusHeader))
+	assert.JSONEq(t, `{"id":"1"}`, hit.Body.String())
+	for _, name := range []string{"ETag", "Cache-Control", "Content-Language", "X-Total-Count", "Content-Type"} {
+		assert.NotEmpty(t, hit.Header().Get(name), name)
+		assert.Equal(t, miss.Header().Get(name), hit.Header().Get(name), name)
+	}
+	assert.Empty(t, hit.Header().Get("Set-Cookie"), "cookies belong to the first request")
+}
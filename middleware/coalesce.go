@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+)
+
+// CoalescedHeader marks responses shared from the execution of a concurrent identical request
+const CoalescedHeader = "X-Coalesced"
+
+// CoalesceConfig defines the config for Coalesce middleware
+type CoalesceConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Operations are the coalesced operations. Nil coalesces every GET operation,
+	// list the expensive reads instead when the service streams replies.
+	Operations []string
+
+	// Vary lists the request headers distinguishing responses, so responses of one user
+	// are never shared with another. Keep Authorization and Cookie for credentialed requests,
+	// and add the headers of other credentials, e.g. X-API-Key.
+	Vary []string
+}
+
+// DefaultCoalesceConfig returns a default coalesce configuration
+func DefaultCoalesceConfig() CoalesceConfig {
+	return CoalesceConfig{
+		Skipper: nil,
+		Vary:    []string{"Authorization", "Cookie"},
+	}
+}
+
+// Coalesce returns a middleware sharing one handler execution among concurrent identical GET requests
+func Coalesce() gin.HandlerFunc {
+	return CoalesceWithConfig(DefaultCoalesceConfig())
+}
+
+// CoalesceWithConfig returns a middleware sharing one handler execution among concurrent identical GET requests,
+// identified by operation, path, query with sorted parameters and vary headers. The first request runs the
+// handler, the others wait for its response and get a copy of status, headers and body, protecting expensive
+// reads from a stampede when a cache entry expires. Responses are buffered, do not coalesce streaming methods.
+// It needs the operation of the request, register it with the generated WithXGlobalMiddleware option.
+func CoalesceWithConfig(config CoalesceConfig) gin.HandlerFunc {
+	var coalesced map[string]bool
+	if config.Operations != nil {
+		coalesced = make(map[string]bool, len(config.Operations))
+		for _, op := range config.Operations {
+			coalesced[op] = true
+		}
+	}
+	var (
+		mu       sync.Mutex
+		inflight = make(map[string]*coalescedCall)
+	)
+
+	return func(c *gin.Context) {
+		operation := ginpb.OperationFromContext(c)
+		if operation == "" || c.Request.Method != http.MethodGet || (coalesced != nil && !coalesced[operation]) ||
+			(config.Skipper != nil && config.Skipper(c)) {
+			c.Next()
+			return
+		}
+
+		key := varyKey(c, operation+"\x00"+c.Request.URL.Path+"?"+c.Request.URL.Query().Encode(), config.Vary)
+		mu.Lock()
+		if call, ok := inflight[key]; ok {
+			mu.Unlock()
+			select {
+			case <-call.done:
+			case <-c.Request.Context().Done():
+				c.AbortWithStatus(http.StatusServiceUnavailable)
+				return
+			}
+			// The first request did not finish its response, run this one on its own
+			if call.resp == nil {
+				c.Next()
+				return
+			}
+			call.resp.write(c)
+			c.Abort()
+			return
+		}
+		call := &coalescedCall{done: make(chan struct{})}
+		inflight[key] = call
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			delete(inflight, key)
+			mu.Unlock()
+			close(call.done)
+		}()
+		w := &responseBodyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+		call.resp = &coalescedResponse{status: c.Writer.Status(), header: c.Writer.Header().Clone(), body: w.body.Bytes()}
+	}
+}
+
+// coalescedCall is the in-flight execution shared by identical requests
+type coalescedCall struct {
+	done chan struct{}
+	resp *coalescedResponse // nil when the handler panicked
+}
+
+// coalescedResponse is the response copied to the waiting requests
+type coalescedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (r *coalescedResponse) write(c *gin.Context) {
+	for name, values := range r.header {
+		// Cookies belong to the first request
+		if name == "Set-Cookie" {
+			continue
+		}
+		// Every waiter gets its own values, middleware may change them in place
+		c.Writer.Header()[name] = slices.Clone(values)
+	}
+	c.Header(CoalescedHeader, "true")
+	c.Status(r.status)
+	_, _ = c.Writer.Write(r.body)
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesce(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	e := gin.New()
+	e.GET("/reports", func(c *gin.Context) { c.Set(ginpb.OperationKey, "GetReport") }, Coalesce(), func(c *gin.Context) {
+		calls.Add(1)
+		<-release
+		c.Header("X-Region", c.Query("region"))
+		c.JSON(http.StatusOK, gin.H{"region": c.Query("region")})
+	})
+
+	// Identical requests with reordered query parameters share one execution
+	var wg sync.WaitGroup
+	responses := make([]*httptest.ResponseRecorder, 4)
+	for i, uri := range []string{"/reports?region=eu&year=2024", "/reports?year=2024&region=eu", "/reports?region=eu&year=2024", "/reports?region=eu&year=2024"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i] = httptest.NewRecorder()
+			e.ServeHTTP(responses[i], httptest.NewRequest(http.MethodGet, uri, nil))
+		}()
+		if i == 0 {
+			assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	coalesced := 0
+	for _, w := range responses {
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"region":"eu"}`, w.Body.String())
+		if w.Header().Get(CoalescedHeader) == "true" {
+			coalesced++
+		}
+	}
+	assert.Equal(t, 3, coalesced)
+
+	// Waiters do not share header values
+	var shared []http.Header
+	for _, w := range responses {
+		if w.Header().Get(CoalescedHeader) == "true" {
+			shared = append(shared, w.Header())
+		}
+	}
+	shared[0]["X-Region"][0] = "us"
+	assert.Equal(t, "eu", shared[1].Get("X-Region"))
+
+	// Later requests run the handler again
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/reports?region=eu&year=2024", nil))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCoalesceVary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	e := gin.New()
+	e.GET("/me", func(c *gin.Context) { c.Set(ginpb.OperationKey, "GetMe") }, Coalesce(), func(c *gin.Context) {
+		calls.Add(1)
+		<-release
+		c.String(http.StatusOK, c.GetHeader("Cookie"))
+	})
+
+	// Requests of different sessions never share a response
+	var wg sync.WaitGroup
+	responses := make([]*httptest.ResponseRecorder, 2)
+	for i, cookie := range []string{"session=alice", "session=bob"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set("Cookie", cookie)
+			responses[i] = httptest.NewRecorder()
+			e.ServeHTTP(responses[i], req)
+		}()
+	}
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, "session=alice", responses[0].Body.String())
+	assert.Equal(t, "session=bob", responses[1].Body.String())
+	assert.Empty(t, responses[1].Header().Get(CoalescedHeader))
+}
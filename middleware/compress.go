@@ -0,0 +1,246 @@
+package middleware
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// Supported content encodings
+const (
+	EncodingBrotli  = "br"
+	EncodingGzip    = "gzip"
+	EncodingDeflate = "deflate"
+)
+
+// CompressConfig defines the config for the Compress middleware
+type CompressConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Encodings are the offered encodings, the first one wins when the client accepts several equally
+	Encodings []string
+
+	// MinLength is the body size below which responses are sent uncompressed.
+	// Streaming responses are compressed as soon as they flush.
+	MinLength int
+
+	// ExcludedContentTypes are media types that are already compressed, a trailing "/*" matches the whole type
+	ExcludedContentTypes []string
+}
+
+// DefaultCompressConfig returns a default compression configuration
+func DefaultCompressConfig() CompressConfig {
+	return CompressConfig{
+		Skipper:   nil,
+		Encodings: []string{EncodingBrotli, EncodingGzip, EncodingDeflate},
+		MinLength: 1024,
+		ExcludedContentTypes: []string{
+			"image/*", "video/*", "audio/*", "font/woff2",
+			"application/zip", "application/gzip", "application/x-gzip", "application/zstd", "application/x-7z-compressed",
+		},
+	}
+}
+
+// Compress returns a response compression middleware with default configuration
+func Compress() gin.HandlerFunc {
+	return CompressWithConfig(DefaultCompressConfig())
+}
+
+// CompressWithConfig returns a middleware compressing responses with the best encoding in Accept-Encoding.
+// Use the generated operation middleware options to configure single operations differently.
+func CompressWithConfig(config CompressConfig) gin.HandlerFunc {
+	if len(config.Encodings) == 0 {
+		config.Encodings = DefaultCompressConfig().Encodings
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		// Upgraded connections and range requests must not be re-encoded
+		if c.GetHeader("Upgrade") != "" || c.GetHeader("Range") != "" {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"), config.Encodings)
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{ResponseWriter: c.Writer, config: &config, encoding: encoding}
+		c.Writer = w
+		defer func() {
+			w.close()
+			c.Writer = w.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+// negotiateEncoding returns the offered encoding with the highest quality in header, empty for identity
+func negotiateEncoding(header string, offered []string) string {
+	if header == "" {
+		return ""
+	}
+	quality := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		quality[strings.ToLower(strings.TrimSpace(name))] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range offered {
+		q, ok := quality[encoding]
+		if !ok {
+			q = quality["*"]
+		}
+		if q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	return best
+}
+
+// compressWriter buffers the body until MinLength bytes or a flush decide whether it is compressed
+type compressWriter struct {
+	gin.ResponseWriter
+	config   *CompressConfig
+	encoding string
+
+	buf     []byte
+	decided bool
+	enc     encoder
+}
+
+// encoder is implemented by the gzip, flate and brotli writers
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+var encoderPools = map[string]*sync.Pool{
+	EncodingBrotli: {New: func() any { return brotli.NewWriter(nil) }},
+	EncodingGzip:   {New: func() any { return gzip.NewWriter(nil) }},
+	EncodingDeflate: {New: func() any {
+		w, _ := flate.NewWriter(nil, flate.DefaultCompression)
+		return w
+	}},
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		if w.enc != nil {
+			return w.enc.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+	w.buf = append(w.buf, b...)
+	if len(w.buf) >= w.config.MinLength {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Written reports whether the body was started, including bytes still buffered
+func (w *compressWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// Flush compresses what has been written so far and flushes it, so streaming responses keep working
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(true)
+	}
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide starts compressing when the response qualifies and writes the buffered body
+func (w *compressWriter) decide(large bool) error {
+	w.decided = true
+	if large && w.compressible() {
+		h := w.Header()
+		h.Set("Content-Encoding", w.encoding)
+		h.Del("Content-Length")
+		w.enc = encoderPools[w.encoding].Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+	}
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if w.enc != nil {
+		_, err = w.enc.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	contentType := h.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(w.buf)
+	}
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, excluded := range w.config.ExcludedContentTypes {
+		if prefix, ok := strings.CutSuffix(excluded, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return false
+			}
+		} else if mediaType == excluded {
+			return false
+		}
+	}
+	return true
+}
+
+// close writes small bodies uncompressed and finishes the compressed stream
+func (w *compressWriter) close() {
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.enc != nil {
+		_ = w.enc.Close()
+		w.enc.Reset(io.Discard)
+		encoderPools[w.encoding].Put(w.enc)
+		w.enc = nil
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := strings.Repeat("compressible ", 200)
+	e := gin.New()
+	e.Use(Compress())
+	e.GET("/large", func(c *gin.Context) { c.String(http.StatusOK, large) })
+	e.GET("/small", func(c *gin.Context) { c.String(http.StatusOK, "tiny") })
+	e.GET("/image", func(c *gin.Context) { c.Data(http.StatusOK, "image/png", []byte(large)) })
+	e.GET("/stream", func(c *gin.Context) {
+		w := ginpb.NewListWriter(c, ginpb.StreamFormatNDJSON)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, w.Send(gin.H{"i": i}))
+		}
+		w.Close(nil)
+	})
+
+	get := func(path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", accept)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/large", "gzip, deflate")
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	r, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(r)
+	assert.Equal(t, large, string(body))
+
+	w = get("/large", "gzip;q=0.5, br")
+	require.Equal(t, "br", w.Header().Get("Content-Encoding"))
+	body, _ = io.ReadAll(brotli.NewReader(w.Body))
+	assert.Equal(t, large, string(body))
+
+	w = get("/small", "gzip")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "tiny", w.Body.String())
+
+	assert.Empty(t, get("/image", "gzip").Header().Get("Content-Encoding"))
+	assert.Empty(t, get("/large", "identity").Header().Get("Content-Encoding"))
+
+	// Streaming responses are compressed on the first flush even below MinLength
+	w = get("/stream", "gzip")
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	r, err = gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, _ = io.ReadAll(r)
+	assert.Equal(t, "{\"i\":0}\n{\"i\":1}\n{\"i\":2}\n", string(body))
+}
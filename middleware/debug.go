@@ -0,0 +1,296 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+)
+
+// redacted replaces sensitive values in debug records
+const redacted = "[REDACTED]"
+
+// DebugRecorderConfig defines the config for the debug ring buffer
+type DebugRecorderConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Size is the number of exchanges kept per operation
+	Size int
+
+	// SampleRate is the fraction of requests recorded, between 0 and 1
+	SampleRate float64
+
+	// MaxBodyBytes caps the captured request and response bodies
+	MaxBodyBytes int
+
+	// RedactHeaders lists headers whose values are never stored
+	RedactHeaders []string
+
+	// RedactFields lists JSON fields and query parameters whose values are never stored, matched case-insensitively
+	RedactFields []string
+}
+
+// DefaultDebugRecorderConfig returns a default debug recorder configuration
+func DefaultDebugRecorderConfig() DebugRecorderConfig {
+	return DebugRecorderConfig{
+		Skipper:       nil,
+		Size:          20,
+		SampleRate:    1,
+		MaxBodyBytes:  16 << 10,
+		RedactHeaders: []string{"Authorization", "Cookie", "Set-Cookie", "X-API-Key"},
+		RedactFields:  []string{"password", "token", "access_token", "refresh_token", "secret", "api_key"},
+	}
+}
+
+// DebugRecord is a redacted request/response exchange
+type DebugRecord struct {
+	Time            time.Time   `json:"time"`
+	Operation       string      `json:"operation"`
+	Method          string      `json:"method"`
+	Path            string      `json:"path"`
+	Query           string      `json:"query,omitempty"`
+	Status          int         `json:"status"`
+	Latency         string      `json:"latency"`
+	RequestHeaders  http.Header `json:"request_headers,omitempty"`
+	Request         interface{} `json:"request,omitempty"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	Response        interface{} `json:"response,omitempty"`
+	Truncated       bool        `json:"truncated,omitempty"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// DebugRecorder keeps the last exchanges of every operation in ring buffers,
+// to reproduce rare failures without full request logging
+type DebugRecorder struct {
+	config  DebugRecorderConfig
+	headers map[string]bool
+	fields  map[string]bool
+
+	mu    sync.RWMutex
+	rings map[string]*debugRing
+}
+
+// debugRing is a fixed size ring buffer of records
+type debugRing struct {
+	records []DebugRecord
+	next    int
+	full    bool
+}
+
+// NewDebugRecorder creates a debug recorder, use Middleware to record and Handler to dump
+func NewDebugRecorder(config DebugRecorderConfig) *DebugRecorder {
+	if config.Size <= 0 {
+		config.Size = 20
+	}
+	d := &DebugRecorder{
+		config:  config,
+		headers: make(map[string]bool),
+		fields:  make(map[string]bool),
+		rings:   make(map[string]*debugRing),
+	}
+	for _, h := range config.RedactHeaders {
+		d.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, f := range config.RedactFields {
+		d.fields[strings.ToLower(f)] = true
+	}
+	return d
+}
+
+// Middleware records sampled exchanges, the operation is read once the handler returns so it can be
+// registered on the engine as well as through the generated global middleware option
+func (d *DebugRecorder) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if d.config.Skipper != nil && d.config.Skipper(c) || rand.Float64() >= d.config.SampleRate {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		record := DebugRecord{
+			Time:           start,
+			Method:         c.Request.Method,
+			Path:           c.Request.URL.Path,
+			Query:          d.redactQuery(c.Request.URL.Query()),
+			RequestHeaders: d.redactHeaders(c.Request.Header),
+		}
+		if c.Request.Body != nil {
+			body, err := io.ReadAll(c.Request.Body)
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+			if err == nil {
+				record.Request, record.Truncated = d.redactBody(body)
+			}
+		}
+
+		w := &cappedBodyWriter{ResponseWriter: c.Writer, limit: d.config.MaxBodyBytes}
+		c.Writer = w
+		c.Next()
+
+		record.Operation = ginpb.OperationFromContext(c)
+		record.Status = c.Writer.Status()
+		record.Latency = time.Since(start).String()
+		record.ResponseHeaders = d.redactHeaders(c.Writer.Header())
+		response, truncated := d.redactBody(w.body.Bytes())
+		record.Response = response
+		record.Truncated = record.Truncated || truncated || w.truncated
+		if len(c.Errors) > 0 {
+			record.Error = c.Errors.String()
+		}
+		d.add(record)
+	}
+}
+
+// Handler dumps the recorded exchanges as JSON, newest first, filtered by the "operation" query parameter.
+// Mount it on an admin route protected by authentication.
+func (d *DebugRecorder) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"operations": d.Records(c.Query("operation"))})
+	}
+}
+
+// Records returns the recorded exchanges per operation, newest first, all operations when operation is empty
+func (d *DebugRecorder) Records(operation string) map[string][]DebugRecord {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	res := make(map[string][]DebugRecord)
+	for op, ring := range d.rings {
+		if operation != "" && op != operation {
+			continue
+		}
+		res[op] = ring.snapshot()
+	}
+	return res
+}
+
+func (d *DebugRecorder) add(record DebugRecord) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ring, ok := d.rings[record.Operation]
+	if !ok {
+		ring = &debugRing{records: make([]DebugRecord, d.config.Size)}
+		d.rings[record.Operation] = ring
+	}
+	ring.records[ring.next] = record
+	ring.next = (ring.next + 1) % len(ring.records)
+	ring.full = ring.full || ring.next == 0
+}
+
+// snapshot copies the records, newest first
+func (r *debugRing) snapshot() []DebugRecord {
+	n := r.next
+	if r.full {
+		n = len(r.records)
+	}
+	res := make([]DebugRecord, 0, n)
+	for i := 1; i <= n; i++ {
+		res = append(res, r.records[(r.next-i+len(r.records))%len(r.records)])
+	}
+	return res
+}
+
+func (d *DebugRecorder) redactHeaders(h http.Header) http.Header {
+	res := make(http.Header, len(h))
+	for k, vs := range h {
+		if d.headers[http.CanonicalHeaderKey(k)] {
+			res[k] = []string{redacted}
+			continue
+		}
+		res[k] = append([]string(nil), vs...)
+	}
+	return res
+}
+
+func (d *DebugRecorder) redactQuery(q map[string][]string) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		for _, v := range q[k] {
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			if d.fields[strings.ToLower(k)] {
+				v = redacted
+			}
+			b.WriteString(k + "=" + v)
+		}
+	}
+	return b.String()
+}
+
+// redactBody decodes a captured body and redacts sensitive JSON fields. Bodies over the limit are cut,
+// cut or malformed JSON is dropped as it cannot be redacted reliably.
+func (d *DebugRecorder) redactBody(body []byte) (interface{}, bool) {
+	if len(body) == 0 {
+		return nil, false
+	}
+	truncated := d.config.MaxBodyBytes > 0 && len(body) > d.config.MaxBodyBytes
+	if truncated {
+		body = body[:d.config.MaxBodyBytes]
+	}
+	var v interface{}
+	if !truncated && json.Unmarshal(body, &v) == nil {
+		return d.redactValue(v), false
+	}
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return nil, true
+	}
+	return string(body), truncated
+}
+
+func (d *DebugRecorder) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if d.fields[strings.ToLower(k)] {
+				v[k] = redacted
+			} else {
+				v[k] = d.redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = d.redactValue(item)
+		}
+	}
+	return v
+}
+
+// cappedBodyWriter captures at most limit bytes of the response body
+type cappedBodyWriter struct {
+	gin.ResponseWriter
+	body      bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (w *cappedBodyWriter) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *cappedBodyWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *cappedBodyWriter) capture(b []byte) {
+	if w.limit > 0 && w.body.Len()+len(b) > w.limit {
+		w.body.Write(b[:w.limit-w.body.Len()])
+		w.truncated = true
+		return
+	}
+	w.body.Write(b)
+}
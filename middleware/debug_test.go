@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugRecorder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	config := DefaultDebugRecorderConfig()
+	config.Size = 2
+	recorder := NewDebugRecorder(config)
+
+	e := gin.New()
+	e.Use(recorder.Middleware())
+	e.POST("/login", func(c *gin.Context) {
+		c.Set(ginpb.OperationKey, "Login")
+		c.JSON(http.StatusOK, gin.H{"access_token": "secret-token", "user": gin.H{"name": "alice"}})
+	})
+	e.GET("/debug/requests", recorder.Handler())
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/login?attempt="+strconv.Itoa(i)+"&token=t", strings.NewReader(`{"user":"alice","password":"hunter2"}`))
+		req.Header.Set("Authorization", "Bearer abc")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/requests?operation=Login", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "hunter2")
+	assert.NotContains(t, w.Body.String(), "secret-token")
+	assert.NotContains(t, w.Body.String(), "Bearer abc")
+
+	var dump struct {
+		Operations map[string][]DebugRecord `json:"operations"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &dump))
+	records := dump.Operations["Login"]
+	require.Len(t, records, 2)
+	assert.Equal(t, "attempt=2&token=[REDACTED]", records[0].Query)
+	assert.Equal(t, "attempt=1&token=[REDACTED]", records[1].Query)
+	assert.Equal(t, map[string]interface{}{"user": "alice", "password": redacted}, records[0].Request)
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/internal/digest"
+)
+
+// BodyDigestConfig defines the config for BodyDigest middleware
+type BodyDigestConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Required rejects requests with a body but without a supported Content-MD5 or Digest checksum
+	Required bool
+
+	// MaxBodyBytes caps the body read to verify the checksum, larger bodies are rejected with 413
+	MaxBodyBytes int64
+
+	// ErrorHandler writes the rejection, status is 400 or 413
+	ErrorHandler func(c *gin.Context, status int, err error)
+}
+
+// DefaultBodyDigestConfig returns a default body digest configuration
+func DefaultBodyDigestConfig() BodyDigestConfig {
+	return BodyDigestConfig{
+		Skipper:      nil,
+		Required:     false,
+		MaxBodyBytes: 32 << 20,
+		ErrorHandler: defaultBodyDigestErrorHandler,
+	}
+}
+
+// BodyDigest returns a middleware verifying the Content-MD5 and Digest checksums a request declares
+func BodyDigest() gin.HandlerFunc {
+	return BodyDigestWithConfig(DefaultBodyDigestConfig())
+}
+
+// BodyDigestWithConfig returns a body digest middleware with config. Requests whose body does not match a
+// declared MD5, SHA-256 or SHA-512 checksum are rejected with 400 before binding; other Digest algorithms
+// are ignored. The verified body is handed on to the handlers.
+func BodyDigestWithConfig(config BodyDigestConfig) gin.HandlerFunc {
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = DefaultBodyDigestConfig().MaxBodyBytes
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultBodyDigestErrorHandler
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		expected := digest.Expected(c.Request.Header)
+		if len(expected) == 0 {
+			if config.Required && c.Request.Body != nil && c.Request.Body != http.NoBody && c.Request.ContentLength != 0 {
+				rejectBodyDigest(c, config, http.StatusBadRequest, errors.New("request body has no Content-MD5 or Digest checksum"))
+				return
+			}
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(io.LimitReader(c.Request.Body, config.MaxBodyBytes+1))
+			if err != nil {
+				rejectBodyDigest(c, config, http.StatusBadRequest, fmt.Errorf("read request body: %w", err))
+				return
+			}
+			if int64(len(body)) > config.MaxBodyBytes {
+				err := fmt.Errorf("request body exceeds the checksum limit of %d bytes", config.MaxBodyBytes)
+				rejectBodyDigest(c, config, http.StatusRequestEntityTooLarge, err)
+				return
+			}
+		}
+		for algorithm, sum := range expected {
+			if actual := digest.Sum(algorithm, body); actual != sum {
+				err := fmt.Errorf("request body %s checksum is %s, the request declares %s", algorithm, actual, sum)
+				rejectBodyDigest(c, config, http.StatusBadRequest, err)
+				return
+			}
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+func rejectBodyDigest(c *gin.Context, config BodyDigestConfig, status int, err error) {
+	config.ErrorHandler(c, status, err)
+	c.Abort()
+}
+
+// defaultBodyDigestErrorHandler is the default error handler for the body digest middleware
+func defaultBodyDigestErrorHandler(c *gin.Context, status int, err error) {
+	c.JSON(status, gin.H{"error": err.Error()})
+}
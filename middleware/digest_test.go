@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb/client"
+)
+
+func TestBodyDigest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.POST("/payments", BodyDigestWithConfig(BodyDigestConfig{Required: true}), func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Data(http.StatusOK, "application/json", body)
+	})
+
+	for _, algorithm := range []string{"MD5", "SHA-256", "SHA-512"} {
+		cli := client.NewClient(client.WithInProcess(e), client.WithBodyDigest(algorithm))
+		var reply map[string]any
+		err := cli.Invoke(context.Background(), http.MethodPost, "/payments", map[string]any{"amount": 100}, &reply)
+		require.NoError(t, err, algorithm)
+		assert.Equal(t, float64(100), reply["amount"])
+	}
+
+	do := func(header, value string) int {
+		req := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"amount":100}`))
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w.Code
+	}
+	// Tampered body
+	assert.Equal(t, http.StatusBadRequest, do("Content-MD5", "1B2M2Y8AsgTpgAmY7PhCfg=="))
+	// Missing checksum, or only algorithms that are not supported
+	assert.Equal(t, http.StatusBadRequest, do("", ""))
+	assert.Equal(t, http.StatusBadRequest, do("Digest", "UNIXsum=30637"))
+}
@@ -0,0 +1,218 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/propagation"
+)
+
+// Headers understood by the Envoy middleware and MeshHealth
+const (
+	// EnvoyExpectedTimeoutHeader is the time the calling Envoy waits for the response, retries included
+	EnvoyExpectedTimeoutHeader = "X-Envoy-Expected-Rq-Timeout-Ms"
+	// EnvoyUpstreamTimeoutHeader is the route timeout requested by the original caller
+	EnvoyUpstreamTimeoutHeader = "X-Envoy-Upstream-Rq-Timeout-Ms"
+	// EnvoyHealthCheckFailHeader makes Envoy fail the host immediately when active health checking is on
+	EnvoyHealthCheckFailHeader = "X-Envoy-Immediate-Health-Check-Fail"
+	// EnvoyDegradedHeader marks the host as degraded, Envoy only routes to it when no healthy host is left
+	EnvoyDegradedHeader = "X-Envoy-Degraded"
+)
+
+// EnvoyTraceHeaders are the tracing headers Envoy and Istio expect services to pass on to their upstream calls
+var EnvoyTraceHeaders = []string{
+	"X-Request-Id", "Traceparent", "Tracestate", "X-B3-*", "B3", "X-Ot-Span-Context",
+	"X-Client-Trace-Id", "X-Envoy-Force-Trace", "X-Cloud-Trace-Context", "Grpc-Trace-Bin",
+}
+
+// MeshState is the health of an instance as reported to the service mesh
+type MeshState int32
+
+const (
+	// MeshServing routes traffic to the instance normally
+	MeshServing MeshState = iota
+	// MeshDegraded keeps the instance as a fallback when no serving instance is left
+	MeshDegraded
+	// MeshDraining takes the instance out of rotation, in-flight requests still complete
+	MeshDraining
+)
+
+// String returns the name of the state
+func (s MeshState) String() string {
+	switch s {
+	case MeshServing:
+		return "serving"
+	case MeshDegraded:
+		return "degraded"
+	case MeshDraining:
+		return "draining"
+	}
+	return fmt.Sprintf("MeshState(%d)", int32(s))
+}
+
+// MeshHealth holds the state reported by the Envoy middleware and the health check Handler, it is safe
+// for concurrent use. Set MeshDraining on shutdown before the server stops accepting connections.
+type MeshHealth struct {
+	state atomic.Int32
+}
+
+// NewMeshHealth returns a serving MeshHealth
+func NewMeshHealth() *MeshHealth {
+	return &MeshHealth{}
+}
+
+// Set changes the reported state
+func (h *MeshHealth) Set(state MeshState) {
+	h.state.Store(int32(state))
+}
+
+// State returns the reported state, a nil MeshHealth is serving
+func (h *MeshHealth) State() MeshState {
+	if h == nil {
+		return MeshServing
+	}
+	return MeshState(h.state.Load())
+}
+
+// Handler answers active health checks: 200 while serving, 200 with X-Envoy-Degraded while degraded and
+// 503 with X-Envoy-Immediate-Health-Check-Fail while draining. Only this endpoint reports failures with a
+// 5xx, so the outlier detection of the mesh keeps counting real errors of the API.
+func (h *MeshHealth) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		state := h.State()
+		h.setHeaders(c)
+		if state == MeshDraining {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": state.String()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"status": state.String()})
+	}
+}
+
+// setHeaders announces a degraded or draining state on the response
+func (h *MeshHealth) setHeaders(c *gin.Context) {
+	switch h.State() {
+	case MeshDegraded:
+		c.Header(EnvoyDegradedHeader, "true")
+	case MeshDraining:
+		c.Header(EnvoyHealthCheckFailHeader, "true")
+		c.Header("Connection", "close")
+	}
+}
+
+// EnvoyConfig defines the config for the Envoy middleware
+type EnvoyConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// TimeoutHeaders carry the caller's timeout in milliseconds, the first present header wins
+	TimeoutHeaders []string
+
+	// Margin is kept for writing the response and deducted from the timeout
+	Margin time.Duration
+
+	// TraceHeaders are captured for clients created with client.WithHeaderPropagation,
+	// a trailing * matches a prefix
+	TraceHeaders []string
+
+	// Health is announced on every response, nil means always serving
+	Health *MeshHealth
+
+	// ErrorHandler answers requests arriving after the caller's timeout already elapsed
+	ErrorHandler func(c *gin.Context, err error)
+}
+
+// DefaultEnvoyConfig returns a default Envoy configuration
+func DefaultEnvoyConfig() EnvoyConfig {
+	return EnvoyConfig{
+		Skipper:        nil,
+		TimeoutHeaders: []string{EnvoyExpectedTimeoutHeader, EnvoyUpstreamTimeoutHeader},
+		Margin:         10 * time.Millisecond,
+		TraceHeaders:   EnvoyTraceHeaders,
+		Health:         nil,
+		ErrorHandler:   defaultEnvoyErrorHandler,
+	}
+}
+
+// Envoy returns an Envoy middleware with default configuration
+func Envoy() gin.HandlerFunc {
+	return EnvoyWithConfig(DefaultEnvoyConfig())
+}
+
+// EnvoyWithConfig returns a middleware for services behind an Envoy or Istio sidecar. The timeout of the
+// calling proxy becomes the deadline of the request context, so work is not continued after Envoy gave up,
+// tracing headers are captured for the upstream calls of the request, and the state of config.Health is
+// announced on every response so draining instances leave the load balancer without failing requests.
+func EnvoyWithConfig(config EnvoyConfig) gin.HandlerFunc {
+	defaults := DefaultEnvoyConfig()
+	if config.TimeoutHeaders == nil {
+		config.TimeoutHeaders = defaults.TimeoutHeaders
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaults.ErrorHandler
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		config.Health.setHeaders(c)
+		captureTraceHeaders(c, config.TraceHeaders)
+
+		timeout, ok := envoyTimeout(c.Request.Header, config.TimeoutHeaders)
+		if !ok {
+			c.Next()
+			return
+		}
+		timeout -= config.Margin
+		if timeout <= 0 {
+			config.ErrorHandler(c, fmt.Errorf("envoy timeout elapsed: %w", context.DeadlineExceeded))
+			c.Abort()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// envoyTimeout returns the timeout of the first present header, zero meaning no timeout is skipped
+func envoyTimeout(h http.Header, headers []string) (time.Duration, bool) {
+	for _, name := range headers {
+		ms, err := strconv.ParseInt(h.Get(name), 10, 64)
+		if err == nil && ms > 0 {
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+	return 0, false
+}
+
+// captureTraceHeaders adds the trace headers of the request to the headers captured by propagation.Middleware
+func captureTraceHeaders(c *gin.Context, allowlist []string) {
+	trace := propagation.Capture(c.Request.Header, allowlist)
+	if len(trace) == 0 {
+		return
+	}
+	if h, ok := propagation.FromContext(c); ok {
+		for name, values := range h {
+			if _, ok := trace[name]; !ok {
+				trace[name] = values
+			}
+		}
+	}
+	c.Set(propagation.ContextKey, trace)
+}
+
+// defaultEnvoyErrorHandler answers with 408 rather than a 5xx, the caller gave up and this host is not at fault
+// in the outlier detection of the mesh
+func defaultEnvoyErrorHandler(c *gin.Context, err error) {
+	c.JSON(http.StatusRequestTimeout, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/client"
+	"github.com/go-kenka/ginpb/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvoy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	downstream := gin.New()
+	downstream.POST("/echo", func(c *gin.Context) {
+		c.JSON(http.StatusOK, map[string]string{
+			"traceparent": c.GetHeader("traceparent"),
+			"b3":          c.GetHeader("x-b3-traceid"),
+			"retry_on":    c.GetHeader("x-envoy-retry-on"),
+			"max_retries": c.GetHeader("x-envoy-max-retries"),
+			"timeout":     c.GetHeader("x-envoy-upstream-rq-timeout-ms"),
+		})
+	})
+	c := client.NewClient(client.WithInProcess(downstream), client.WithHeaderPropagation(),
+		client.WithEnvoy(client.EnvoyConfig{MaxRetries: 2}), client.WithIdempotentOperations("Echo"))
+
+	health := NewMeshHealth()
+	config := DefaultEnvoyConfig()
+	config.Health = health
+	config.Margin = 100 * time.Millisecond
+	e := gin.New()
+	e.Use(EnvoyWithConfig(config))
+	e.GET("/healthz", health.Handler())
+	e.GET("/", func(ctx *gin.Context) {
+		// Service methods receive the deadline and the captured headers through the metadata context
+		md := metadata.NewContext(ctx)
+		deadline, ok := md.Deadline()
+		var out map[string]string
+		require.NoError(t, c.Invoke(md, http.MethodPost, "/echo", nil, &out, client.Operation(ctx.Query("op"))))
+		if ok {
+			out["deadline"] = time.Until(deadline).Round(100 * time.Millisecond).String()
+		}
+		ctx.JSON(http.StatusOK, out)
+	})
+	get := func(path string, header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w
+	}
+
+	// The expected timeout becomes the deadline, trace headers reach upstream calls, only retryable calls retry
+	w := get("/?op=Echo", map[string]string{
+		"x-envoy-expected-rq-timeout-ms": "1500",
+		"traceparent":                    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"x-b3-traceid":                   "80f198ee56343ba864fe8b2a57d3eff7",
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	var out map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+	// The remaining time is truncated to milliseconds when sent upstream
+	assert.InDelta(t, 1400, atoi(t, out["timeout"]), 10)
+	out["timeout"] = "1400"
+	body, _ := json.Marshal(out)
+	assert.JSONEq(t, `{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"b3": "80f198ee56343ba864fe8b2a57d3eff7",
+		"retry_on": "gateway-error,reset,connect-failure",
+		"max_retries": "2",
+		"timeout": "1400",
+		"deadline": "1.4s"
+	}`, string(body))
+
+	w = get("/?op=Create", nil)
+	assert.JSONEq(t, `{"traceparent": "", "b3": "", "retry_on": "", "max_retries": "0", "timeout": ""}`, w.Body.String())
+
+	// A timeout already spent by the margin is answered without a 5xx
+	w = get("/", map[string]string{"x-envoy-upstream-rq-timeout-ms": "50"})
+	assert.Equal(t, http.StatusRequestTimeout, w.Code)
+	assert.Contains(t, w.Body.String(), "envoy timeout elapsed")
+
+	w = get("/healthz", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(EnvoyHealthCheckFailHeader))
+
+	health.Set(MeshDegraded)
+	w = get("/healthz", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "true", w.Header().Get(EnvoyDegradedHeader))
+
+	health.Set(MeshDraining)
+	w = get("/healthz", nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"status": "draining"}`, w.Body.String())
+	w = get("/?op=Echo", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "true", w.Header().Get(EnvoyHealthCheckFailHeader))
+	assert.Equal(t, "close", w.Header().Get("Connection"))
+}
+
+func atoi(t *testing.T, s string) float64 {
+	n, err := strconv.Atoi(s)
+	require.NoError(t, err)
+	return float64(n)
+}
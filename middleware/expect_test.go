@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpectContinue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.PUT("/objects/:key", ExpectContinueWithConfig(ExpectContinueConfig{
+		MaxBodyBytes: 8,
+		Check: func(c *gin.Context) error {
+			if c.Param("key") == "locked" {
+				return errors.New("object is locked")
+			}
+			return nil
+		},
+	}), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, tc := range []struct {
+		key, body, expect string
+		want              int
+	}{
+		{"a", "small", "100-continue", http.StatusOK},
+		{"a", "far too large", "100-continue", http.StatusRequestEntityTooLarge},
+		{"locked", "small", "100-continue", http.StatusExpectationFailed},
+		// Requests without Expect are left to body limits
+		{"locked", "far too large", "", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/objects/"+tc.key, strings.NewReader(tc.body))
+		if tc.expect != "" {
+			req.Header.Set("Expect", tc.expect)
+		}
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		assert.Equal(t, tc.want, w.Code, tc)
+	}
+}
@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gRPC status headers sent by the GRPCStatus middleware
+const (
+	GRPCStatusHeader  = "Grpc-Status"
+	GRPCMessageHeader = "Grpc-Message"
+)
+
+// gRPC status codes, see google.golang.org/grpc/codes
+const (
+	grpcOK                 = 0
+	grpcCanceled           = 1
+	grpcUnknown            = 2
+	grpcInvalidArgument    = 3
+	grpcDeadlineExceeded   = 4
+	grpcNotFound           = 5
+	grpcPermissionDenied   = 7
+	grpcResourceExhausted  = 8
+	grpcFailedPrecondition = 9
+	grpcAborted            = 10
+	grpcUnimplemented      = 12
+	grpcInternal           = 13
+	grpcUnavailable        = 14
+	grpcUnauthenticated    = 16
+)
+
+// GRPCStatusConfig defines the config for GRPCStatus middleware
+type GRPCStatusConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Code maps the HTTP status of responses whose error carries no gRPC status to a gRPC code
+	Code func(status int) int
+}
+
+// DefaultGRPCStatusConfig returns a default gRPC status configuration
+func DefaultGRPCStatusConfig() GRPCStatusConfig {
+	return GRPCStatusConfig{
+		Skipper: nil,
+		Code:    GRPCCodeFromHTTPStatus,
+	}
+}
+
+// GRPCStatus returns a middleware adding Grpc-Status and Grpc-Message headers to every response, so clients
+// migrating from grpc-gateway keep their status based handling
+func GRPCStatus() gin.HandlerFunc {
+	return GRPCStatusWithConfig(DefaultGRPCStatusConfig())
+}
+
+// GRPCStatusWithConfig returns a gRPC status middleware with config. The headers are set when the response is
+// written: errors added with c.Error that carry a gRPC status (a GRPCStatus method, e.g. errors of
+// google.golang.org/grpc/status) send their code and message, other responses the code of their HTTP status.
+func GRPCStatusWithConfig(config GRPCStatusConfig) gin.HandlerFunc {
+	if config.Code == nil {
+		config.Code = GRPCCodeFromHTTPStatus
+	}
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		w := &grpcStatusWriter{ResponseWriter: c.Writer, c: c, config: &config}
+		c.Writer = w
+		c.Next()
+		w.setHeaders()
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+// GRPCCodeFromHTTPStatus maps an HTTP status to the gRPC code grpc-gateway maps to it
+func GRPCCodeFromHTTPStatus(status int) int {
+	switch {
+	case status >= 200 && status < 300:
+		return grpcOK
+	case status == http.StatusBadRequest:
+		return grpcInvalidArgument
+	case status == http.StatusUnauthorized:
+		return grpcUnauthenticated
+	case status == http.StatusForbidden:
+		return grpcPermissionDenied
+	case status == http.StatusNotFound:
+		return grpcNotFound
+	case status == http.StatusConflict:
+		return grpcAborted
+	case status == http.StatusPreconditionFailed:
+		return grpcFailedPrecondition
+	case status == http.StatusTooManyRequests:
+		return grpcResourceExhausted
+	case status == 499:
+		return grpcCanceled
+	case status == http.StatusNotImplemented:
+		return grpcUnimplemented
+	case status == http.StatusServiceUnavailable:
+		return grpcUnavailable
+	case status == http.StatusGatewayTimeout:
+		return grpcDeadlineExceeded
+	case status >= 500:
+		return grpcInternal
+	}
+	return grpcUnknown
+}
+
+// grpcStatusWriter sets the gRPC status headers right before the response header is written
+type grpcStatusWriter struct {
+	gin.ResponseWriter
+	c      *gin.Context
+	config *GRPCStatusConfig
+	done   bool
+}
+
+func (w *grpcStatusWriter) setHeaders() {
+	if w.done || w.ResponseWriter.Written() {
+		return
+	}
+	w.done = true
+	code, message := w.config.Code(w.Status()), ""
+	if err := w.c.Errors.Last(); err != nil {
+		message = err.Error()
+		if c, m, ok := grpcStatusOf(err.Err); ok {
+			code, message = c, m
+		}
+	}
+	if code == grpcOK {
+		message = ""
+	}
+	h := w.Header()
+	h.Set(GRPCStatusHeader, strconv.Itoa(code))
+	if message != "" {
+		h.Set(GRPCMessageHeader, encodeGRPCMessage(message))
+	}
+}
+
+// WriteHeaderNow defers writing a header without body until the handlers returned, as c.AbortWithError
+// writes the header before adding the error
+func (w *grpcStatusWriter) WriteHeaderNow() {}
+
+func (w *grpcStatusWriter) Write(b []byte) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *grpcStatusWriter) WriteString(s string) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *grpcStatusWriter) Flush() {
+	w.setHeaders()
+	w.ResponseWriter.Flush()
+}
+
+// grpcStatusOf returns the code and message of an error with a GRPCStatus method, e.g. a status error
+// of google.golang.org/grpc, without depending on grpc
+func grpcStatusOf(err error) (int, string, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		m := reflect.ValueOf(err).MethodByName("GRPCStatus")
+		if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+			continue
+		}
+		s := m.Call(nil)[0]
+		if s.Kind() == reflect.Pointer && s.IsNil() {
+			continue
+		}
+		code, message := s.MethodByName("Code"), s.MethodByName("Message")
+		if !code.IsValid() || !message.IsValid() {
+			continue
+		}
+		c, msg := code.Call(nil)[0], message.Call(nil)[0]
+		if !c.CanUint() || msg.Kind() != reflect.String {
+			continue
+		}
+		return int(c.Uint()), msg.String(), true
+	}
+	return 0, "", false
+}
+
+// encodeGRPCMessage percent-encodes a status message as the gRPC protocol requires
+func encodeGRPCMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		if ch := msg[i]; ch >= ' ' && ch <= '~' && ch != '%' {
+			b.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
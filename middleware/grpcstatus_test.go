@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCode uint32
+
+type testStatus struct {
+	code    testCode
+	message string
+}
+
+func (s *testStatus) Code() testCode  { return s.code }
+func (s *testStatus) Message() string { return s.message }
+
+type testStatusError struct{ s *testStatus }
+
+func (e *testStatusError) Error() string           { return "rpc error: " + e.s.message }
+func (e *testStatusError) GRPCStatus() *testStatus { return e.s }
+
+func TestGRPCStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.Use(GRPCStatus())
+	e.GET("/ok", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{}) })
+	e.GET("/missing", func(c *gin.Context) {
+		_ = c.AbortWithError(http.StatusNotFound, errors.New("user 100% gone"))
+	})
+	e.GET("/status", func(c *gin.Context) {
+		err := fmt.Errorf("get user: %w", &testStatusError{&testStatus{code: 9, message: "not ready"}})
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+	})
+	do := func(path string) http.Header {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Header()
+	}
+
+	h := do("/ok")
+	assert.Equal(t, "0", h.Get(GRPCStatusHeader))
+	assert.Empty(t, h.Get(GRPCMessageHeader))
+
+	h = do("/missing")
+	assert.Equal(t, "5", h.Get(GRPCStatusHeader))
+	assert.Equal(t, "user 100%25 gone", h.Get(GRPCMessageHeader))
+
+	// Errors carrying a gRPC status win over the HTTP status
+	h = do("/status")
+	assert.Equal(t, "9", h.Get(GRPCStatusHeader))
+	assert.Equal(t, "not ready", h.Get(GRPCMessageHeader))
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/metadata"
+	"golang.org/x/text/language"
+)
+
+// LocaleConfig defines the config for the Locale middleware
+type LocaleConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Supported lists the locales the service is translated to, the first one is the default
+	Supported []language.Tag
+
+	// Query names a query parameter overriding the Accept-Language header, e.g. "lang", empty disables it
+	Query string
+
+	// Localizer translates messages for metadata.Localize, nil leaves messages untranslated
+	Localizer metadata.Localizer
+}
+
+// DefaultLocaleConfig returns a default locale configuration
+func DefaultLocaleConfig() LocaleConfig {
+	return LocaleConfig{
+		Skipper:   nil,
+		Supported: []language.Tag{language.English},
+		Query:     "",
+		Localizer: nil,
+	}
+}
+
+// Locale returns a locale middleware with default configuration
+func Locale() gin.HandlerFunc {
+	return LocaleWithConfig(DefaultLocaleConfig())
+}
+
+// LocaleWithConfig returns a middleware negotiating the locale of the request from the Accept-Language
+// header against the supported locales. The locale is available through metadata.Locale, written as
+// Content-Language response header, and used by metadata.Localize to translate messages of handlers and error encoders.
+func LocaleWithConfig(config LocaleConfig) gin.HandlerFunc {
+	if len(config.Supported) == 0 {
+		config.Supported = DefaultLocaleConfig().Supported
+	}
+	matcher := language.NewMatcher(config.Supported)
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		var preferred []language.Tag
+		if config.Query != "" {
+			if tag, err := language.Parse(c.Query(config.Query)); err == nil {
+				preferred = append(preferred, tag)
+			}
+		}
+		// Malformed entries are ignored, the default locale is used when nothing matches
+		accepted, _, _ := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+		preferred = append(preferred, accepted...)
+
+		_, index, _ := matcher.Match(preferred...)
+		locale := config.Supported[index]
+		c.Set(metadata.LocaleKey, locale)
+		if config.Localizer != nil {
+			c.Set(metadata.LocalizerKey, config.Localizer)
+		}
+		c.Header("Content-Language", locale.String())
+		c.Next()
+	}
+}
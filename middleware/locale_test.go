@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb/metadata"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestLocale(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	translations := map[language.Tag]map[string]string{
+		language.German: {"user %s not found": "Benutzer %s nicht gefunden"},
+	}
+	e := gin.New()
+	e.GET("/users/:id", LocaleWithConfig(LocaleConfig{
+		Supported: []language.Tag{language.English, language.German, language.SimplifiedChinese},
+		Query:     "lang",
+		Localizer: metadata.LocalizerFunc(func(locale language.Tag, message string, args ...any) string {
+			if m, ok := translations[locale][message]; ok {
+				message = m
+			}
+			return fmt.Sprintf(message, args...)
+		}),
+	}), func(c *gin.Context) {
+		// Service methods see the locale through the metadata context
+		ctx := metadata.NewContext(c)
+		locale, _ := metadata.Locale(ctx)
+		c.String(http.StatusNotFound, locale.String()+": "+metadata.Localize(ctx, "user %s not found", c.Param("id")))
+	})
+
+	for _, tt := range []struct {
+		path, accept, want string
+	}{
+		{"/users/7", "", "en: user 7 not found"},
+		{"/users/7", "de-CH, en;q=0.5", "de: Benutzer 7 nicht gefunden"},
+		{"/users/7", "zh-CN", "zh-Hans: user 7 not found"},
+		{"/users/7", "fr, invalid;;", "en: user 7 not found"},
+		{"/users/7?lang=de", "en", "de: Benutzer 7 nicht gefunden"},
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		req.Header.Set("Accept-Language", tt.accept)
+		e.ServeHTTP(w, req)
+		assert.Equal(t, tt.want, w.Body.String(), tt.accept)
+		assert.Equal(t, strings.SplitN(tt.want, ":", 2)[0], w.Header().Get("Content-Language"))
+	}
+
+	assert.Equal(t, "user 7 not found", metadata.Localize(metadata.WithLocale(context.Background(), language.German), "user %d not found", 7))
+}
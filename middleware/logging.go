@@ -5,9 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/metadata"
 )
 
 // LoggingConfig defines the config for Logging middleware
@@ -15,6 +20,9 @@ type LoggingConfig struct {
 	// Optional. Default value is gin.DefaultWriter
 	Output io.Writer
 
+	// Optional. When set, entries are sent to Sink instead of Output
+	Sink LogSink
+
 	// Skip defines a function to skip middleware
 	Skipper func(*gin.Context) bool
 
@@ -29,6 +37,35 @@ type LoggingConfig struct {
 	LogOperation bool
 	LogRequest   bool
 	LogResponse  bool
+	// LogFacts logs the facts recorded in the metadata.Store of the request, e.g. the database query count
+	LogFacts bool
+
+	// MaxBodyBytes caps the logged request and response bodies, longer bodies are truncated.
+	// Zero uses 4KB, a negative value logs whole bodies.
+	MaxBodyBytes int
+
+	// LogContentTypes lists the media types whose bodies are logged, e.g. "application/json" or "text/*".
+	// Empty logs every body that is not binary; multipart, protobuf, octet-stream, image, audio and video
+	// bodies and bodies that are not valid UTF-8 are never logged.
+	LogContentTypes []string
+}
+
+// defaultLogBodyBytes is the logged body limit when LoggingConfig.MaxBodyBytes is zero
+const defaultLogBodyBytes = 4 << 10
+
+// binaryContentTypes are the media types whose bodies are never logged
+var binaryContentTypes = []string{
+	"multipart/*",
+	"application/octet-stream",
+	"application/protobuf",
+	"application/x-protobuf",
+	"application/grpc*",
+	"application/zip",
+	"application/gzip",
+	"application/pdf",
+	"image/*",
+	"audio/*",
+	"video/*",
 }
 
 // DefaultLoggingConfig returns a default logging configuration
@@ -46,6 +83,8 @@ func DefaultLoggingConfig() LoggingConfig {
 		LogOperation: true,
 		LogRequest:   false,
 		LogResponse:  false,
+		LogFacts:     true,
+		MaxBodyBytes: defaultLogBodyBytes,
 	}
 }
 
@@ -63,6 +102,8 @@ type LogEntry struct {
 	Request   interface{} `json:"request,omitempty"`
 	Response  interface{} `json:"response,omitempty"`
 	Error     string      `json:"error,omitempty"`
+	// Facts recorded in the metadata.Store of the request
+	Facts map[string]any `json:"facts,omitempty"`
 }
 
 // responseBodyWriter wraps gin.ResponseWriter to capture response body
@@ -76,6 +117,44 @@ func (r responseBodyWriter) Write(b []byte) (int, error) {
 	return r.ResponseWriter.Write(b)
 }
 
+func (r responseBodyWriter) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// logBodyWriter captures up to limit bytes of the response body, a negative limit captures all of it
+type logBodyWriter struct {
+	gin.ResponseWriter
+	body  bytes.Buffer
+	limit int
+	size  int64
+}
+
+func (w *logBodyWriter) capture(b []byte) {
+	w.size += int64(len(b))
+	if n := w.limit - w.body.Len(); w.limit < 0 || n > len(b) {
+		w.body.Write(b)
+	} else if n > 0 {
+		w.body.Write(b[:n])
+	}
+}
+
+func (w *logBodyWriter) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *logBodyWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// prefixedBody restores a request body whose beginning was read for logging
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
 // Logging returns a gin middleware for logging requests and responses
 func Logging() gin.HandlerFunc {
 	return LoggingWithConfig(DefaultLoggingConfig())
@@ -94,33 +173,40 @@ func LoggingWithConfig(config LoggingConfig) gin.HandlerFunc {
 		path := c.Request.URL.Path
 		method := c.Request.Method
 
-		// Capture request body if needed
+		limit := config.MaxBodyBytes
+		if limit == 0 {
+			limit = defaultLogBodyBytes
+		}
+
+		// Capture the beginning of the request body if needed
 		var requestBody interface{}
-		if config.LogRequest {
-			if c.Request.Body != nil {
-				bodyBytes, err := io.ReadAll(c.Request.Body)
+		if config.LogRequest && c.Request.Body != nil && c.Request.Body != http.NoBody {
+			contentType := c.ContentType()
+			if !logContentType(contentType, config.LogContentTypes) {
+				requestBody = omittedBody(contentType, c.Request.ContentLength)
+			} else {
+				var r io.Reader = c.Request.Body
+				if limit >= 0 {
+					r = io.LimitReader(r, int64(limit)+1)
+				}
+				bodyBytes, err := io.ReadAll(r)
 				if err == nil {
 					// Restore request body for further processing
-					c.Request.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-
-					// Try to parse JSON
-					var jsonBody interface{}
-					if json.Unmarshal(bodyBytes, &jsonBody) == nil {
-						requestBody = jsonBody
-					} else {
-						requestBody = string(bodyBytes)
-					}
+					c.Request.Body = prefixedBody{io.MultiReader(bytes.NewReader(bodyBytes), c.Request.Body), c.Request.Body}
+					requestBody = loggedBody(bodyBytes, c.Request.ContentLength, contentType, limit)
 				}
 			}
 		}
 
+		var store *metadata.Store
+		if config.LogFacts {
+			store = metadata.EnsureStore(c)
+		}
+
 		// Capture response body if needed
-		var responseWriter *responseBodyWriter
+		var responseWriter *logBodyWriter
 		if config.LogResponse {
-			responseWriter = &responseBodyWriter{
-				body:           bytes.NewBufferString(""),
-				ResponseWriter: c.Writer,
-			}
+			responseWriter = &logBodyWriter{ResponseWriter: c.Writer, limit: limit}
 			c.Writer = responseWriter
 		}
 
@@ -157,35 +243,100 @@ func LoggingWithConfig(config LoggingConfig) gin.HandlerFunc {
 			entry.Referer = c.Request.Referer()
 		}
 		if config.LogOperation {
-			if op, exists := c.Get("operation"); exists {
-				entry.Operation = fmt.Sprintf("%v", op)
-			}
+			entry.Operation = ginpb.OperationFromContext(c)
 		}
 		if config.LogRequest && requestBody != nil {
 			entry.Request = requestBody
 		}
-		if config.LogResponse && responseWriter != nil {
-			var responseBody interface{}
-			if responseWriter.body.Len() > 0 {
-				bodyBytes := responseWriter.body.Bytes()
-				// Try to parse JSON
-				var jsonBody interface{}
-				if json.Unmarshal(bodyBytes, &jsonBody) == nil {
-					responseBody = jsonBody
-				} else {
-					responseBody = string(bodyBytes)
-				}
+		if config.LogResponse && responseWriter != nil && responseWriter.size > 0 {
+			contentType := responseWriter.Header().Get("Content-Type")
+			if !logContentType(contentType, config.LogContentTypes) {
+				entry.Response = omittedBody(contentType, responseWriter.size)
+			} else {
+				entry.Response = loggedBody(responseWriter.body.Bytes(), responseWriter.size, contentType, limit)
 			}
-			entry.Response = responseBody
 		}
 
+		entry.Facts = store.Values()
+
 		// Log errors if any
 		if len(c.Errors) > 0 {
 			entry.Error = c.Errors.String()
 		}
 
 		// Write log
+		if config.Sink != nil {
+			_ = config.Sink.Write(entry)
+			return
+		}
 		logBytes, _ := json.Marshal(entry)
 		fmt.Fprintln(config.Output, string(logBytes))
 	})
 }
+
+// loggedBody returns the logged form of a body starting with b: parsed JSON when the body is complete, the
+// text otherwise, truncated to limit bytes. size is the body length, negative when unknown.
+func loggedBody(b []byte, size int64, contentType string, limit int) interface{} {
+	truncated := limit >= 0 && (len(b) > limit || size > int64(len(b)))
+	if truncated {
+		b = b[:min(len(b), limit)]
+		// Cut at a rune boundary so truncated text stays valid UTF-8
+		for i := 0; i < utf8.UTFMax && len(b) > 0 && !utf8.Valid(b); i++ {
+			b = b[:len(b)-1]
+		}
+	}
+	if bytes.IndexByte(b, 0) >= 0 || !utf8.Valid(b) {
+		return omittedBody(contentType, size)
+	}
+	if truncated {
+		if size > int64(limit) {
+			return fmt.Sprintf("%s...[truncated, %d bytes]", b, size)
+		}
+		return fmt.Sprintf("%s...[truncated]", b)
+	}
+	var jsonBody interface{}
+	if json.Unmarshal(b, &jsonBody) == nil {
+		return jsonBody
+	}
+	return string(b)
+}
+
+// omittedBody describes a body that is not logged
+func omittedBody(contentType string, size int64) string {
+	if contentType == "" {
+		contentType = "binary"
+	}
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = strings.TrimSpace(contentType[:i])
+	}
+	if size < 0 {
+		return fmt.Sprintf("[%s body omitted]", contentType)
+	}
+	return fmt.Sprintf("[%s body of %d bytes omitted]", contentType, size)
+}
+
+// logContentType reports whether bodies of contentType are logged
+func logContentType(contentType string, allowed []string) bool {
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if matchContentType(contentType, binaryContentTypes) {
+		return false
+	}
+	return len(allowed) == 0 || matchContentType(contentType, allowed)
+}
+
+// matchContentType matches a media type against patterns such as "application/json" and "text/*"
+func matchContentType(contentType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == contentType || pattern == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb/metadata"
+)
+
+func TestLoggingBodies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var out bytes.Buffer
+	e := gin.New()
+	e.Use(LoggingWithConfig(LoggingConfig{Output: &out, LogRequest: true, LogResponse: true, MaxBodyBytes: 8}))
+	e.POST("/echo", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Data(http.StatusOK, c.ContentType(), body)
+	})
+	do := func(contentType, body string) LogEntry {
+		out.Reset()
+		req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		// Handlers still read the whole body
+		assert.Equal(t, body, w.Body.String())
+		var entry LogEntry
+		require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+		return entry
+	}
+
+	entry := do("application/json", `{"a":1}`)
+	assert.Equal(t, map[string]any{"a": float64(1)}, entry.Request)
+
+	entry = do("text/plain", "0123456789abcdef")
+	assert.Equal(t, "01234567...[truncated, 16 bytes]", entry.Request)
+	assert.Equal(t, "01234567...[truncated, 16 bytes]", entry.Response)
+
+	entry = do("multipart/form-data; boundary=x", "--x--")
+	assert.Equal(t, "[multipart/form-data body of 5 bytes omitted]", entry.Request)
+
+	entry = do("application/json", "\x00\x01\x02")
+	assert.Equal(t, "[application/json body of 3 bytes omitted]", entry.Request)
+}
+
+func TestLoggingFacts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var out bytes.Buffer
+	e := gin.New()
+	e.Use(LoggingWithConfig(LoggingConfig{Output: &out, LogFacts: true}))
+	e.GET("/users", func(c *gin.Context) {
+		// Service methods record facts through the context they receive
+		ctx := metadata.NewContext(c)
+		var wg sync.WaitGroup
+		for range 3 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				metadata.StoreFromContext(ctx).Add("db_queries", 1)
+			}()
+		}
+		wg.Wait()
+		metadata.StoreFromContext(ctx).Set("cache", "miss")
+	})
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	var entry LogEntry
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, map[string]any{"db_queries": float64(3), "cache": "miss"}, entry.Facts)
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+)
+
+// MaskProfileRule selects the masking profile of callers granted a scope
+type MaskProfileRule struct {
+	// Scope is the auth scope granted to the caller, e.g. "partner"
+	Scope string
+	// Profile is the masking profile of the caller, empty to mask nothing
+	Profile string
+}
+
+// MaskProfileConfig defines the config for MaskProfile middleware
+type MaskProfileConfig struct {
+	// Skip defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Rules select the profile by scope, the first rule whose scope is granted wins
+	Rules []MaskProfileRule
+
+	// Default is the profile of callers matching no rule, e.g. "public" for anonymous callers
+	Default string
+
+	// Granted returns the scopes granted to the caller
+	Granted func(*gin.Context) []string
+}
+
+// DefaultMaskProfileConfig returns a default masking profile configuration
+func DefaultMaskProfileConfig() MaskProfileConfig {
+	return MaskProfileConfig{
+		Skipper: nil,
+		Granted: defaultGrantedScopes,
+	}
+}
+
+// MaskProfile returns a middleware selecting the masking profile applied to replies from the scopes of the
+// caller, register it after the authentication middleware
+func MaskProfile(defaultProfile string, rules ...MaskProfileRule) gin.HandlerFunc {
+	config := DefaultMaskProfileConfig()
+	config.Default = defaultProfile
+	config.Rules = rules
+	return MaskProfileWithConfig(config)
+}
+
+// MaskProfileWithConfig returns a masking profile middleware with config
+func MaskProfileWithConfig(config MaskProfileConfig) gin.HandlerFunc {
+	if config.Granted == nil {
+		config.Granted = defaultGrantedScopes
+	}
+
+	return gin.HandlerFunc(func(c *gin.Context) {
+		// Skip middleware if skipper returns true
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+
+		profile := config.Default
+		granted := config.Granted(c)
+		for _, rule := range config.Rules {
+			if contains(granted, rule.Scope) {
+				profile = rule.Profile
+				break
+			}
+		}
+		ginpb.SetMaskProfile(c, profile)
+		c.Next()
+	})
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskProfile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.Use(func(c *gin.Context) {
+		c.Set("scopes", c.QueryArray("scope"))
+	})
+	e.GET("/", MaskProfile("public", MaskProfileRule{Scope: "admin"}, MaskProfileRule{Scope: "partner", Profile: "partner"}), func(c *gin.Context) {
+		c.String(http.StatusOK, ginpb.MaskProfileFromContext(c.Request.Context()))
+	})
+
+	for query, want := range map[string]string{
+		"":                           "public",
+		"?scope=partner":             "partner",
+		"?scope=partner&scope=admin": "",
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/"+query, nil))
+		assert.Equal(t, want, w.Body.String(), query)
+	}
+}
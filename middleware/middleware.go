@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
 )
 
 // Handler defines the handler used by ginpb middleware as return value
@@ -41,7 +42,7 @@ func NewOperationMiddleware(operation string, middleware gin.HandlerFunc) *Opera
 func (om *OperationMiddleware) Apply() gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
 		// Get operation from context (set by generated handler)
-		if op, exists := c.Get("operation"); exists && op == om.operation {
+		if ginpb.OperationFromContext(c) == om.operation {
 			om.middleware(c)
 		} else {
 			c.Next()
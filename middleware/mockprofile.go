@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"gopkg.in/yaml.v3"
+)
+
+// z95 is the standard normal quantile of 0.95
+const z95 = 1.6448536269514722
+
+// MockProfile describes the simulated behavior of an operation
+type MockProfile struct {
+	// P50 is the median latency
+	P50 time.Duration `yaml:"p50"`
+
+	// P95 is the 95th percentile latency, latencies follow a log-normal distribution through P50 and P95.
+	// Zero or less than P50 delays every request by P50.
+	P95 time.Duration `yaml:"p95"`
+
+	// ErrorRate is the fraction of requests failing, between 0 and 1
+	ErrorRate float64 `yaml:"error_rate"`
+
+	// ErrorStatus is the status of failing requests, 503 by default
+	ErrorStatus int `yaml:"error_status"`
+}
+
+// MockProfiles holds the profiles of a mock server, read from YAML:
+//
+//	default: {p50: 20ms, p95: 80ms}
+//	operations:
+//	  /example.UserService/GetUser: {p50: 40ms, p95: 300ms, error_rate: 0.01}
+//	  SearchUsers: {p50: 200ms, p95: 1s, error_rate: 0.05, error_status: 500}
+//
+// Operations are matched by full name first, then by method name.
+type MockProfiles struct {
+	Default    MockProfile            `yaml:"default"`
+	Operations map[string]MockProfile `yaml:"operations"`
+}
+
+// LoadMockProfiles reads and validates the profiles of a YAML file
+func LoadMockProfiles(path string) (*MockProfiles, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	profiles := &MockProfiles{}
+	if err := yaml.Unmarshal(data, profiles); err != nil {
+		return nil, fmt.Errorf("parse mock profiles %s: %w", path, err)
+	}
+	if err := profiles.Validate(); err != nil {
+		return nil, fmt.Errorf("mock profiles %s: %w", path, err)
+	}
+	return profiles, nil
+}
+
+// Validate checks the latencies, error rates and statuses of all profiles
+func (p *MockProfiles) Validate() error {
+	errs := []error{p.Default.validate("default")}
+	for op, profile := range p.Operations {
+		errs = append(errs, profile.validate(op))
+	}
+	return errors.Join(errs...)
+}
+
+func (p MockProfile) validate(name string) error {
+	switch {
+	case p.P50 < 0 || p.P95 < 0:
+		return fmt.Errorf("%s: latencies must not be negative", name)
+	case p.ErrorRate < 0 || p.ErrorRate > 1:
+		return fmt.Errorf("%s: error_rate %v must be between 0 and 1", name, p.ErrorRate)
+	case p.ErrorStatus != 0 && (p.ErrorStatus < 400 || p.ErrorStatus > 599):
+		return fmt.Errorf("%s: error_status %d must be a 4xx or 5xx status", name, p.ErrorStatus)
+	}
+	return nil
+}
+
+// profile returns the profile of operation
+func (p *MockProfiles) profile(operation string) MockProfile {
+	if profile, ok := p.Operations[operation]; ok {
+		return profile
+	}
+	if profile, ok := p.Operations[operation[strings.LastIndex(operation, "/")+1:]]; ok {
+		return profile
+	}
+	return p.Default
+}
+
+// SimulateConfig defines the config for the Simulate middleware
+type SimulateConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Profiles are the simulated latencies and errors
+	Profiles *MockProfiles
+
+	// Seed makes the simulation reproducible, zero seeds from the clock
+	Seed int64
+
+	// ErrorHandler answers the requests selected to fail
+	ErrorHandler func(c *gin.Context, status int, err error)
+}
+
+// DefaultSimulateConfig returns a default simulation configuration without latency or errors
+func DefaultSimulateConfig() SimulateConfig {
+	return SimulateConfig{
+		Skipper:      nil,
+		Profiles:     &MockProfiles{},
+		Seed:         0,
+		ErrorHandler: defaultSimulateErrorHandler,
+	}
+}
+
+// Simulate returns a simulation middleware with default configuration and the given profiles
+func Simulate(profiles *MockProfiles) gin.HandlerFunc {
+	config := DefaultSimulateConfig()
+	config.Profiles = profiles
+	return SimulateWithConfig(config)
+}
+
+// SimulateWithConfig returns a middleware delaying and failing requests as described by mock profiles, so load
+// tests and frontend development see realistic backend behavior from stub or mock implementations of a service.
+// Register it through the generated global middleware option, the operation is unknown before.
+func SimulateWithConfig(config SimulateConfig) gin.HandlerFunc {
+	if config.Profiles == nil {
+		config.Profiles = &MockProfiles{}
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultSimulateErrorHandler
+	}
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	var mu sync.Mutex
+	rnd := rand.New(rand.NewSource(seed))
+	draw := func(profile MockProfile) (time.Duration, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		return profile.latency(rnd.NormFloat64()), rnd.Float64() < profile.ErrorRate
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		profile := config.Profiles.profile(ginpb.OperationFromContext(c))
+		latency, fail := draw(profile)
+		if latency > 0 {
+			timer := time.NewTimer(latency)
+			select {
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				timer.Stop()
+				c.Abort()
+				return
+			}
+		}
+		if fail {
+			status := profile.ErrorStatus
+			if status == 0 {
+				status = http.StatusServiceUnavailable
+			}
+			config.ErrorHandler(c, status, errors.New("simulated failure of mock profile"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// latency returns the latency of the profile at the standard normal variate z
+func (p MockProfile) latency(z float64) time.Duration {
+	if p.P50 <= 0 || p.P95 <= p.P50 {
+		return p.P50
+	}
+	sigma := math.Log(float64(p.P95)/float64(p.P50)) / z95
+	return time.Duration(float64(p.P50) * math.Exp(sigma*z))
+}
+
+// defaultSimulateErrorHandler is the default error handler for the Simulate middleware
+func defaultSimulateErrorHandler(c *gin.Context, status int, err error) {
+	c.JSON(status, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	file := filepath.Join(t.TempDir(), "profiles.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+default: {p50: 1ms}
+operations:
+  /svc/Search: {error_rate: 1, error_status: 500}
+  Delete: {error_rate: 1}
+`), 0o644))
+	profiles, err := LoadMockProfiles(file)
+	require.NoError(t, err)
+	assert.Equal(t, time.Millisecond, profiles.Default.P50)
+
+	e := gin.New()
+	handle := func(path, operation string) {
+		e.GET(path, func(c *gin.Context) {
+			c.Set(ginpb.OperationKey, operation)
+		}, Simulate(profiles), func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+	}
+	handle("/get", "/svc/Get")
+	handle("/search", "/svc/Search")
+	handle("/delete", "/svc/Delete")
+
+	for path, want := range map[string]int{"/get": http.StatusOK, "/search": http.StatusInternalServerError, "/delete": http.StatusServiceUnavailable} {
+		w := httptest.NewRecorder()
+		start := time.Now()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, want, w.Code, path)
+		if path == "/get" {
+			assert.GreaterOrEqual(t, time.Since(start), time.Millisecond)
+		}
+	}
+
+	// Latencies are log-normal through p50 and p95
+	p := MockProfile{P50: 100 * time.Millisecond, P95: 400 * time.Millisecond}
+	assert.Equal(t, 100*time.Millisecond, p.latency(0))
+	assert.InDelta(t, float64(400*time.Millisecond), float64(p.latency(z95)), float64(time.Microsecond))
+
+	profiles.Operations["x"] = MockProfile{ErrorRate: 2, ErrorStatus: 200}
+	assert.EqualError(t, profiles.Validate(), "x: error_rate 2 must be between 0 and 1")
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NormalizeConfig defines the config for the request normalization middleware
+type NormalizeConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// TrimQuery trims leading and trailing whitespace of query parameter values
+	TrimQuery bool
+
+	// CollapseSlashes replaces repeated slashes in the path with a single one
+	CollapseSlashes bool
+
+	// QueryPlusAsSpace decodes '+' in the query as a space like HTML forms do,
+	// when false it is kept as a literal plus, e.g. for "+08:00" timezone offsets sent unescaped
+	QueryPlusAsSpace bool
+
+	// LowercaseHeaders lists headers whose values are lowercased, e.g. "X-Tenant"
+	LowercaseHeaders []string
+}
+
+// DefaultNormalizeConfig returns a default normalization configuration
+func DefaultNormalizeConfig() NormalizeConfig {
+	return NormalizeConfig{
+		Skipper:          nil,
+		TrimQuery:        true,
+		CollapseSlashes:  true,
+		QueryPlusAsSpace: true,
+		LowercaseHeaders: nil,
+	}
+}
+
+// Normalize returns a request normalization middleware with default configuration
+func Normalize() gin.HandlerFunc {
+	return NormalizeWithConfig(DefaultNormalizeConfig())
+}
+
+// NormalizeWithConfig returns a middleware normalizing the request before binding so requests
+// rewritten differently by proxies bind to the same values. Register it first: gin caches the query
+// on the first c.Query call. Routing happens before middlewares run, wrap the engine with
+// NormalizeHandler to also route paths containing duplicate slashes.
+func NormalizeWithConfig(config NormalizeConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Skipper == nil || !config.Skipper(c) {
+			normalizeRequest(c.Request, config)
+		}
+		c.Next()
+	}
+}
+
+// NormalizeHandler normalizes requests before they reach next, typically a *gin.Engine
+func NormalizeHandler(next http.Handler, config NormalizeConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		normalizeRequest(r, config)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func normalizeRequest(r *http.Request, config NormalizeConfig) {
+	if config.CollapseSlashes {
+		r.URL.Path = collapseSlashes(r.URL.Path)
+		r.URL.RawPath = collapseSlashes(r.URL.RawPath)
+	}
+	if r.URL.RawQuery != "" && (config.TrimQuery || !config.QueryPlusAsSpace) {
+		r.URL.RawQuery = normalizeQuery(r.URL.RawQuery, config)
+	}
+	for _, name := range config.LowercaseHeaders {
+		values := r.Header.Values(name)
+		for i, v := range values {
+			values[i] = strings.ToLower(v)
+		}
+	}
+}
+
+// normalizeQuery re-encodes the query so '+' and %20 both end up as an encoded space,
+// or both a literal plus and %2B as an encoded plus when QueryPlusAsSpace is false
+func normalizeQuery(raw string, config NormalizeConfig) string {
+	if !config.QueryPlusAsSpace {
+		raw = strings.ReplaceAll(raw, "+", "%2B")
+	}
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		// Leave malformed queries to the binding, which reports them
+		return raw
+	}
+	if config.TrimQuery {
+		for _, vs := range values {
+			for i, v := range vs {
+				vs[i] = strings.TrimSpace(v)
+			}
+		}
+	}
+	return values.Encode()
+}
+
+func collapseSlashes(path string) string {
+	if !strings.Contains(path, "//") {
+		return path
+	}
+	var b strings.Builder
+	b.Grow(len(path))
+	for i := 0; i < len(path); i++ {
+		if path[i] == '/' && i > 0 && path[i-1] == '/' {
+			continue
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	config := DefaultNormalizeConfig()
+	config.LowercaseHeaders = []string{"X-Tenant"}
+
+	var (
+		q      string
+		tz     string
+		tenant string
+	)
+	e := gin.New()
+	e.Use(NormalizeWithConfig(config))
+	e.GET("/users/:id", func(c *gin.Context) {
+		q, tz, tenant = c.Query("q"), c.Query("tz"), c.GetHeader("X-Tenant")
+	})
+
+	for _, raw := range []string{"q=+hello+world+&tz=+08:00", "q=%20hello%20world%20&tz=%2008:00"} {
+		req := httptest.NewRequest(http.MethodGet, "/users/1?"+raw, nil)
+		req.Header.Set("X-Tenant", "ACME")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+		assert.Equal(t, "hello world", q, raw)
+		assert.Equal(t, "08:00", tz, raw)
+		assert.Equal(t, "acme", tenant)
+	}
+
+	config.QueryPlusAsSpace = false
+	w := httptest.NewRecorder()
+	h := NormalizeHandler(e, config)
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "//users//1?tz=+08:00", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "+08:00", tz)
+}
@@ -0,0 +1,535 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPISpec is an OpenAPI 3 document prepared for request validation.
+// It supports the subset emitted by protoc-gen-openapi: path, query and header parameters,
+// JSON request bodies, local $ref and the common type, enum, range, length, pattern and required keywords.
+type OpenAPISpec struct {
+	routes  []*openAPIRoute
+	schemas map[string]*openAPISchema
+}
+
+type openAPIDocument struct {
+	Paths      map[string]map[string]yaml.Node `yaml:"paths"`
+	Components struct {
+		Schemas map[string]*openAPISchema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type openAPIOperation struct {
+	OperationID string              `yaml:"operationId"`
+	Parameters  []*openAPIParameter `yaml:"parameters"`
+	RequestBody *struct {
+		Required bool `yaml:"required"`
+		Content  map[string]struct {
+			Schema *openAPISchema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"requestBody"`
+}
+
+type openAPIParameter struct {
+	Name     string         `yaml:"name"`
+	In       string         `yaml:"in"`
+	Required bool           `yaml:"required"`
+	Schema   *openAPISchema `yaml:"schema"`
+}
+
+type openAPISchema struct {
+	Ref              string                    `yaml:"$ref"`
+	Type             string                    `yaml:"type"`
+	Format           string                    `yaml:"format"`
+	Nullable         bool                      `yaml:"nullable"`
+	Enum             []any                     `yaml:"enum"`
+	Required         []string                  `yaml:"required"`
+	Properties       map[string]*openAPISchema `yaml:"properties"`
+	Items            *openAPISchema            `yaml:"items"`
+	AllOf            []*openAPISchema          `yaml:"allOf"`
+	Minimum          *float64                  `yaml:"minimum"`
+	Maximum          *float64                  `yaml:"maximum"`
+	ExclusiveMinimum bool                      `yaml:"exclusiveMinimum"`
+	ExclusiveMaximum bool                      `yaml:"exclusiveMaximum"`
+	MinLength        *int                      `yaml:"minLength"`
+	MaxLength        *int                      `yaml:"maxLength"`
+	MinItems         *int                      `yaml:"minItems"`
+	MaxItems         *int                      `yaml:"maxItems"`
+	Pattern          string                    `yaml:"pattern"`
+
+	pattern *regexp.Regexp
+}
+
+// openAPIRoute is an operation with its path split into segments, "{name}" segments match any value
+type openAPIRoute struct {
+	method   string
+	segments []string
+	literals int
+	op       *openAPIOperation
+	body     *openAPISchema // JSON body schema, nil if the operation has none
+}
+
+// LoadOpenAPISpec parses an OpenAPI 3 document in YAML or JSON
+func LoadOpenAPISpec(data []byte) (*OpenAPISpec, error) {
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse OpenAPI spec: %w", err)
+	}
+	spec := &OpenAPISpec{schemas: doc.Components.Schemas}
+
+	for path, item := range doc.Paths {
+		// Parameters declared on the path apply to all its operations
+		var shared []*openAPIParameter
+		if node, ok := item["parameters"]; ok {
+			if err := node.Decode(&shared); err != nil {
+				return nil, fmt.Errorf("parse parameters of %s: %w", path, err)
+			}
+		}
+		for method, node := range item {
+			method = strings.ToUpper(method)
+			if !isHTTPMethod(method) {
+				continue
+			}
+			op := new(openAPIOperation)
+			if err := node.Decode(op); err != nil {
+				return nil, fmt.Errorf("parse operation %s %s: %w", method, path, err)
+			}
+			op.Parameters = append(append([]*openAPIParameter{}, shared...), op.Parameters...)
+			route := &openAPIRoute{method: method, segments: splitPath(path), op: op}
+			for _, s := range route.segments {
+				if !strings.HasPrefix(s, "{") {
+					route.literals++
+				}
+			}
+			if op.RequestBody != nil {
+				for contentType, media := range op.RequestBody.Content {
+					if strings.Contains(contentType, "json") {
+						route.body = media.Schema
+					}
+				}
+			}
+			spec.routes = append(spec.routes, route)
+		}
+	}
+	// Literal segments win over templates, e.g. /users/me over /users/{id}
+	sort.SliceStable(spec.routes, func(i, j int) bool { return spec.routes[i].literals > spec.routes[j].literals })
+
+	for _, route := range spec.routes {
+		for _, p := range route.op.Parameters {
+			if err := spec.prepare(p.Schema, map[*openAPISchema]bool{}); err != nil {
+				return nil, fmt.Errorf("parameter %q of %s: %w", p.Name, route.op.OperationID, err)
+			}
+		}
+		if err := spec.prepare(route.body, map[*openAPISchema]bool{}); err != nil {
+			return nil, fmt.Errorf("request body of %s: %w", route.op.OperationID, err)
+		}
+	}
+	return spec, nil
+}
+
+// LoadOpenAPISpecFile reads and parses the OpenAPI 3 document at name
+func LoadOpenAPISpecFile(name string) (*OpenAPISpec, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return LoadOpenAPISpec(data)
+}
+
+// prepare checks references and compiles patterns once
+func (s *OpenAPISpec) prepare(schema *openAPISchema, seen map[*openAPISchema]bool) error {
+	if schema == nil || seen[schema] {
+		return nil
+	}
+	seen[schema] = true
+	if schema.Ref != "" {
+		target, err := s.resolve(schema.Ref)
+		if err != nil {
+			return err
+		}
+		return s.prepare(target, seen)
+	}
+	if schema.Pattern != "" {
+		re, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", schema.Pattern, err)
+		}
+		schema.pattern = re
+	}
+	children := append([]*openAPISchema{schema.Items}, schema.AllOf...)
+	for _, p := range schema.Properties {
+		children = append(children, p)
+	}
+	for _, child := range children {
+		if err := s.prepare(child, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *OpenAPISpec) resolve(ref string) (*openAPISchema, error) {
+	name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+	if !ok {
+		return nil, fmt.Errorf("unsupported reference %q, only #/components/schemas/ is supported", ref)
+	}
+	target, ok := s.schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q", ref)
+	}
+	return target, nil
+}
+
+// match returns the route of the request and its path parameters
+func (s *OpenAPISpec) match(method, path string) (*openAPIRoute, map[string]string) {
+	segments := splitPath(path)
+	for _, route := range s.routes {
+		if route.method != method || len(route.segments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		matched := true
+		for i, seg := range route.segments {
+			if name, ok := strings.CutPrefix(seg, "{"); ok {
+				params[strings.TrimSuffix(name, "}")] = segments[i]
+			} else if seg != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return route, params
+		}
+	}
+	return nil, nil
+}
+
+// OpenAPIValidateConfig defines the config for the OpenAPIValidate middleware
+type OpenAPIValidateConfig struct {
+	// Skipper defines a function to skip middleware, e.g. for single routes
+	Skipper func(*gin.Context) bool
+
+	// ExcludedOperations are operationIds of the spec whose requests are not validated
+	ExcludedOperations []string
+
+	// RejectUnknownRoutes rejects requests matching no operation of the spec with 404, they pass by default
+	RejectUnknownRoutes bool
+
+	// ErrorHandler answers invalid requests, status is 400 or 404
+	ErrorHandler func(c *gin.Context, status int, err error)
+}
+
+// DefaultOpenAPIValidateConfig returns a default OpenAPI validation configuration
+func DefaultOpenAPIValidateConfig() OpenAPIValidateConfig {
+	return OpenAPIValidateConfig{
+		Skipper:             nil,
+		ExcludedOperations:  nil,
+		RejectUnknownRoutes: false,
+		ErrorHandler:        defaultOpenAPIValidateErrorHandler,
+	}
+}
+
+// OpenAPIValidate returns an OpenAPI request validation middleware with default configuration
+func OpenAPIValidate(spec *OpenAPISpec) gin.HandlerFunc {
+	return OpenAPIValidateWithConfig(spec, DefaultOpenAPIValidateConfig())
+}
+
+// OpenAPIValidateWithConfig returns a middleware validating path, query and header parameters and JSON bodies
+// against spec before the handler runs, independently of the binding tags of the generated code.
+// Generate the spec with naming=proto when services bind parameters by proto field name.
+func OpenAPIValidateWithConfig(spec *OpenAPISpec, config OpenAPIValidateConfig) gin.HandlerFunc {
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultOpenAPIValidateErrorHandler
+	}
+	excluded := make(map[string]bool, len(config.ExcludedOperations))
+	for _, op := range config.ExcludedOperations {
+		excluded[op] = true
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		route, params := spec.match(c.Request.Method, c.Request.URL.Path)
+		if route == nil {
+			if config.RejectUnknownRoutes {
+				config.ErrorHandler(c, http.StatusNotFound, fmt.Errorf("%s %s is not described by the OpenAPI spec", c.Request.Method, c.Request.URL.Path))
+				c.Abort()
+				return
+			}
+			c.Next()
+			return
+		}
+		if excluded[route.op.OperationID] {
+			c.Next()
+			return
+		}
+		if err := spec.validateRequest(c, route, params); err != nil {
+			config.ErrorHandler(c, http.StatusBadRequest, err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func (s *OpenAPISpec) validateRequest(c *gin.Context, route *openAPIRoute, pathParams map[string]string) error {
+	query := c.Request.URL.Query()
+	for _, p := range route.op.Parameters {
+		var values []string
+		switch p.In {
+		case "path":
+			values = []string{pathParams[p.Name]}
+		case "query":
+			values = query[p.Name]
+		case "header":
+			values = c.Request.Header.Values(p.Name)
+		default:
+			continue
+		}
+		if len(values) == 0 || values[0] == "" {
+			if p.Required {
+				return fmt.Errorf("%s parameter %q is required", p.In, p.Name)
+			}
+			continue
+		}
+		if err := s.validateParam(p, values); err != nil {
+			return fmt.Errorf("%s parameter %q: %w", p.In, p.Name, err)
+		}
+	}
+
+	if route.op.RequestBody == nil {
+		return nil
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return fmt.Errorf("read request body: %w", err)
+	}
+	// Restore the body for the binding layer
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 {
+		if route.op.RequestBody.Required {
+			return fmt.Errorf("request body is required")
+		}
+		return nil
+	}
+	if route.body == nil || !strings.Contains(c.ContentType(), "json") {
+		return nil
+	}
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("request body is not valid JSON: %w", err)
+	}
+	return s.validate(route.body, v, "body")
+}
+
+// validateParam converts the raw parameter values to the schema type before validating them
+func (s *OpenAPISpec) validateParam(p *openAPIParameter, values []string) error {
+	schema := s.deref(p.Schema)
+	if schema == nil {
+		return nil
+	}
+	if schema.Type == "array" {
+		items := make([]any, len(values))
+		for i, raw := range values {
+			v, err := parseParamValue(s.deref(schema.Items), raw)
+			if err != nil {
+				return err
+			}
+			items[i] = v
+		}
+		return s.validate(schema, items, p.Name)
+	}
+	v, err := parseParamValue(schema, values[0])
+	if err != nil {
+		return err
+	}
+	return s.validate(schema, v, p.Name)
+}
+
+func parseParamValue(schema *openAPISchema, raw string) (any, error) {
+	if schema == nil {
+		return raw, nil
+	}
+	switch schema.Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		return json.Number(raw), nil
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", raw)
+		}
+		return b, nil
+	}
+	return raw, nil
+}
+
+func (s *OpenAPISpec) deref(schema *openAPISchema) *openAPISchema {
+	for schema != nil && schema.Ref != "" {
+		schema, _ = s.resolve(schema.Ref)
+	}
+	return schema
+}
+
+// validate checks v decoded with json.Decoder.UseNumber against schema, path locates v in error messages
+func (s *OpenAPISpec) validate(schema *openAPISchema, v any, path string) error {
+	schema = s.deref(schema)
+	if schema == nil {
+		return nil
+	}
+	for _, sub := range schema.AllOf {
+		if err := s.validate(sub, v, path); err != nil {
+			return err
+		}
+	}
+	if v == nil {
+		if schema.Nullable || schema.Type == "" {
+			return nil
+		}
+		return fmt.Errorf("%s must not be null", path)
+	}
+	if len(schema.Enum) > 0 && !enumContains(schema.Enum, v) {
+		return fmt.Errorf("%s must be one of %v", path, schema.Enum)
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s must be an object", path)
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		for name, prop := range schema.Properties {
+			if value, ok := obj[name]; ok {
+				if err := s.validate(prop, value, path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+		if schema.MinItems != nil && len(items) < *schema.MinItems {
+			return fmt.Errorf("%s must have at least %d items", path, *schema.MinItems)
+		}
+		if schema.MaxItems != nil && len(items) > *schema.MaxItems {
+			return fmt.Errorf("%s must have at most %d items", path, *schema.MaxItems)
+		}
+		for i, item := range items {
+			if err := s.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s must be a number", path)
+		}
+		f, _ := n.Float64()
+		if schema.Type == "integer" && f != math.Trunc(f) {
+			return fmt.Errorf("%s must be an integer", path)
+		}
+		return checkRange(schema, f, path)
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			// 64-bit integers are described as strings but encoding/json clients send numbers
+			if _, isNumber := v.(json.Number); isNumber && strings.HasSuffix(schema.Format, "int64") {
+				return nil
+			}
+			return fmt.Errorf("%s must be a string", path)
+		}
+		length := len([]rune(str))
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return fmt.Errorf("%s must be at least %d characters", path, *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return fmt.Errorf("%s must be at most %d characters", path, *schema.MaxLength)
+		}
+		if schema.pattern != nil && !schema.pattern.MatchString(str) {
+			return fmt.Errorf("%s must match %q", path, schema.Pattern)
+		}
+	}
+	return nil
+}
+
+func checkRange(schema *openAPISchema, f float64, path string) error {
+	if lo := schema.Minimum; lo != nil && (f < *lo || schema.ExclusiveMinimum && f == *lo) {
+		return fmt.Errorf("%s must be greater than %s%v", path, orEqual(!schema.ExclusiveMinimum), *lo)
+	}
+	if hi := schema.Maximum; hi != nil && (f > *hi || schema.ExclusiveMaximum && f == *hi) {
+		return fmt.Errorf("%s must be less than %s%v", path, orEqual(!schema.ExclusiveMaximum), *hi)
+	}
+	return nil
+}
+
+func orEqual(inclusive bool) string {
+	if inclusive {
+		return "or equal to "
+	}
+	return ""
+}
+
+// enumContains compares numbers by value, enum values decoded from YAML are ints or floats
+func enumContains(enum []any, v any) bool {
+	for _, e := range enum {
+		if n, ok := v.(json.Number); ok {
+			f, _ := n.Float64()
+			if ef, err := strconv.ParseFloat(fmt.Sprint(e), 64); err == nil && ef == f {
+				return true
+			}
+			continue
+		}
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func isHTTPMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+		http.MethodOptions, http.MethodHead, http.MethodPatch, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// defaultOpenAPIValidateErrorHandler is the default error handler for the OpenAPIValidate middleware
+func defaultOpenAPIValidateErrorHandler(c *gin.Context, status int, err error) {
+	c.JSON(status, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOpenAPISpec = `
+openapi: 3.0.3
+paths:
+  /users/{user_id}:
+    parameters:
+      - name: user_id
+        in: path
+        required: true
+        schema: {type: integer, minimum: 1}
+    get:
+      operationId: GetUser
+      parameters:
+        - name: fields
+          in: query
+          schema: {type: array, items: {type: string, enum: [name, email]}}
+        - name: X-Tenant
+          in: header
+          required: true
+          schema: {type: string}
+  /users/me:
+    get:
+      operationId: GetMe
+  /users:
+    post:
+      operationId: CreateUser
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/CreateUserRequest'}
+components:
+  schemas:
+    CreateUserRequest:
+      type: object
+      required: [name]
+      properties:
+        name: {type: string, minLength: 2}
+        id: {type: string, format: int64}
+        tags: {type: array, maxItems: 2, items: {type: string}}
+`
+
+func TestOpenAPIValidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spec, err := LoadOpenAPISpec([]byte(testOpenAPISpec))
+	require.NoError(t, err)
+
+	e := gin.New()
+	e.Use(OpenAPIValidate(spec))
+	ok := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	}
+	e.GET("/users/:id", ok)
+	e.POST("/users", ok)
+
+	for _, tc := range []struct {
+		method, target, body, want string
+		status                     int
+	}{
+		{"GET", "/users/7?fields=name&fields=email", "", "", http.StatusOK},
+		{"GET", "/users/me", "", "", http.StatusOK},
+		{"GET", "/users/0", "", "user_id must be greater than or equal to 1", http.StatusBadRequest},
+		{"GET", "/users/abc", "", `is not a number`, http.StatusBadRequest},
+		{"GET", "/users/7?fields=phone", "", "fields[0] must be one of [name email]", http.StatusBadRequest},
+		{"POST", "/users", `{"name":"ann","id":12,"tags":["a"]}`, `{"name":"ann","id":12,"tags":["a"]}`, http.StatusOK},
+		{"POST", "/users", `{"id":"12"}`, "body.name is required", http.StatusBadRequest},
+		{"POST", "/users", `{"name":"a"}`, "body.name must be at least 2 characters", http.StatusBadRequest},
+		{"POST", "/users", `{"name":"ann","tags":["a","b","c"]}`, "body.tags must have at most 2 items", http.StatusBadRequest},
+		{"POST", "/users", ``, "request body is required", http.StatusBadRequest},
+	} {
+		req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant", "acme")
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		assert.Equal(t, tc.status, w.Code, tc.target)
+		assert.Contains(t, w.Body.String(), tc.want, tc.target)
+	}
+
+	req := httptest.NewRequest("GET", "/users/7", nil)
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Contains(t, w.Body.String(), `X-Tenant\" is required`)
+
+	_, err = LoadOpenAPISpec([]byte("paths:\n  /a:\n    post:\n      requestBody:\n        content:\n          application/json:\n            schema: {$ref: '#/components/schemas/Missing'}\n"))
+	assert.ErrorContains(t, err, `unknown schema "#/components/schemas/Missing"`)
+}
+
+func TestOpenAPIValidateExcludedOperations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	spec, err := LoadOpenAPISpec([]byte(testOpenAPISpec))
+	require.NoError(t, err)
+
+	e := gin.New()
+	e.Use(OpenAPIValidateWithConfig(spec, OpenAPIValidateConfig{ExcludedOperations: []string{"CreateUser"}, RejectUnknownRoutes: true}))
+	e.POST("/users", func(c *gin.Context) { c.Status(http.StatusOK) })
+	e.GET("/other", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest("POST", "/users", strings.NewReader(`{}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest("GET", "/other", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenSearchConfig defines the config for the OpenSearch / Elasticsearch log sink
+type OpenSearchConfig struct {
+	// URL of the cluster, e.g. http://localhost:9200
+	URL string
+
+	// Index name, Go time layouts in braces are expanded, e.g. "access-{2006.01.02}"
+	Index string
+
+	// Optional basic auth credentials
+	Username string
+	Password string
+
+	// Optional. Default value is http.DefaultClient
+	Client *http.Client
+
+	// Batching behavior
+	Batch BatchSinkConfig
+}
+
+// OpenSearchWriter is a BatchWriter using the _bulk API, it works with OpenSearch and Elasticsearch
+type OpenSearchWriter struct {
+	config OpenSearchConfig
+}
+
+// NewOpenSearchWriter creates an OpenSearchWriter
+func NewOpenSearchWriter(config OpenSearchConfig) *OpenSearchWriter {
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	config.URL = strings.TrimSuffix(config.URL, "/")
+	return &OpenSearchWriter{config: config}
+}
+
+// NewOpenSearchSink creates a batching LogSink shipping entries to OpenSearch / Elasticsearch
+func NewOpenSearchSink(config OpenSearchConfig) *BatchSink {
+	return NewBatchSink(NewOpenSearchWriter(config), config.Batch)
+}
+
+// WriteBatch indexes entries with a single _bulk request
+func (w *OpenSearchWriter) WriteBatch(ctx context.Context, entries []json.RawMessage) error {
+	action, err := json.Marshal(map[string]map[string]string{
+		"index": {"_index": w.index(time.Now())},
+	})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	for _, entry := range entries {
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(entry)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL+"/_bulk", &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if w.config.Username != "" {
+		req.SetBasicAuth(w.config.Username, w.config.Password)
+	}
+
+	resp, err := w.config.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("opensearch bulk request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("opensearch bulk request failed with status %d: %s", resp.StatusCode, data)
+	}
+
+	// The bulk API reports per item failures with status 200
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err == nil && result.Errors {
+		return fmt.Errorf("opensearch bulk request had item errors: %s", data)
+	}
+	return nil
+}
+
+// index expands time layouts in braces of the configured index name
+func (w *OpenSearchWriter) index(t time.Time) string {
+	name := w.config.Index
+	for {
+		start := strings.IndexByte(name, '{')
+		end := strings.IndexByte(name, '}')
+		if start < 0 || end < start {
+			return name
+		}
+		name = name[:start] + t.Format(name[start+1:end]) + name[end+1:]
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"runtime/pprof"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+)
+
+// OperationLabel is the pprof label holding the operation of the request
+const OperationLabel = "operation"
+
+// ProfileLabelsConfig defines the config for ProfileLabels middleware
+type ProfileLabelsConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Labels returns additional label key/value pairs for the request, e.g. the tenant. An odd number of
+	// strings is ignored.
+	Labels func(*gin.Context) []string
+}
+
+// DefaultProfileLabelsConfig returns a default profile labels configuration
+func DefaultProfileLabelsConfig() ProfileLabelsConfig {
+	return ProfileLabelsConfig{
+		Skipper: nil,
+		Labels:  nil,
+	}
+}
+
+// ProfileLabels returns a middleware setting the runtime/pprof label "operation" while the request is handled,
+// so CPU profiles can be broken down per operation, e.g. with `go tool pprof -tagfocus operation=GetUser`
+func ProfileLabels() gin.HandlerFunc {
+	return ProfileLabelsWithConfig(DefaultProfileLabelsConfig())
+}
+
+// ProfileLabelsWithConfig returns a profile labels middleware with config. The labels are set on the handling
+// goroutine, which goroutines started by the handler inherit, and on the request context for pprof.Do.
+// It must be registered through the generated global middleware option to see the operation.
+func ProfileLabelsWithConfig(config ProfileLabelsConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		var labels []string
+		if op := ginpb.OperationFromContext(c); op != "" {
+			labels = append(labels, OperationLabel, op)
+		}
+		if config.Labels != nil {
+			// Unpaired labels are dropped rather than failing the request
+			if extra := config.Labels(c); len(extra)%2 == 0 {
+				labels = append(labels, extra...)
+			}
+		}
+		if len(labels) == 0 {
+			c.Next()
+			return
+		}
+		pprof.Do(c.Request.Context(), pprof.Labels(labels...), func(ctx context.Context) {
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime/pprof"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-kenka/ginpb"
+)
+
+func TestProfileLabels(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	var operation, tenant string
+	e.GET("/users", func(c *gin.Context) { c.Set(ginpb.OperationKey, "ListUsers") }, ProfileLabelsWithConfig(ProfileLabelsConfig{
+		Labels: func(c *gin.Context) []string { return []string{"tenant", c.GetHeader("X-Tenant")} },
+	}), func(c *gin.Context) {
+		operation, _ = pprof.Label(c.Request.Context(), OperationLabel)
+		tenant, _ = pprof.Label(c.Request.Context(), "tenant")
+	})
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("X-Tenant", "acme")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "ListUsers", operation)
+	assert.Equal(t, "acme", tenant)
+}
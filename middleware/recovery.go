@@ -7,6 +7,8 @@ import (
 	"runtime/debug"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/report"
 )
 
 // RecoveryConfig defines the config for Recovery middleware
@@ -22,6 +24,9 @@ type RecoveryConfig struct {
 
 	// Enable detailed error information
 	EnableDetailedError bool
+
+	// Reporter captures recovered panics with their stack trace, e.g. to Sentry
+	Reporter report.Reporter
 }
 
 // DefaultRecoveryConfig returns a default recovery configuration
@@ -65,12 +70,18 @@ func RecoveryWithConfig(config RecoveryConfig) gin.HandlerFunc {
 				// Log the panic
 				fmt.Printf("[Recovery] panic recovered:\n%s\n%s\n", err, stack)
 
+				// Capture the panic before the response is written
+				event := reportEvent(c, report.SourcePanic)
+				event.Status = http.StatusInternalServerError
+				event.Stack = stack
+				report.Capture(c.Request.Context(), config.Reporter, &report.PanicError{Value: err}, event)
+
 				// Create detailed error response if enabled
 				if config.EnableDetailedError {
 					response := gin.H{
 						"error":     "panic recovered",
 						"message":   fmt.Sprintf("%v", err),
-						"operation": c.GetString("operation"),
+						"operation": ginpb.OperationFromContext(c),
 						"path":      c.Request.URL.Path,
 						"method":    c.Request.Method,
 					}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/report"
+)
+
+// ErrorReporterConfig defines the config for ErrorReporter middleware
+type ErrorReporterConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Reporter captures the errors
+	Reporter report.Reporter
+
+	// ReportClientErrors also captures errors of requests answered with 4xx and binding errors
+	ReportClientErrors bool
+}
+
+// DefaultErrorReporterConfig returns a default error reporter configuration
+func DefaultErrorReporterConfig() ErrorReporterConfig {
+	return ErrorReporterConfig{
+		Skipper:            nil,
+		ReportClientErrors: false,
+	}
+}
+
+// ErrorReporter returns a middleware capturing the errors handlers add to the gin context with r,
+// e.g. the errors returned by service methods. Register it before the middleware encoding errors into responses.
+func ErrorReporter(r report.Reporter) gin.HandlerFunc {
+	config := DefaultErrorReporterConfig()
+	config.Reporter = r
+	return ErrorReporterWithConfig(config)
+}
+
+// ErrorReporterWithConfig returns an error reporter middleware with config
+func ErrorReporterWithConfig(config ErrorReporterConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+
+		c.Next()
+
+		if len(c.Errors) == 0 {
+			return
+		}
+		status := c.Writer.Status()
+		if !config.ReportClientErrors && status >= 400 && status < 500 {
+			return
+		}
+		event := reportEvent(c, report.SourceServer)
+		event.Status = status
+		for _, e := range c.Errors {
+			if e.IsType(gin.ErrorTypeBind) && !config.ReportClientErrors {
+				continue
+			}
+			report.Capture(c.Request.Context(), config.Reporter, e.Err, event)
+		}
+	}
+}
+
+// reportEvent returns the report event of the request of c
+func reportEvent(c *gin.Context, source report.Source) report.Event {
+	return report.Event{
+		Source:    source,
+		Operation: ginpb.OperationFromContext(c),
+		Method:    c.Request.Method,
+		URL:       c.Request.URL.String(),
+		RequestID: c.GetHeader("X-Request-ID"),
+	}
+}
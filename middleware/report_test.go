@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb/report"
+)
+
+type capturedError struct {
+	err   error
+	event report.Event
+}
+
+func TestErrorReporting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var (
+		mu       sync.Mutex
+		captured []capturedError
+	)
+	reporter := report.Func(func(_ context.Context, err error, event report.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		captured = append(captured, capturedError{err, event})
+	})
+	recovery := DefaultRecoveryConfig()
+	recovery.Reporter = reporter
+	e := gin.New()
+	e.Use(RecoveryWithConfig(recovery), ErrorReporter(reporter))
+	e.GET("/panic", func(c *gin.Context) { panic("boom") })
+	e.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(errors.New("db down"))
+		c.Status(http.StatusServiceUnavailable)
+	})
+	e.GET("/invalid", func(c *gin.Context) {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("bad id")).SetType(gin.ErrorTypeBind)
+	})
+
+	for _, path := range []string{"/panic", "/fail", "/invalid"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Request-ID", "req-1")
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// Client errors are not reported
+	require.Len(t, captured, 2)
+	assert.True(t, report.IsPanic(captured[0].err))
+	assert.Equal(t, report.SourcePanic, captured[0].event.Source)
+	assert.Equal(t, http.StatusInternalServerError, captured[0].event.Status)
+	assert.NotEmpty(t, captured[0].event.Stack)
+	assert.Equal(t, "req-1", captured[0].event.RequestID)
+
+	assert.EqualError(t, captured[1].err, "db down")
+	assert.Equal(t, report.SourceServer, captured[1].event.Source)
+	assert.Equal(t, http.StatusServiceUnavailable, captured[1].event.Status)
+	assert.Equal(t, "/fail", captured[1].event.URL)
+}
@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrSinkClosed is returned when writing to a closed sink
+var ErrSinkClosed = errors.New("log sink is closed")
+
+// LogSink receives structured log entries, such as LogEntry from the Logging middleware
+type LogSink interface {
+	// Write queues an entry, it must not block the request
+	Write(entry interface{}) error
+
+	// Close flushes pending entries and releases resources
+	Close() error
+}
+
+// BatchWriter ships a batch of JSON encoded entries to a log backend
+type BatchWriter interface {
+	WriteBatch(ctx context.Context, entries []json.RawMessage) error
+}
+
+// BatchSinkConfig defines the config for BatchSink
+type BatchSinkConfig struct {
+	// BatchSize is the number of entries sent at once. Default value is 500
+	BatchSize int
+
+	// FlushInterval sends incomplete batches after this delay. Default value is 1s
+	FlushInterval time.Duration
+
+	// QueueSize is the number of buffered entries, entries are dropped when it is full. Default value is 10000
+	QueueSize int
+
+	// Timeout bounds a single WriteBatch call. Default value is 10s
+	Timeout time.Duration
+
+	// ErrorHandler is called when a batch fails or entries are dropped, dropped is the number of lost entries
+	ErrorHandler func(err error, dropped int)
+}
+
+// DefaultBatchSinkConfig returns a default batch sink configuration
+func DefaultBatchSinkConfig() BatchSinkConfig {
+	return BatchSinkConfig{
+		BatchSize:     500,
+		FlushInterval: time.Second,
+		QueueSize:     10000,
+		Timeout:       10 * time.Second,
+		ErrorHandler:  nil,
+	}
+}
+
+// BatchSink is a LogSink that buffers entries and ships them in batches from a background goroutine
+type BatchSink struct {
+	writer BatchWriter
+	config BatchSinkConfig
+	queue  chan json.RawMessage
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewBatchSink creates a BatchSink shipping entries with w
+func NewBatchSink(w BatchWriter, config BatchSinkConfig) *BatchSink {
+	defaults := DefaultBatchSinkConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaults.QueueSize
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+
+	s := &BatchSink{
+		writer: w,
+		config: config,
+		queue:  make(chan json.RawMessage, config.QueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write encodes and queues an entry, it drops the entry if the queue is full
+func (s *BatchSink) Write(entry interface{}) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrSinkClosed
+	}
+	select {
+	case s.queue <- data:
+		return nil
+	default:
+		err := errors.New("log sink queue is full, entry dropped")
+		s.handleError(err, 1)
+		return err
+	}
+}
+
+// Close flushes the queued entries and stops the background goroutine
+func (s *BatchSink) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.queue)
+	s.mu.Unlock()
+
+	<-s.done
+	return nil
+}
+
+func (s *BatchSink) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]json.RawMessage, 0, s.config.BatchSize)
+	for {
+		select {
+		case entry, ok := <-s.queue:
+			if !ok {
+				s.flush(batch)
+				return
+			}
+			batch = append(batch, entry)
+			if len(batch) >= s.config.BatchSize {
+				s.flush(batch)
+				batch = make([]json.RawMessage, 0, s.config.BatchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				s.flush(batch)
+				batch = make([]json.RawMessage, 0, s.config.BatchSize)
+			}
+		}
+	}
+}
+
+func (s *BatchSink) flush(batch []json.RawMessage) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.Timeout)
+	defer cancel()
+	if err := s.writer.WriteBatch(ctx, batch); err != nil {
+		s.handleError(err, len(batch))
+	}
+}
+
+func (s *BatchSink) handleError(err error, dropped int) {
+	if s.config.ErrorHandler != nil {
+		s.config.ErrorHandler(err, dropped)
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenSearchSink(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		lines []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_bulk", r.URL.Path)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		scanner := bufio.NewScanner(r.Body)
+		mu.Lock()
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"errors":false}`))
+	}))
+	defer srv.Close()
+
+	sink := NewOpenSearchSink(OpenSearchConfig{
+		URL:   srv.URL,
+		Index: "access",
+		Batch: BatchSinkConfig{BatchSize: 2},
+	})
+	for i := 0; i < 3; i++ {
+		require.NoError(t, sink.Write(LogEntry{Status: 200 + i}))
+	}
+	require.NoError(t, sink.Close())
+	assert.ErrorIs(t, sink.Write(LogEntry{}), ErrSinkClosed)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, lines, 6)
+	for i := 0; i < 3; i++ {
+		assert.JSONEq(t, `{"index":{"_index":"access"}}`, lines[2*i])
+		var entry LogEntry
+		require.NoError(t, json.Unmarshal([]byte(lines[2*i+1]), &entry))
+		assert.Equal(t, 200+i, entry.Status)
+	}
+}
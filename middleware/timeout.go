@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// megabyte is the unit of TimeoutConfig.PerMB
+const megabyte = 1 << 20
+
+// TimeoutConfig defines the config for Timeout middleware
+type TimeoutConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Base is the deadline of requests without body
+	Base time.Duration
+
+	// PerMB is added to Base for every started megabyte of the Content-Length
+	PerMB time.Duration
+
+	// Max caps the deadline, requests of unknown length such as chunked uploads get Max
+	Max time.Duration
+}
+
+// DefaultTimeoutConfig returns a default timeout configuration
+func DefaultTimeoutConfig() TimeoutConfig {
+	return TimeoutConfig{
+		Skipper: nil,
+		Base:    10 * time.Second,
+		PerMB:   time.Second,
+		Max:     2 * time.Minute,
+	}
+}
+
+// Timeout returns a middleware bounding requests by base plus the default allowance per megabyte of body
+func Timeout(base time.Duration) gin.HandlerFunc {
+	config := DefaultTimeoutConfig()
+	config.Base = base
+	return TimeoutWithConfig(config)
+}
+
+// TimeoutWithConfig returns a middleware scaling the deadline of the request context with its Content-Length,
+// so large uploads are not cut off by a blanket timeout while small requests still fail fast. The read deadline
+// of the connection is moved along, overriding http.Server.ReadTimeout for the request when supported.
+func TimeoutWithConfig(config TimeoutConfig) gin.HandlerFunc {
+	defaults := DefaultTimeoutConfig()
+	if config.Base <= 0 {
+		config.Base = defaults.Base
+	}
+	if config.Max <= 0 {
+		config.Max = defaults.Max
+	}
+	if config.Max < config.Base {
+		config.Max = config.Base
+	}
+
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+
+		timeout := config.requestTimeout(c.Request.ContentLength)
+		// Connections without read deadline support, e.g. in tests, keep only the context deadline
+		_ = http.NewResponseController(c.Writer).SetReadDeadline(time.Now().Add(timeout))
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// requestTimeout returns the deadline of a request with a body of length bytes, -1 when unknown
+func (config TimeoutConfig) requestTimeout(length int64) time.Duration {
+	if length < 0 {
+		return config.Max
+	}
+	mb := (length + megabyte - 1) / megabyte
+	if config.PerMB > 0 && mb > int64((config.Max-config.Base)/config.PerMB) {
+		return config.Max
+	}
+	return config.Base + time.Duration(mb)*config.PerMB
+}
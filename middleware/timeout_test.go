@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	config := TimeoutConfig{Base: 5 * time.Second, PerMB: 2 * time.Second, Max: time.Minute}
+	assert.Equal(t, 5*time.Second, config.requestTimeout(0))
+	assert.Equal(t, 7*time.Second, config.requestTimeout(1))
+	assert.Equal(t, 9*time.Second, config.requestTimeout(megabyte+1))
+	assert.Equal(t, time.Minute, config.requestTimeout(1<<40), "the deadline is capped")
+	assert.Equal(t, time.Minute, config.requestTimeout(-1), "unknown lengths get the cap")
+
+	gin.SetMode(gin.TestMode)
+	var remaining time.Duration
+	e := gin.New()
+	e.POST("/", TimeoutWithConfig(config), func(c *gin.Context) {
+		deadline, _ := c.Request.Context().Deadline()
+		remaining = time.Until(deadline)
+	})
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", 3*megabyte))))
+	assert.InDelta(t, float64(11*time.Second), float64(remaining), float64(time.Second))
+}
@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/auth"
+	"github.com/go-kenka/ginpb/clock"
+)
+
+// UsageOtherClients counts the calls of clients beyond UsageConfig.MaxClients
+const UsageOtherClients = "(other)"
+
+// UsageConfig defines the config for the usage tracker
+type UsageConfig struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Client returns the identity of the caller, the subject of the auth principal, the X-Client-ID header
+	// or the product of the User-Agent by default
+	Client func(*gin.Context) string
+
+	// Version returns the API version of the request, the first path segment like "v1" by default
+	Version func(*gin.Context) string
+
+	// Operations are reported even without calls, e.g. the generated XOperations. Nil reports the
+	// operations of the routes recorded on Router, see ginpb.Routes.
+	Operations []string
+
+	// Router is the engine or group the services were registered on, used when Operations is nil
+	Router gin.IRouter
+
+	// MaxClients caps the clients counted per operation, further clients are counted as UsageOtherClients
+	MaxClients int
+
+	// Clock stamps the calls, the system clock by default
+	Clock clock.Clock
+}
+
+// DefaultUsageConfig returns a default usage tracker configuration
+func DefaultUsageConfig() UsageConfig {
+	return UsageConfig{
+		Skipper:    nil,
+		Client:     DefaultUsageClient,
+		Version:    DefaultUsageVersion,
+		MaxClients: 100,
+	}
+}
+
+// OperationUsage is the usage of an operation since the tracker started
+type OperationUsage struct {
+	Operation string           `json:"operation"`
+	Calls     int64            `json:"calls"`
+	FirstCall *time.Time       `json:"first_call,omitempty"`
+	LastCall  *time.Time       `json:"last_call,omitempty"`
+	Clients   map[string]int64 `json:"clients,omitempty"`
+	Versions  map[string]int64 `json:"versions,omitempty"`
+}
+
+// UsageTracker counts calls per operation, client and API version in memory, so platform teams find
+// endpoints nobody calls anymore and the clients to contact before deprecating the others
+type UsageTracker struct {
+	config UsageConfig
+	clock  clock.Clock
+
+	mu    sync.Mutex
+	usage map[string]*OperationUsage
+}
+
+// NewUsageTracker creates a usage tracker, use Middleware to record and Handler to report
+func NewUsageTracker(config UsageConfig) *UsageTracker {
+	defaults := DefaultUsageConfig()
+	if config.Client == nil {
+		config.Client = defaults.Client
+	}
+	if config.Version == nil {
+		config.Version = defaults.Version
+	}
+	if config.MaxClients <= 0 {
+		config.MaxClients = defaults.MaxClients
+	}
+	return &UsageTracker{config: config, clock: clock.OrSystem(config.Clock), usage: make(map[string]*OperationUsage)}
+}
+
+// Middleware counts every call of an operation, the operation is read once the handler returns so it can be
+// registered on the engine as well as through the generated global middleware option
+func (u *UsageTracker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if u.config.Skipper != nil && u.config.Skipper(c) {
+			c.Next()
+			return
+		}
+		c.Next()
+		if op := ginpb.OperationFromContext(c); op != "" {
+			u.Record(op, u.config.Client(c), u.config.Version(c))
+		}
+	}
+}
+
+// Record counts a call of operation by client with the API version, both may be empty
+func (u *UsageTracker) Record(operation, client, version string) {
+	now := u.clock.Now()
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.usage[operation]
+	if !ok {
+		usage = &OperationUsage{Operation: operation, FirstCall: &now, Clients: make(map[string]int64), Versions: make(map[string]int64)}
+		u.usage[operation] = usage
+	}
+	usage.Calls++
+	usage.LastCall = &now
+	if client != "" {
+		if _, known := usage.Clients[client]; !known && len(usage.Clients) >= u.config.MaxClients {
+			client = UsageOtherClients
+		}
+		usage.Clients[client]++
+	}
+	if version != "" {
+		usage.Versions[version]++
+	}
+}
+
+// Report returns the usage of every called or configured operation, ordered by operation
+func (u *UsageTracker) Report() []OperationUsage {
+	operations := u.config.Operations
+	if operations == nil && u.config.Router != nil {
+		for _, r := range ginpb.Routes(u.config.Router) {
+			operations = append(operations, r.Operation)
+		}
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	res := make([]OperationUsage, 0, len(u.usage))
+	for _, usage := range u.usage {
+		res = append(res, copyUsage(usage))
+	}
+	// Operations of additional bindings are listed once
+	unused := make(map[string]bool)
+	for _, op := range operations {
+		if _, ok := u.usage[op]; !ok && !unused[op] {
+			unused[op] = true
+			res = append(res, OperationUsage{Operation: op})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Operation < res[j].Operation })
+	return res
+}
+
+// Unused returns the operations of Report without calls since the tracker started
+func (u *UsageTracker) Unused() []string {
+	var res []string
+	for _, usage := range u.Report() {
+		if usage.Calls == 0 {
+			res = append(res, usage.Operation)
+		}
+	}
+	return res
+}
+
+// Handler writes the report as JSON, filtered by the "operation" query parameter, only operations without
+// calls with unused=true. Mount it on an admin route protected by authentication.
+func (u *UsageTracker) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, unused := c.Query("operation"), c.Query("unused") == "true"
+		report := u.Report()
+		filtered := report[:0]
+		for _, usage := range report {
+			if (op == "" || usage.Operation == op) && (!unused || usage.Calls == 0) {
+				filtered = append(filtered, usage)
+			}
+		}
+		c.JSON(http.StatusOK, filtered)
+	}
+}
+
+// copyUsage returns a snapshot of usage
+func copyUsage(usage *OperationUsage) OperationUsage {
+	res := *usage
+	res.Clients = make(map[string]int64, len(usage.Clients))
+	for k, v := range usage.Clients {
+		res.Clients[k] = v
+	}
+	res.Versions = make(map[string]int64, len(usage.Versions))
+	for k, v := range usage.Versions {
+		res.Versions[k] = v
+	}
+	return res
+}
+
+// DefaultUsageClient identifies the caller by the subject of its auth principal, the X-Client-ID header or
+// the product of its User-Agent, e.g. "billing-worker" of "billing-worker/1.4 go-resty"
+func DefaultUsageClient(c *gin.Context) string {
+	if p, ok := auth.PrincipalFromContext(c); ok && p.Subject != "" {
+		return p.Subject
+	}
+	if id := c.GetHeader("X-Client-ID"); id != "" {
+		return id
+	}
+	product, _, _ := strings.Cut(c.GetHeader("User-Agent"), " ")
+	name, _, _ := strings.Cut(product, "/")
+	return name
+}
+
+// versionSegment matches path segments naming API versions, e.g. v1, v2beta1
+var versionSegment = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// DefaultUsageVersion returns the first path segment of the request naming an API version, e.g. "v1" of
+// /api/v1/users, empty when there is none
+func DefaultUsageVersion(c *gin.Context) string {
+	for _, segment := range strings.Split(c.Request.URL.Path, "/") {
+		if versionSegment.MatchString(segment) {
+			return segment
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/go-kenka/ginpb/auth"
+	"github.com/go-kenka/ginpb/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageTracker(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	start := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	fake := clock.NewFake(start)
+	usage := NewUsageTracker(UsageConfig{
+		Operations: []string{"/example.Svc/ListUsers", "/example.Svc/GetUser", "/example.Svc/LegacyExport", "/example.Svc/ListUsers"},
+		MaxClients: 2,
+		Clock:      fake,
+	})
+	operation := func(op string) gin.HandlerFunc {
+		return func(c *gin.Context) { c.Set(ginpb.OperationKey, op) }
+	}
+	e := gin.New()
+	e.Use(usage.Middleware())
+	e.GET("/api/v1/users", operation("/example.Svc/ListUsers"), func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "" {
+			auth.SetPrincipal(c, &auth.Principal{Subject: "admin-console"})
+		}
+		c.Status(http.StatusOK)
+	})
+	e.GET("/api/v2beta1/users/:id", operation("/example.Svc/GetUser"), func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+	call := func(path string, header http.Header) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	call("/api/v1/users", http.Header{"User-Agent": {"billing-worker/1.4 go-resty"}})
+	fake.Advance(time.Minute)
+	call("/api/v1/users", http.Header{"Authorization": {"Bearer t"}})
+	call("/api/v1/users", http.Header{"X-Client-Id": {"mobile-app"}})
+	call("/api/v1/users", http.Header{"X-Client-Id": {"mobile-app"}})
+	call("/api/v2beta1/users/42", nil)
+	call("/unknown", nil)
+
+	report := usage.Report()
+	require.Len(t, report, 3)
+	assert.Equal(t, "/example.Svc/GetUser", report[0].Operation)
+	assert.Equal(t, int64(1), report[0].Calls)
+	assert.Empty(t, report[0].Clients)
+	assert.Equal(t, map[string]int64{"v2beta1": 1}, report[0].Versions)
+
+	assert.Equal(t, OperationUsage{Operation: "/example.Svc/LegacyExport"}, report[1])
+
+	list := report[2]
+	assert.Equal(t, int64(4), list.Calls)
+	assert.Equal(t, start, *list.FirstCall)
+	assert.Equal(t, start.Add(time.Minute), *list.LastCall)
+	// The third client exceeds MaxClients
+	assert.Equal(t, map[string]int64{"billing-worker": 1, "admin-console": 1, UsageOtherClients: 2}, list.Clients)
+	assert.Equal(t, map[string]int64{"v1": 4}, list.Versions)
+
+	assert.Equal(t, []string{"/example.Svc/LegacyExport"}, usage.Unused())
+
+	admin := gin.New()
+	admin.GET("/admin/usage", usage.Handler())
+	w := httptest.NewRecorder()
+	admin.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/usage?unused=true", nil))
+	var unused []OperationUsage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &unused))
+	assert.Equal(t, []OperationUsage{{Operation: "/example.Svc/LegacyExport"}}, unused)
+
+	w = httptest.NewRecorder()
+	admin.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/usage?operation=/example.Svc/GetUser", nil))
+	assert.Contains(t, w.Body.String(), `"calls":1`)
+	assert.NotContains(t, w.Body.String(), "ListUsers")
+}
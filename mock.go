@@ -0,0 +1,34 @@
+package ginpb
+
+import "sync"
+
+// MockCalls counts the calls per operation of the generated XHTTPServerMock, the zero value is ready to use
+// and safe for concurrent handlers
+type MockCalls struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// Record counts a call of operation
+func (m *MockCalls) Record(operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	m.calls[operation]++
+}
+
+// Calls returns how often operation was called since the mock was created or reset
+func (m *MockCalls) Calls(operation string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[operation]
+}
+
+// Reset forgets the recorded calls
+func (m *MockCalls) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
@@ -0,0 +1,29 @@
+package ginpb
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockCalls(t *testing.T) {
+	var calls MockCalls
+	assert.Zero(t, calls.Calls("/example.Svc/GetUser"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			calls.Record("/example.Svc/GetUser")
+		}()
+	}
+	wg.Wait()
+	calls.Record("/example.Svc/ListUsers")
+	assert.Equal(t, 10, calls.Calls("/example.Svc/GetUser"))
+	assert.Equal(t, 1, calls.Calls("/example.Svc/ListUsers"))
+
+	calls.Reset()
+	assert.Zero(t, calls.Calls("/example.Svc/GetUser"))
+}
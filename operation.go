@@ -0,0 +1,30 @@
+package ginpb
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OperationKey is the gin context key holding the current operation
+const OperationKey = "operation"
+
+// interned maps operation names to their boxed interface values
+var interned sync.Map
+
+// Intern returns operation boxed as an interface value shared by all callers.
+// Storing the result with gin.Context.Set avoids boxing the string on every request.
+func Intern(operation string) any {
+	if v, ok := interned.Load(operation); ok {
+		return v
+	}
+	v, _ := interned.LoadOrStore(operation, any(operation))
+	return v
+}
+
+// OperationFromContext returns the operation of the current request without allocating
+func OperationFromContext(c *gin.Context) string {
+	v, _ := c.Get(OperationKey)
+	op, _ := v.(string)
+	return op
+}
@@ -0,0 +1,33 @@
+package ginpb
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// benchOperation is a variable like the closure-captured operation in generated handlers
+var benchOperation = "/example.Svc/" + "ListUsers"
+
+func newBenchContext() *gin.Context {
+	return &gin.Context{Keys: make(map[string]any)}
+}
+
+func BenchmarkSetOperationString(b *testing.B) {
+	c := newBenchContext()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Set(OperationKey, benchOperation)
+		_ = OperationFromContext(c)
+	}
+}
+
+func BenchmarkSetOperationInterned(b *testing.B) {
+	c := newBenchContext()
+	op := Intern(benchOperation)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Set(OperationKey, op)
+		_ = OperationFromContext(c)
+	}
+}
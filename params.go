@@ -0,0 +1,119 @@
+package ginpb
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ParamKind is the type a path parameter is converted to before validation
+type ParamKind int
+
+// Path parameter kinds
+const (
+	ParamString ParamKind = iota
+	ParamInt
+	ParamUint
+	ParamFloat
+)
+
+// PathParam is the validation rule of a path parameter, generated from the binding tag of its field
+type PathParam struct {
+	Name string
+	Kind ParamKind
+	Rule string
+}
+
+// ValidatePathParams returns a handler rejecting requests whose path parameters violate their rules
+// with 400 before any middleware, body binding or business logic runs.
+// Rules are checked with the validator engine of gin's binding.Validator, custom validations apply.
+func ValidatePathParams(params ...PathParam) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		for _, p := range params {
+			if err := p.validate(v, c.Param(p.Name)); err != nil {
+				_ = c.AbortWithError(http.StatusBadRequest, err).SetType(gin.ErrorTypeBind)
+				return
+			}
+		}
+	}
+}
+
+func (p PathParam) validate(v *validator.Validate, raw string) error {
+	var (
+		value interface{}
+		err   error
+	)
+	switch p.Kind {
+	case ParamInt:
+		value, err = strconv.ParseInt(raw, 10, 64)
+	case ParamUint:
+		value, err = strconv.ParseUint(raw, 10, 64)
+	case ParamFloat:
+		value, err = strconv.ParseFloat(raw, 64)
+	default:
+		value = raw
+	}
+	if err != nil {
+		return fmt.Errorf("invalid path parameter %q: %q is not a number", p.Name, raw)
+	}
+	if err := v.Var(value, p.Rule); err != nil {
+		return fmt.Errorf("invalid path parameter %q: %q does not satisfy %q", p.Name, raw, p.Rule)
+	}
+	return nil
+}
+
+// PathWildcard is a path variable matching several segments, e.g. {name=shelves/*} or {path=**}.
+// Its segments are routed as separate gin parameters and joined back into the variable.
+type PathWildcard struct {
+	Name string
+	// Segments of the pattern: literals, ":param" for one segment and "*param" for the rest of the path
+	Segments []string
+}
+
+// JoinPathWildcards returns a handler setting each wildcard variable to the value of its segments,
+// e.g. name to "shelves/1" for /v1/shelves/1, so validation, middleware and binding see the whole value
+func JoinPathWildcards(wildcards ...PathWildcard) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, w := range wildcards {
+			setParam(c, w.Name, w.join(c))
+		}
+	}
+}
+
+func (w PathWildcard) join(c *gin.Context) string {
+	parts := make([]string, 0, len(w.Segments))
+	for _, s := range w.Segments {
+		switch {
+		case strings.HasPrefix(s, ":"):
+			parts = append(parts, c.Param(s[1:]))
+		case strings.HasPrefix(s, "*"):
+			// Catch-all values start with the slash, an empty rest matches no segment
+			if rest := strings.TrimPrefix(c.Param(s[1:]), "/"); rest != "" {
+				parts = append(parts, rest)
+			}
+		default:
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// setParam replaces the path parameter key of c or adds it
+func setParam(c *gin.Context, key, value string) {
+	for i := range c.Params {
+		if c.Params[i].Key == key {
+			c.Params[i].Value = value
+			return
+		}
+	}
+	c.Params = append(c.Params, gin.Param{Key: key, Value: value})
+}
@@ -0,0 +1,57 @@
+package ginpb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePathParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/users/:id/posts/:page", ValidatePathParams(
+		PathParam{Name: "id", Kind: ParamString, Rule: "required,uuid"},
+		PathParam{Name: "page", Kind: ParamInt, Rule: "min=1,max=100"},
+	), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for path, want := range map[string]int{
+		"/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts/1":   http.StatusOK,
+		"/users/not-a-uuid/posts/1":                             http.StatusBadRequest,
+		"/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts/0":   http.StatusBadRequest,
+		"/users/3fa85f64-5717-4562-b3fc-2c963f66afa6/posts/one": http.StatusBadRequest,
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, want, w.Code, path)
+	}
+}
+
+func TestJoinPathWildcards(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/v1/shelves/:name.2/books/:name.4", JoinPathWildcards(
+		PathWildcard{Name: "name", Segments: []string{"shelves", ":name.2", "books", ":name.4"}},
+	), func(c *gin.Context) {
+		c.String(http.StatusOK, c.Param("name"))
+	})
+	e.GET("/v1/files/*path.1", JoinPathWildcards(
+		PathWildcard{Name: "path", Segments: []string{"*path.1"}},
+	), func(c *gin.Context) {
+		c.String(http.StatusOK, c.Param("path"))
+	})
+
+	for path, want := range map[string]string{
+		"/v1/shelves/1/books/2": "shelves/1/books/2",
+		"/v1/files/a/b/c.txt":   "a/b/c.txt",
+		"/v1/files/":            "",
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, want, w.Body.String(), path)
+	}
+}
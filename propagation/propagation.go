@@ -0,0 +1,98 @@
+// Package propagation forwards an allowlist of request headers, e.g. X-Request-ID, Authorization and tenant
+// headers, from incoming requests to the calls generated clients make while serving them. The Middleware
+// captures the headers into the request context, clients created with client.WithHeaderPropagation send them.
+package propagation
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContextKey is the gin context key holding the captured headers
+const ContextKey = "propagation"
+
+// headersKey carries headers set with NewContext outside of gin
+type headersKey struct{}
+
+// DefaultHeaders are the headers propagated by default
+var DefaultHeaders = []string{"X-Request-ID", "Authorization", "X-Tenant-*"}
+
+// Config defines the config for the propagation Middleware
+type Config struct {
+	// Skipper defines a function to skip middleware
+	Skipper func(*gin.Context) bool
+
+	// Headers is the allowlist of propagated headers, a trailing * matches a prefix, e.g. "X-Tenant-*"
+	Headers []string
+}
+
+// DefaultConfig returns a default propagation configuration
+func DefaultConfig() Config {
+	return Config{
+		Skipper: nil,
+		Headers: DefaultHeaders,
+	}
+}
+
+// Middleware returns a middleware capturing the DefaultHeaders of requests
+func Middleware() gin.HandlerFunc {
+	return MiddlewareWithConfig(DefaultConfig())
+}
+
+// MiddlewareWithConfig returns a middleware capturing the allowlisted headers of requests into their context,
+// available through FromContext in handlers and service methods
+func MiddlewareWithConfig(config Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Skipper != nil && config.Skipper(c) {
+			c.Next()
+			return
+		}
+		if h := Capture(c.Request.Header, config.Headers); len(h) > 0 {
+			c.Set(ContextKey, h)
+		}
+		c.Next()
+	}
+}
+
+// Capture returns the headers of h matching the allowlist
+func Capture(h http.Header, allowlist []string) http.Header {
+	res := make(http.Header)
+	for name, values := range h {
+		if allowed(name, allowlist) {
+			res[name] = append([]string(nil), values...)
+		}
+	}
+	return res
+}
+
+// allowed reports whether the canonical header name matches an entry of the allowlist
+func allowed(name string, allowlist []string) bool {
+	for _, a := range allowlist {
+		if prefix, ok := strings.CutSuffix(a, "*"); ok {
+			if len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix) {
+				return true
+			}
+		} else if strings.EqualFold(name, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext returns a copy of ctx carrying h, e.g. to propagate headers from background jobs
+func NewContext(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, headersKey{}, h)
+}
+
+// FromContext returns the headers captured by the Middleware or set with NewContext.
+// It works with the gin context as well as the context passed to service methods.
+func FromContext(ctx context.Context) (http.Header, bool) {
+	if h, ok := ctx.Value(ContextKey).(http.Header); ok {
+		return h, true
+	}
+	h, ok := ctx.Value(headersKey{}).(http.Header)
+	return h, ok
+}
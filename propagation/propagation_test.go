@@ -0,0 +1,49 @@
+package propagation_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb/client"
+	"github.com/go-kenka/ginpb/propagation"
+)
+
+func TestPropagation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	downstream := gin.New()
+	downstream.GET("/echo", func(c *gin.Context) {
+		c.JSON(http.StatusOK, map[string]string{
+			"request_id": c.GetHeader("X-Request-ID"),
+			"tenant":     c.GetHeader("X-Tenant-Region"),
+			"auth":       c.GetHeader("Authorization"),
+			"cookie":     c.GetHeader("Cookie"),
+			"agent":      c.GetHeader("X-Agent"),
+		})
+	})
+	c := client.NewClient(client.WithInProcess(downstream), client.WithHeaderPropagation(), client.WithHeader("x-agent", "svc"))
+
+	upstream := gin.New()
+	upstream.Use(propagation.MiddlewareWithConfig(propagation.Config{Headers: append(propagation.DefaultHeaders, "X-Agent")}))
+	upstream.GET("/", func(ctx *gin.Context) {
+		var out map[string]string
+		require.NoError(t, c.Invoke(ctx, http.MethodGet, "/echo", nil, &out))
+		ctx.JSON(http.StatusOK, out)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	req.Header.Set("X-Tenant-Region", "eu")
+	req.Header.Set("Authorization", "Bearer user")
+	req.Header.Set("Cookie", "session=secret")
+	req.Header.Set("X-Agent", "browser")
+	w := httptest.NewRecorder()
+	upstream.ServeHTTP(w, req)
+
+	// Only allowlisted headers are forwarded, default headers of the client win
+	assert.JSONEq(t, `{"request_id":"req-1","tenant":"eu","auth":"Bearer user","cookie":"","agent":"svc"}`, w.Body.String())
+}
@@ -0,0 +1,118 @@
+package ginpb
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterConfig defines registration defaults shared by all services passed to RegisterAll
+type RegisterConfig struct {
+	// Middlewares are applied to every route of every service
+	Middlewares []gin.HandlerFunc
+
+	// OperationMiddlewares are applied to the routes of the matching operations
+	OperationMiddlewares map[string][]gin.HandlerFunc
+
+	// ResponseRewriters rewrite the replies of the matching operations
+	ResponseRewriters map[string]*ResponseRewriter
+
+	// Exposures are the exposures of the deployment, e.g. "public", see Exposed
+	Exposures []string
+
+	// JSONNaming selects how response field names and 64-bit integers are encoded
+	JSONNaming JSONNaming
+
+	// JSONEngine marshals replies where encoding/json would, nil uses encoding/json
+	JSONEngine JSONEngine
+
+	// RouteTable mounts the routes through a table supporting replacement and unregistration, nil mounts them directly
+	RouteTable *RouteTable
+	// KeyProvider encrypts and decrypts fields annotated with ginpb.encrypt
+	KeyProvider KeyProvider
+	// Validator validates bound requests of services generated with the validate option, nil uses DefaultValidator
+	Validator Validator
+	// Deduplicator drops duplicate deliveries of methods with a ginpb.message_id field, nil uses an in-memory store
+	Deduplicator *Deduplicator
+	// ErrorEncoder writes the errors returned by unary methods, nil uses RenderError
+	ErrorEncoder ErrorEncoder
+	// ResponseEncoder writes the replies of unary methods, nil writes them with RenderReply
+	ResponseEncoder ResponseEncoder
+}
+
+// ErrorEncoder writes the error returned by a service method to the response
+type ErrorEncoder func(c *gin.Context, err error)
+
+// ResponseEncoder writes the reply of a service method with the success status of the method
+type ResponseEncoder func(c *gin.Context, status int, reply any)
+
+// OperationMiddlewaresFor returns the operation middlewares bound to the given operations
+func (c RegisterConfig) OperationMiddlewaresFor(operations []string) map[string][]gin.HandlerFunc {
+	return forOperations(c.OperationMiddlewares, operations)
+}
+
+// ResponseRewritersFor returns the response rewriters bound to the given operations
+func (c RegisterConfig) ResponseRewritersFor(operations []string) map[string]*ResponseRewriter {
+	return forOperations(c.ResponseRewriters, operations)
+}
+
+// forOperations returns the entries of m keyed by the given operations
+func forOperations[T any](m map[string]T, operations []string) map[string]T {
+	res := make(map[string]T)
+	for _, op := range operations {
+		if v, ok := m[op]; ok {
+			res[op] = v
+		}
+	}
+	return res
+}
+
+// Exposed reports whether a method annotated with (ginpb.expose) = expose is registered in a deployment
+// with the given exposures. Methods without annotation and deployments without exposures register every route,
+// so an edge gateway passes "public" to drop internal-only routes while an internal gateway serves all of them.
+func Exposed(expose string, exposures []string) bool {
+	if expose == "" || len(exposures) == 0 {
+		return true
+	}
+	for _, e := range exposures {
+		if e == expose {
+			return true
+		}
+	}
+	return false
+}
+
+// Registration describes a generated service ready to be registered, see the generated XRegistration functions
+type Registration struct {
+	// Operations lists all operations of the service
+	Operations []string
+
+	// Register registers the service routes on r applying the shared defaults
+	Register func(r gin.IRouter, config RegisterConfig)
+}
+
+// RegisterAll registers all services on r
+func RegisterAll(r gin.IRouter, regs ...Registration) {
+	RegisterAllWithConfig(r, RegisterConfig{}, regs...)
+}
+
+// RegisterAllWithConfig registers all services on r with shared defaults.
+// It panics if the operation middlewares or response rewriters of config reference an operation none of the
+// services defines.
+func RegisterAllWithConfig(r gin.IRouter, config RegisterConfig, regs ...Registration) {
+	var known, referenced []string
+	for _, reg := range regs {
+		known = append(known, reg.Operations...)
+	}
+	for op := range config.OperationMiddlewares {
+		referenced = append(referenced, op)
+	}
+	for op := range config.ResponseRewriters {
+		referenced = append(referenced, op)
+	}
+	if err := ValidateOperations(known, referenced...); err != nil {
+		panic(err)
+	}
+
+	for _, reg := range regs {
+		reg.Register(r, config)
+	}
+}
@@ -0,0 +1,94 @@
+package ginpb
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExposed(t *testing.T) {
+	assert.True(t, Exposed("", []string{"public"}))
+	assert.True(t, Exposed("internal", nil))
+	assert.True(t, Exposed("internal", []string{"public", "internal"}))
+	assert.False(t, Exposed("internal", []string{"public"}))
+}
+
+func TestRegisterAllValidatesResponseRewriters(t *testing.T) {
+	reg := Registration{
+		Operations: []string{"/example.Svc/GetUser"},
+		Register:   func(gin.IRouter, RegisterConfig) {},
+	}
+	config := RegisterConfig{ResponseRewriters: map[string]*ResponseRewriter{"/example.Svc/GetUsr": {}}}
+	assert.PanicsWithError(t, `ginpb: unknown operations "/example.Svc/GetUsr": middleware and response rewriters bound to them would never run; use the generated Operation constants`, func() {
+		RegisterAllWithConfig(gin.New(), config, reg)
+	})
+}
+
+func TestRegisterAllSharesConfig(t *testing.T) {
+	var users, posts RegisterConfig
+	var routers []gin.IRouter
+	regs := []Registration{
+		{
+			Operations: []string{"/example.Users/GetUser", "/example.Users/ListUsers"},
+			Register: func(r gin.IRouter, config RegisterConfig) {
+				routers = append(routers, r)
+				users = config
+			},
+		},
+		{
+			Operations: []string{"/example.Posts/GetPost"},
+			Register: func(r gin.IRouter, config RegisterConfig) {
+				routers = append(routers, r)
+				posts = config
+			},
+		},
+	}
+
+	var calls []string
+	logger := func(*gin.Context) { calls = append(calls, "logger") }
+	audit := func(*gin.Context) { calls = append(calls, "audit") }
+	config := RegisterConfig{
+		Middlewares:          []gin.HandlerFunc{logger},
+		OperationMiddlewares: map[string][]gin.HandlerFunc{"/example.Posts/GetPost": {audit}},
+		Exposures:            []string{"public"},
+		JSONNaming:           JSONCamelCase,
+	}
+	r := gin.New()
+	RegisterAllWithConfig(r, config, regs...)
+
+	// Every service gets the same router and defaults
+	assert.Equal(t, []gin.IRouter{r, r}, routers)
+	for _, got := range []RegisterConfig{users, posts} {
+		require.Len(t, got.Middlewares, 1)
+		got.Middlewares[0](nil)
+		assert.Equal(t, []string{"public"}, got.Exposures)
+		assert.Equal(t, JSONCamelCase, got.JSONNaming)
+	}
+	assert.Equal(t, []string{"logger", "logger"}, calls)
+
+	// Operation middlewares are picked by the service owning the operation
+	assert.Empty(t, users.OperationMiddlewaresFor(regs[0].Operations))
+	assert.Len(t, posts.OperationMiddlewaresFor(regs[1].Operations)["/example.Posts/GetPost"], 1)
+
+	// RegisterAll registers every service with empty defaults
+	users, posts, routers = RegisterConfig{}, RegisterConfig{}, nil
+	RegisterAll(r, regs...)
+	assert.Len(t, routers, 2)
+	assert.Empty(t, users.Middlewares)
+	assert.Empty(t, posts.Middlewares)
+}
+
+func TestRegisterAllValidatesOperationMiddlewares(t *testing.T) {
+	registered := false
+	reg := Registration{
+		Operations: []string{"/example.Svc/GetUser"},
+		Register:   func(gin.IRouter, RegisterConfig) { registered = true },
+	}
+	config := RegisterConfig{OperationMiddlewares: map[string][]gin.HandlerFunc{"/example.Svc/GetUsr": {}}}
+	assert.PanicsWithError(t, `ginpb: unknown operations "/example.Svc/GetUsr": middleware and response rewriters bound to them would never run; use the generated Operation constants`, func() {
+		RegisterAllWithConfig(gin.New(), config, reg)
+	})
+	assert.False(t, registered, "nothing is registered when the config is invalid")
+}
@@ -0,0 +1,114 @@
+// Package report captures the errors of servers and clients in one place, e.g. to send them to Sentry.
+// middleware.Recovery reports panics, middleware.ErrorReporter the errors of handlers and
+// client.ReportingErrorMiddleware the failed calls of generated clients.
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+// Source is the subsystem an error was captured in
+type Source string
+
+// Sources of captured errors
+const (
+	SourcePanic  Source = "panic"
+	SourceServer Source = "server"
+	SourceClient Source = "client"
+)
+
+// Event is the context of a captured error
+type Event struct {
+	Source Source
+	// Operation is the ginpb operation of the request, e.g. /user.v1.UserService/GetUser
+	Operation string
+	// Method and URL of the request
+	Method string
+	URL    string
+	// Status is the HTTP status of the response, zero when no response was received
+	Status int
+	// RequestID is the X-Request-ID header of the request
+	RequestID string
+	// Stack is the stack trace of a panic
+	Stack []byte
+	// Tags are set with WithTag on the request context, e.g. the tenant
+	Tags map[string]string
+}
+
+// Reporter captures errors, implementations must be safe for concurrent use and should not block
+type Reporter interface {
+	Capture(ctx context.Context, err error, event Event)
+}
+
+// Func adapts a function to a Reporter
+type Func func(ctx context.Context, err error, event Event)
+
+// Capture calls f(ctx, err, event)
+func (f Func) Capture(ctx context.Context, err error, event Event) {
+	f(ctx, err, event)
+}
+
+// Multi returns a Reporter capturing every error with all reporters
+func Multi(reporters ...Reporter) Reporter {
+	return Func(func(ctx context.Context, err error, event Event) {
+		for _, r := range reporters {
+			r.Capture(ctx, err, event)
+		}
+	})
+}
+
+// Capture captures err with r after adding the tags of ctx to the event, a nil r discards it
+func Capture(ctx context.Context, r Reporter, err error, event Event) {
+	if r == nil || err == nil {
+		return
+	}
+	if tags := Tags(ctx); len(tags) > 0 {
+		merged := maps.Clone(tags)
+		maps.Copy(merged, event.Tags)
+		event.Tags = merged
+	}
+	r.Capture(ctx, err, event)
+}
+
+// tagsKey is the context key of the tags set with WithTag
+type tagsKey struct{}
+
+// WithTag returns a context whose captured errors carry the tag key=value
+func WithTag(ctx context.Context, key, value string) context.Context {
+	tags := maps.Clone(Tags(ctx))
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	tags[key] = value
+	return context.WithValue(ctx, tagsKey{}, tags)
+}
+
+// Tags returns the tags set with WithTag, the map must not be modified
+func Tags(ctx context.Context) map[string]string {
+	tags, _ := ctx.Value(tagsKey{}).(map[string]string)
+	return tags
+}
+
+// PanicError is the error captured for a recovered panic
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value when it is an error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// IsPanic reports whether err was captured for a recovered panic
+func IsPanic(err error) bool {
+	var pe *PanicError
+	return errors.As(err, &pe)
+}
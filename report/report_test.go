@@ -0,0 +1,38 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapture(t *testing.T) {
+	var got []Event
+	r := Func(func(_ context.Context, _ error, event Event) {
+		got = append(got, event)
+	})
+
+	ctx := WithTag(WithTag(context.Background(), "tenant", "acme"), "region", "eu")
+	Capture(ctx, Multi(r, r), errors.New("failed"), Event{Source: SourceServer, Tags: map[string]string{"region": "us"}})
+	assert.Len(t, got, 2)
+	// Tags of the event win over those of the context
+	assert.Equal(t, map[string]string{"tenant": "acme", "region": "us"}, got[0].Tags)
+	assert.Equal(t, map[string]string{"tenant": "acme", "region": "eu"}, Tags(ctx))
+
+	// Nil reporters and errors are ignored
+	Capture(ctx, nil, errors.New("failed"), Event{})
+	Capture(ctx, r, nil, Event{})
+	assert.Len(t, got, 2)
+}
+
+func TestPanicError(t *testing.T) {
+	cause := errors.New("nil map")
+	err := fmt.Errorf("handler: %w", &PanicError{Value: cause})
+	assert.True(t, IsPanic(err))
+	assert.ErrorIs(t, err, cause)
+	assert.EqualError(t, &PanicError{Value: "boom"}, "panic: boom")
+	assert.False(t, IsPanic(cause))
+}
@@ -0,0 +1,20 @@
+module github.com/go-kenka/ginpb/report/sentry
+
+go 1.23.0
+
+require (
+	github.com/getsentry/sentry-go v0.42.0
+	github.com/go-kenka/ginpb v0.0.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+	golang.org/x/text v0.28.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// Built against the ginpb of this repository, require a released ginpb version when tagging report/sentry
+replace github.com/go-kenka/ginpb => ../..
@@ -0,0 +1,58 @@
+// Package sentry sends the errors captured by report.Reporter to Sentry.
+package sentry
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/getsentry/sentry-go"
+
+	"github.com/go-kenka/ginpb/report"
+)
+
+// Reporter captures errors as Sentry exceptions with the event as tags and context.
+// The hub of the request context is used when present, e.g. set by sentrygin, otherwise Hub.
+type Reporter struct {
+	// Hub is the fallback hub, nil uses sentry.CurrentHub
+	Hub *sentry.Hub
+}
+
+// New returns a Reporter sending to hub, call sentry.Init before
+func New(hub *sentry.Hub) *Reporter {
+	return &Reporter{Hub: hub}
+}
+
+// Capture implements report.Reporter
+func (r *Reporter) Capture(ctx context.Context, err error, event report.Event) {
+	hub := sentry.GetHubFromContext(ctx)
+	if hub == nil {
+		hub = r.Hub
+	}
+	if hub == nil {
+		hub = sentry.CurrentHub()
+	}
+	hub.WithScope(func(scope *sentry.Scope) {
+		scope.SetTag("source", string(event.Source))
+		if event.Operation != "" {
+			scope.SetTag("operation", event.Operation)
+			// Group errors per operation instead of per call site only
+			scope.SetFingerprint([]string{"{{ default }}", event.Operation})
+		}
+		if event.RequestID != "" {
+			scope.SetTag("request_id", event.RequestID)
+		}
+		if event.Status != 0 {
+			scope.SetTag("http.status_code", strconv.Itoa(event.Status))
+		}
+		scope.SetTags(event.Tags)
+		request := sentry.Context{"method": event.Method, "url": event.URL}
+		if len(event.Stack) > 0 {
+			request["panic_stack"] = string(event.Stack)
+		}
+		scope.SetContext("request", request)
+		if event.Source == report.SourcePanic {
+			scope.SetLevel(sentry.LevelFatal)
+		}
+		hub.CaptureException(err)
+	})
+}
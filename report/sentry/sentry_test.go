@@ -0,0 +1,47 @@
+package sentry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-kenka/ginpb/report"
+)
+
+func TestReporter(t *testing.T) {
+	var events []*sentry.Event
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		BeforeSend: func(event *sentry.Event, _ *sentry.EventHint) *sentry.Event {
+			events = append(events, event)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	r := New(sentry.NewHub(client, sentry.NewScope()))
+
+	ctx := report.WithTag(context.Background(), "tenant", "acme")
+	report.Capture(ctx, r, &report.PanicError{Value: errors.New("nil map")}, report.Event{
+		Source:    report.SourcePanic,
+		Operation: "/user.v1.UserService/GetUser",
+		Method:    "GET",
+		URL:       "/users/1",
+		Status:    500,
+		Stack:     []byte("goroutine 1"),
+	})
+	require.Len(t, events, 1)
+	e := events[0]
+	assert.Equal(t, sentry.LevelFatal, e.Level)
+	assert.Equal(t, map[string]string{
+		"source":           "panic",
+		"operation":        "/user.v1.UserService/GetUser",
+		"http.status_code": "500",
+		"tenant":           "acme",
+	}, e.Tags)
+	assert.Equal(t, "GET", e.Contexts["request"]["method"])
+	assert.Equal(t, "goroutine 1", e.Contexts["request"]["panic_stack"])
+	assert.Equal(t, []string{"{{ default }}", "/user.v1.UserService/GetUser"}, e.Fingerprint)
+}
@@ -0,0 +1,63 @@
+package ginpb
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+)
+
+// ResponseRewriter rewrites the replies of an operation before they are written, e.g. to keep serving
+// legacy field names or envelopes to old clients while a migrated proto is deprecated. Either hook may be
+// nil, Reply runs before JSON. Both receive the gin context, so a shim can depend on a client version header.
+// Pass it with the generated WithXResponseRewriter option or RegisterConfig.ResponseRewriters.
+type ResponseRewriter struct {
+	// Reply returns the value written instead of the typed reply, e.g. a legacy struct wrapping it
+	Reply func(c *gin.Context, reply any) (any, error)
+	// JSON rewrites the encoded body, e.g. renaming fields
+	JSON func(c *gin.Context, body []byte) ([]byte, error)
+}
+
+// RenderRewrittenJSON writes v like RenderJSON after applying rw. A nil rw writes v unchanged, errors of the
+// hooks abort the request with 500.
+func RenderRewrittenJSON(c *gin.Context, status int, naming JSONNaming, rw *ResponseRewriter, v any) {
+	RenderReply(c, status, naming, nil, rw, v)
+}
+
+// RenderReply writes v like RenderRewrittenJSON, marshalling with engine where encoding/json would be used,
+// generated handlers call it for unary replies with the engine of their WithXJSONEngine option. A nil engine
+// uses encoding/json.
+func RenderReply(c *gin.Context, status int, naming JSONNaming, engine JSONEngine, rw *ResponseRewriter, v any) {
+	if rw == nil {
+		renderJSON(c, status, naming, engine, v)
+		return
+	}
+	var err error
+	if rw.Reply != nil {
+		if v, err = rw.Reply(c, v); err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, fmt.Errorf("rewrite reply of %s: %w", OperationFromContext(c), err))
+			return
+		}
+	}
+	// The JSON hook does not apply to messages written as protobuf
+	if _, ok := v.(proto.Message); rw.JSON == nil || (ok && AcceptsProtobuf(c)) {
+		renderJSON(c, status, naming, engine, v)
+		return
+	}
+	if engine == nil {
+		engine = StdJSON
+	}
+	b, ok, err := naming.marshalWith(engine, v)
+	if !ok {
+		b, err = engine.Marshal(v)
+	}
+	if err == nil {
+		b, err = rw.JSON(c, b)
+	}
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, fmt.Errorf("rewrite JSON reply of %s: %w", OperationFromContext(c), err))
+		return
+	}
+	c.Data(status, "application/json; charset=utf-8", b)
+}
@@ -0,0 +1,44 @@
+package ginpb
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRenderRewrittenJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	render := func(rw *ResponseRewriter, naming JSONNaming) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("GET", "/", nil)
+		RenderRewrittenJSON(c, 201, naming, rw, wrapperspb.String("v1"))
+		return w
+	}
+
+	w := render(nil, JSONProtoNames)
+	assert.Equal(t, 201, w.Code)
+	assert.JSONEq(t, `"v1"`, w.Body.String())
+
+	// Legacy clients expect the reply wrapped in an envelope with a renamed field
+	w = render(&ResponseRewriter{
+		Reply: func(_ *gin.Context, reply any) (any, error) {
+			return map[string]any{"data": reply}, nil
+		},
+		JSON: func(_ *gin.Context, body []byte) ([]byte, error) {
+			return bytes.Replace(body, []byte(`"data"`), []byte(`"result"`), 1), nil
+		},
+	}, JSONDefault)
+	assert.Equal(t, 201, w.Code)
+	assert.JSONEq(t, `{"result":{"value":"v1"}}`, w.Body.String())
+
+	w = render(&ResponseRewriter{
+		JSON: func(*gin.Context, []byte) ([]byte, error) { return nil, errors.New("broken shim") },
+	}, JSONProtoNames)
+	assert.Equal(t, 500, w.Code)
+}
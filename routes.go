@@ -0,0 +1,165 @@
+// Package ginpb provides runtime helpers shared by code generated with protoc-gen-gin.
+package ginpb
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteInfo describes a route registered by generated code
+type RouteInfo struct {
+	Operation   string
+	Method      string
+	Path        string
+	Middlewares int
+	// RequestType and ReplyType are the full protobuf names of the messages, set in the generated HTTPRoutes tables
+	RequestType string
+	ReplyType   string
+	// ContentTypes are the request content types declared with (ginpb.content_types), empty when any is accepted
+	ContentTypes []string
+	// Example is a sample request used by SelfTest, nil for routes generated by older versions
+	Example *RouteExample
+}
+
+var (
+	routesMu sync.RWMutex
+	routes   = make(map[gin.IRouter][]RouteInfo) // by the engine or group the routes were registered on
+)
+
+// AddRoute records a route registered on r, resolving the path against the router base path
+func AddRoute(r gin.IRouter, info RouteInfo) {
+	if g, ok := r.(interface{ BasePath() string }); ok {
+		info.Path = joinPaths(g.BasePath(), info.Path)
+		if info.Example != nil {
+			example := *info.Example
+			example.Path = joinPaths(g.BasePath(), example.Path)
+			info.Example = &example
+		}
+	}
+	routesMu.Lock()
+	defer routesMu.Unlock()
+	// Routes registered again through a RouteTable replace their earlier record
+	for i, rec := range routes[r] {
+		if rec.Method == info.Method && rec.Path == info.Path {
+			routes[r][i] = info
+			return
+		}
+	}
+	routes[r] = append(routes[r], info)
+}
+
+// removeRoutes deletes the records of the routes on r matching match
+func removeRoutes(r gin.IRouter, match func(RouteInfo) bool) {
+	routesMu.Lock()
+	defer routesMu.Unlock()
+	if routes[r] = slices.DeleteFunc(routes[r], match); len(routes[r]) == 0 {
+		delete(routes, r)
+	}
+}
+
+// recordedOperation reports whether a route on r matching match serves one of operations
+func recordedOperation(r gin.IRouter, match func(RouteInfo) bool, operations map[string]bool) bool {
+	routesMu.RLock()
+	defer routesMu.RUnlock()
+	for _, info := range routes[r] {
+		if match(info) && operations[info.Operation] {
+			return true
+		}
+	}
+	return false
+}
+
+// Routes returns the routes generated code recorded on r, ordered by path and method. r is the engine or
+// group passed to the generated Register functions, routes registered on a group are listed by that group,
+// e.g. ginpb.Routes(e) for services registered on engine e. The same path may be served by several
+// engines, e.g. a public and an admin one, each lists its own routes.
+func Routes(r gin.IRouter) []RouteInfo {
+	routesMu.RLock()
+	res := slices.Clone(routes[r])
+	routesMu.RUnlock()
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Path != res[j].Path {
+			return res[i].Path < res[j].Path
+		}
+		return res[i].Method < res[j].Method
+	})
+	return res
+}
+
+// ReleaseRoutes forgets the routes recorded on r, e.g. when an engine built for a test or a reload is dropped.
+// The records would otherwise keep r alive for the lifetime of the process.
+func ReleaseRoutes(r gin.IRouter) {
+	routesMu.Lock()
+	defer routesMu.Unlock()
+	delete(routes, r)
+}
+
+// DumpRoutes logs the ginpb routes recorded on r to gin.DefaultWriter
+func DumpRoutes(r gin.IRouter) {
+	FdumpRoutes(gin.DefaultWriter, r)
+}
+
+// FdumpRoutes writes the ginpb routes recorded on router to w
+func FdumpRoutes(w io.Writer, router gin.IRouter) {
+	for _, r := range Routes(router) {
+		fmt.Fprintf(w, "[GINPB] %-7s %-40s --> %s (%d middlewares)\n", r.Method, r.Path, r.Operation, r.Middlewares)
+	}
+}
+
+// ValidateOperations checks that every referenced operation is one of the known operations.
+// It reports all unknown names at once so that typos in middleware maps fail at startup.
+func ValidateOperations(known []string, referenced ...string) error {
+	set := make(map[string]bool, len(known))
+	for _, op := range known {
+		set[op] = true
+	}
+
+	var unknown []string
+	for _, op := range referenced {
+		if !set[op] {
+			unknown = append(unknown, op)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	msgs := make([]string, 0, len(unknown))
+	for _, op := range unknown {
+		if s := suggest(op, known); s != "" {
+			msgs = append(msgs, fmt.Sprintf("%q (did you mean %q?)", op, s))
+		} else {
+			msgs = append(msgs, fmt.Sprintf("%q", op))
+		}
+	}
+	return fmt.Errorf("ginpb: unknown operations %s: middleware and response rewriters bound to them would never run; use the generated Operation constants", strings.Join(msgs, ", "))
+}
+
+// suggest returns the known operation whose method name matches op, if any
+func suggest(op string, known []string) string {
+	name := op[strings.LastIndex(op, "/")+1:]
+	for _, k := range known {
+		if strings.EqualFold(k[strings.LastIndex(k, "/")+1:], name) {
+			return k
+		}
+	}
+	return ""
+}
+
+func joinPaths(base, rel string) string {
+	if rel == "" {
+		return base
+	}
+	if base == "" || base == "/" {
+		return rel
+	}
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(rel, "/")
+}
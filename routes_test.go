@@ -0,0 +1,61 @@
+package ginpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOperations(t *testing.T) {
+	known := []string{"/example.Svc/CreateUser", "/example.Svc/GetUser"}
+
+	assert.NoError(t, ValidateOperations(known, "/example.Svc/GetUser"))
+
+	err := ValidateOperations(known, "CreateUser", "/example.Svc/Missing")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"CreateUser" (did you mean "/example.Svc/CreateUser"?)`)
+	assert.Contains(t, err.Error(), `"/example.Svc/Missing"`)
+}
+
+func TestFdumpRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	g := e.Group("/api")
+	g.GET("/users", func(*gin.Context) {})
+	AddRoute(g, RouteInfo{Operation: "/example.Svc/ListUsers", Method: "GET", Path: "/users", Middlewares: 2})
+
+	t.Cleanup(func() { ReleaseRoutes(g) })
+
+	var buf bytes.Buffer
+	FdumpRoutes(&buf, g)
+	assert.Contains(t, buf.String(), "/api/users")
+	assert.Contains(t, buf.String(), "/example.Svc/ListUsers (2 middlewares)")
+}
+
+func TestRoutesPerRouter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	public, admin := gin.New(), gin.New()
+	adminAPI := admin.Group("/engines")
+	AddRoute(public, RouteInfo{Operation: "/example.Svc/GetUser", Method: "GET", Path: "/engines/users/:id", Middlewares: 1})
+	AddRoute(adminAPI, RouteInfo{Operation: "/example.Admin/GetUser", Method: "GET", Path: "/users/:id", Middlewares: 3})
+	// Registering again on the same router replaces the record
+	AddRoute(adminAPI, RouteInfo{Operation: "/example.Admin/GetUser", Method: "GET", Path: "/users/:id", Middlewares: 4})
+	AddRoute(adminAPI, RouteInfo{Operation: "/example.Admin/ListUsers", Method: "GET", Path: "/users"})
+
+	assert.Equal(t, []RouteInfo{{Operation: "/example.Svc/GetUser", Method: "GET", Path: "/engines/users/:id", Middlewares: 1}}, Routes(public))
+	assert.Equal(t, []RouteInfo{
+		{Operation: "/example.Admin/ListUsers", Method: "GET", Path: "/engines/users"},
+		{Operation: "/example.Admin/GetUser", Method: "GET", Path: "/engines/users/:id", Middlewares: 4},
+	}, Routes(adminAPI))
+	assert.Empty(t, Routes(admin), "routes registered on a group are listed by the group")
+
+	ReleaseRoutes(public)
+	ReleaseRoutes(adminAPI)
+	assert.Empty(t, Routes(public))
+	assert.Empty(t, Routes(adminAPI))
+	routesMu.RLock()
+	defer routesMu.RUnlock()
+	assert.NotContains(t, routes, gin.IRouter(public), "released routers are not kept alive")
+}
@@ -0,0 +1,84 @@
+package ginpb
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteTable mounts generated routes behind an indirection, so services can be replaced or unregistered
+// at runtime, e.g. by plugins. gin's tree holds each method and path once, the mounted route looks up the
+// handlers of the current registration in the table and runs them on the incoming context.
+// Pass it with the generated WithXRouteTable option or RegisterConfig.RouteTable, use one table per engine.
+type RouteTable struct {
+	mu     sync.RWMutex
+	routes map[string]*tableRoute // by method and full path
+}
+
+// tableRoute is a mounted route, chain is nil while the route is unregistered
+type tableRoute struct {
+	router       gin.IRouter
+	method, path string
+	operation    string
+	chain        gin.HandlersChain
+}
+
+// tableChainKey holds the handlers of the route table entry of a request
+const tableChainKey = "ginpb.table.chain"
+
+// NewRouteTable creates an empty route table
+func NewRouteTable() *RouteTable {
+	return &RouteTable{routes: make(map[string]*tableRoute)}
+}
+
+// Handle sets the handlers of a route, replacing those of an earlier registration with the same method and path.
+// The route is mounted on r the first time only, so registering a service again does not make gin panic.
+func (t *RouteTable) Handle(r gin.IRouter, method, path, operation string, handlers ...gin.HandlerFunc) {
+	full := path
+	if g, ok := r.(interface{ BasePath() string }); ok {
+		full = joinPaths(g.BasePath(), path)
+	}
+	checkChain(method, full, handlers)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	route, ok := t.routes[method+" "+full]
+	if !ok {
+		route = &tableRoute{router: r, method: method, path: full}
+		t.routes[method+" "+full] = route
+		r.Handle(method, path, chainSlots(tableChainKey, func(*gin.Context) gin.HandlersChain {
+			t.mu.RLock()
+			defer t.mu.RUnlock()
+			return route.chain
+		})...)
+	}
+	route.operation = operation
+	route.chain = handlers
+}
+
+// Unregister removes the routes of the given operations, they are answered with 404 until registered again.
+// Custom verbs sharing a gin route, e.g. :cancel and :undelete of /v1/operations/{name}, are removed together.
+// It returns the number of removed routes.
+func (t *RouteTable) Unregister(operations ...string) int {
+	set := make(map[string]bool, len(operations))
+	for _, op := range operations {
+		set[op] = true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	removed := 0
+	for _, route := range t.routes {
+		// Records of custom verbs hold the path with the verb, they are matched by the gin route they share
+		onRoute := func(info RouteInfo) bool {
+			return info.Method == route.method && verbRoutePath(info.Path) == route.path
+		}
+		if route.chain == nil || !set[route.operation] && !recordedOperation(route.router, onRoute, set) {
+			continue
+		}
+		route.chain = nil
+		removeRoutes(route.router, onRoute)
+		removed++
+	}
+	return removed
+}
@@ -0,0 +1,108 @@
+package ginpb
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteTable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.RemoteIPHeaders = []string{"X-Real-IP"}
+	var errs []error
+	e.Use(func(c *gin.Context) {
+		c.Set("tenant", "acme")
+		c.Next()
+		for _, err := range c.Errors {
+			errs = append(errs, err.Err)
+		}
+	})
+	api := e.Group("/api")
+	table := NewRouteTable()
+
+	var order []string
+	timing := func(c *gin.Context) {
+		order = append(order, "before")
+		c.Next()
+		order = append(order, "after")
+	}
+	serve := func(version string) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			order = append(order, "handler")
+			c.String(http.StatusOK, version+" "+c.Param("id")+" "+c.GetString("tenant")+" "+c.ClientIP())
+		}
+	}
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, req)
+		return w
+	}
+
+	table.Handle(api, http.MethodGet, "/users/:id", "/svc/GetUser", timing, serve("v1"))
+	// Handlers run on the engine's context, it does not trust the forwarded address
+	assert.Equal(t, "v1 7 acme 192.0.2.1", get("/api/users/7").Body.String())
+	assert.Equal(t, []string{"before", "handler", "after"}, order)
+
+	// Registering again replaces the handlers without gin panicking on the duplicate route
+	table.Handle(api, http.MethodGet, "/users/:id", "/svc/GetUser", serve("v2"))
+	assert.Equal(t, "v2 7 acme 192.0.2.1", get("/api/users/7").Body.String())
+
+	table.Handle(api, http.MethodGet, "/fail", "/svc/Fail", func(c *gin.Context) {
+		_ = c.AbortWithError(http.StatusBadRequest, errors.New("invalid"))
+	})
+	assert.Equal(t, http.StatusBadRequest, get("/api/fail").Code)
+	assert.EqualError(t, errors.Join(errs...), "invalid", "errors reach middleware of the outer engine")
+
+	assert.Equal(t, 1, table.Unregister("/svc/GetUser"))
+	assert.Equal(t, http.StatusNotFound, get("/api/users/7").Code)
+	assert.Equal(t, 0, table.Unregister("/svc/GetUser"))
+
+	table.Handle(api, http.MethodGet, "/users/:id", "/svc/GetUser", serve("v3"))
+	assert.Equal(t, "v3 7 acme 192.0.2.1", get("/api/users/7").Body.String())
+}
+
+func TestRouteTableUnregisterVerbs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	api := e.Group("/verbs")
+	table := NewRouteTable()
+	t.Cleanup(func() { ReleaseRoutes(api) })
+
+	// Registered like the generated code, verbs of a path share one gin route
+	verbs := NewVerbRoutes()
+	for _, r := range []struct{ operation, path, verb string }{
+		{"/svc/CancelJob", "/jobs/:id", ":cancel"},
+		{"/svc/UndeleteJob", "/jobs/:id", ":undelete"},
+		{"/svc/BatchGetJobs", "/:ginpb.verb", "jobs:batchGet"},
+	} {
+		if h := verbs.Handle(api, http.MethodPost, r.path, r.verb, func(c *gin.Context) { c.Status(http.StatusNoContent) }); h != nil {
+			table.Handle(api, http.MethodPost, r.path, r.operation, h...)
+		}
+		AddRoute(api, RouteInfo{Operation: r.operation, Method: http.MethodPost, Path: VerbPath(r.path, r.verb)})
+	}
+	paths := func() []string {
+		var res []string
+		for _, r := range Routes(api) {
+			res = append(res, r.Path)
+		}
+		return res
+	}
+	assert.Equal(t, []string{"/verbs/jobs/:id:cancel", "/verbs/jobs/:id:undelete", "/verbs/jobs:batchGet"}, paths())
+
+	// Any operation of the shared route removes it with the records of all its verbs
+	assert.Equal(t, 1, table.Unregister("/svc/UndeleteJob"))
+	assert.Equal(t, []string{"/verbs/jobs:batchGet"}, paths())
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/verbs/jobs/1:cancel", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.Equal(t, 1, table.Unregister("/svc/BatchGetJobs"))
+	assert.Empty(t, paths())
+}
@@ -0,0 +1,125 @@
+package ginpb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteExample is a sample request of a route generated from the binding rules of its fields
+type RouteExample struct {
+	// Path is the request path with sample path parameters
+	Path string
+	// Query is the encoded query string
+	Query string
+	// Header holds header fields, including Content-Type for bodies
+	Header map[string]string
+	// Body is the JSON request body, empty for none
+	Body string
+}
+
+// selfTestKey marks self-test requests in the request context, it cannot be set by remote clients
+type selfTestKey struct{}
+
+// SelfTestConfig defines the config of SelfTestWithConfig
+type SelfTestConfig struct {
+	// Header is added to every request, e.g. a token accepted by authentication middlewares
+	Header http.Header
+}
+
+// SelfTestResult is the outcome of the self-test of a route
+type SelfTestResult struct {
+	Route  RouteInfo
+	Status int
+	Err    error
+}
+
+// SelfTest runs SelfTestWithConfig with default configuration
+func SelfTest(e *gin.Engine, routes []RouteInfo) ([]SelfTestResult, error) {
+	return SelfTestWithConfig(e, routes, SelfTestConfig{})
+}
+
+// SelfTestWithConfig sends the generated example request of every route to e in-process, e.g. at startup
+// before reporting ready. Generated handlers answer self-test requests with 204 right after binding, without
+// calling the service, so the test checks routing, middleware wiring and binding without side effects.
+// Requests rejected with 401 or 403 by authentication middlewares count as wired.
+func SelfTestWithConfig(e *gin.Engine, routes []RouteInfo, config SelfTestConfig) ([]SelfTestResult, error) {
+	var (
+		results []SelfTestResult
+		errs    []error
+	)
+	for _, route := range routes {
+		if route.Example == nil {
+			continue
+		}
+		res := SelfTestResult{Route: route}
+		res.Status, res.Err = selfTestRoute(e, route, config)
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s (%s): %w", route.Method, route.Path, route.Operation, res.Err))
+		}
+		results = append(results, res)
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("ginpb: self-test failed for %d of %d routes:\n%w", len(errs), len(results), errors.Join(errs...))
+	}
+	return results, nil
+}
+
+func selfTestRoute(e *gin.Engine, route RouteInfo, config SelfTestConfig) (int, error) {
+	target := route.Example.Path
+	if route.Example.Query != "" {
+		target += "?" + route.Example.Query
+	}
+	req := httptest.NewRequest(route.Method, target, strings.NewReader(route.Example.Body))
+	req = req.WithContext(context.WithValue(req.Context(), selfTestKey{}, true))
+	for k, v := range route.Example.Header {
+		req.Header.Set(k, v)
+	}
+	for k, vs := range config.Header {
+		req.Header[k] = vs
+	}
+
+	w := httptest.NewRecorder()
+	c := gin.CreateTestContextOnly(w, e)
+	c.Request = req
+	if err := handleRecovered(e, c); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	switch w.Code {
+	case http.StatusNoContent, http.StatusUnauthorized, http.StatusForbidden:
+		return w.Code, nil
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return w.Code, fmt.Errorf("route not mounted, got %d", w.Code)
+	}
+	// Binding errors are only recorded in the context
+	detail := strings.TrimSpace(w.Body.String())
+	if len(c.Errors) > 0 {
+		detail = c.Errors.String()
+	}
+	return w.Code, fmt.Errorf("the example request failed with %d: %s", w.Code, strings.TrimSpace(detail))
+}
+
+func handleRecovered(e *gin.Engine, c *gin.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	e.HandleContext(c)
+	return nil
+}
+
+// EndSelfTest answers self-test requests with 204 and reports whether the request was one,
+// generated handlers call it after binding
+func EndSelfTest(c *gin.Context) bool {
+	if c.Request.Context().Value(selfTestKey{}) == nil {
+		return false
+	}
+	c.Status(http.StatusNoContent)
+	return true
+}
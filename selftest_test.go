@@ -0,0 +1,55 @@
+package ginpb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfTest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+
+	type createReq struct {
+		Name string `json:"name" binding:"required"`
+	}
+	var called bool
+	e.POST("/users", func(c *gin.Context) {
+		var req createReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.AbortWithError(http.StatusBadRequest, err).SetType(gin.ErrorTypeBind)
+			return
+		}
+		if EndSelfTest(c) {
+			return
+		}
+		called = true
+	})
+	e.GET("/panic", func(*gin.Context) { panic("boom") })
+
+	ok := RouteInfo{Operation: "Create", Method: http.MethodPost, Path: "/users", Example: &RouteExample{
+		Path:   "/users",
+		Header: map[string]string{"Content-Type": "application/json"},
+		Body:   `{"name":"string"}`,
+	}}
+	results, err := SelfTest(e, []RouteInfo{ok, {Operation: "Old", Method: http.MethodGet, Path: "/old"}})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, http.StatusNoContent, results[0].Status)
+	assert.False(t, called, "self-test requests must not reach the service")
+
+	invalid := ok
+	invalid.Example = &RouteExample{Path: "/users", Header: ok.Example.Header, Body: `{}`}
+	missing := RouteInfo{Operation: "Get", Method: http.MethodGet, Path: "/missing", Example: &RouteExample{Path: "/missing"}}
+	panics := RouteInfo{Operation: "Panic", Method: http.MethodGet, Path: "/panic", Example: &RouteExample{Path: "/panic"}}
+	results, err = SelfTest(e, []RouteInfo{invalid, missing, panics})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "self-test failed for 3 of 3 routes")
+	assert.Contains(t, err.Error(), "'required' tag")
+	assert.Contains(t, err.Error(), "route not mounted")
+	assert.Contains(t, err.Error(), "handler panicked: boom")
+	assert.Equal(t, http.StatusBadRequest, results[0].Status)
+}
@@ -0,0 +1,357 @@
+package ginpb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ServerConfig hardens the http.Server serving generated handlers. gin's r.Run and a zero http.Server have no
+// timeouts, so slowloris clients trickling headers or bodies hold connections and goroutines indefinitely.
+type ServerConfig struct {
+	// ReadHeaderTimeout bounds reading the request headers
+	ReadHeaderTimeout time.Duration
+
+	// IdleTimeout closes keep-alive connections without a request for that long
+	IdleTimeout time.Duration
+
+	// WriteTimeout bounds writing the response, zero keeps Server-Sent Events and long downloads working
+	WriteTimeout time.Duration
+
+	// MaxHeaderBytes limits the size of the request headers
+	MaxHeaderBytes int
+
+	// MaxConns limits the open connections, further connections wait to be accepted, zero means unlimited
+	MaxConns int
+
+	// MaxConnsPerIP limits the open connections of a remote IP, further connections are closed, zero means
+	// unlimited. Leave it unset behind load balancers, all their connections share a few addresses.
+	MaxConnsPerIP int
+
+	// MinBodyRate is the rate in bytes per second request bodies must arrive at, zero disables the check
+	MinBodyRate int64
+
+	// BodyRateBurst is how far a body may fall behind MinBodyRate, e.g. while the client opens the upload
+	BodyRateBurst time.Duration
+
+	// ShutdownTimeout bounds the graceful shutdown of Serve
+	ShutdownTimeout time.Duration
+}
+
+// DefaultServerConfig returns a default server configuration for internet-facing deployments
+func DefaultServerConfig() ServerConfig {
+	return ServerConfig{
+		ReadHeaderTimeout: 5 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		WriteTimeout:      0,
+		MaxHeaderBytes:    64 << 10,
+		MaxConns:          0,
+		MaxConnsPerIP:     0,
+		MinBodyRate:       1 << 10,
+		BodyRateBurst:     10 * time.Second,
+		ShutdownTimeout:   10 * time.Second,
+	}
+}
+
+// withDefaults fills the zero timeouts and limits of config, limits and MinBodyRate stay disabled
+func (config ServerConfig) withDefaults() ServerConfig {
+	defaults := DefaultServerConfig()
+	if config.ReadHeaderTimeout <= 0 {
+		config.ReadHeaderTimeout = defaults.ReadHeaderTimeout
+	}
+	if config.IdleTimeout <= 0 {
+		config.IdleTimeout = defaults.IdleTimeout
+	}
+	if config.MaxHeaderBytes <= 0 {
+		config.MaxHeaderBytes = defaults.MaxHeaderBytes
+	}
+	if config.BodyRateBurst <= 0 {
+		config.BodyRateBurst = defaults.BodyRateBurst
+	}
+	if config.ShutdownTimeout <= 0 {
+		config.ShutdownTimeout = defaults.ShutdownTimeout
+	}
+	return config
+}
+
+// NewServer returns an http.Server serving h with the timeouts of config. Requests whose body arrives slower
+// than config.MinBodyRate are answered with 408 Request Timeout and their connection is closed.
+func NewServer(h http.Handler, config ServerConfig) *http.Server {
+	config = config.withDefaults()
+	if config.MinBodyRate > 0 {
+		h = minBodyRateHandler(h, config.MinBodyRate, config.BodyRateBurst)
+	}
+	return &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		IdleTimeout:       config.IdleTimeout,
+		WriteTimeout:      config.WriteTimeout,
+		MaxHeaderBytes:    config.MaxHeaderBytes,
+	}
+}
+
+// ListenAndServe listens on the TCP address addr and serves h like Serve
+func ListenAndServe(ctx context.Context, addr string, h http.Handler, config ServerConfig) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", addr, err)
+	}
+	return Serve(ctx, l, h, config)
+}
+
+// Serve serves h on l with the timeouts and connection limits of config until ctx is done, then shuts down
+// gracefully within config.ShutdownTimeout. It returns nil after a graceful shutdown.
+func Serve(ctx context.Context, l net.Listener, h http.Handler, config ServerConfig) error {
+	config = config.withDefaults()
+	srv := NewServer(h, config)
+	l = LimitListener(l, config.MaxConns, config.MaxConnsPerIP)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Serve(l) }()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("graceful shutdown: %w", err)
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// LimitListener returns a listener accepting at most maxConns open connections in total, zero means unlimited,
+// and closing connections beyond maxPerIP open connections of the same remote IP
+func LimitListener(l net.Listener, maxConns, maxPerIP int) net.Listener {
+	if maxConns <= 0 && maxPerIP <= 0 {
+		return l
+	}
+	ll := &limitListener{Listener: l, perIP: maxPerIP, conns: make(map[string]int), done: make(chan struct{})}
+	if maxConns > 0 {
+		ll.sem = make(chan struct{}, maxConns)
+	}
+	return ll
+}
+
+type limitListener struct {
+	net.Listener
+	sem   chan struct{} // slots of open connections, nil when unlimited
+	perIP int
+
+	mu    sync.Mutex
+	conns map[string]int // open connections by remote IP
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (l *limitListener) Accept() (net.Conn, error) {
+	for {
+		if l.sem != nil {
+			select {
+			case l.sem <- struct{}{}:
+			case <-l.done:
+				return nil, net.ErrClosed
+			}
+		}
+		c, err := l.Listener.Accept()
+		if err != nil {
+			l.release("")
+			return nil, err
+		}
+		ip := remoteIP(c)
+		if l.acquireIP(ip) {
+			return &limitConn{Conn: c, release: func() { l.release(ip) }}, nil
+		}
+		// Reject without reading, the client sees the connection closed
+		_ = c.Close()
+		l.release("")
+	}
+}
+
+func (l *limitListener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return l.Listener.Close()
+}
+
+// acquireIP counts a connection of ip, false when ip already has perIP open connections
+func (l *limitListener) acquireIP(ip string) bool {
+	if l.perIP <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conns[ip] >= l.perIP {
+		return false
+	}
+	l.conns[ip]++
+	return true
+}
+
+// release frees the slot of a closed connection and, when ip is set, its count
+func (l *limitListener) release(ip string) {
+	if ip != "" && l.perIP > 0 {
+		l.mu.Lock()
+		if l.conns[ip]--; l.conns[ip] <= 0 {
+			delete(l.conns, ip)
+		}
+		l.mu.Unlock()
+	}
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+// remoteIP returns the IP of the remote address of c, or the whole address when it has no port
+func remoteIP(c net.Conn) string {
+	addr := c.RemoteAddr().String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+type limitConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
+
+// minBodyRateHandler answers requests whose body arrives slower than rate bytes per second with 408
+func minBodyRateHandler(h http.Handler, rate int64, burst time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody {
+			h.ServeHTTP(w, r)
+			return
+		}
+		sw := &slowRequestWriter{ResponseWriter: w}
+		r.Body = &minRateReader{
+			body:   r.Body,
+			rc:     http.NewResponseController(w),
+			w:      sw,
+			rate:   rate,
+			burst:  burst,
+			credit: burst,
+		}
+		h.ServeHTTP(sw, r)
+	})
+}
+
+// minRateReader enforces the minimum body rate with a token bucket of time: every byte received adds
+// 1/rate seconds of credit, up to burst, time spent blocked in Read spends it. Time the handler spends
+// between reads is not counted. The read deadline of the connection is moved to the moment the credit
+// runs out at the start of every Read.
+type minRateReader struct {
+	body   io.ReadCloser
+	rc     *http.ResponseController
+	w      *slowRequestWriter
+	rate   int64
+	burst  time.Duration
+	credit time.Duration
+}
+
+func (r *minRateReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	if err := r.rc.SetReadDeadline(start.Add(r.credit)); err != nil {
+		// Connections without read deadline support, e.g. in tests, are not limited
+		return r.body.Read(p)
+	}
+	n, err := r.body.Read(p)
+	r.credit += time.Duration(float64(n)/float64(r.rate)*float64(time.Second)) - time.Since(start)
+	if r.credit > r.burst {
+		r.credit = r.burst
+	}
+	switch {
+	case errors.Is(err, io.EOF):
+		// The server keeps reading the connection to detect disconnects while the handler runs
+		_ = r.rc.SetReadDeadline(time.Time{})
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		r.w.timeout()
+		return n, fmt.Errorf("request body arrives slower than %d bytes/s: %w", r.rate, err)
+	}
+	return n, err
+}
+
+func (r *minRateReader) Close() error {
+	return r.body.Close()
+}
+
+// slowRequestWriter answers slow requests with 408 and discards what the handler writes afterwards
+type slowRequestWriter struct {
+	http.ResponseWriter
+	mu          sync.Mutex
+	wroteHeader bool
+	timedOut    bool
+}
+
+// timeout writes the 408 response unless the handler already sent its status
+func (w *slowRequestWriter) timeout() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut || w.wroteHeader {
+		return
+	}
+	w.timedOut = true
+	w.ResponseWriter.Header().Set("Connection", "close")
+	w.ResponseWriter.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.ResponseWriter.WriteHeader(http.StatusRequestTimeout)
+	_, _ = io.WriteString(w.ResponseWriter, "request body timeout\n")
+}
+
+func (w *slowRequestWriter) WriteHeader(code int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return
+	}
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *slowRequestWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return len(b), nil
+	}
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush sends buffered data unless the request timed out, e.g. for Server-Sent Events
+func (w *slowRequestWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok && !w.timedOut {
+		w.wroteHeader = true
+		f.Flush()
+	}
+}
+
+// Hijack hands the connection over, e.g. to upgrade it
+func (w *slowRequestWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *slowRequestWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
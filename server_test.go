@@ -0,0 +1,159 @@
+package ginpb
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer serves h with config on a local port until the test ends
+func startServer(t *testing.T, h http.Handler, config ServerConfig) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- Serve(ctx, l, h, config) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+	return l.Addr().String()
+}
+
+// postStatus sends a POST with the body chunks, sleeping delay after each of them
+func postStatus(t *testing.T, addr string, chunks []string, delay time.Duration) int {
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	body := strings.Join(chunks, "")
+	_, err = io.WriteString(conn, "POST / HTTP/1.1\r\nHost: test\r\nContent-Length: "+strconv.Itoa(len(body))+"\r\n\r\n")
+	require.NoError(t, err)
+	for _, chunk := range chunks {
+		if _, err := io.WriteString(conn, chunk); err != nil {
+			break
+		}
+		time.Sleep(delay)
+	}
+	res, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	return res.StatusCode
+}
+
+func TestServeMinBodyRate(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	addr := startServer(t, h, ServerConfig{MinBodyRate: 100, BodyRateBurst: 200 * time.Millisecond})
+
+	assert.Equal(t, http.StatusNoContent, postStatus(t, addr, []string{strings.Repeat("x", 50)}, 0))
+	// Every 20 bytes earn 200ms at 100 bytes/s, so a steady client outlasts the burst
+	chunks := []string{"xxxxxxxxxxxxxxxxxxxx", "xxxxxxxxxxxxxxxxxxxx", "xxxxxxxxxxxxxxxxxxxx", "xxxxxxxxxxxxxxxxxxxx"}
+	assert.Equal(t, http.StatusNoContent, postStatus(t, addr, chunks, 100*time.Millisecond))
+	// A stalled body gets 408 instead of the handler's 400
+	assert.Equal(t, http.StatusRequestTimeout, postStatus(t, addr, []string{"x", "xxxx"}, 500*time.Millisecond))
+}
+
+func TestServeMinBodyRateSlowHandler(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Reads larger than the server's connection buffer leave nothing buffered for the next one
+		if _, err := io.ReadFull(r.Body, make([]byte, 16<<10)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Work between reads does not spend the credit of the body
+		time.Sleep(500 * time.Millisecond)
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	addr := startServer(t, h, ServerConfig{MinBodyRate: 100, BodyRateBurst: 200 * time.Millisecond})
+
+	assert.Equal(t, http.StatusNoContent, postStatus(t, addr, []string{strings.Repeat("x", 64<<10)}, 0))
+}
+
+func TestServeMaxConnsPerIP(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	addr := startServer(t, h, ServerConfig{MaxConnsPerIP: 1})
+
+	first, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	_, err = io.WriteString(first, "GET / HTTP/1.1\r\nHost: test\r\n\r\n")
+	require.NoError(t, err)
+	res, err := http.ReadResponse(bufio.NewReader(first), nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	// The second connection of the same IP is closed while the first is open
+	second, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer second.Close()
+	_ = second.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = second.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, first.Close())
+	assert.Eventually(t, func() bool {
+		res, err := http.Get("http://" + addr)
+		if err != nil {
+			return false
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusNoContent
+	}, time.Second, 20*time.Millisecond)
+}
+
+func TestLimitListenerMaxConns(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ll := LimitListener(l, 1, 0)
+
+	accepted := make(chan net.Conn, 2)
+	go func() {
+		for {
+			c, err := ll.Accept()
+			if err != nil {
+				close(accepted)
+				return
+			}
+			accepted <- c
+		}
+	}()
+	for i := 0; i < 2; i++ {
+		c, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		defer c.Close()
+	}
+
+	first := <-accepted
+	select {
+	case <-accepted:
+		t.Fatal("second connection accepted while the first is open")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, first.Close())
+	second := <-accepted
+	require.NotNil(t, second)
+	second.Close()
+
+	require.NoError(t, ll.Close())
+	_, ok := <-accepted
+	assert.False(t, ok)
+}
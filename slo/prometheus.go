@@ -0,0 +1,108 @@
+package slo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// prometheusContentType is the content type of the Prometheus text exposition format
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// metric is a gauge family of the exposition
+type metric struct {
+	name, help string
+	values     func(r Report) []sample
+}
+
+// sample is a value of a metric with extra labels besides the operation
+type sample struct {
+	labels string
+	value  float64
+}
+
+// metrics lists the exposed families, window values are gauges as they shrink when requests leave the window
+var metrics = []metric{
+	{"ginpb_slo_requests", "Requests in the SLO window.", func(r Report) []sample {
+		return []sample{{value: float64(r.Requests)}}
+	}},
+	{"ginpb_slo_errors", "Failed requests in the SLO window.", func(r Report) []sample {
+		return []sample{{value: float64(r.Errors)}}
+	}},
+	{"ginpb_slo_success_ratio", "Ratio of successful requests in the SLO window.", func(r Report) []sample {
+		return []sample{{value: r.SuccessRate}}
+	}},
+	{"ginpb_slo_latency_seconds", "Latency percentiles in the SLO window.", func(r Report) []sample {
+		return []sample{
+			{`,quantile="0.5"`, r.P50.Seconds()},
+			{`,quantile="0.9"`, r.P90.Seconds()},
+			{`,quantile="0.99"`, r.P99.Seconds()},
+		}
+	}},
+	{"ginpb_slo_objective_availability", "Availability objective of the operation.", func(r Report) []sample {
+		return objectiveSample(r.Objective != nil && r.Objective.Availability > 0, func() float64 { return r.Objective.Availability })
+	}},
+	{"ginpb_slo_error_budget_remaining", "Ratio of the error budget of the SLO window left.", func(r Report) []sample {
+		return objectiveSample(r.Objective != nil, func() float64 { return r.ErrorBudgetRemaining })
+	}},
+	{"ginpb_slo_burn_rate", "Error budget burn rate over the long and short window.", func(r Report) []sample {
+		if r.Objective == nil {
+			return nil
+		}
+		return []sample{{`,window="long"`, r.BurnRate}, {`,window="short"`, r.ShortBurnRate}}
+	}},
+	{"ginpb_slo_alerting", "Whether the burn rate of both windows reaches the alert threshold.", func(r Report) []sample {
+		return objectiveSample(r.Objective != nil && r.Objective.BurnRate > 0, func() float64 {
+			if r.Alerting {
+				return 1
+			}
+			return 0
+		})
+	}},
+}
+
+// objectiveSample returns the single sample of value when the operation has the objective
+func objectiveSample(ok bool, value func() float64) []sample {
+	if !ok {
+		return nil
+	}
+	return []sample{{value: value()}}
+}
+
+// Metrics serves the reports in the Prometheus text exposition format
+func (t *Tracker) Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Status(http.StatusOK)
+		c.Header("Content-Type", prometheusContentType)
+		if err := t.WritePrometheus(c.Writer); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+// WritePrometheus writes the reports in the Prometheus text exposition format
+func (t *Tracker) WritePrometheus(w io.Writer) error {
+	reports := t.Reports()
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		fmt.Fprintf(bw, "# HELP %s %s\n# TYPE %s gauge\n", m.name, m.help, m.name)
+		for _, r := range reports {
+			for _, s := range m.values(r) {
+				fmt.Fprintf(bw, "%s{operation=%s%s} %s\n", m.name, quoteLabel(r.Operation), s.labels, strconv.FormatFloat(s.value, 'g', -1, 64))
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// labelEscaper escapes label values as required by the exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quoteLabel(v string) string {
+	return `"` + labelEscaper.Replace(v) + `"`
+}
@@ -0,0 +1,302 @@
+// Package slo tracks per-operation service level indicators in-process: success rate, latency
+// percentiles and the burn rate of the error budget of objectives declared with (ginpb.slo).
+package slo
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+)
+
+// Objective is the service level objective of an operation, generated into XOperationSLOs
+type Objective struct {
+	// Availability is the target ratio of successful requests, e.g. 0.999, zero disables the availability SLI
+	Availability float64
+
+	// Latency is the threshold of a fast request
+	Latency time.Duration
+
+	// LatencyTarget is the target ratio of requests faster than Latency, e.g. 0.99
+	LatencyTarget float64
+
+	// BurnRate is the error budget burn rate raising an alert, zero never alerts
+	BurnRate float64
+}
+
+// Config defines the config of a Tracker
+type Config struct {
+	// Window is the period reports are computed over
+	Window time.Duration
+
+	// ShortWindow is the recent period that must burn too before an alert is raised,
+	// so alerts stop soon after an incident ends
+	ShortWindow time.Duration
+
+	// Resolution is the granularity requests are bucketed in, Window and ShortWindow are rounded to it
+	Resolution time.Duration
+
+	// Objectives maps operations to their objectives, e.g. the generated XOperationSLOs
+	Objectives map[string]Objective
+
+	// Success reports whether a response status counts as success, statuses below 500 by default
+	Success func(status int) bool
+}
+
+// DefaultConfig returns a default tracker configuration
+func DefaultConfig() Config {
+	return Config{
+		Window:      time.Hour,
+		ShortWindow: 5 * time.Minute,
+		Resolution:  time.Minute,
+		Objectives:  nil,
+		Success:     defaultSuccess,
+	}
+}
+
+// Tracker aggregates requests per operation over a sliding window
+type Tracker struct {
+	config Config
+	slots  int // number of time buckets kept per operation
+	now    func() time.Time
+
+	mu         sync.RWMutex
+	objectives map[string]Objective
+	series     map[string]*series
+}
+
+// New creates a tracker, use Middleware to record, Handler and Metrics to report
+func New(config Config) *Tracker {
+	def := DefaultConfig()
+	if config.Window <= 0 {
+		config.Window = def.Window
+	}
+	if config.Resolution <= 0 {
+		config.Resolution = def.Resolution
+	}
+	if config.ShortWindow <= 0 || config.ShortWindow > config.Window {
+		config.ShortWindow = config.Window / 12
+	}
+	if config.Success == nil {
+		config.Success = defaultSuccess
+	}
+	t := &Tracker{
+		config:     config,
+		slots:      int(math.Ceil(float64(config.Window) / float64(config.Resolution))),
+		now:        time.Now,
+		objectives: make(map[string]Objective),
+		series:     make(map[string]*series),
+	}
+	t.SetObjectives(config.Objectives)
+	return t
+}
+
+// SetObjectives adds or replaces the objectives of operations, e.g. of several generated services
+func (t *Tracker) SetObjectives(objectives ...map[string]Objective) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, m := range objectives {
+		for op, o := range m {
+			t.objectives[op] = o
+		}
+	}
+}
+
+// Middleware records every request of an operation, the operation is read once the handler returns
+// so it can be registered on the engine as well as through the generated global middleware option
+func (t *Tracker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := t.now()
+		c.Next()
+		if op := ginpb.OperationFromContext(c); op != "" {
+			t.Record(op, c.Writer.Status(), t.now().Sub(start))
+		}
+	}
+}
+
+// Record adds a request of operation with the response status and its latency
+func (t *Tracker) Record(operation string, status int, latency time.Duration) {
+	t.mu.RLock()
+	s, ok := t.series[operation]
+	objective := t.objectives[operation]
+	t.mu.RUnlock()
+	if !ok {
+		t.mu.Lock()
+		if s, ok = t.series[operation]; !ok {
+			s = &series{slots: make([]slot, t.slots)}
+			t.series[operation] = s
+		}
+		t.mu.Unlock()
+	}
+
+	slow := objective.Latency > 0 && latency > objective.Latency
+	s.add(t.index(t.now()), !t.config.Success(status), slow, latency)
+}
+
+// Handler writes the reports as JSON, filtered by the "operation" query parameter.
+// Mount it on an admin route protected by authentication.
+func (t *Tracker) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reports := t.Reports()
+		if op := c.Query("operation"); op != "" {
+			filtered := reports[:0]
+			for _, r := range reports {
+				if r.Operation == op {
+					filtered = append(filtered, r)
+				}
+			}
+			reports = filtered
+		}
+		c.JSON(http.StatusOK, reports)
+	}
+}
+
+// Reports returns the report of every operation with requests in the window or an objective, ordered by operation
+func (t *Tracker) Reports() []Report {
+	now := t.index(t.now())
+	long := t.slots
+	short := int(math.Ceil(float64(t.config.ShortWindow) / float64(t.config.Resolution)))
+
+	t.mu.RLock()
+	ops := make(map[string]bool, len(t.series)+len(t.objectives))
+	for op := range t.series {
+		ops[op] = true
+	}
+	for op := range t.objectives {
+		ops[op] = true
+	}
+	reports := make([]Report, 0, len(ops))
+	for op := range ops {
+		r := Report{Operation: op}
+		if o, ok := t.objectives[op]; ok {
+			r.Objective = &o
+		}
+		var longTotal, shortTotal window
+		if s := t.series[op]; s != nil {
+			longTotal = s.sum(now, long)
+			shortTotal = s.sum(now, short)
+		}
+		r.fill(longTotal, shortTotal)
+		reports = append(reports, r)
+	}
+	t.mu.RUnlock()
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Operation < reports[j].Operation })
+	return reports
+}
+
+// index returns the absolute time bucket of now
+func (t *Tracker) index(now time.Time) int64 {
+	return now.UnixNano() / int64(t.config.Resolution)
+}
+
+// Report is the state of an operation over the tracker window
+type Report struct {
+	Operation   string
+	Requests    int64
+	Errors      int64
+	Slow        int64 // requests slower than the objective latency
+	SuccessRate float64
+	P50         time.Duration
+	P90         time.Duration
+	P99         time.Duration
+
+	// Objective is nil for operations without objective, the fields below are zero then
+	Objective *Objective
+	// ErrorBudgetRemaining is the ratio of the error budget of the window left, negative once overspent.
+	// It considers the most spent of the availability and latency budgets.
+	ErrorBudgetRemaining float64
+	// BurnRate is the rate the error budget is spent at over the window, 1 spends it exactly in the window
+	BurnRate float64
+	// ShortBurnRate is the burn rate over the short window
+	ShortBurnRate float64
+	// Alerting is set when both burn rates reach the burn rate of the objective
+	Alerting bool
+}
+
+// fill computes the report from the totals of the window and short window
+func (r *Report) fill(long, short window) {
+	r.Requests, r.Errors, r.Slow = long.requests, long.errors, long.slow
+	if long.requests > 0 {
+		r.SuccessRate = 1 - float64(long.errors)/float64(long.requests)
+	}
+	r.P50 = long.percentile(0.5)
+	r.P90 = long.percentile(0.9)
+	r.P99 = long.percentile(0.99)
+	if r.Objective == nil {
+		return
+	}
+	r.BurnRate = r.Objective.burnRate(long)
+	r.ShortBurnRate = r.Objective.burnRate(short)
+	r.ErrorBudgetRemaining = 1 - r.BurnRate
+	r.Alerting = r.Objective.BurnRate > 0 && r.BurnRate >= r.Objective.BurnRate && r.ShortBurnRate >= r.Objective.BurnRate
+}
+
+// MarshalJSON writes durations as strings, e.g. "120ms"
+func (r Report) MarshalJSON() ([]byte, error) {
+	type objective struct {
+		Availability  float64 `json:"availability,omitempty"`
+		Latency       string  `json:"latency,omitempty"`
+		LatencyTarget float64 `json:"latency_target,omitempty"`
+		BurnRate      float64 `json:"burn_rate,omitempty"`
+	}
+	v := struct {
+		Operation            string     `json:"operation"`
+		Requests             int64      `json:"requests"`
+		Errors               int64      `json:"errors"`
+		Slow                 int64      `json:"slow"`
+		SuccessRate          float64    `json:"success_rate"`
+		P50                  string     `json:"p50"`
+		P90                  string     `json:"p90"`
+		P99                  string     `json:"p99"`
+		Objective            *objective `json:"objective,omitempty"`
+		ErrorBudgetRemaining float64    `json:"error_budget_remaining,omitempty"`
+		BurnRate             float64    `json:"burn_rate,omitempty"`
+		ShortBurnRate        float64    `json:"short_burn_rate,omitempty"`
+		Alerting             bool       `json:"alerting"`
+	}{
+		Operation:            r.Operation,
+		Requests:             r.Requests,
+		Errors:               r.Errors,
+		Slow:                 r.Slow,
+		SuccessRate:          r.SuccessRate,
+		P50:                  r.P50.String(),
+		P90:                  r.P90.String(),
+		P99:                  r.P99.String(),
+		ErrorBudgetRemaining: r.ErrorBudgetRemaining,
+		BurnRate:             r.BurnRate,
+		ShortBurnRate:        r.ShortBurnRate,
+		Alerting:             r.Alerting,
+	}
+	if o := r.Objective; o != nil {
+		v.Objective = &objective{Availability: o.Availability, LatencyTarget: o.LatencyTarget, BurnRate: o.BurnRate}
+		if o.Latency > 0 {
+			v.Objective.Latency = o.Latency.String()
+		}
+	}
+	return json.Marshal(v)
+}
+
+// burnRate returns the highest burn rate of the availability and latency SLIs over w
+func (o Objective) burnRate(w window) float64 {
+	if w.requests == 0 {
+		return 0
+	}
+	var rate float64
+	if o.Availability > 0 && o.Availability < 1 {
+		rate = float64(w.errors) / float64(w.requests) / (1 - o.Availability)
+	}
+	if o.Latency > 0 && o.LatencyTarget > 0 && o.LatencyTarget < 1 {
+		rate = max(rate, float64(w.slow)/float64(w.requests)/(1-o.LatencyTarget))
+	}
+	return rate
+}
+
+func defaultSuccess(status int) bool {
+	return status < http.StatusInternalServerError
+}
@@ -0,0 +1,102 @@
+package slo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-kenka/ginpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tr := New(Config{
+		Window:      time.Hour,
+		ShortWindow: 5 * time.Minute,
+		Resolution:  time.Minute,
+		Objectives: map[string]Objective{
+			"/svc/Get": {Availability: 0.99, Latency: 100 * time.Millisecond, LatencyTarget: 0.9, BurnRate: 10},
+		},
+	})
+	tr.now = func() time.Time { return now }
+
+	// 100 requests long ago in the window: 1 error, 5 slow
+	for i := 0; i < 100; i++ {
+		status, latency := http.StatusOK, 20*time.Millisecond
+		if i == 0 {
+			status = http.StatusInternalServerError
+		}
+		if i < 5 {
+			latency = 150 * time.Millisecond
+		}
+		tr.Record("/svc/Get", status, latency)
+	}
+	tr.Record("/svc/List", http.StatusNotFound, time.Millisecond)
+
+	reports := tr.Reports()
+	require.Len(t, reports, 2)
+	r := reports[0]
+	assert.Equal(t, "/svc/Get", r.Operation)
+	assert.EqualValues(t, 100, r.Requests)
+	assert.EqualValues(t, 1, r.Errors)
+	assert.EqualValues(t, 5, r.Slow)
+	assert.InDelta(t, 0.99, r.SuccessRate, 1e-9)
+	assert.True(t, r.P50 > 10*time.Millisecond && r.P50 <= 20*time.Millisecond, r.P50)
+	assert.True(t, r.P99 > 100*time.Millisecond && r.P99 <= 200*time.Millisecond, r.P99)
+	assert.InDelta(t, 1, r.BurnRate, 1e-9, "availability and latency budget both exactly spent")
+	assert.InDelta(t, 0, r.ErrorBudgetRemaining, 1e-9)
+	assert.False(t, r.Alerting)
+	assert.Equal(t, 1.0, reports[1].SuccessRate, "4xx count as success")
+	assert.Nil(t, reports[1].Objective)
+
+	// An outage burns fast in both windows
+	now = now.Add(30 * time.Minute)
+	for i := 0; i < 20; i++ {
+		tr.Record("/svc/Get", http.StatusServiceUnavailable, time.Millisecond)
+	}
+	r = tr.Reports()[0]
+	assert.InDelta(t, 21.0/120/0.01, r.BurnRate, 1e-9)
+	assert.InDelta(t, 100, r.ShortBurnRate, 1e-9)
+	assert.True(t, r.Alerting)
+
+	// The alert stops once the short window is clean, the outage leaves the window after an hour
+	now = now.Add(10 * time.Minute)
+	assert.False(t, tr.Reports()[0].Alerting)
+	now = now.Add(time.Hour)
+	assert.Zero(t, tr.Reports()[0].Requests)
+}
+
+func TestTrackerHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tr := New(DefaultConfig())
+	tr.SetObjectives(map[string]Objective{"/svc/Get": {Availability: 0.999, BurnRate: 14.4}})
+
+	e := gin.New()
+	e.Use(tr.Middleware())
+	e.GET("/users", func(c *gin.Context) {
+		c.Set(ginpb.OperationKey, "/svc/Get")
+		c.Status(http.StatusBadGateway)
+	})
+	e.GET("/slo", tr.Handler())
+	e.GET("/metrics", tr.Metrics())
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/slo?operation=/svc/Get", nil))
+	assert.Contains(t, w.Body.String(), `"operation":"/svc/Get","requests":1,"errors":1`)
+	assert.Contains(t, w.Body.String(), `"objective":{"availability":0.999,"burn_rate":14.4}`)
+	assert.Contains(t, w.Body.String(), `"alerting":true`)
+
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.True(t, strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain; version=0.0.4"))
+	body := w.Body.String()
+	assert.Contains(t, body, "# TYPE ginpb_slo_requests gauge\nginpb_slo_requests{operation=\"/svc/Get\"} 1\n")
+	assert.Contains(t, body, `ginpb_slo_burn_rate{operation="/svc/Get",window="short"} 999.99`)
+	assert.Contains(t, body, `ginpb_slo_alerting{operation="/svc/Get"} 1`)
+}
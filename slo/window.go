@@ -0,0 +1,99 @@
+package slo
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencyBounds are the upper bounds of the latency histogram buckets
+var latencyBounds = [...]time.Duration{
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond, 10 * time.Millisecond,
+	20 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond,
+	300 * time.Millisecond, 500 * time.Millisecond, 750 * time.Millisecond, time.Second,
+	1500 * time.Millisecond, 2 * time.Second, 3 * time.Second, 5 * time.Second,
+	10 * time.Second, 30 * time.Second, time.Minute,
+}
+
+// series is the ring of time buckets of an operation
+type series struct {
+	mu    sync.Mutex
+	slots []slot
+}
+
+// slot counts the requests of one time bucket
+type slot struct {
+	index    int64 // absolute time bucket, stale slots are reset on reuse
+	requests int64
+	errors   int64
+	slow     int64
+	latency  [len(latencyBounds) + 1]int64 // the last bucket counts requests over the largest bound
+}
+
+// window is the sum of the slots of a period
+type window struct {
+	requests int64
+	errors   int64
+	slow     int64
+	latency  [len(latencyBounds) + 1]int64
+}
+
+func (s *series) add(index int64, failed, slow bool, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sl := &s.slots[index%int64(len(s.slots))]
+	if sl.index != index {
+		*sl = slot{index: index}
+	}
+	sl.requests++
+	if failed {
+		sl.errors++
+	}
+	if slow {
+		sl.slow++
+	}
+	sl.latency[sort.Search(len(latencyBounds), func(i int) bool { return latency <= latencyBounds[i] })]++
+}
+
+// sum adds the slots of the last n buckets up to index
+func (s *series) sum(index int64, n int) window {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var w window
+	for i := range s.slots {
+		sl := &s.slots[i]
+		if sl.index > index-int64(n) && sl.index <= index {
+			w.requests += sl.requests
+			w.errors += sl.errors
+			w.slow += sl.slow
+			for b, c := range sl.latency {
+				w.latency[b] += c
+			}
+		}
+	}
+	return w
+}
+
+// percentile estimates the latency quantile q by linear interpolation within its histogram bucket
+func (w window) percentile(q float64) time.Duration {
+	if w.requests == 0 {
+		return 0
+	}
+	rank := q * float64(w.requests)
+	var seen float64
+	for b, c := range w.latency {
+		if c == 0 || seen+float64(c) < rank {
+			seen += float64(c)
+			continue
+		}
+		if b == len(latencyBounds) {
+			return latencyBounds[b-1]
+		}
+		var lower time.Duration
+		if b > 0 {
+			lower = latencyBounds[b-1]
+		}
+		return lower + time.Duration((rank-seen)/float64(c)*float64(latencyBounds[b]-lower))
+	}
+	return latencyBounds[len(latencyBounds)-1]
+}
@@ -0,0 +1,102 @@
+package ginpb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EventStream sends the replies of a server-streaming method as Server-Sent Events, flushing after every message.
+// Each message is a "message" event with JSON data and an increasing id. A successful stream ends with an "end" event,
+// a failed one with an "error" event, so clients tell completion from a dropped connection.
+type EventStream[T any] struct {
+	ctx     *gin.Context
+	naming  JSONNaming
+	prepare func(T) error
+	started bool
+	id      int
+}
+
+// NewEventStream creates an EventStream for ctx encoding messages with the given naming
+func NewEventStream[T any](ctx *gin.Context, naming JSONNaming) *EventStream[T] {
+	return &EventStream[T]{ctx: ctx, naming: naming}
+}
+
+// Prepare sets a function applied to every message before it is sent, generated handlers use it to encrypt fields
+func (s *EventStream[T]) Prepare(fn func(T) error) {
+	s.prepare = fn
+}
+
+// Context returns the request context, it is cancelled when the client disconnects
+func (s *EventStream[T]) Context() context.Context {
+	return s.ctx.Request.Context()
+}
+
+// Send writes one message, it fails once the client has gone away
+func (s *EventStream[T]) Send(msg T) error {
+	if err := s.Context().Err(); err != nil {
+		return err
+	}
+	if s.prepare != nil {
+		if err := s.prepare(msg); err != nil {
+			return err
+		}
+	}
+	data, ok, err := s.naming.marshal(msg)
+	if !ok {
+		data, err = json.Marshal(msg)
+	}
+	if err != nil {
+		return err
+	}
+	s.id++
+	return s.write("message", strconv.Itoa(s.id), data)
+}
+
+// Close finishes the stream with the result of the service method.
+// If the method failed before sending anything the error is reported as usual, otherwise an "error" event is sent.
+func (s *EventStream[T]) Close(err error) {
+	if err != nil {
+		_ = s.ctx.Error(err)
+		if !s.started || errors.Is(err, context.Canceled) || s.Context().Err() != nil {
+			return
+		}
+		data, _ := json.Marshal(gin.H{"error": err.Error()})
+		_ = s.write("error", "", data)
+		return
+	}
+	_ = s.write("end", "", []byte("{}"))
+}
+
+// write sends one event, starting the stream on the first call
+func (s *EventStream[T]) write(event, id string, data []byte) error {
+	if !s.started {
+		s.started = true
+		h := s.ctx.Writer.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		h.Set("Connection", "keep-alive")
+		// Disable response buffering of nginx
+		h.Set("X-Accel-Buffering", "no")
+		s.ctx.Status(http.StatusOK)
+	}
+	var b strings.Builder
+	b.WriteString("event: " + event + "\n")
+	if id != "" {
+		b.WriteString("id: " + id + "\n")
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	if _, err := s.ctx.Writer.WriteString(b.String()); err != nil {
+		return err
+	}
+	s.ctx.Writer.Flush()
+	return nil
+}
@@ -0,0 +1,49 @@
+package ginpb
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	type event struct {
+		Name string `json:"name"`
+	}
+	var fail error
+	e := gin.New()
+	e.GET("/watch", func(c *gin.Context) {
+		s := NewEventStream[*event](c, JSONDefault)
+		s.Prepare(func(ev *event) error {
+			ev.Name += "!"
+			return nil
+		})
+		err := s.Send(&event{Name: "a"})
+		if err == nil {
+			err = s.Send(&event{Name: "b"})
+		}
+		if err == nil {
+			err = fail
+		}
+		s.Close(err)
+	})
+	watch := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/watch", nil))
+		return w
+	}
+
+	w := watch()
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "event: message\nid: 1\ndata: {\"name\":\"a!\"}\n\n"+
+		"event: message\nid: 2\ndata: {\"name\":\"b!\"}\n\n"+
+		"event: end\ndata: {}\n\n", w.Body.String())
+
+	fail = errors.New("upstream closed")
+	assert.Contains(t, watch().Body.String(), "event: error\ndata: {\"error\":\"upstream closed\"}\n\n")
+}
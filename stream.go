@@ -0,0 +1,110 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Stream formats supported by ListWriter
+const (
+	StreamFormatJSON   = "json"
+	StreamFormatNDJSON = "ndjson"
+)
+
+// ListWriter streams list items as a chunked JSON array or NDJSON, flushing after every item
+type ListWriter struct {
+	ctx     *gin.Context
+	ndjson  bool
+	started bool
+	enc     *json.Encoder
+	naming  JSONNaming
+	engine  JSONEngine
+}
+
+// NewListWriter creates a ListWriter for ctx in the given format, JSON array by default
+func NewListWriter(ctx *gin.Context, format string) *ListWriter {
+	return NewListWriterWithNaming(ctx, format, JSONDefault)
+}
+
+// NewListWriterWithNaming creates a ListWriter encoding message items with the given naming
+func NewListWriterWithNaming(ctx *gin.Context, format string, naming JSONNaming) *ListWriter {
+	return &ListWriter{
+		ctx:    ctx,
+		ndjson: format == StreamFormatNDJSON,
+		enc:    json.NewEncoder(ctx.Writer),
+		naming: naming,
+	}
+}
+
+// WithEngine marshals items with engine where encoding/json would be used, nil keeps encoding/json
+func (w *ListWriter) WithEngine(engine JSONEngine) *ListWriter {
+	w.engine = engine
+	return w
+}
+
+// Send writes one item, it fails once the client has gone away
+func (w *ListWriter) Send(item any) error {
+	if err := w.ctx.Request.Context().Err(); err != nil {
+		return err
+	}
+	if !w.started {
+		w.start()
+	} else if !w.ndjson {
+		if _, err := w.ctx.Writer.WriteString(","); err != nil {
+			return err
+		}
+	}
+	if err := w.encode(item); err != nil {
+		return err
+	}
+	w.ctx.Writer.Flush()
+	return nil
+}
+
+// Close finishes the stream with the handler result.
+// If the handler failed before sending anything the error is reported as usual,
+// otherwise the error is recorded and a JSON array is left unterminated so clients detect truncation.
+func (w *ListWriter) Close(err error) {
+	if err != nil {
+		_ = w.ctx.Error(err)
+		return
+	}
+	if !w.started {
+		w.start()
+	}
+	if !w.ndjson {
+		_, _ = w.ctx.Writer.WriteString("]")
+	}
+	w.ctx.Writer.Flush()
+}
+
+// encode writes item followed by a newline like json.Encoder
+func (w *ListWriter) encode(item any) error {
+	b, ok, err := w.naming.marshalWith(w.engine, item)
+	if !ok && w.engine == nil {
+		return w.enc.Encode(item)
+	}
+	if !ok {
+		b, err = w.engine.Marshal(item)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.ctx.Writer.Write(append(b, '\n'))
+	return err
+}
+
+func (w *ListWriter) start() {
+	w.started = true
+	if w.ndjson {
+		w.ctx.Header("Content-Type", "application/x-ndjson")
+	} else {
+		w.ctx.Header("Content-Type", "application/json; charset=utf-8")
+	}
+	w.ctx.Status(http.StatusOK)
+	if !w.ndjson {
+		_, _ = w.ctx.Writer.WriteString("[")
+	}
+}
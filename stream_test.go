@@ -0,0 +1,94 @@
+package ginpb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWriter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	failed := errors.New("database gone")
+	tests := []struct {
+		name        string
+		format      string
+		items       int
+		err         error
+		body        string
+		contentType string
+	}{
+		{"json array", StreamFormatJSON, 2, nil, "[{\"id\":1}\n,{\"id\":2}\n]", "application/json; charset=utf-8"},
+		{"empty json array", StreamFormatJSON, 0, nil, "[]", "application/json; charset=utf-8"},
+		{"unknown format is a json array", "csv", 1, nil, "[{\"id\":1}\n]", "application/json; charset=utf-8"},
+		{"json array failing partway is left unterminated", StreamFormatJSON, 2, failed, "[{\"id\":1}\n,{\"id\":2}\n", "application/json; charset=utf-8"},
+		{"ndjson", StreamFormatNDJSON, 2, nil, "{\"id\":1}\n{\"id\":2}\n", "application/x-ndjson"},
+		{"empty ndjson", StreamFormatNDJSON, 0, nil, "", "application/x-ndjson"},
+		{"ndjson failing partway", StreamFormatNDJSON, 1, failed, "{\"id\":1}\n", "application/x-ndjson"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/users:export", nil)
+
+			lw := NewListWriter(c, tt.format)
+			for i := 1; i <= tt.items; i++ {
+				require.NoError(t, lw.Send(map[string]int{"id": i}))
+			}
+			lw.Close(tt.err)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.body, w.Body.String())
+			assert.True(t, w.Flushed)
+			if tt.err != nil {
+				assert.Equal(t, []error{tt.err}, errorsOf(c))
+			} else {
+				assert.Empty(t, c.Errors)
+			}
+		})
+	}
+}
+
+func TestListWriterFailsBeforeSending(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users:export", nil)
+
+	// Nothing is written so the error is rendered as usual
+	failed := errors.New("permission denied")
+	NewListWriter(c, StreamFormatJSON).Close(failed)
+	assert.False(t, c.Writer.Written())
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, []error{failed}, errorsOf(c))
+}
+
+func TestListWriterClientGone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx, cancel := context.WithCancel(context.Background())
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/users:export", nil).WithContext(ctx)
+
+	lw := NewListWriter(c, StreamFormatNDJSON)
+	require.NoError(t, lw.Send(map[string]int{"id": 1}))
+	cancel()
+	assert.ErrorIs(t, lw.Send(map[string]int{"id": 2}), context.Canceled)
+	assert.Equal(t, "{\"id\":1}\n", w.Body.String())
+}
+
+// errorsOf returns the errors recorded on c
+func errorsOf(c *gin.Context) []error {
+	var errs []error
+	for _, e := range c.Errors {
+		errs = append(errs, e.Err)
+	}
+	return errs
+}
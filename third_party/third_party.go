@@ -0,0 +1,10 @@
+// Package thirdparty bundles the proto files services generated with protoc-gen-gin import, such as the
+// google.api annotations and the ginpb options, so tools can put them on the include path.
+package thirdparty
+
+import "embed"
+
+// Protos holds the bundled proto files by import path, e.g. google/api/annotations.proto
+//
+//go:embed */*.proto */*/*.proto
+var Protos embed.FS
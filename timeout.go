@@ -0,0 +1,25 @@
+package ginpb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// CodeDeadlineExceeded is the error of methods whose (ginpb.timeout) fired before the service returned,
+// generated handlers return it with the operation and the timeout as arguments
+var CodeDeadlineExceeded = &ErrorCode{Status: http.StatusGatewayTimeout, Reason: "DEADLINE_EXCEEDED", Message: "operation %s did not finish within %s"}
+
+// TimeoutError returns CodeDeadlineExceeded for the error of a service called with ctx bounded by the method
+// timeout once its deadline fired. Errors of an ErrorCode and errors returned before the deadline are kept.
+func TimeoutError(ctx context.Context, err error, operation string, timeout time.Duration) error {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return CodeDeadlineExceeded.New(operation, timeout)
+}
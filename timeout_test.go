@@ -0,0 +1,30 @@
+package ginpb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutError(t *testing.T) {
+	expired, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-expired.Done()
+
+	err := TimeoutError(expired, fmt.Errorf("query users: %w", context.DeadlineExceeded), "/example.Svc/ListUsers", 5*time.Second)
+	assert.True(t, IsErrorReason(err, "DEADLINE_EXCEEDED"))
+	assert.EqualError(t, err, "operation /example.Svc/ListUsers did not finish within 5s")
+
+	// Errors of the service keep their code
+	unimplemented := CodeUnimplemented.New("/example.Svc/ListUsers")
+	assert.Same(t, unimplemented, TimeoutError(expired, unimplemented, "/example.Svc/ListUsers", 5*time.Second))
+	assert.NoError(t, TimeoutError(expired, nil, "/example.Svc/ListUsers", 5*time.Second))
+
+	// Errors returned before the deadline are not timeouts
+	failed := errors.New("boom")
+	assert.Same(t, failed, TimeoutError(context.Background(), failed, "/example.Svc/ListUsers", 5*time.Second))
+}
@@ -0,0 +1,27 @@
+package ginpb
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeclareTrailers announces response trailers in the Trailer header, it must be called before the body is written.
+// Declaring trailers forces a chunked response so they are delivered even for small bodies.
+func DeclareTrailers(c *gin.Context, keys ...string) {
+	for _, key := range keys {
+		c.Writer.Header().Add("Trailer", http.CanonicalHeaderKey(key))
+	}
+}
+
+// SetTrailer sets a response trailer once the body has been written, e.g. a checksum computed while streaming.
+// Undeclared trailers are only delivered when the response is already chunked.
+func SetTrailer(c *gin.Context, key, value string) {
+	c.Writer.Header().Set(http.TrailerPrefix+key, value)
+}
+
+// RequestTrailer returns the trailers sent by the client.
+// They are only populated after the request body has been read to EOF.
+func RequestTrailer(c *gin.Context) http.Header {
+	return c.Request.Trailer
+}
@@ -0,0 +1,53 @@
+package ginpb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kenka/ginpb/client"
+)
+
+// Upstream configures the client of an upstream service
+type Upstream struct {
+	Endpoint string        `json:"endpoint" yaml:"endpoint"`
+	Timeout  time.Duration `json:"timeout" yaml:"timeout"`
+	Retries  int           `json:"retries" yaml:"retries"`
+	// PropagateHeaders forwards the headers captured by propagation.Middleware, see client.WithHeaderPropagation
+	PropagateHeaders bool `json:"propagate_headers" yaml:"propagate_headers"`
+
+	// Options are applied after the options derived from the fields above, e.g. a token source
+	Options []client.ClientOption `json:"-" yaml:"-"`
+}
+
+// Upstreams maps the full names of upstream services, e.g. "billing.v1.BillingService", to their client config.
+// It is passed to the generated NewXDependencies of services declaring (ginpb.depends_on).
+type Upstreams map[string]Upstream
+
+// ClientOptions returns the client options of an upstream service
+func (u Upstreams) ClientOptions(service string) ([]client.ClientOption, error) {
+	up, ok := u[service]
+	if !ok || up.Endpoint == "" {
+		return nil, fmt.Errorf("upstream %s has no endpoint configured", service)
+	}
+	opts := []client.ClientOption{client.WithEndpoint(up.Endpoint)}
+	if up.Timeout > 0 {
+		opts = append(opts, client.WithTimeout(up.Timeout))
+	}
+	if up.Retries > 0 {
+		opts = append(opts, client.WithRetryCount(up.Retries))
+	}
+	if up.PropagateHeaders {
+		opts = append(opts, client.WithHeaderPropagation())
+	}
+	return append(opts, up.Options...), nil
+}
+
+// NewUpstreamClient constructs the client of an upstream service with the generated NewXHTTPClient
+func NewUpstreamClient[T any](upstreams Upstreams, service string, newClient func(...client.ClientOption) T) (T, error) {
+	opts, err := upstreams.ClientOptions(service)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return newClient(opts...), nil
+}
@@ -0,0 +1,23 @@
+package ginpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kenka/ginpb/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpstreamsClientOptions(t *testing.T) {
+	upstreams := Upstreams{
+		"billing.v1.BillingService": {Endpoint: "http://billing:8000", Timeout: time.Second, Retries: 2},
+	}
+
+	opts, err := upstreams.ClientOptions("billing.v1.BillingService")
+	require.NoError(t, err)
+	assert.Len(t, opts, 3)
+
+	_, err = NewUpstreamClient(upstreams, "users.v1.UserService", client.NewClient)
+	assert.EqualError(t, err, "upstream users.v1.UserService has no endpoint configured")
+}
@@ -0,0 +1,207 @@
+package ginpb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validator validates bound requests before the service method is called, handlers generated with
+// the validate option use it. Pass it with the generated WithXValidator option or RegisterConfig.Validator.
+type Validator interface {
+	Validate(msg proto.Message) error
+}
+
+// ValidatorFunc adapts a function to a Validator, e.g. protovalidate:
+//
+//	v, _ := protovalidate.New()
+//	ginpb.ValidatorFunc(func(m proto.Message) error { return v.Validate(m) })
+type ValidatorFunc func(msg proto.Message) error
+
+// Validate calls f(msg)
+func (f ValidatorFunc) Validate(msg proto.Message) error {
+	return f(msg)
+}
+
+// DefaultValidator validates messages with their generated ValidateAll or Validate method (protoc-gen-validate),
+// messages without such methods are valid
+var DefaultValidator Validator = ValidatorFunc(func(msg proto.Message) error {
+	switch m := msg.(type) {
+	case interface{ ValidateAll() error }:
+		return m.ValidateAll()
+	case interface{ Validate() error }:
+		return m.Validate()
+	}
+	return nil
+})
+
+// FieldViolation is a violated validation rule of a request field
+type FieldViolation struct {
+	// Field is the path of the field, e.g. "address.city" or "tags[0]"
+	Field string `json:"field"`
+	// Rule is the id of the violated rule when the validator reports it, e.g. "string.min_len"
+	Rule string `json:"rule,omitempty"`
+	// Message describes the violation
+	Message string `json:"message"`
+}
+
+// ValidationResponse is the body of the 400 response to requests violating their validation rules
+type ValidationResponse struct {
+	Error      string           `json:"error"`
+	Violations []FieldViolation `json:"violations"`
+}
+
+// ValidateRequest validates msg with v, or DefaultValidator when v is nil. Requests violating their rules are
+// aborted with 400 and a ValidationResponse listing the field violations, other validator errors are reported
+// to gin and aborted with 500. It returns whether the handler may continue.
+func ValidateRequest(c *gin.Context, v Validator, msg proto.Message) bool {
+	if v == nil {
+		v = DefaultValidator
+	}
+	err := v.Validate(msg)
+	if err == nil {
+		return true
+	}
+	violations := Violations(err)
+	if len(violations) == 0 {
+		_ = c.AbortWithError(http.StatusInternalServerError, fmt.Errorf("validate %s: %w", msg.ProtoReflect().Descriptor().FullName(), err))
+		return false
+	}
+	_ = c.Error(err).SetType(gin.ErrorTypeBind)
+	c.AbortWithStatusJSON(http.StatusBadRequest, ValidationResponse{Error: "invalid request", Violations: violations})
+	return false
+}
+
+// Violations extracts the field violations of a validation error. It understands the errors of
+// protoc-gen-validate (Field, Reason, Cause and AllErrors methods) and of protovalidate (ToProto returning
+// buf.validate.Violations). It returns nil for errors that are not validation errors.
+func Violations(err error) []FieldViolation {
+	var res []FieldViolation
+	collectViolations(err, "", &res)
+	return res
+}
+
+// pgvError is a single field error of protoc-gen-validate
+type pgvError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+func collectViolations(err error, prefix string, res *[]FieldViolation) {
+	if err == nil {
+		return
+	}
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		for _, e := range multi.AllErrors() {
+			collectViolations(e, prefix, res)
+		}
+		return
+	}
+	var fe pgvError
+	if errors.As(err, &fe) {
+		field := joinFieldPath(prefix, fe.Field())
+		// Errors of embedded messages carry the violations of the nested fields as cause
+		before := len(*res)
+		collectViolations(fe.Cause(), field, res)
+		if len(*res) == before {
+			*res = append(*res, FieldViolation{Field: field, Message: fe.Reason()})
+		}
+		return
+	}
+	if pb, ok := toProto(err); ok {
+		protoViolations(pb, prefix, res)
+	}
+}
+
+// toProto returns the proto form of a protovalidate error without depending on protovalidate
+func toProto(err error) (protoreflect.Message, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		m := reflect.ValueOf(err).MethodByName("ToProto")
+		if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+			continue
+		}
+		if pb, ok := m.Call(nil)[0].Interface().(proto.Message); ok && pb != nil {
+			return pb.ProtoReflect(), true
+		}
+	}
+	return nil, false
+}
+
+// protoViolations reads a buf.validate.Violations message by field names
+func protoViolations(m protoreflect.Message, prefix string, res *[]FieldViolation) {
+	list := messageField(m, "violations")
+	if !list.IsValid() {
+		return
+	}
+	for i := 0; i < list.List().Len(); i++ {
+		v := list.List().Get(i).Message()
+		path := stringField(v, "field_path")
+		if fp := messageField(v, "field"); fp.IsValid() && fp.Message().IsValid() {
+			path = fieldPath(fp.Message())
+		}
+		*res = append(*res, FieldViolation{
+			Field:   joinFieldPath(prefix, path),
+			Rule:    stringField(v, "rule_id"),
+			Message: stringField(v, "message"),
+		})
+	}
+}
+
+// fieldPath formats a buf.validate.FieldPath, e.g. "items[0].tags[\"k\"]"
+func fieldPath(m protoreflect.Message) string {
+	elements := messageField(m, "elements")
+	if !elements.IsValid() {
+		return ""
+	}
+	var b strings.Builder
+	for i := 0; i < elements.List().Len(); i++ {
+		e := elements.List().Get(i).Message()
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(stringField(e, "field_name"))
+		if od := e.Descriptor().Oneofs().ByName("subscript"); od != nil {
+			if fd := e.WhichOneof(od); fd != nil {
+				if fd.Kind() == protoreflect.StringKind {
+					fmt.Fprintf(&b, "[%q]", e.Get(fd).String())
+				} else {
+					fmt.Fprintf(&b, "[%v]", e.Get(fd).Interface())
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// messageField returns the value of the field name of m, or an invalid value when m has no such field
+func messageField(m protoreflect.Message, name protoreflect.Name) protoreflect.Value {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil {
+		return protoreflect.Value{}
+	}
+	return m.Get(fd)
+}
+
+// stringField returns the string field name of m, or "" when m has no such field
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	if v := messageField(m, name); v.IsValid() {
+		if s, ok := v.Interface().(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+func joinFieldPath(prefix, field string) string {
+	if prefix == "" || field == "" {
+		return prefix + field
+	}
+	return prefix + "." + field
+}
@@ -0,0 +1,144 @@
+package ginpb
+
+import (
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fieldError mirrors the errors generated by protoc-gen-validate
+type fieldError struct {
+	field, reason string
+	cause         error
+}
+
+func (e fieldError) Field() string  { return e.field }
+func (e fieldError) Reason() string { return e.reason }
+func (e fieldError) Cause() error   { return e.cause }
+func (e fieldError) Error() string  { return fmt.Sprintf("invalid %s: %s", e.field, e.reason) }
+
+type multiError []error
+
+func (m multiError) Error() string      { return fmt.Sprintf("%d errors", len(m)) }
+func (m multiError) AllErrors() []error { return m }
+
+// protoError mirrors protovalidate.ValidationError
+type protoError struct{ pb proto.Message }
+
+func (e *protoError) Error() string          { return "validation error" }
+func (e *protoError) ToProto() proto.Message { return e.pb }
+
+// violationsMessage builds a message with the schema of buf.validate.Violations
+func violationsMessage(t *testing.T) protoreflect.Message {
+	str, msg, u64 := descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(), descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum()
+	opt, rep := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(), descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("violations.proto"),
+		Package: proto.String("buf.validate"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Violations"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("violations"), Number: proto.Int32(1), Label: rep, Type: msg, TypeName: proto.String(".buf.validate.Violation")},
+			}},
+			{Name: proto.String("Violation"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("rule_id"), Number: proto.Int32(2), Label: opt, Type: str},
+				{Name: proto.String("message"), Number: proto.Int32(3), Label: opt, Type: str},
+				{Name: proto.String("field"), Number: proto.Int32(5), Label: opt, Type: msg, TypeName: proto.String(".buf.validate.FieldPath")},
+			}},
+			{Name: proto.String("FieldPath"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("elements"), Number: proto.Int32(1), Label: rep, Type: msg, TypeName: proto.String(".buf.validate.FieldPathElement")},
+			}},
+			{Name: proto.String("FieldPathElement"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: proto.String("field_name"), Number: proto.Int32(2), Label: opt, Type: str},
+				{Name: proto.String("index"), Number: proto.Int32(6), Label: opt, Type: u64, OneofIndex: proto.Int32(0)},
+				{Name: proto.String("string_key"), Number: proto.Int32(10), Label: opt, Type: str, OneofIndex: proto.Int32(0)},
+			}, OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("subscript")}}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	msgs := fd.Messages()
+	newMsg := func(name protoreflect.Name) protoreflect.Message {
+		return dynamicpb.NewMessage(msgs.ByName(name))
+	}
+	set := func(m protoreflect.Message, name protoreflect.Name, v protoreflect.Value) {
+		m.Set(m.Descriptor().Fields().ByName(name), v)
+	}
+	element := func(name string, subscript protoreflect.Name, v protoreflect.Value) protoreflect.Value {
+		e := newMsg("FieldPathElement")
+		set(e, "field_name", protoreflect.ValueOfString(name))
+		if subscript != "" {
+			set(e, subscript, v)
+		}
+		return protoreflect.ValueOfMessage(e)
+	}
+
+	path := newMsg("FieldPath")
+	elements := path.Mutable(path.Descriptor().Fields().ByName("elements")).List()
+	elements.Append(element("items", "index", protoreflect.ValueOfUint64(2)))
+	elements.Append(element("labels", "string_key", protoreflect.ValueOfString("env")))
+	violation := newMsg("Violation")
+	set(violation, "rule_id", protoreflect.ValueOfString("string.min_len"))
+	set(violation, "message", protoreflect.ValueOfString("value length must be at least 1 characters"))
+	set(violation, "field", protoreflect.ValueOfMessage(path))
+	violations := newMsg("Violations")
+	violations.Mutable(violations.Descriptor().Fields().ByName("violations")).List().Append(protoreflect.ValueOfMessage(violation))
+	return violations
+}
+
+func TestViolations(t *testing.T) {
+	// protoc-gen-validate errors, embedded message errors carry the nested violations as cause
+	err := multiError{
+		fieldError{field: "Name", reason: "value length must be at least 1 runes"},
+		fieldError{field: "Address", reason: "embedded message failed validation", cause: fieldError{field: "City", reason: "value is required"}},
+	}
+	assert.Equal(t, []FieldViolation{
+		{Field: "Name", Message: "value length must be at least 1 runes"},
+		{Field: "Address.City", Message: "value is required"},
+	}, Violations(err))
+	assert.Equal(t, []FieldViolation{{Field: "Name", Message: "empty"}}, Violations(fmt.Errorf("wrapped: %w", fieldError{field: "Name", reason: "empty"})))
+
+	// protovalidate errors are read from their proto form
+	pe := &protoError{pb: violationsMessage(t).Interface()}
+	assert.Equal(t, []FieldViolation{
+		{Field: `items[2].labels["env"]`, Rule: "string.min_len", Message: "value length must be at least 1 characters"},
+	}, Violations(pe))
+
+	assert.Nil(t, Violations(errors.New("compile rules")))
+	assert.Nil(t, Violations(nil))
+}
+
+func TestValidateRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	run := func(v Validator) (*httptest.ResponseRecorder, bool) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		ok := ValidateRequest(c, v, wrapperspb.String(""))
+		return w, ok
+	}
+
+	// Messages without generated Validate methods are valid with DefaultValidator
+	_, ok := run(nil)
+	assert.True(t, ok)
+
+	w, ok := run(ValidatorFunc(func(proto.Message) error {
+		return fieldError{field: "Value", reason: "value is required"}
+	}))
+	assert.False(t, ok)
+	assert.Equal(t, 400, w.Code)
+	assert.JSONEq(t, `{"error":"invalid request","violations":[{"field":"Value","message":"value is required"}]}`, w.Body.String())
+
+	// Errors that are not validation errors are server errors
+	w, ok = run(ValidatorFunc(func(proto.Message) error { return errors.New("compile rules") }))
+	assert.False(t, ok)
+	assert.Equal(t, 500, w.Code)
+}
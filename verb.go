@@ -0,0 +1,111 @@
+package ginpb
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerbRoutes dispatches the custom verbs of AIP paths sharing one gin route, e.g. POST /v1/operations/{name}:cancel
+// and POST /v1/operations/{name}:undelete both route /v1/operations/:name. gin cannot match a literal after
+// a parameter in one segment, the route matches the whole last segment and the verb after its last ':' picks
+// the handlers, which run on the incoming context. Generated registrations use one per service.
+type VerbRoutes struct {
+	mu     sync.Mutex
+	routes map[string]*verbRoute // by method and full path
+}
+
+// verbRoute is a gin route shared by custom verbs
+type verbRoute struct {
+	mu     sync.RWMutex
+	chains map[string]gin.HandlersChain // by verb
+}
+
+// verbChainKey holds the handlers of the verb of a request
+const verbChainKey = "ginpb.verb.chain"
+
+// NewVerbRoutes creates an empty verb dispatcher
+func NewVerbRoutes() *VerbRoutes {
+	return &VerbRoutes{routes: make(map[string]*verbRoute)}
+}
+
+// Handle sets the handlers of verb on the gin route of method and path. The verb is ":cancel" for a last
+// segment holding a path variable, e.g. {name}:cancel, or the whole segment, e.g. "things:batchGet", for
+// a literal one routed as a parameter. It returns the handlers to mount on the route when the route is new,
+// nil when it is mounted already.
+func (v *VerbRoutes) Handle(r gin.IRouter, method, path, verb string, handlers ...gin.HandlerFunc) gin.HandlersChain {
+	full := path
+	if g, ok := r.(interface{ BasePath() string }); ok {
+		full = joinPaths(g.BasePath(), path)
+	}
+	chain := append(gin.HandlersChain{trimVerb(verb)}, handlers...)
+	checkChain(method, VerbPath(full, verb), chain)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	route, ok := v.routes[method+" "+full]
+	if !ok {
+		route = &verbRoute{chains: make(map[string]gin.HandlersChain)}
+		v.routes[method+" "+full] = route
+	}
+	route.mu.Lock()
+	route.chains[verb] = chain
+	route.mu.Unlock()
+	if ok {
+		return nil
+	}
+	return chainSlots(verbChainKey, route.pick)
+}
+
+// pick returns the handlers of the verb of the last path segment, nil for unknown verbs
+func (r *verbRoute) pick(c *gin.Context) gin.HandlersChain {
+	segment := c.Request.URL.Path[strings.LastIndex(c.Request.URL.Path, "/")+1:]
+	i := strings.LastIndex(segment, ":")
+	if i < 0 {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if chain, ok := r.chains[segment]; ok {
+		return chain
+	}
+	return r.chains[segment[i:]]
+}
+
+// trimVerb removes ":verb" from the path variable of the last segment
+func trimVerb(verb string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if n := len(c.Params); n > 0 && strings.HasPrefix(verb, ":") {
+			c.Params[n-1].Value = strings.TrimSuffix(c.Params[n-1].Value, verb)
+		}
+	}
+}
+
+// VerbPath returns the path of a route with its custom verb, e.g. /v1/operations/:name:cancel, for route listings
+func VerbPath(path, verb string) string {
+	switch {
+	case verb == "":
+		return path
+	case strings.HasPrefix(verb, ":"):
+		return path + verb
+	default:
+		return path[:strings.LastIndex(path, "/")+1] + verb
+	}
+}
+
+// verbRoutePath returns the gin route of a path recorded with its custom verb, the inverse of VerbPath for
+// generated routes, e.g. /v1/operations/:name of /v1/operations/:name:cancel. Literal segments with a verb
+// are routed as the ginpb.verb parameter, e.g. /v1/:ginpb.verb of /v1/things:batchGet.
+func verbRoutePath(path string) string {
+	i := strings.LastIndex(path, "/") + 1
+	segment := path[i:]
+	switch j := strings.LastIndex(segment, ":"); {
+	case j <= 0:
+		return path
+	case strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*"):
+		return path[:i+j]
+	default:
+		return path[:i] + ":ginpb.verb"
+	}
+}
@@ -0,0 +1,93 @@
+package ginpb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerbRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	verbs := NewVerbRoutes()
+	handle := func(path, verb string) {
+		if h := verbs.Handle(e, http.MethodPost, path, verb, func(c *gin.Context) {
+			c.String(http.StatusOK, verb+" "+c.Param("name"))
+		}); h != nil {
+			e.POST(path, h...)
+		}
+	}
+	handle("/v1/operations/:name", ":cancel")
+	handle("/v1/operations/:name", ":undelete")
+	handle("/v1/:ginpb.verb", "operations:purge")
+
+	for path, want := range map[string]string{
+		"/v1/operations/op1:cancel":   ":cancel op1",
+		"/v1/operations/op1:undelete": ":undelete op1",
+		"/v1/operations:purge":        "operations:purge ",
+		"/v1/operations/op1:unknown":  "",
+		"/v1/operations/op1":          "",
+	} {
+		w := httptest.NewRecorder()
+		e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		assert.Equal(t, want, w.Body.String(), path)
+		if want == "" {
+			assert.Equal(t, http.StatusNotFound, w.Code, path)
+		}
+	}
+
+	assert.Equal(t, "/v1/operations/:name:cancel", VerbPath("/v1/operations/:name", ":cancel"))
+	assert.Equal(t, "/v1/operations:purge", VerbPath("/v1/:ginpb.verb", "operations:purge"))
+}
+
+func TestVerbRoutesOuterContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.RemoteIPHeaders = []string{"X-Real-IP"}
+	var status int
+	e.Use(func(c *gin.Context) {
+		c.Set("tenant", "acme")
+		c.Next()
+		status = c.Writer.Status()
+	})
+	verbs := NewVerbRoutes()
+
+	var order []string
+	timing := func(c *gin.Context) {
+		order = append(order, "before")
+		c.Next()
+		order = append(order, "after")
+	}
+	h := verbs.Handle(e, http.MethodPost, "/jobs/:id", ":cancel", timing, func(c *gin.Context) {
+		order = append(order, "handler")
+		c.String(http.StatusOK, c.Param("id")+" "+c.ClientIP()+" "+c.GetString("tenant"))
+	})
+	e.POST("/jobs/:id", h...)
+	verbs.Handle(e, http.MethodPost, "/jobs/:id", ":deny", func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusForbidden)
+	}, func(c *gin.Context) {
+		t.Error("handlers after an abort do not run")
+	})
+
+	// The handlers run on the engine's context, with its settings, keys and middleware around them
+	req := httptest.NewRequest(http.MethodPost, "/jobs/7:cancel", nil)
+	req.RemoteAddr = "203.0.113.9:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	assert.Equal(t, "7 203.0.113.9 acme", w.Body.String(), "X-Forwarded-For is not trusted by the engine")
+	assert.Equal(t, []string{"before", "handler", "after"}, order)
+	assert.Equal(t, http.StatusOK, status)
+
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/jobs/7:deny", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	assert.PanicsWithValue(t, "ginpb: route POST /jobs/:id:huge has 33 handlers, a swappable route takes 1 to 32", func() {
+		verbs.Handle(e, http.MethodPost, "/jobs/:id", ":huge", make([]gin.HandlerFunc, maxChain)...)
+	})
+}
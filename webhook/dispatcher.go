@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb/client"
+)
+
+// ErrClosed is returned by Publish after Close
+var ErrClosed = errors.New("webhook dispatcher is closed")
+
+// Event is the JSON body of a delivery
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	// Data is the event message encoded with protojson
+	Data json.RawMessage `json:"data"`
+}
+
+// Config defines the config of NewDispatcher
+type Config struct {
+	// Store keeps subscriptions and delivery statuses, nil uses an in-memory store
+	Store Store
+	// Events are the event types subscriptions may select, set by the generated XWebhooks constructors.
+	// Empty accepts any type.
+	Events []string
+	// Client sends the deliveries to the subscribed URLs, nil uses a client with a 10s timeout
+	Client client.Client
+	// MaxAttempts is the number of attempts of a delivery, zero uses 5
+	MaxAttempts int
+	// Backoff returns the wait before attempt n+1 after attempt n failed, nil doubles from 1s up to 1m
+	Backoff func(attempt int) time.Duration
+	// Workers is the number of concurrent deliveries, zero uses 4. A worker waits out the backoff of its delivery.
+	Workers int
+	// QueueSize is the number of deliveries waiting for a worker, zero uses 1024
+	QueueSize int
+	// Now returns the current time, nil uses time.Now
+	Now func() time.Time
+}
+
+// DefaultBackoff doubles the wait after each failed attempt, from 1s up to 1m
+func DefaultBackoff(attempt int) time.Duration {
+	return min(time.Second<<min(attempt-1, 6), time.Minute)
+}
+
+// Dispatcher delivers published events to their subscriptions in the background and serves the
+// subscription management endpoints, see Register
+type Dispatcher struct {
+	config Config
+	queue  chan *job
+	done   chan struct{}
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// job is a delivery waiting for a worker
+type job struct {
+	sub      *Subscription
+	delivery *Delivery
+	body     []byte
+}
+
+// NewDispatcher creates a dispatcher and starts its workers, stop them with Close
+func NewDispatcher(config Config) *Dispatcher {
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+	if config.Client == nil {
+		config.Client = client.NewClient(client.WithTimeout(10 * time.Second))
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.Backoff == nil {
+		config.Backoff = DefaultBackoff
+	}
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1024
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	d := &Dispatcher{
+		config: config,
+		queue:  make(chan *job, config.QueueSize),
+		done:   make(chan struct{}),
+	}
+	for range config.Workers {
+		d.wg.Add(1)
+		go d.work()
+	}
+	return d
+}
+
+// Store returns the store of the dispatcher
+func (d *Dispatcher) Store() Store {
+	return d.config.Store
+}
+
+// Events returns the event types subscriptions may select
+func (d *Dispatcher) Events() []string {
+	return d.config.Events
+}
+
+// Publish queues the delivery of event to the subscriptions of its type and returns once their pending
+// deliveries are stored. Deliveries that do not fit into the queue are recorded as failed.
+func (d *Dispatcher) Publish(ctx context.Context, event string, payload proto.Message) error {
+	if !d.known(event) {
+		return fmt.Errorf("unknown webhook event %q, known events are %v", event, d.config.Events)
+	}
+	data, err := protojson.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode %s webhook event: %w", event, err)
+	}
+	now, id := d.config.Now(), newID()
+	body, err := json.Marshal(&Event{ID: id, Type: event, CreatedAt: now, Data: data})
+	if err != nil {
+		return fmt.Errorf("encode %s webhook event: %w", event, err)
+	}
+	subs, err := d.config.Store.ListSubscriptions(ctx)
+	if err != nil {
+		return fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return ErrClosed
+	}
+	var errs []error
+	for _, sub := range subs {
+		if !sub.Subscribed(event) {
+			continue
+		}
+		delivery := &Delivery{ID: newID(), SubscriptionID: sub.ID, EventID: id, Event: event, Status: StatusPending, UpdatedAt: now}
+		if err := d.config.Store.SaveDelivery(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("save webhook delivery to %s: %w", sub.URL, err))
+			continue
+		}
+		select {
+		case d.queue <- &job{sub: sub, delivery: delivery, body: body}:
+		default:
+			delivery.Status, delivery.Error = StatusFailed, "delivery queue is full"
+			errs = append(errs, fmt.Errorf("webhook delivery to %s dropped: queue of %d is full", sub.URL, d.config.QueueSize))
+			if err := d.config.Store.SaveDelivery(ctx, delivery); err != nil {
+				errs = append(errs, fmt.Errorf("save webhook delivery to %s: %w", sub.URL, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close stops accepting events and waits for the workers to finish the queued deliveries. Deliveries
+// waiting for a retry are left pending.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return
+	}
+	d.closed = true
+	close(d.done)
+	close(d.queue)
+	d.mu.Unlock()
+	d.wg.Wait()
+}
+
+// known reports whether subscriptions may select event
+func (d *Dispatcher) known(event string) bool {
+	return len(d.config.Events) == 0 || slices.Contains(d.config.Events, event)
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for j := range d.queue {
+		d.deliver(j)
+	}
+}
+
+// deliver sends a delivery until it succeeds, is rejected permanently or runs out of attempts
+func (d *Dispatcher) deliver(j *job) {
+	ctx := context.Background()
+	for {
+		j.delivery.Attempts++
+		code, err := d.send(ctx, j)
+		j.delivery.StatusCode = code
+		j.delivery.UpdatedAt = d.config.Now()
+		switch {
+		case err == nil:
+			j.delivery.Status, j.delivery.Error = StatusSucceeded, ""
+		case !retryable(code) || j.delivery.Attempts >= d.config.MaxAttempts:
+			j.delivery.Status, j.delivery.Error = StatusFailed, err.Error()
+		default:
+			j.delivery.Error = err.Error()
+		}
+		_ = d.config.Store.SaveDelivery(ctx, j.delivery)
+		if j.delivery.Status != StatusPending {
+			return
+		}
+		select {
+		case <-time.After(d.config.Backoff(j.delivery.Attempts)):
+		case <-d.done:
+			return
+		}
+	}
+}
+
+// send makes one signed attempt of a delivery and returns the error status of a rejected attempt, zero when
+// it succeeded or no response arrived
+func (d *Dispatcher) send(ctx context.Context, j *job) (int, error) {
+	err := d.config.Client.Invoke(ctx, http.MethodPost, j.sub.URL, j.body, nil,
+		client.ContentType("application/json"),
+		client.Header(SignatureHeader, Sign(j.sub.Secret, d.config.Now(), j.body)),
+		client.Header(EventHeader, j.delivery.Event),
+		client.Header(DeliveryHeader, j.delivery.ID),
+	)
+	if err == nil {
+		return 0, nil
+	}
+	var httpErr *client.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code, err
+	}
+	return 0, err
+}
+
+// retryable reports whether an attempt answered with code may succeed later: transport errors,
+// timeouts, throttling and server errors
+func retryable(code int) bool {
+	return code == 0 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500
+}
+
+// newID returns a random 128-bit hex id
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscribeRequest is the body of the subscribe endpoint
+type SubscribeRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// Register mounts the subscription management endpoints on r:
+//
+//	GET    /webhooks/events                           event types subscriptions may select
+//	POST   /webhooks/subscriptions                    subscribe, the reply carries the signing secret
+//	GET    /webhooks/subscriptions                    list subscriptions
+//	GET    /webhooks/subscriptions/:id                get a subscription
+//	DELETE /webhooks/subscriptions/:id                unsubscribe
+//	GET    /webhooks/subscriptions/:id/deliveries     delivery statuses of a subscription
+//
+// Protect them with the authentication middlewares of the router group.
+func (d *Dispatcher) Register(r gin.IRouter) {
+	g := r.Group("/webhooks")
+	g.GET("/events", d.listEvents)
+	g.POST("/subscriptions", d.subscribe)
+	g.GET("/subscriptions", d.listSubscriptions)
+	g.GET("/subscriptions/:id", d.getSubscription)
+	g.DELETE("/subscriptions/:id", d.unsubscribe)
+	g.GET("/subscriptions/:id/deliveries", d.listDeliveries)
+}
+
+func (d *Dispatcher) listEvents(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"events": d.config.Events})
+}
+
+func (d *Dispatcher) subscribe(c *gin.Context) {
+	var req SubscribeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid subscription: " + err.Error()})
+		return
+	}
+	if err := d.validate(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	sub := &Subscription{
+		ID:        newID(),
+		URL:       req.URL,
+		Events:    req.Events,
+		Secret:    "whsec_" + newID(),
+		CreatedAt: d.config.Now(),
+	}
+	if err := d.config.Store.CreateSubscription(c.Request.Context(), sub); err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "create webhook subscription failed"})
+		return
+	}
+	c.JSON(http.StatusCreated, sub)
+}
+
+// validate checks that a subscription targets an absolute http(s) URL and selects known event types
+func (d *Dispatcher) validate(req *SubscribeRequest) error {
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url %q must be an absolute http or https URL", req.URL)
+	}
+	if len(req.Events) == 0 {
+		return errors.New("events must select at least one event type")
+	}
+	for _, event := range req.Events {
+		if !d.known(event) {
+			return fmt.Errorf("unknown event %q, known events are %v", event, d.config.Events)
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) listSubscriptions(c *gin.Context) {
+	subs, err := d.config.Store.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "list webhook subscriptions failed"})
+		return
+	}
+	for _, sub := range subs {
+		sub.Secret = ""
+	}
+	c.JSON(http.StatusOK, gin.H{"subscriptions": subs})
+}
+
+func (d *Dispatcher) getSubscription(c *gin.Context) {
+	sub, ok := d.subscription(c)
+	if !ok {
+		return
+	}
+	sub.Secret = ""
+	c.JSON(http.StatusOK, sub)
+}
+
+func (d *Dispatcher) unsubscribe(c *gin.Context) {
+	err := d.config.Store.DeleteSubscription(c.Request.Context(), c.Param("id"))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("webhook subscription %q not found", c.Param("id"))})
+	case err != nil:
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "delete webhook subscription failed"})
+	default:
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (d *Dispatcher) listDeliveries(c *gin.Context) {
+	sub, ok := d.subscription(c)
+	if !ok {
+		return
+	}
+	deliveries, err := d.config.Store.ListDeliveries(c.Request.Context(), sub.ID)
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "list webhook deliveries failed"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"deliveries": deliveries})
+}
+
+// subscription loads the subscription of the id path parameter, writing the error response when it fails
+func (d *Dispatcher) subscription(c *gin.Context) (*Subscription, bool) {
+	sub, err := d.config.Store.GetSubscription(c.Request.Context(), c.Param("id"))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("webhook subscription %q not found", c.Param("id"))})
+		return nil, false
+	case err != nil:
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "get webhook subscription failed"})
+		return nil, false
+	}
+	return sub, true
+}
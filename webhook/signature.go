@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the signature of a delivery, "t=<unix seconds>,v1=<hex HMAC-SHA256>"
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader carries the event type of a delivery
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader carries the delivery id, retries of a delivery reuse it
+	DeliveryHeader = "X-Webhook-Delivery"
+)
+
+// DefaultTolerance is the maximum age of a signature accepted by Verify when tolerance is zero
+const DefaultTolerance = 5 * time.Minute
+
+// Sign returns the SignatureHeader value of body sent at t, the HMAC-SHA256 with secret of "<t>.<body>"
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac(secret, ts, body))
+}
+
+// Verify checks the SignatureHeader value of a received body at time now, rejecting signatures older than
+// tolerance to prevent replays
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	var ts string
+	var sigs [][]byte
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			if sig, err := hex.DecodeString(v); err == nil {
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return fmt.Errorf("malformed %s %q, want t=<unix seconds>,v1=<hex signature>", SignatureHeader, header)
+	}
+	if age := now.Sub(time.Unix(sec, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("%s timestamp is %s away from now, outside the tolerance of %s", SignatureHeader, age.Round(time.Second), tolerance)
+	}
+	expected := mac(secret, ts, body)
+	for _, sig := range sigs {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return errors.New("webhook signature does not match the body")
+}
+
+func mac(secret, ts string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
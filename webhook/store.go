@@ -0,0 +1,155 @@
+// Package webhook delivers events to the HTTP endpoints subscribed to them: subscription management
+// endpoints, a delivery worker signing and retrying requests with the client package, and the storage
+// of subscriptions and delivery statuses. Files declaring messages with (ginpb.event) generate a typed
+// XWebhooks dispatcher on top of it.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by stores for unknown subscriptions
+var ErrNotFound = errors.New("webhook subscription not found")
+
+// Subscription is an endpoint receiving the events of the given types
+type Subscription struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// Secret signs the deliveries, it is only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Subscribed reports whether the subscription receives events of type event
+func (s *Subscription) Subscribed(event string) bool {
+	return slices.Contains(s.Events, event)
+}
+
+// DeliveryStatus is the state of the delivery of an event to a subscription
+type DeliveryStatus string
+
+const (
+	// StatusPending deliveries are queued or waiting for a retry
+	StatusPending DeliveryStatus = "pending"
+	// StatusSucceeded deliveries were answered with a 2xx status
+	StatusSucceeded DeliveryStatus = "succeeded"
+	// StatusFailed deliveries were rejected permanently or ran out of attempts
+	StatusFailed DeliveryStatus = "failed"
+)
+
+// Delivery records the delivery of an event to a subscription
+type Delivery struct {
+	ID             string         `json:"id"`
+	SubscriptionID string         `json:"subscription_id"`
+	EventID        string         `json:"event_id"`
+	Event          string         `json:"event"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	// StatusCode is the HTTP status rejecting the last attempt, zero when it succeeded or no response arrived
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Store persists subscriptions and delivery statuses, e.g. in a database shared by all instances
+type Store interface {
+	// CreateSubscription stores a new subscription
+	CreateSubscription(ctx context.Context, s *Subscription) error
+	// DeleteSubscription removes a subscription, ErrNotFound for unknown ids
+	DeleteSubscription(ctx context.Context, id string) error
+	// GetSubscription returns a subscription, ErrNotFound for unknown ids
+	GetSubscription(ctx context.Context, id string) (*Subscription, error)
+	// ListSubscriptions returns all subscriptions
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+	// SaveDelivery creates or updates the record of a delivery
+	SaveDelivery(ctx context.Context, d *Delivery) error
+	// ListDeliveries returns the deliveries of a subscription, the most recent last
+	ListDeliveries(ctx context.Context, subscriptionID string) ([]*Delivery, error)
+}
+
+// MemoryStore is an in-process Store for single instances and tests
+type MemoryStore struct {
+	mu            sync.RWMutex
+	subscriptions []*Subscription
+	deliveries    []*Delivery
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (m *MemoryStore) CreateSubscription(_ context.Context, s *Subscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sub := *s
+	m.subscriptions = append(m.subscriptions, &sub)
+	return nil
+}
+
+func (m *MemoryStore) DeleteSubscription(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, s := range m.subscriptions {
+		if s.ID == id {
+			m.subscriptions = slices.Delete(m.subscriptions, i, i+1)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (m *MemoryStore) GetSubscription(_ context.Context, id string) (*Subscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, s := range m.subscriptions {
+		if s.ID == id {
+			sub := *s
+			return &sub, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (m *MemoryStore) ListSubscriptions(_ context.Context) ([]*Subscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make([]*Subscription, len(m.subscriptions))
+	for i, s := range m.subscriptions {
+		sub := *s
+		res[i] = &sub
+	}
+	return res, nil
+}
+
+func (m *MemoryStore) SaveDelivery(_ context.Context, d *Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delivery := *d
+	for i, existing := range m.deliveries {
+		if existing.ID == d.ID {
+			m.deliveries[i] = &delivery
+			return nil
+		}
+	}
+	m.deliveries = append(m.deliveries, &delivery)
+	return nil
+}
+
+func (m *MemoryStore) ListDeliveries(_ context.Context, subscriptionID string) ([]*Delivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var res []*Delivery
+	for _, d := range m.deliveries {
+		if d.SubscriptionID == subscriptionID {
+			delivery := *d
+			res = append(res, &delivery)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDispatcher(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	var attempts atomic.Int32
+	received := make(chan *http.Request, 1)
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt fails and is retried
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+	}))
+	defer receiver.Close()
+
+	d := NewDispatcher(Config{Events: []string{"user.created"}, Backoff: func(int) time.Duration { return time.Millisecond }})
+	r := gin.New()
+	d.Register(r)
+
+	w := serve(r, http.MethodPost, "/webhooks/subscriptions", `{"url":"`+receiver.URL+`","events":["user.deleted"]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `unknown event \"user.deleted\"`)
+
+	w = serve(r, http.MethodPost, "/webhooks/subscriptions", `{"url":"`+receiver.URL+`","events":["user.created"]}`)
+	require.Equal(t, http.StatusCreated, w.Code)
+	var sub Subscription
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sub))
+	assert.True(t, strings.HasPrefix(sub.Secret, "whsec_"))
+
+	payload, err := structpb.NewStruct(map[string]any{"name": "alice"})
+	require.NoError(t, err)
+	assert.ErrorContains(t, d.Publish(ctx, "user.deleted", payload), "unknown webhook event")
+	require.NoError(t, d.Publish(ctx, "user.created", payload))
+
+	req := <-received
+	d.Close()
+	assert.Equal(t, "user.created", req.Header.Get(EventHeader))
+	assert.NoError(t, Verify(sub.Secret, req.Header.Get(SignatureHeader), body, time.Now(), 0))
+	assert.Error(t, Verify("whsec_other", req.Header.Get(SignatureHeader), body, time.Now(), 0))
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+	assert.Equal(t, "user.created", event.Type)
+	assert.JSONEq(t, `{"name":"alice"}`, string(event.Data))
+
+	w = serve(r, http.MethodGet, "/webhooks/subscriptions/"+sub.ID+"/deliveries", "")
+	var deliveries struct{ Deliveries []*Delivery }
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &deliveries))
+	require.Len(t, deliveries.Deliveries, 1)
+	assert.Equal(t, StatusSucceeded, deliveries.Deliveries[0].Status)
+	assert.Equal(t, 2, deliveries.Deliveries[0].Attempts)
+	assert.Equal(t, event.ID, deliveries.Deliveries[0].EventID)
+
+	w = serve(r, http.MethodGet, "/webhooks/subscriptions", "")
+	assert.NotContains(t, w.Body.String(), sub.Secret)
+	assert.Equal(t, http.StatusNoContent, serve(r, http.MethodDelete, "/webhooks/subscriptions/"+sub.ID, "").Code)
+	assert.Equal(t, http.StatusNotFound, serve(r, http.MethodDelete, "/webhooks/subscriptions/"+sub.ID, "").Code)
+	assert.ErrorIs(t, d.Publish(ctx, "user.created", payload), ErrClosed)
+}
+
+func TestDeliveryRejected(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer receiver.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	require.NoError(t, store.CreateSubscription(ctx, &Subscription{ID: "s1", URL: receiver.URL, Events: []string{"user.created"}}))
+	d := NewDispatcher(Config{Store: store})
+	require.NoError(t, d.Publish(ctx, "user.created", &structpb.Struct{}))
+	d.Close()
+
+	deliveries, err := store.ListDeliveries(ctx, "s1")
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	// Client errors other than 408 and 429 are not retried
+	assert.Equal(t, StatusFailed, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusGone, deliveries[0].StatusCode)
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"1"}`)
+	sig := Sign("secret", now, body)
+	assert.NoError(t, Verify("secret", sig, body, now.Add(time.Minute), 0))
+	assert.ErrorContains(t, Verify("secret", sig, body, now.Add(time.Hour), 0), "outside the tolerance")
+	assert.ErrorContains(t, Verify("secret", sig, []byte(`{"id":"2"}`), now, 0), "does not match")
+	assert.ErrorContains(t, Verify("secret", "v1=abc", body, now, 0), "malformed")
+}
+
+func serve(r http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
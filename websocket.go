@@ -0,0 +1,149 @@
+package ginpb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-kenka/ginpb/internal/jsonfield"
+)
+
+// WebSocketUpgrader configures the upgrade of WebSocket connections, e.g. buffer sizes and the origin check
+type WebSocketUpgrader = websocket.Upgrader
+
+// ErrInvalidWebSocketMessage is returned by Recv for messages that cannot be decoded into the request type,
+// Close reports it to the client with the "invalid payload data" close code
+var ErrInvalidWebSocketMessage = errors.New("invalid websocket message")
+
+// WebSocketStream is the bidirectional stream of a client or bidirectional streaming method served over
+// a WebSocket connection. Every request and reply is one JSON text message. Recv returns io.EOF once the
+// client closes the connection normally, Close ends the stream with the result of the service method.
+type WebSocketStream[Req, Reply any] struct {
+	gin         *gin.Context
+	conn        *websocket.Conn
+	ctx         context.Context
+	cancel      context.CancelFunc
+	naming      JSONNaming
+	prepareSend func(*Reply) error
+	prepareRecv func(*Req) error
+	sendMu      sync.Mutex
+}
+
+// UpgradeWebSocket upgrades the request of ctx to a WebSocket connection. On failure the upgrader has
+// already written an HTTP error response. A nil upgrader accepts same-origin requests only.
+func UpgradeWebSocket[Req, Reply any](ctx *gin.Context, naming JSONNaming, upgrader *WebSocketUpgrader) (*WebSocketStream[Req, Reply], error) {
+	if upgrader == nil {
+		upgrader = &WebSocketUpgrader{}
+	}
+	conn, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		return nil, err
+	}
+	streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+	return &WebSocketStream[Req, Reply]{gin: ctx, conn: conn, ctx: streamCtx, cancel: cancel, naming: naming}, nil
+}
+
+// Prepare sets a function applied to every reply before it is sent, generated handlers use it to encrypt fields
+func (s *WebSocketStream[Req, Reply]) Prepare(fn func(*Reply) error) {
+	s.prepareSend = fn
+}
+
+// PrepareRecv sets a function applied to every received request, generated handlers use it to decrypt fields
+func (s *WebSocketStream[Req, Reply]) PrepareRecv(fn func(*Req) error) {
+	s.prepareRecv = fn
+}
+
+// Context returns the stream context, it is cancelled once Recv observes a closed or broken connection
+func (s *WebSocketStream[Req, Reply]) Context() context.Context {
+	return s.ctx
+}
+
+// Send writes one reply, it is safe to call concurrently with Recv
+func (s *WebSocketStream[Req, Reply]) Send(msg *Reply) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	if s.prepareSend != nil {
+		if err := s.prepareSend(msg); err != nil {
+			return err
+		}
+	}
+	data, ok, err := s.naming.marshal(msg)
+	if !ok {
+		data, err = json.Marshal(msg)
+	}
+	if err != nil {
+		return err
+	}
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// Recv reads the next request, it returns io.EOF when the client closed the connection normally
+func (s *WebSocketStream[Req, Reply]) Recv() (*Req, error) {
+	_, data, err := s.conn.ReadMessage()
+	if err != nil {
+		s.cancel()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	msg := new(Req)
+	m, ok := any(msg).(proto.Message)
+	if ok && jsonfield.HasEncodedBytes(m.ProtoReflect().Descriptor()) {
+		data, err = jsonfield.RecodeBytes(data, m.ProtoReflect().Descriptor(), true)
+	}
+	switch {
+	case err != nil:
+	case ok && s.naming.naming() != JSONDefault:
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+	case ok:
+		// Accept 64-bit integers sent as strings by protojson peers
+		if data, err = convertInt64(data, m.ProtoReflect().Descriptor(), false); err == nil {
+			err = json.Unmarshal(data, msg)
+		}
+	default:
+		err = json.Unmarshal(data, msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebSocketMessage, err)
+	}
+	if s.prepareRecv != nil {
+		if err := s.prepareRecv(msg); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// Close finishes the stream with the result of the service method and closes the connection.
+// A nil error sends a normal closure, otherwise the error is reported to gin and sent as close reason.
+func (s *WebSocketStream[Req, Reply]) Close(err error) {
+	defer s.cancel()
+	defer s.conn.Close()
+	code, reason := websocket.CloseNormalClosure, ""
+	if err != nil && !errors.Is(err, io.EOF) {
+		_ = s.gin.Error(err)
+		code, reason = websocket.CloseInternalServerErr, err.Error()
+		if errors.Is(err, ErrInvalidWebSocketMessage) {
+			code = websocket.CloseInvalidFramePayloadData
+		}
+		// Control frames carry at most 123 bytes of reason
+		if len(reason) > 123 {
+			reason = reason[:123]
+		}
+	}
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	_ = s.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
@@ -0,0 +1,89 @@
+package ginpb
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	type message struct {
+		Text string `json:"text"`
+	}
+	e := gin.New()
+	e.GET("/chat", func(c *gin.Context) {
+		s, err := UpgradeWebSocket[message, message](c, JSONDefault, nil)
+		if err != nil {
+			return
+		}
+		s.Prepare(func(m *message) error {
+			m.Text = strings.ToUpper(m.Text)
+			return nil
+		})
+		// Echo every message until the client closes the connection or sends "fail"
+		for {
+			in, err := s.Recv()
+			if errors.Is(err, io.EOF) {
+				s.Close(nil)
+				return
+			}
+			if err == nil && in.Text == "fail" {
+				err = errors.New("chat failed")
+			}
+			if err == nil {
+				err = s.Send(in)
+			}
+			if err != nil {
+				s.Close(err)
+				return
+			}
+		}
+	})
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	dial := func() *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/chat", nil)
+		require.NoError(t, err)
+		return conn
+	}
+
+	conn := dial()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"hi"}`)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"HI"}`, string(data))
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "normal closure echoed, got %v", err)
+	conn.Close()
+
+	// Errors of the service method are sent as close reason
+	conn = dial()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"fail"}`)))
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, websocket.CloseInternalServerErr, closeErr.Code)
+	assert.Equal(t, "chat failed", closeErr.Text)
+	conn.Close()
+
+	conn = dial()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`not json`)))
+	_, _, err = conn.ReadMessage()
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, websocket.CloseInvalidFramePayloadData, closeErr.Code)
+	conn.Close()
+
+	// Plain HTTP requests are rejected by the upgrader
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest("GET", "/chat", nil))
+	assert.Equal(t, 400, w.Code)
+}
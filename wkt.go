@@ -0,0 +1,120 @@
+package ginpb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Duration is the type of google.protobuf.Duration fields in generated gin structs, it binds the protojson
+// form "1.5s" as well as Go durations like "1m30s" from JSON, queries and paths
+type Duration time.Duration
+
+// UnmarshalJSON accepts duration strings and numbers of seconds
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	s := strings.TrimSpace(string(b))
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return d.UnmarshalParam(unquoted)
+	}
+	seconds, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid duration %s: expected a string like \"1.5s\" or a number of seconds", b)
+	}
+	*d = Duration(seconds * float64(time.Second))
+	return nil
+}
+
+// UnmarshalParam binds query and path parameters, see gin binding.BindUnmarshaler
+func (d *Duration) UnmarshalParam(param string) error {
+	v, err := time.ParseDuration(param)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", param, err)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// TimestampProto converts a bound time, nil stays nil
+func TimestampProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// TimestampFromProto converts a timestamp to its bound time, nil stays nil
+func TimestampFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// DurationProto converts a bound duration, nil stays nil
+func DurationProto(d *Duration) *durationpb.Duration {
+	if d == nil {
+		return nil
+	}
+	return durationpb.New(time.Duration(*d))
+}
+
+// DurationFromProto converts a duration to its bound duration, nil stays nil
+func DurationFromProto(d *durationpb.Duration) *Duration {
+	if d == nil {
+		return nil
+	}
+	v := Duration(d.AsDuration())
+	return &v
+}
+
+// StructProto converts a bound JSON object, values that have no google.protobuf.Value form are dropped
+func StructProto(m map[string]any) *structpb.Struct {
+	if m == nil {
+		return nil
+	}
+	s := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(m))}
+	for k, v := range m {
+		if value, err := structpb.NewValue(v); err == nil {
+			s.Fields[k] = value
+		}
+	}
+	return s
+}
+
+// StructFromProto converts a struct to its bound JSON object, nil stays nil
+func StructFromProto(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// Wrap converts a bound optional value to a wrapper with wrap, e.g. wrapperspb.String, nil stays nil
+func Wrap[T any, W any](v *T, wrap func(T) W) W {
+	if v == nil {
+		var zero W
+		return zero
+	}
+	return wrap(*v)
+}
+
+// Unwrap converts a wrapper, e.g. *wrapperspb.StringValue, to its bound optional value, nil stays nil
+func Unwrap[T any, P any, W interface {
+	*P
+	GetValue() T
+}](w W) *T {
+	if w == nil {
+		return nil
+	}
+	v := w.GetValue()
+	return &v
+}
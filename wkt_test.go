@@ -0,0 +1,47 @@
+package ginpb
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDurationBinding(t *testing.T) {
+	var v struct {
+		TTL     *Duration `json:"ttl" form:"ttl"`
+		Timeout Duration  `json:"timeout"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"ttl":"1.5s","timeout":2}`), &v))
+	assert.Equal(t, Duration(1500*time.Millisecond), *v.TTL)
+	assert.Equal(t, Duration(2*time.Second), v.Timeout)
+	assert.Equal(t, durationpb.New(1500*time.Millisecond), DurationProto(v.TTL))
+
+	require.NoError(t, binding.MapFormWithTag(&v, url.Values{"ttl": {"1m30s"}}, "form"))
+	assert.Equal(t, Duration(90*time.Second), *v.TTL)
+
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"ttl":"soon"}`), &v), `invalid duration "soon"`)
+	assert.Nil(t, DurationProto(nil))
+}
+
+func TestWellKnownConversions(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	assert.Equal(t, now, *TimestampFromProto(TimestampProto(&now)))
+	assert.Nil(t, TimestampFromProto(nil))
+
+	m := map[string]any{"a": 1.0, "b": []any{true, "x"}}
+	assert.Equal(t, m, StructFromProto(StructProto(m)))
+	assert.Nil(t, StructProto(nil))
+
+	nick := "n"
+	assert.Equal(t, "n", Wrap(&nick, wrapperspb.String).GetValue())
+	assert.Nil(t, Wrap(nil, wrapperspb.String))
+	assert.Equal(t, &nick, Unwrap[string](wrapperspb.String("n")))
+	assert.Nil(t, Unwrap[string]((*wrapperspb.StringValue)(nil)))
+}